@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "irmc-redfish_bios_pending" "pending" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+}
+
+output "bios_reboot_required" {
+  value = { for k, v in data.irmc-redfish_bios_pending.pending : k => v.reboot_required }
+}
+
+output "bios_pending_attributes" {
+  value = { for k, v in data.irmc-redfish_bios_pending.pending : k => v.pending_attributes }
+}
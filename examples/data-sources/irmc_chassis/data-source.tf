@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "irmc-redfish_irmc_chassis" "chassis" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+}
+
+// FRU data of main chassis in format usable e.g. for import of devices into NetBox
+output "devices" {
+  value = {
+    for key, ds in data.irmc-redfish_irmc_chassis.chassis : key => {
+      manufacturer  = ds.chassis[0].manufacturer
+      model         = ds.chassis[0].model
+      part_number   = ds.chassis[0].part_number
+      serial_number = ds.chassis[0].serial_number
+      asset_tag     = ds.chassis[0].asset_tag
+      height_mm     = ds.chassis[0].height_mm
+    }
+  }
+}
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "irmc-redfish_irmc_gpus" "gpus" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+}
+
+// Expected number of healthy GPUs per node before it joins the cluster
+resource "terraform_data" "cluster_node" {
+  for_each = var.rack1
+
+  lifecycle {
+    precondition {
+      condition     = length([for gpu in data.irmc-redfish_irmc_gpus.gpus[each.key].gpus : gpu if gpu.health == "OK"]) == 4
+      error_message = "Node ${each.key} does not report 4 healthy GPUs."
+    }
+  }
+}
+
+output "gpus" {
+  value = {
+    for key, ds in data.irmc-redfish_irmc_gpus.gpus : key => [
+      for gpu in ds.gpus : {
+        model               = gpu.model
+        memory_mib          = gpu.memory_mib
+        firmware_version    = gpu.firmware_version
+        temperature_celsius = gpu.temperature_celsius
+      }
+    ]
+  }
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "irmc-redfish_irmc_health" "health" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+}
+
+// Firmware is updated only on servers without hardware problems
+resource "irmc-redfish_irmc_firmware_update" "irmc" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  update_type      = "TFTP"
+  tftp_server_addr = "10.172.181.125"
+  tftp_update_file = "irmc/RX2530M7/RX2530M7_02.58f_sdr03.83.bin"
+
+  lifecycle {
+    precondition {
+      condition     = data.irmc-redfish_irmc_health.health[each.key].health == "OK"
+      error_message = "Server ${each.key} is not healthy: power supplies ${jsonencode(data.irmc-redfish_irmc_health.health[each.key].power_supplies.unhealthy)}, fans ${jsonencode(data.irmc-redfish_irmc_health.health[each.key].fans.unhealthy)}."
+    }
+  }
+}
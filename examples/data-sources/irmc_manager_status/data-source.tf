@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+data "irmc-redfish_irmc_manager_status" "ms" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+}
+
+// Firmware update is started only on healthy iRMCs ready to accept long running operations
+resource "irmc-redfish_irmc_firmware_update" "fw" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  update_type         = "File"
+  irmc_path_to_binary = "/tmp/RX2530M7_02.58c_sdr03.83.bin"
+
+  lifecycle {
+    precondition {
+      condition     = data.irmc-redfish_irmc_manager_status.ms[each.key].ready && data.irmc-redfish_irmc_manager_status.ms[each.key].health == "OK"
+      error_message = "iRMC is not ready or not healthy."
+    }
+  }
+}
+
+output "irmc_uptime" {
+  value = { for k, v in data.irmc-redfish_irmc_manager_status.ms : k => v.uptime }
+}
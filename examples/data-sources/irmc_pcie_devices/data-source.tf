@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "irmc-redfish_irmc_pcie_devices" "pcie" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+}
+
+// GPUs (NVIDIA display controllers) of every server with their slot and link width
+output "gpus" {
+  value = {
+    for key, ds in data.irmc-redfish_irmc_pcie_devices.pcie : key => [
+      for device in ds.pcie_devices : {
+        slot         = device.slot
+        model        = device.model
+        pcie_type    = device.pcie_type
+        lanes_in_use = device.lanes_in_use
+      } if anytrue([for f in device.functions : f.vendor_id == "0x10de" && f.device_class == "DisplayController"])
+    ]
+  }
+}
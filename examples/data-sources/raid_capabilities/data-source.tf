@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "irmc-redfish_raid_capabilities" "raid1" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  storage_controller_serial_number = "SKC49104211"
+  raid_type                        = "RAID1"
+}
+
+// Stripe sizes accepted by controller for RAID1 volume built of SSD drives
+output "raid1_ssd_stripe_sizes" {
+  value = {
+    for key, ds in data.irmc-redfish_raid_capabilities.raid1 : key =>
+    coalescelist(ds.raid_levels[0].stripe_sizes, ds.raid_levels[0].stripe_sizes_ssd)
+  }
+}
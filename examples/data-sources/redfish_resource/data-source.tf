@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "irmc-redfish_redfish_resource" "system" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  path = "/redfish/v1/Systems/0"
+}
+
+output "power_state" {
+  value = { for k, v in data.irmc-redfish_redfish_resource.system : k => v.attributes["PowerState"] }
+}
+
+output "total_memory_gib" {
+  value = { for k, v in data.irmc-redfish_redfish_resource.system : k => jsondecode(v.json).MemorySummary.TotalSystemMemoryGiB }
+}
@@ -29,3 +29,12 @@ output "virtual_media" {
   value     = data.irmc-redfish_virtual_media.vm
   sensitive = true
 }
+
+// Images already attached to one of the slots, e.g. to skip mounting them again
+output "attached_images" {
+  value = {
+    for key, vm in data.irmc-redfish_virtual_media.vm : key => [
+      for slot in vm.virtual_media : slot.image if slot.inserted
+    ]
+  }
+}
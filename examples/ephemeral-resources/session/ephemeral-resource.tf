@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Requires Terraform 1.10 or later
+ephemeral "irmc-redfish_session" "session" {
+  server {
+    username     = var.rack1["batman"].username
+    password     = var.rack1["batman"].password
+    endpoint     = var.rack1["batman"].endpoint
+    ssl_insecure = var.rack1["batman"].ssl_insecure
+  }
+}
+
+// Token is never stored in plan or state, session is logged out once Terraform does not need it
+resource "terraform_data" "configure" {
+  provisioner "local-exec" {
+    command = "curl -sk -H \"X-Auth-Token: $TOKEN\" $ENDPOINT/redfish/v1/Systems/0"
+    environment = {
+      TOKEN    = ephemeral.irmc-redfish_session.session.token
+      ENDPOINT = ephemeral.irmc-redfish_session.session.endpoint
+    }
+  }
+}
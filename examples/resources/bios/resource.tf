@@ -24,9 +24,19 @@ resource "irmc-redfish_bios" "bio" {
     ssl_insecure = each.value.ssl_insecure
   }
 
+  // Built-in profile (Virtualization, LowLatency or PowerSave) expanded for Intel or AMD platform
+  # profile = "Virtualization"
+
+  // Attributes take precedence over attributes of profile
   attributes = {
     "AssetTag" : "MyTagAZZ"
     "BIOSParameterBackup" : "Enabled"
   }
   system_reset_type = "ForceRestart"
+
+  // Use "OnNextReboot" to only stage settings without host reset
+  apply_time = "Immediate"
+
+  // Fail immediately if host is not powered on, instead of waiting for job_timeout
+  # required_host_state = "On"
 }
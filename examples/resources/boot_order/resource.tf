@@ -29,6 +29,8 @@ resource "irmc-redfish_boot_order" "bo" {
   # boot_order = [ "HD.Emb.0.5", "NIC.LOM.1.2.IPv4PXE", "NIC.LOM.2.3.IPv4PXE" ]
   #  boot_order = [ "HD.Emb.0.5", "NIC.LOM.2.3.IPv4PXE", "NIC.LOM.1.2.IPv4PXE" ]
   #  boot_order = ["NIC.LOM.2.3.IPv4PXE", "HD.Emb.0.5", "HD.Emb.0.5"]
+  // Entries might be also defined using DeviceName reported by the system
+  #  boot_order = ["PCI LAN: IPv4 PXE (LOM 2)", "UEFI: Embedded HDD", "NIC.LOM.1.2.IPv4PXE"]
 
   // rx4770m7
   #  boot_order = ["RAID.Slot.4.0", "SATA.Emb.1.1", "RAID.Slot.4.0.EFI_BOOT_BOOTX64", "NIC.LOM.0.1.IPv4.PXE", "NIC.LOM.0.1.IPv6.PXE", "HD.Emb.1.1"]
@@ -59,4 +61,23 @@ resource "irmc-redfish_boot_order" "bo" {
   #  ]
 
   system_reset_type = "ForceRestart"
+
+  // Fail immediately if host is not powered on, instead of waiting for job_timeout
+  # required_host_state = "On"
+}
+
+resource "irmc-redfish_boot_order" "bo_prefix" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // Only listed devices are moved to the front of boot order
+  mode       = "prefix"
+  boot_order = ["HD.Emb.0.5"]
+
+  system_reset_type = "ForceRestart"
 }
@@ -24,8 +24,38 @@ resource "irmc-redfish_boot_source_override" "bso" {
     ssl_insecure = each.value.ssl_insecure
   }
 
-  boot_source_override_enabled = "Continues"
-  boot_source_override_target  = "Hdd"
+  boot_source_override_target  = "Pxe"
+  boot_source_override_enabled = "Once"
 
+  // Optional, if not set override will be applied during next boot of the host
   system_reset_type = "ForceRestart"
 }
+
+resource "irmc-redfish_boot_source_override" "http_boot" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  boot_source_override_target  = "UefiHttp"
+  boot_source_override_enabled = "Once"
+  http_boot_uri                = "http://10.172.181.125:8080/images/rhel9.iso"
+}
+
+resource "irmc-redfish_boot_source_override" "bios_setup" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // Enter BIOS setup during next boot and reboot the host right away
+  boot_source_override_target  = "BiosSetup"
+  boot_source_override_enabled = "Once"
+  system_reset_type            = "ForceRestart"
+}
@@ -0,0 +1,31 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Firmware update of drives in slots 0-3, image is downloaded by iRMC
+resource "irmc-redfish_drive_firmware_update" "drive_fw" {
+  for_each = toset(["252-0", "252-1", "252-2", "252-3"])
+  server {
+    username     = var.rack1["theodore"].username
+    password     = var.rack1["theodore"].password
+    endpoint     = var.rack1["theodore"].endpoint
+    ssl_insecure = var.rack1["theodore"].ssl_insecure
+  }
+
+  storage_controller_serial_number = "SKC49104211"
+  slot                             = each.key
+  transfer_protocol                = "http"
+  update_image                     = "10.172.200.100/binaries/drive_fw.bin"
+}
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Data drives of storage node are exposed to the host as JBOD,
+// drives 0 and 1 are left for RAID1 system volume
+locals {
+  drive_modes = { "0" = "UnconfiguredGood", "1" = "UnconfiguredGood", "2" = "JBOD", "3" = "JBOD", "4" = "JBOD", "5" = "JBOD" }
+  drives = merge([
+    for key, server in var.rack1 : {
+      for slot, mode in local.drive_modes : "${key}-${slot}" => merge(server, { slot = slot, mode = mode })
+    }
+  ]...)
+}
+
+resource "irmc-redfish_drive_mode" "drive" {
+  for_each = local.drives
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  storage_controller_serial_number = "SKC49104211"
+  slot                             = each.value.slot
+  mode                             = each.value.mode
+}
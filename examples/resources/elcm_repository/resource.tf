@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+resource "irmc-redfish_elcm_repository" "repo" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // Only defined settings are changed, others are read from iRMC
+  repository_server = "https://support.ts.fujitsu.com"
+  repository_path   = "DownloadManager/globalflash"
+  proxy_server      = "proxy.example.com"
+  proxy_port        = 3128
+  proxy_user        = "updates"
+  proxy_password    = var.elcm_proxy_password
+
+  // Check for available updates every week during the night
+  update_check_enabled  = true
+  update_check_interval = "Weekly"
+  update_check_time     = "02:00"
+}
@@ -29,4 +29,7 @@ resource "irmc-redfish_irmc_attributes" "attr" {
     "BmcSnmpServiceCommunityName" : "public"
     "BmcCasAssignConfiguredPermissions" : "yyy"
   }
+
+  // Values of these attributes are applied, but their drift is not detected
+  // ignore_attributes = ["BmcCasAssignConfiguredPermissions"]
 }
@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Decommissioning: iRMC settings are reset except network and user accounts, BIOS defaults are applied by host restart
+resource "irmc-redfish_irmc_factory_reset" "decommission" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  confirm_reset     = true
+  reset_irmc        = true
+  keep_network      = true
+  keep_users        = true
+  reset_bios        = true
+  system_reset_type = "ForceRestart"
+}
@@ -28,4 +28,13 @@ resource "irmc-redfish_irmc_firmware_update" "irmcfu" {
   tftp_update_file    = "irmc/RX2530M7/RX2530M7_02.58f_sdr03.83.bin"
   irmc_path_to_binary = "/home/polecp/terraform/terraform-irmc-provider/examples/resources/irmc_firmware_update/firmware_upd_file/RX2530M7_02.58c_sdr03.83.bin"
 
+  // Used if update_type = "HTTPS"
+  # https_url          = "https://10.172.181.125:8006/irmc/RX2530M7/RX2530M7_02.58f_sdr03.83.bin"
+  # https_proxy        = "http://proxy.example.com:3128"
+  # https_ssl_insecure = false
+
+  // Only compare running firmware version with version of the update (derived from file name if not defined)
+  # check_only     = true
+  # target_version = "2.58c"
+
 }
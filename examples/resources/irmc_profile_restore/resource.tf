@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// BIOS configuration taken from a reference server is applied to every server,
+// it is applied again whenever the profile file changes
+resource "irmc-redfish_irmc_profile_restore" "restore" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  file_path = "${path.module}/reference-profile.json"
+  sections  = ["BiosConfig"]
+
+  triggers = {
+    profile = filesha256("${path.module}/reference-profile.json")
+  }
+}
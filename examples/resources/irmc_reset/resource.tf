@@ -24,3 +24,22 @@ resource "irmc-redfish_irmc_reset" "irmc_rst" {
     ssl_insecure = each.value.ssl_insecure
   }
 }
+
+// Reset between configuration stages, which verifies activated firmware
+resource "irmc-redfish_irmc_reset" "irmc_rst_stage" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  reset_type                = "ForceRestart"
+  ready_timeout             = 900
+  expected_firmware_version = "3.52"
+
+  triggers = {
+    stage = "network-configured"
+  }
+}
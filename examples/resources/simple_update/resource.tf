@@ -27,4 +27,14 @@ resource "irmc-redfish_simple_update" "s_update" {
   ume_tool_directory_name = "Hello"
   transfer_protocol       = "http"
   update_image            = "10.172.181.97:8080/BIOS/D3931_C1_1_50_BIOS.zip"
+
+  // Alternatively image can be uploaded from local file instead of transfer_protocol and update_image
+  # update_file = "/home/user/firmware/D3931_C1_1_50_BIOS.zip"
+}
+
+// Host reboot is needed only if any component has been updated
+output "reboot_required" {
+  value = {
+    for key, update in irmc-redfish_simple_update.s_update : key => length(update.updated_components) > 0
+  }
 }
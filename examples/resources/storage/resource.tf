@@ -35,4 +35,5 @@ resource "irmc-redfish_storage" "storage" {
   rebuild_rate                     = 32
   migration_rate                   = 36
   auto_rebuild_enabled             = false
+  // boot_volume                   = "/redfish/v1/Systems/0/Storage/0/Volumes/0"
 }
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+resource "irmc-redfish_storage_layout" "layout" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  storage_controller_serial_number = "SKC4910421"
+
+  // Optional, volumes not declared below are deleted from the controller
+  delete_extra_volumes = true
+
+  volumes = [
+    {
+      name                  = "os"
+      raid_type             = "RAID1"
+      optimum_io_size_bytes = 65536
+      physical_drives       = ["[\"0\", \"1\"]"]
+    },
+    {
+      name                  = "data"
+      raid_type             = "RAID5"
+      optimum_io_size_bytes = 65536
+      init_mode             = "Fast"
+      physical_drives       = ["[\"2\", \"3\", \"4\"]"]
+    },
+  ]
+}
@@ -30,3 +30,61 @@ resource "irmc-redfish_user_account" "ua" {
   user_password = "Testtest123!"
   user_role     = "Administrator"
 }
+
+// With Terraform 1.11 or later password can be defined as write-only argument,
+// so it will not be persisted in state. Change of user_password_wo_version applies new password.
+resource "irmc-redfish_user_account" "ua_wo" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  user_username            = "Tester_2"
+  user_password_wo         = "Testtest123!"
+  user_password_wo_version = 1
+  user_role                = "Operator"
+}
+
+// SSHv2 public keys allow key based login of the user to iRMC CLI.
+resource "irmc-redfish_user_account" "ua_ssh" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  user_username     = "Tester_3"
+  user_password     = "Testtest123!"
+  user_role         = "Operator"
+  user_shell_access = "RemoteManager"
+  user_ssh_public_keys = [
+    "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMRtE3iRnGbTuvFh1nO0oDxdC1sJJ1Lgt0mDN+d3HT6q admin@workstation",
+  ]
+}
+
+// Alerts are routed per user, only defined alert groups are managed.
+resource "irmc-redfish_user_account" "ua_alerts" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  user_username            = "Tester_4"
+  user_password            = "Testtest123!"
+  user_role                = "ReadOnly"
+  user_alert_email_enabled = true
+  user_alert_email_address = "operator@example.com"
+  user_alert_mail_format   = "Standard"
+  user_alert_levels = {
+    "CriticalHardwareErrors" = "All"
+    "TemperatureSensors"     = "Critical"
+  }
+}
@@ -27,3 +27,39 @@ resource "irmc-redfish_virtual_media" "vm" {
   image                  = "10.172.181.125/gauge/vmedia/Cd!123.iso"
   transfer_protocol_type = "HTTPS"
 }
+
+// Authenticated CIFS share, password is not persisted in state (Terraform 1.11 or later)
+resource "irmc-redfish_virtual_media" "vm_cifs" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  image                  = "//10.172.181.125/share/vmedia/disk.img"
+  transfer_protocol_type = "CIFS"
+  user_name              = "deploy"
+  password_wo            = "deployPassword"
+  share_domain           = "LAB"
+
+  // large image accessed over slow link
+  mount_timeout = 300
+}
+
+// Configuration image mounted into explicitly chosen CD device next to OS image
+resource "irmc-redfish_virtual_media" "vm_config" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  image                  = "10.172.181.125/gauge/vmedia/config.iso"
+  transfer_protocol_type = "HTTPS"
+  media_type             = "CD"
+  slot                   = "2"
+}
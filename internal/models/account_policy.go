@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccountPolicyResourceModel describes the resource data model.
+type AccountPolicyResourceModel struct {
+	Id                      types.String    `tfsdk:"id"`
+	RedfishServer           []RedfishServer `tfsdk:"server"`
+	MinPasswordLength       types.Int64     `tfsdk:"min_password_length"`
+	PasswordExpirationDays  types.Int64     `tfsdk:"password_expiration_days"`
+	AccountLockoutThreshold types.Int64     `tfsdk:"account_lockout_threshold"`
+	AccountLockoutDuration  types.Int64     `tfsdk:"account_lockout_duration"`
+	SessionTimeout          types.Int64     `tfsdk:"session_timeout"`
+}
@@ -22,14 +22,25 @@ import (
 )
 
 type BiosResourceModel struct {
-	Id              types.String    `tfsdk:"id"`
-	RedfishServer   []RedfishServer `tfsdk:"server"`
-	Attributes      types.Map       `tfsdk:"attributes"`
-	SystemResetType types.String    `tfsdk:"system_reset_type"`
-	JobTimeout      types.Int64     `tfsdk:"job_timeout"`
+	Id                types.String    `tfsdk:"id"`
+	RedfishServer     []RedfishServer `tfsdk:"server"`
+	Attributes        types.Map       `tfsdk:"attributes"`
+	Profile           types.String    `tfsdk:"profile"`
+	ProfileAttributes types.Map       `tfsdk:"profile_attributes"`
+	SystemResetType   types.String    `tfsdk:"system_reset_type"`
+	ApplyTime         types.String    `tfsdk:"apply_time"`
+	JobTimeout        types.Int64     `tfsdk:"job_timeout"`
+	RequiredHostState types.String    `tfsdk:"required_host_state"`
 }
 
 type BiosDataSourceModel struct {
 	RedfishServer []RedfishServer `tfsdk:"server"`
 	Attributes    types.Map       `tfsdk:"attributes"`
 }
+
+type BiosPendingDataSourceModel struct {
+	RedfishServer     []RedfishServer `tfsdk:"server"`
+	ID                types.String    `tfsdk:"id"`
+	PendingAttributes types.Map       `tfsdk:"pending_attributes"`
+	RebootRequired    types.Bool      `tfsdk:"reboot_required"`
+}
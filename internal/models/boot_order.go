@@ -22,9 +22,14 @@ import (
 )
 
 type BootOrderResourceModel struct {
-	Id              types.String    `tfsdk:"id"`
-	RedfishServer   []RedfishServer `tfsdk:"server"`
-	BootOrder       types.List      `tfsdk:"boot_order"`
-	SystemResetType types.String    `tfsdk:"system_reset_type"`
-	JobTimeout      types.Int64     `tfsdk:"job_timeout"`
+	Id                   types.String    `tfsdk:"id"`
+	RedfishServer        []RedfishServer `tfsdk:"server"`
+	BootOrder            types.List      `tfsdk:"boot_order"`
+	BootOrderDeviceNames types.List      `tfsdk:"boot_order_device_names"`
+	Mode                 types.String    `tfsdk:"mode"`
+	ApplyMode            types.String    `tfsdk:"apply_mode"`
+	PendingBootOrder     types.List      `tfsdk:"pending_boot_order"`
+	SystemResetType      types.String    `tfsdk:"system_reset_type"`
+	JobTimeout           types.Int64     `tfsdk:"job_timeout"`
+	RequiredHostState    types.String    `tfsdk:"required_host_state"`
 }
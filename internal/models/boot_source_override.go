@@ -21,8 +21,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// VirtualMediaResourceModel describes the resource data model.
+// BootSourceOverrideResourceModel describes the resource data model.
 type BootSourceOverrideResourceModel struct {
+	Id                        types.String    `tfsdk:"id"`
+	RedfishServer             []RedfishServer `tfsdk:"server"`
+	BootSourceOverrideTarget  types.String    `tfsdk:"boot_source_override_target"`
+	BootSourceOverrideEnabled types.String    `tfsdk:"boot_source_override_enabled"`
+	HttpBootUri               types.String    `tfsdk:"http_boot_uri"`
+	SystemResetType           types.String    `tfsdk:"system_reset_type"`
+	JobTimeout                types.Int64     `tfsdk:"job_timeout"`
+}
+
+// BootSourceOverrideResourceModelV0 describes data model of schema version 0,
+// in which override has been configured through OEM BootConfig endpoint.
+type BootSourceOverrideResourceModelV0 struct {
 	Id                        types.String    `tfsdk:"id"`
 	RedfishServer             []RedfishServer `tfsdk:"server"`
 	BootSourceOverrideTarget  types.String    `tfsdk:"boot_source_override_target"`
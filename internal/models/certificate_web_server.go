@@ -27,4 +27,5 @@ type CertificateWebServerResourceModel struct {
 	RedfishServer  []RedfishServer `tfsdk:"server"`
 	CertPrivateKey types.String    `tfsdk:"cert_private_key"`
 	CertPublicKey  types.String    `tfsdk:"cert_public_key"`
+	ReadyTimeout   types.Int64     `tfsdk:"ready_timeout"`
 }
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DriveFirmwareUpdateResourceModel describes the resource data model.
+type DriveFirmwareUpdateResourceModel struct {
+	Id                      types.String    `tfsdk:"id"`
+	RedfishServer           []RedfishServer `tfsdk:"server"`
+	StorageControllerSN     types.String    `tfsdk:"storage_controller_serial_number"`
+	Slot                    types.String    `tfsdk:"slot"`
+	DurableName             types.String    `tfsdk:"durable_name"`
+	Protocol                types.String    `tfsdk:"transfer_protocol"`
+	UpdateImage             types.String    `tfsdk:"update_image"`
+	UpdateFile              types.String    `tfsdk:"update_file"`
+	UploadTimeout           types.Int64     `tfsdk:"upload_timeout"`
+	UpdateTimeout           types.Int64     `tfsdk:"update_timeout"`
+	DriveSerialNumber       types.String    `tfsdk:"drive_serial_number"`
+	PreviousFirmwareVersion types.String    `tfsdk:"previous_firmware_version"`
+	FirmwareVersion         types.String    `tfsdk:"firmware_version"`
+}
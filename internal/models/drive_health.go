@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DriveHealthDataSourceModel struct {
+	RedfishServer       []RedfishServer   `tfsdk:"server"`
+	StorageControllerSN types.String      `tfsdk:"storage_controller_serial_number"`
+	Drives              []DriveHealthData `tfsdk:"drives"`
+}
+
+type DriveHealthData struct {
+	Id                      types.String  `tfsdk:"id"`
+	Name                    types.String  `tfsdk:"name"`
+	Slot                    types.String  `tfsdk:"slot"`
+	Model                   types.String  `tfsdk:"model"`
+	SerialNumber            types.String  `tfsdk:"serial_number"`
+	MediaType               types.String  `tfsdk:"media_type"`
+	Protocol                types.String  `tfsdk:"protocol"`
+	Health                  types.String  `tfsdk:"health"`
+	State                   types.String  `tfsdk:"state"`
+	FailurePredicted        types.Bool    `tfsdk:"failure_predicted"`
+	MediaLifeLeftPercent    types.Float64 `tfsdk:"predicted_media_life_left_percent"`
+	PercentageUsed          types.Float64 `tfsdk:"percentage_used"`
+	PowerOnHours            types.Float64 `tfsdk:"power_on_hours"`
+	BadBlockCount           types.Int64   `tfsdk:"bad_block_count"`
+	UncorrectableErrorCount types.Int64   `tfsdk:"uncorrectable_error_count"`
+}
@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ElcmUpdateResourceModel describes the resource data model.
+type ElcmUpdateResourceModel struct {
+	Id               types.String    `tfsdk:"id"`
+	RedfishServer    []RedfishServer `tfsdk:"server"`
+	RepositoryServer types.String    `tfsdk:"repository_server"`
+	RepositoryPath   types.String    `tfsdk:"repository_path"`
+	SystemResetType  types.String    `tfsdk:"system_reset_type"`
+	PrepareTimeout   types.Int64     `tfsdk:"prepare_timeout"`
+	UpdateTimeout    types.Int64     `tfsdk:"update_timeout"`
+}
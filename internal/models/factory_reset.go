@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FactoryResetResourceModel describes the resource data model.
+type FactoryResetResourceModel struct {
+	Id              types.String    `tfsdk:"id"`
+	RedfishServer   []RedfishServer `tfsdk:"server"`
+	ConfirmReset    types.Bool      `tfsdk:"confirm_reset"`
+	ResetIrmc       types.Bool      `tfsdk:"reset_irmc"`
+	KeepNetwork     types.Bool      `tfsdk:"keep_network"`
+	KeepUsers       types.Bool      `tfsdk:"keep_users"`
+	ResetBios       types.Bool      `tfsdk:"reset_bios"`
+	SystemResetType types.String    `tfsdk:"system_reset_type"`
+	JobTimeout      types.Int64     `tfsdk:"job_timeout"`
+	Triggers        types.Map       `tfsdk:"triggers"`
+}
@@ -22,10 +22,12 @@ import (
 )
 
 type IrmcAttributesResourceModel struct {
-	Id            types.String    `tfsdk:"id"`
-	RedfishServer []RedfishServer `tfsdk:"server"`
-	Attributes    types.Map       `tfsdk:"attributes"`
-	JobTimeout    types.Int64     `tfsdk:"job_timeout"`
+	Id               types.String    `tfsdk:"id"`
+	RedfishServer    []RedfishServer `tfsdk:"server"`
+	Attributes       types.Map       `tfsdk:"attributes"`
+	IgnoreAttributes types.List      `tfsdk:"ignore_attributes"`
+	JobTimeout       types.Int64     `tfsdk:"job_timeout"`
+	ReadyTimeout     types.Int64     `tfsdk:"ready_timeout"`
 }
 
 type IrmcAttributesDataSourceModel struct {
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IrmcChassisDataSourceModel struct {
+	RedfishServer []RedfishServer   `tfsdk:"server"`
+	Chassis       []IrmcChassisData `tfsdk:"chassis"`
+}
+
+type IrmcChassisData struct {
+	Id           types.String  `tfsdk:"id"`
+	Name         types.String  `tfsdk:"name"`
+	ChassisType  types.String  `tfsdk:"chassis_type"`
+	Manufacturer types.String  `tfsdk:"manufacturer"`
+	Model        types.String  `tfsdk:"model"`
+	SKU          types.String  `tfsdk:"sku"`
+	PartNumber   types.String  `tfsdk:"part_number"`
+	SerialNumber types.String  `tfsdk:"serial_number"`
+	AssetTag     types.String  `tfsdk:"asset_tag"`
+	UUID         types.String  `tfsdk:"uuid"`
+	PowerState   types.String  `tfsdk:"power_state"`
+	Health       types.String  `tfsdk:"health"`
+	HeightMm     types.Float64 `tfsdk:"height_mm"`
+	WidthMm      types.Float64 `tfsdk:"width_mm"`
+	DepthMm      types.Float64 `tfsdk:"depth_mm"`
+	WeightKg     types.Float64 `tfsdk:"weight_kg"`
+}
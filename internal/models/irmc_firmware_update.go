@@ -29,8 +29,17 @@ type IrmcFirmwareUpdateResourceModel struct {
 	IRMCPathToBinary     types.String    `tfsdk:"irmc_path_to_binary"`
 	TftpServerAddr       types.String    `tfsdk:"tftp_server_addr"`
 	TftpUpdateFile       types.String    `tfsdk:"tftp_update_file"`
+	HttpsUrl             types.String    `tfsdk:"https_url"`
+	HttpsProxy           types.String    `tfsdk:"https_proxy"`
+	HttpsSslInsecure     types.Bool      `tfsdk:"https_ssl_insecure"`
 	IRMCFlashSelector    types.String    `tfsdk:"irmc_flash_selector"`
 	IRMCBootSelector     types.String    `tfsdk:"irmc_boot_selector"`
 	UpdateTimeout        types.Int64     `tfsdk:"update_timeout"`
+	UploadTimeout        types.Int64     `tfsdk:"upload_timeout"`
 	ResetIrmcAfterUpdate types.Bool      `tfsdk:"reset_irmc_after_update"`
+	ApplySchedule        types.String    `tfsdk:"apply_schedule"`
+	CheckOnly            types.Bool      `tfsdk:"check_only"`
+	TargetVersion        types.String    `tfsdk:"target_version"`
+	RunningVersion       types.String    `tfsdk:"running_version"`
+	UpdateRequired       types.Bool      `tfsdk:"update_required"`
 }
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IrmcGpusDataSourceModel struct {
+	RedfishServer []RedfishServer `tfsdk:"server"`
+	Count         types.Int64     `tfsdk:"count"`
+	Gpus          []IrmcGpuData   `tfsdk:"gpus"`
+}
+
+type IrmcGpuData struct {
+	Id                 types.String  `tfsdk:"id"`
+	Name               types.String  `tfsdk:"name"`
+	Manufacturer       types.String  `tfsdk:"manufacturer"`
+	Model              types.String  `tfsdk:"model"`
+	SerialNumber       types.String  `tfsdk:"serial_number"`
+	PartNumber         types.String  `tfsdk:"part_number"`
+	FirmwareVersion    types.String  `tfsdk:"firmware_version"`
+	MemoryMiB          types.Int64   `tfsdk:"memory_mib"`
+	MemoryType         types.String  `tfsdk:"memory_type"`
+	TemperatureCelsius types.Float64 `tfsdk:"temperature_celsius"`
+	Health             types.String  `tfsdk:"health"`
+}
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IrmcHealthDataSourceModel struct {
+	RedfishServer []RedfishServer     `tfsdk:"server"`
+	Health        types.String        `tfsdk:"health"`
+	HealthRollup  types.String        `tfsdk:"health_rollup"`
+	Processors    IrmcHealthSubsystem `tfsdk:"processors"`
+	Memory        IrmcHealthSubsystem `tfsdk:"memory"`
+	Storage       IrmcHealthSubsystem `tfsdk:"storage"`
+	PowerSupplies IrmcHealthSubsystem `tfsdk:"power_supplies"`
+	Fans          IrmcHealthSubsystem `tfsdk:"fans"`
+	Temperatures  IrmcHealthSubsystem `tfsdk:"temperatures"`
+}
+
+type IrmcHealthSubsystem struct {
+	Health    types.String   `tfsdk:"health"`
+	Total     types.Int64    `tfsdk:"total"`
+	Unhealthy []types.String `tfsdk:"unhealthy"`
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IrmcPcieDevicesDataSourceModel struct {
+	RedfishServer []RedfishServer      `tfsdk:"server"`
+	PcieDevices   []IrmcPcieDeviceData `tfsdk:"pcie_devices"`
+}
+
+type IrmcPcieDeviceData struct {
+	Id              types.String           `tfsdk:"id"`
+	Name            types.String           `tfsdk:"name"`
+	Manufacturer    types.String           `tfsdk:"manufacturer"`
+	Model           types.String           `tfsdk:"model"`
+	DeviceType      types.String           `tfsdk:"device_type"`
+	SerialNumber    types.String           `tfsdk:"serial_number"`
+	PartNumber      types.String           `tfsdk:"part_number"`
+	FirmwareVersion types.String           `tfsdk:"firmware_version"`
+	Slot            types.String           `tfsdk:"slot"`
+	PcieType        types.String           `tfsdk:"pcie_type"`
+	MaxPcieType     types.String           `tfsdk:"max_pcie_type"`
+	LanesInUse      types.Int64            `tfsdk:"lanes_in_use"`
+	MaxLanes        types.Int64            `tfsdk:"max_lanes"`
+	Health          types.String           `tfsdk:"health"`
+	Functions       []IrmcPcieFunctionData `tfsdk:"functions"`
+}
+
+type IrmcPcieFunctionData struct {
+	FunctionId        types.Int64  `tfsdk:"function_id"`
+	FunctionType      types.String `tfsdk:"function_type"`
+	DeviceClass       types.String `tfsdk:"device_class"`
+	ClassCode         types.String `tfsdk:"class_code"`
+	VendorId          types.String `tfsdk:"vendor_id"`
+	DeviceId          types.String `tfsdk:"device_id"`
+	SubsystemVendorId types.String `tfsdk:"subsystem_vendor_id"`
+	SubsystemId       types.String `tfsdk:"subsystem_id"`
+}
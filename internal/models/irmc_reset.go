@@ -23,6 +23,12 @@ import (
 
 // IrmcResetResourceModel describes the resource data model.
 type IrmcResetResourceModel struct {
-	Id            types.String    `tfsdk:"id"`
-	RedfishServer []RedfishServer `tfsdk:"server"`
+	Id                      types.String    `tfsdk:"id"`
+	RedfishServer           []RedfishServer `tfsdk:"server"`
+	ResetType               types.String    `tfsdk:"reset_type"`
+	ResetToDefaults         types.String    `tfsdk:"reset_to_defaults"`
+	ReadyTimeout            types.Int64     `tfsdk:"ready_timeout"`
+	ExpectedFirmwareVersion types.String    `tfsdk:"expected_firmware_version"`
+	FirmwareVersion         types.String    `tfsdk:"firmware_version"`
+	Triggers                types.Map       `tfsdk:"triggers"`
 }
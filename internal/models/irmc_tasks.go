@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IrmcTasksDataSourceModel struct {
+	RedfishServer []RedfishServer `tfsdk:"server"`
+	Tasks         []IrmcTaskData  `tfsdk:"tasks"`
+}
+
+type IrmcTaskData struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	TaskState         types.String `tfsdk:"task_state"`
+	TaskStatus        types.String `tfsdk:"task_status"`
+	PercentComplete   types.Int64  `tfsdk:"percent_complete"`
+	StartTime         types.String `tfsdk:"start_time"`
+	EndTime           types.String `tfsdk:"end_time"`
+	TargetUri         types.String `tfsdk:"target_uri"`
+	CreatedByProvider types.Bool   `tfsdk:"created_by_provider"`
+	Stale             types.Bool   `tfsdk:"stale"`
+}
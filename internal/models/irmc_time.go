@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IrmcTimeResourceModel describes the resource data model.
+type IrmcTimeResourceModel struct {
+	Id              types.String    `tfsdk:"id"`
+	RedfishServer   []RedfishServer `tfsdk:"server"`
+	TimeZoneOffset  types.String    `tfsdk:"time_zone_offset"`
+	TimeZoneName    types.String    `tfsdk:"time_zone_name"`
+	NtpEnabled      types.Bool      `tfsdk:"ntp_enabled"`
+	NtpServers      types.List      `tfsdk:"ntp_servers"`
+	DateTime        types.String    `tfsdk:"date_time"`
+	CurrentDateTime types.String    `tfsdk:"current_date_time"`
+	TimeSkew        types.Int64     `tfsdk:"time_skew_seconds"`
+}
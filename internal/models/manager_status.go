@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ManagerStatusDataSourceModel describes the data source data model.
+type ManagerStatusDataSourceModel struct {
+	Id                  types.String    `tfsdk:"id"`
+	RedfishServer       []RedfishServer `tfsdk:"server"`
+	State               types.String    `tfsdk:"state"`
+	Health              types.String    `tfsdk:"health"`
+	HealthRollup        types.String    `tfsdk:"health_rollup"`
+	Ready               types.Bool      `tfsdk:"ready"`
+	FirmwareVersion     types.String    `tfsdk:"firmware_version"`
+	Licenses            types.List      `tfsdk:"licenses"`
+	DateTime            types.String    `tfsdk:"date_time"`
+	DateTimeLocalOffset types.String    `tfsdk:"date_time_local_offset"`
+	LastResetTime       types.String    `tfsdk:"last_reset_time"`
+	Uptime              types.Int64     `tfsdk:"uptime"`
+}
@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PostStateDataSourceModel describes the data source data model.
+type PostStateDataSourceModel struct {
+	Id               types.String    `tfsdk:"id"`
+	RedfishServer    []RedfishServer `tfsdk:"server"`
+	PowerState       types.String    `tfsdk:"power_state"`
+	BootProgress     types.String    `tfsdk:"boot_progress"`
+	BootProgressTime types.String    `tfsdk:"boot_progress_time"`
+	BiosInPost       types.Bool      `tfsdk:"bios_in_post"`
+	OsRunning        types.Bool      `tfsdk:"os_running"`
+}
@@ -22,8 +22,16 @@ import (
 )
 
 type RedfishServer struct {
-	User        types.String `tfsdk:"username"`
-	Password    types.String `tfsdk:"password"`
-	Endpoint    types.String `tfsdk:"endpoint"`
-	SslInsecure types.Bool   `tfsdk:"ssl_insecure"`
+	User         types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	PasswordWO   types.String `tfsdk:"password_wo"`
+	SessionToken types.String `tfsdk:"session_token"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+	SslInsecure  types.Bool   `tfsdk:"ssl_insecure"`
+}
+
+// ResourceIdentityModel describes identity of resources supporting import by identity.
+type ResourceIdentityModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Id       types.String `tfsdk:"id"`
 }
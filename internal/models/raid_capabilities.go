@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RaidCapabilitiesDataSourceModel struct {
+	RedfishServer       []RedfishServer           `tfsdk:"server"`
+	Id                  types.String              `tfsdk:"id"`
+	StorageControllerSN types.String              `tfsdk:"storage_controller_serial_number"`
+	RaidType            types.String              `tfsdk:"raid_type"`
+	RaidLevels          []RaidLevelCapabilityData `tfsdk:"raid_levels"`
+}
@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RedfishRawResourceModel describes the resource data model.
+type RedfishRawResourceModel struct {
+	Id            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"server"`
+	Path          types.String    `tfsdk:"path"`
+	Method        types.String    `tfsdk:"method"`
+	Payload       types.String    `tfsdk:"payload"`
+	WaitForTask   types.Bool      `tfsdk:"wait_for_task"`
+	TaskTimeout   types.Int64     `tfsdk:"task_timeout"`
+	StatusCode    types.Int64     `tfsdk:"status_code"`
+	Response      types.String    `tfsdk:"response"`
+	Triggers      types.Map       `tfsdk:"triggers"`
+}
@@ -27,7 +27,13 @@ type SimpleUpdateResourceModel struct {
 	RedfishServer  []RedfishServer `tfsdk:"server"`
 	Protocol       types.String    `tfsdk:"transfer_protocol"`
 	UpdateImage    types.String    `tfsdk:"update_image"`
+	UpdateFile     types.String    `tfsdk:"update_file"`
+	UploadTimeout  types.Int64     `tfsdk:"upload_timeout"`
 	OperationTime  types.String    `tfsdk:"operation_apply_time"`
+	ApplySchedule  types.String    `tfsdk:"apply_schedule"`
 	UpdateTimeout  types.Int64     `tfsdk:"update_timeout"`
 	UmeToolDirName types.String    `tfsdk:"ume_tool_directory_name"`
+	Updated        types.List      `tfsdk:"updated_components"`
+	Skipped        types.List      `tfsdk:"skipped_components"`
+	Failed         types.List      `tfsdk:"failed_components"`
 }
@@ -39,6 +39,7 @@ type StorageSettings struct {
 	MDCScheduleMode           types.String `tfsdk:"mdc_schedule_mode"`
 	MDCAbortOnError           types.Bool   `tfsdk:"mdc_abort_on_error_enabled"`
 	CoercionMode              types.String `tfsdk:"coercion_mode"`
+	BootVolume                types.String `tfsdk:"boot_volume"`
 	/*
 		CopybackSupport                types.Bool   `tfsdk:"copyback_support_enabled"`
 		CopybackOnSmartErrorSupport    types.Bool   `tfsdk:"copyback_on_smart_error_support_enabled"`
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageControllersDataSourceModel struct {
+	RedfishServer      []RedfishServer         `tfsdk:"server"`
+	StorageControllers []StorageControllerData `tfsdk:"storage_controllers"`
+}
+
+type StorageControllerData struct {
+	Id              types.String              `tfsdk:"id"`
+	ODataId         types.String              `tfsdk:"odata_id"`
+	Name            types.String              `tfsdk:"name"`
+	SerialNumber    types.String              `tfsdk:"serial_number"`
+	Model           types.String              `tfsdk:"model"`
+	FirmwareVersion types.String              `tfsdk:"firmware_version"`
+	RaidLevels      []RaidLevelCapabilityData `tfsdk:"raid_levels"`
+}
+
+type RaidLevelCapabilityData struct {
+	RaidType            types.String   `tfsdk:"raid_type"`
+	StripeSizes         []types.Int64  `tfsdk:"stripe_sizes"`
+	StripeSizesHDD      []types.Int64  `tfsdk:"stripe_sizes_hdd"`
+	StripeSizesSSD      []types.Int64  `tfsdk:"stripe_sizes_ssd"`
+	StripeSizesNVMe     []types.Int64  `tfsdk:"stripe_sizes_nvme"`
+	MinimumDriveCount   types.Int64    `tfsdk:"minimum_drive_count"`
+	MaximumDriveCount   types.Int64    `tfsdk:"maximum_drive_count"`
+	MinimumSpanCount    types.Int64    `tfsdk:"minimum_span_count"`
+	MaximumSpanCount    types.Int64    `tfsdk:"maximum_span_count"`
+	SupportedInitModes  []types.String `tfsdk:"supported_init_modes"`
+	SupportedReadModes  []types.String `tfsdk:"supported_read_modes"`
+	SupportedWriteModes []types.String `tfsdk:"supported_write_modes"`
+	SupportedDriveCache []types.String `tfsdk:"supported_drive_cache_modes"`
+}
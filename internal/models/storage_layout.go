@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageLayoutResourceModel describes the resource data model.
+type StorageLayoutResourceModel struct {
+	Id                     types.String          `tfsdk:"id"`
+	RedfishServer          []RedfishServer       `tfsdk:"server"`
+	StorageControllerSN    types.String          `tfsdk:"storage_controller_serial_number"`
+	JobTimeout             types.Int64           `tfsdk:"job_timeout"`
+	DeleteExtraVolumes     types.Bool            `tfsdk:"delete_extra_volumes"`
+	RemoveVolumesOnDestroy types.Bool            `tfsdk:"remove_volumes_on_destroy"`
+	Volumes                []StorageLayoutVolume `tfsdk:"volumes"`
+	VolumeIds              types.Map             `tfsdk:"volume_ids"`
+	UnmanagedVolumes       types.List            `tfsdk:"unmanaged_volumes"`
+}
+
+type StorageLayoutVolume struct {
+	Name               types.String `tfsdk:"name"`
+	RaidType           types.String `tfsdk:"raid_type"`
+	PhysicalDrives     types.List   `tfsdk:"physical_drives"`
+	CapacityBytes      types.Int64  `tfsdk:"capacity_bytes"`
+	OptimumIOSizeBytes types.Int64  `tfsdk:"optimum_io_size_bytes"`
+	InitMode           types.String `tfsdk:"init_mode"`
+}
@@ -12,8 +12,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+const (
+	// Default allowed difference between requested and actual volume capacity.
+	CAPACITY_TOLERANCE_BYTES   int64   = 500000000
+	CAPACITY_TOLERANCE_PERCENT float64 = 0
+)
+
+// CapacityTolerance is allowed difference between requested and actual volume capacity
+// as absolute number of bytes and as percent of requested capacity. The bigger of both values is used.
+type CapacityTolerance struct {
+	Bytes   int64
+	Percent float64
+}
+
+// DefaultCapacityTolerance is used if provider does not define capacity tolerance.
+var DefaultCapacityTolerance = CapacityTolerance{Bytes: CAPACITY_TOLERANCE_BYTES, Percent: CAPACITY_TOLERANCE_PERCENT}
+
+// allowedDifference returns allowed difference in bytes for given requested capacity.
+func (t CapacityTolerance) allowedDifference(requested int64) float64 {
+	return math.Max(float64(t.Bytes), math.Abs(float64(requested))*t.Percent/100)
+}
+
 type CapacityByteValue struct {
 	basetypes.Int64Value
+
+	// Tolerance configured on provider. Semantic equality has no access to provider configuration,
+	// so tolerance is attached to values returned by provider (default tolerance is used if not set).
+	Tolerance *CapacityTolerance
 }
 
 var _ basetypes.Int64Valuable = CapacityByteValue{}
@@ -74,12 +99,21 @@ func (v CapacityByteValue) Int64SemanticEquals(_ context.Context, newValueable b
 		return false, diags
 	}
 
+	// Framework compares value returned by provider (carrying tolerance) with prior requested value
+	tolerance, requested := DefaultCapacityTolerance, newValue.ValueInt64()
+	if v.Tolerance != nil {
+		tolerance = *v.Tolerance
+	} else if newValue.Tolerance != nil {
+		tolerance, requested = *newValue.Tolerance, v.ValueInt64()
+	}
+
 	diff := math.Abs(float64(v.ValueInt64() - newValue.ValueInt64()))
-	if diff < 500000000 {
+	allowed := tolerance.allowedDifference(requested)
+	if diff <= allowed {
 		return true, diags
 	}
 
-	var buff = fmt.Sprintf("Current volume capacity differs too much vs requested value (%f bytes while allowed 500000000 bytes)", diff)
+	var buff = fmt.Sprintf("Current volume capacity differs too much vs requested value (%.0f bytes while allowed %.0f bytes)", diff, allowed)
 	diags.AddError("Int64SemanticsEquals", buff)
 	return false, diags
 }
@@ -118,4 +152,25 @@ type StorageVolumeResourceModel struct {
 	ReadMode           *StorageVolumeDynamicParam `tfsdk:"read_mode"`
 	WriteMode          *StorageVolumeDynamicParam `tfsdk:"write_mode"`
 	DriveCacheMode     types.String               `tfsdk:"drive_cache_mode"`
+	WaitForInit        types.Bool                 `tfsdk:"wait_for_init"`
+	InitTimeout        types.Int64                `tfsdk:"init_timeout"`
+	InitState          types.String               `tfsdk:"init_state"`
+	InitPercent        types.Int64                `tfsdk:"init_percent"`
+}
+
+type StorageVolumesDataSourceModel struct {
+	RedfishServer       []RedfishServer     `tfsdk:"server"`
+	StorageControllerSN types.String        `tfsdk:"storage_controller_serial_number"`
+	Volumes             []StorageVolumeData `tfsdk:"volumes"`
+}
+
+type StorageVolumeData struct {
+	Id                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	RaidType           types.String   `tfsdk:"raid_type"`
+	CapacityBytes      types.Int64    `tfsdk:"capacity_bytes"`
+	OptimumIOSizeBytes types.Int64    `tfsdk:"optimum_io_size_bytes"`
+	Drives             []types.String `tfsdk:"drives"`
+	Health             types.String   `tfsdk:"health"`
+	State              types.String   `tfsdk:"state"`
 }
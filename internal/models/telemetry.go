@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TelemetryServiceDataSourceModel struct {
+	Id                           types.String                 `tfsdk:"id"`
+	RedfishServer                []RedfishServer              `tfsdk:"server"`
+	ServiceEnabled               types.Bool                   `tfsdk:"service_enabled"`
+	MinCollectionInterval        types.String                 `tfsdk:"min_collection_interval"`
+	MaxReports                   types.Int64                  `tfsdk:"max_reports"`
+	SupportedCollectionFunctions []types.String               `tfsdk:"supported_collection_functions"`
+	MetricReportDefinitions      []MetricReportDefinitionData `tfsdk:"metric_report_definitions"`
+}
+
+type MetricReportDefinitionData struct {
+	Id                         types.String   `tfsdk:"id"`
+	ODataId                    types.String   `tfsdk:"odata_id"`
+	Name                       types.String   `tfsdk:"name"`
+	Enabled                    types.Bool     `tfsdk:"enabled"`
+	MetricReportDefinitionType types.String   `tfsdk:"metric_report_definition_type"`
+	RecurrenceInterval         types.String   `tfsdk:"recurrence_interval"`
+	ReportUpdates              types.String   `tfsdk:"report_updates"`
+	MetricProperties           []types.String `tfsdk:"metric_properties"`
+	MetricReport               types.String   `tfsdk:"metric_report"`
+}
+
+// MetricReportDefinitionResourceModel describes the resource data model.
+type MetricReportDefinitionResourceModel struct {
+	Id                         types.String    `tfsdk:"id"`
+	RedfishServer              []RedfishServer `tfsdk:"server"`
+	MetricReportDefinitionId   types.String    `tfsdk:"metric_report_definition_id"`
+	Enabled                    types.Bool      `tfsdk:"enabled"`
+	MetricReportDefinitionType types.String    `tfsdk:"metric_report_definition_type"`
+	RecurrenceInterval         types.String    `tfsdk:"recurrence_interval"`
+	ReportUpdates              types.String    `tfsdk:"report_updates"`
+}
@@ -27,6 +27,8 @@ type IrmcUserAccountResourceModel struct {
 	UserID                        types.String    `tfsdk:"user_id"`
 	UserUsername                  types.String    `tfsdk:"user_username"`
 	UserPassword                  types.String    `tfsdk:"user_password"`
+	UserPasswordWO                types.String    `tfsdk:"user_password_wo"`
+	UserPasswordWOVersion         types.Int64     `tfsdk:"user_password_wo_version"`
 	UserRole                      types.String    `tfsdk:"user_role"`
 	UserEnabled                   types.Bool      `tfsdk:"user_enabled"`
 	UserRedfishEnabled            types.Bool      `tfsdk:"user_redfish_enabled"`
@@ -38,4 +40,12 @@ type IrmcUserAccountResourceModel struct {
 	UserEnabledRemoteStorage      types.Bool      `tfsdk:"user_remote_storage_enabled"`
 	UserShellAccess               types.String    `tfsdk:"user_shell_access"`
 	UserEnabledAlertChassisEvents types.Bool      `tfsdk:"user_alert_chassis_events"`
+	UserSSHPublicKeys             types.List      `tfsdk:"user_ssh_public_keys"`
+	UserAlertEmailEnabled         types.Bool      `tfsdk:"user_alert_email_enabled"`
+	UserAlertEmailAddress         types.String    `tfsdk:"user_alert_email_address"`
+	UserAlertMailFormat           types.String    `tfsdk:"user_alert_mail_format"`
+	UserAlertLevels               types.Map       `tfsdk:"user_alert_levels"`
+	UserExtendedPermissions       types.Map       `tfsdk:"user_extended_permissions"`
+	UserReportedPermissions       types.Map       `tfsdk:"user_reported_extended_permissions"`
+	VerifyPasswordOnRead          types.Bool      `tfsdk:"verify_password_on_read"`
 }
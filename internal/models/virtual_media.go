@@ -28,8 +28,14 @@ type VirtualMediaDataSource struct {
 }
 
 type VirtualMediaData struct {
-	ODataId types.String `tfsdk:"odata_id"`
-	Id      types.String `tfsdk:"id"`
+	ODataId              types.String `tfsdk:"odata_id"`
+	Id                   types.String `tfsdk:"id"`
+	Image                types.String `tfsdk:"image"`
+	Inserted             types.Bool   `tfsdk:"inserted"`
+	TransferProtocolType types.String `tfsdk:"transfer_protocol_type"`
+	MediaType            types.String `tfsdk:"media_type"`
+	ConnectedVia         types.String `tfsdk:"connected_via"`
+	WriteProtected       types.Bool   `tfsdk:"write_protected"`
 }
 
 // VirtualMediaResourceModel describes the resource data model.
@@ -39,4 +45,12 @@ type VirtualMediaResourceModel struct {
 	Image                types.String    `tfsdk:"image"`
 	Inserted             types.Bool      `tfsdk:"inserted"`
 	TransferProtocolType types.String    `tfsdk:"transfer_protocol_type"`
+	UserName             types.String    `tfsdk:"user_name"`
+	Password             types.String    `tfsdk:"password"`
+	PasswordWO           types.String    `tfsdk:"password_wo"`
+	ShareDomain          types.String    `tfsdk:"share_domain"`
+	MountTimeout         types.Int64     `tfsdk:"mount_timeout"`
+	Slot                 types.String    `tfsdk:"slot"`
+	MediaType            types.String    `tfsdk:"media_type"`
+	WriteProtected       types.Bool      `tfsdk:"write_protected"`
 }
@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	OPERATION_TIME_AT_MAINTENANCE_WINDOW = "AtMaintenanceWindowStart"
+	REDFISH_OPERATION_APPLY_TIME         = "@Redfish.OperationApplyTime"
+	REDFISH_MAINTENANCE_WINDOW           = "@Redfish.MaintenanceWindow"
+)
+
+// ApplyScheduleSchema returns schema of apply_schedule attribute shared by update resources,
+// optionally extended by resource specific validators.
+func ApplyScheduleSchema(extraValidators ...validator.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Defines when the update is applied instead of immediately. Value `OnReset` applies the update on next reset, " +
+			"RFC3339 timestamp (e.g. `2025-06-01T02:00:00+02:00`) applies it at start of maintenance window at the specified time. " +
+			"Scheduled update is not awaited, the resource is created once the update is accepted by iRMC.",
+		Description: "Defines when the update is applied instead of immediately. Value OnReset applies the update on next reset, " +
+			"RFC3339 timestamp (e.g. 2025-06-01T02:00:00+02:00) applies it at start of maintenance window at the specified time. " +
+			"Scheduled update is not awaited, the resource is created once the update is accepted by iRMC.",
+		Optional: true,
+		Validators: append([]validator.String{
+			stringvalidator.Any(
+				stringvalidator.OneOf(OPERATION_TIME_ON_RESET),
+				validators.IsRFC3339(),
+			),
+		}, extraValidators...),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// getApplySchedulePayload returns Redfish annotations scheduling the operation according to apply_schedule value.
+// Timestamp is mapped to maintenance window of given duration starting at that time, it must not be in the past.
+// Empty map is returned if schedule is not defined.
+func getApplySchedulePayload(schedule string, duration int64, now time.Time) (map[string]interface{}, error) {
+	payload := map[string]interface{}{}
+	if len(schedule) == 0 {
+		return payload, nil
+	}
+
+	if schedule == OPERATION_TIME_ON_RESET {
+		payload[REDFISH_OPERATION_APPLY_TIME] = OPERATION_TIME_ON_RESET
+		return payload, nil
+	}
+
+	start, err := time.Parse(time.RFC3339, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("apply schedule '%s' is neither %s nor RFC3339 timestamp", schedule, OPERATION_TIME_ON_RESET)
+	}
+
+	if !start.After(now) {
+		return nil, fmt.Errorf("apply schedule '%s' is in the past", schedule)
+	}
+
+	payload[REDFISH_OPERATION_APPLY_TIME] = OPERATION_TIME_AT_MAINTENANCE_WINDOW
+	payload[REDFISH_MAINTENANCE_WINDOW] = map[string]interface{}{
+		"MaintenanceWindowStartTime":         start.Format(time.RFC3339),
+		"MaintenanceWindowDurationInSeconds": duration,
+	}
+
+	return payload, nil
+}
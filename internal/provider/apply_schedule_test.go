@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetApplySchedulePayload(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	payload, err := getApplySchedulePayload("", 600, now)
+	if err != nil || len(payload) != 0 {
+		t.Errorf("Undefined schedule should produce empty payload, got %v (%v)", payload, err)
+	}
+
+	payload, err = getApplySchedulePayload(OPERATION_TIME_ON_RESET, 600, now)
+	if err != nil || payload[REDFISH_OPERATION_APPLY_TIME] != OPERATION_TIME_ON_RESET || len(payload) != 1 {
+		t.Errorf("Got payload %v (%v), expected OnReset apply time", payload, err)
+	}
+
+	payload, err = getApplySchedulePayload("2025-06-02T02:00:00+02:00", 600, now)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	if payload[REDFISH_OPERATION_APPLY_TIME] != OPERATION_TIME_AT_MAINTENANCE_WINDOW {
+		t.Errorf("Got apply time '%v', expected '%s'", payload[REDFISH_OPERATION_APPLY_TIME], OPERATION_TIME_AT_MAINTENANCE_WINDOW)
+	}
+	window, ok := payload[REDFISH_MAINTENANCE_WINDOW].(map[string]interface{})
+	if !ok || window["MaintenanceWindowStartTime"] != "2025-06-02T02:00:00+02:00" || window["MaintenanceWindowDurationInSeconds"] != int64(600) {
+		t.Errorf("Got maintenance window %v", payload[REDFISH_MAINTENANCE_WINDOW])
+	}
+
+	if _, err := getApplySchedulePayload("2025-06-01T11:00:00Z", 600, now); err == nil {
+		t.Errorf("Schedule in the past should be rejected")
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const ENV_IRMC_AUDIT_LOG_FILE = "IRMC_AUDIT_LOG_FILE"
+
+// auditLogEntry describes single Redfish request sent to iRMC. Neither payloads nor headers
+// are recorded, so the log does not contain any credentials or session tokens.
+type auditLogEntry struct {
+	Time       string `json:"time"`
+	Run        string `json:"run"`
+	Host       string `json:"host"`
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	Status     int    `json:"status,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// auditLog appends entries as JSON lines to a local file. All entries written by one
+// provider process (single plan or apply) share the same run identifier.
+type auditLog struct {
+	lock    sync.Mutex
+	path    string
+	run     string
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// openAuditLogs keeps audit logs opened by the process, so they are closed during shutdown.
+var openAuditLogs sync.Map
+
+// openAuditLog opens (or creates) audit log file pointed by path for appending.
+func openAuditLog(path string) (*auditLog, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open audit log file '%s': %w", path, err)
+	}
+
+	log := &auditLog{
+		path:    path,
+		run:     fmt.Sprintf("%s-%d", time.Now().UTC().Format("20060102T150405Z"), os.Getpid()),
+		file:    file,
+		encoder: json.NewEncoder(file),
+	}
+	openAuditLogs.Store(log, struct{}{})
+
+	return log, nil
+}
+
+func (l *auditLog) write(entry auditLogEntry) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.file == nil {
+		return
+	}
+
+	entry.Run = l.run
+	// Failure of audit log must not break operation on iRMC
+	_ = l.encoder.Encode(entry)
+}
+
+// Close flushes and closes the audit log file, entries written afterwards are dropped.
+func (l *auditLog) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	openAuditLogs.Delete(l)
+	if l.file == nil {
+		return nil
+	}
+
+	file := l.file
+	l.file = nil
+	if err := file.Sync(); err != nil {
+		CloseResource(file)
+		return err
+	}
+	return file.Close()
+}
+
+// closeAuditLogs closes all audit logs opened by the process.
+func closeAuditLogs() {
+	openAuditLogs.Range(func(key, value any) bool {
+		_ = key.(*auditLog).Close()
+		return true
+	})
+}
+
+// auditTransport records method, path and status of every request passed to next transport.
+type auditTransport struct {
+	next http.RoundTripper
+	log  *auditLog
+}
+
+func newAuditTransport(next http.RoundTripper, log *auditLog) http.RoundTripper {
+	if log == nil {
+		return next
+	}
+
+	return &auditTransport{next: next, log: log}
+}
+
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+
+	// Query is left out, since it is not used by Redfish operations of the provider
+	entry := auditLogEntry{
+		Time:       start.UTC().Format(time.RFC3339Nano),
+		Host:       req.URL.Host,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Status = res.StatusCode
+	}
+
+	t.log.write(entry)
+	return res, err
+}
@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAuditTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	log, err := openAuditLog(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer CloseResource(log)
+
+	client := &http.Client{Transport: newAuditTransport(http.DefaultTransport, log)}
+
+	res, err := client.Get(server.URL + "/redfish/v1/Systems/0?$expand=.")
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	CloseResource(res.Body)
+
+	res, err = client.Post(server.URL+"/redfish/v1/SessionService/Sessions", "application/json",
+		strings.NewReader(`{"UserName":"admin","Password":"secret"}`))
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	CloseResource(res.Body)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if strings.Contains(string(content), "secret") {
+		t.Errorf("Audit log contains request payload: %s", content)
+	}
+
+	var entries []auditLogEntry
+	scanner := bufio.NewScanner(strings.NewReader(string(content)))
+	for scanner.Scan() {
+		var entry auditLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("Invalid audit log line '%s': %s", scanner.Text(), err.Error())
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %+v", entries)
+	}
+
+	if entries[0].Method != http.MethodGet || entries[0].Path != "/redfish/v1/Systems/0" || entries[0].Status != http.StatusOK {
+		t.Errorf("Unexpected first entry %+v", entries[0])
+	}
+
+	if entries[1].Method != http.MethodPost || entries[1].Path != "/redfish/v1/SessionService/Sessions" || entries[1].Status != http.StatusCreated {
+		t.Errorf("Unexpected second entry %+v", entries[1])
+	}
+
+	if entries[0].Run != entries[1].Run || len(entries[0].Run) == 0 {
+		t.Errorf("Entries of the same run have different identifiers %s and %s", entries[0].Run, entries[1].Run)
+	}
+}
+
+func TestNewAuditTransportDisabled(t *testing.T) {
+	if transport := newAuditTransport(http.DefaultTransport, nil); transport != http.DefaultTransport {
+		t.Errorf("Expected next transport to be returned, if audit log is not configured")
+	}
+}
+
+func TestAuditLogManagerOfflineProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	log, err := openAuditLog(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer CloseResource(log)
+	defer CloseResource(log)
+
+	rserver := []models.RedfishServer{{Endpoint: types.StringValue(server.URL)}}
+	if err := waitForManagerOffline(context.Background(), &IrmcProvider{AuditLog: log}, &rserver, 30); err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	var entry auditLogEntry
+	if err := json.Unmarshal([]byte(strings.TrimSpace(string(content))), &entry); err != nil {
+		t.Fatalf("Invalid audit log content '%s': %s", content, err.Error())
+	}
+
+	if entry.Method != http.MethodGet || entry.Path != "/redfish/v1/" || entry.Status != http.StatusServiceUnavailable {
+		t.Errorf("Unexpected entry of offline probe %+v", entry)
+	}
+}
+
+func TestAuditLogClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	log, err := openAuditLog(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	log.write(auditLogEntry{Method: http.MethodGet, Path: "/redfish/v1/"})
+	closeAuditLogs()
+
+	if _, open := openAuditLogs.Load(log); open || log.file != nil {
+		t.Errorf("Audit log should be closed during shutdown")
+	}
+
+	// Entries written after close are dropped and repeated close does not fail
+	log.write(auditLogEntry{Method: http.MethodGet, Path: "/redfish/v1/Systems"})
+	if err := log.Close(); err != nil {
+		t.Errorf("Unexpected error %s", err.Error())
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	if lines := strings.Count(string(content), "\n"); lines != 1 {
+		t.Errorf("Got %d entries, expected 1: %s", lines, content)
+	}
+}
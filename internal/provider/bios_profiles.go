@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	BIOS_PROFILE_VIRTUALIZATION = "Virtualization"
+	BIOS_PROFILE_LOW_LATENCY    = "LowLatency"
+	BIOS_PROFILE_POWER_SAVE     = "PowerSave"
+)
+
+const (
+	BIOS_PLATFORM_INTEL = "Intel"
+	BIOS_PLATFORM_AMD   = "AMD"
+)
+
+// biosProfiles contains BIOS attribute sets of built-in profiles per processor vendor. Profiles are not
+// specific to platform generation, so attributes not supported by particular system and values not allowed
+// by its BIOS attribute registry (e.g. on older generation) are skipped.
+var biosProfiles = map[string]map[string]map[string]string{
+	BIOS_PLATFORM_INTEL: {
+		BIOS_PROFILE_VIRTUALIZATION: {
+			"HyperThreading":    "Enabled",
+			"VtSupport":         "Enabled",
+			"VtdSupport":        "Enabled",
+			"SriovSupport":      "Enabled",
+			"TurboMode":         "Enabled",
+			"EnergyPerformance": "BalancedPerformance",
+			"PowerTechnology":   "Energy Efficient",
+		},
+		BIOS_PROFILE_LOW_LATENCY: {
+			"HyperThreading":         "Disabled",
+			"TurboMode":              "Enabled",
+			"EnergyPerformance":      "Performance",
+			"PowerTechnology":        "Custom",
+			"CpuCStates":             "Disabled",
+			"PackageCStateLimit":     "C0",
+			"UncoreFrequencyScaling": "Disabled",
+			"HardwarePrefetcher":     "Enabled",
+		},
+		BIOS_PROFILE_POWER_SAVE: {
+			"TurboMode":              "Disabled",
+			"EnergyPerformance":      "EnergyEfficient",
+			"PowerTechnology":        "Energy Efficient",
+			"CpuCStates":             "Enabled",
+			"PackageCStateLimit":     "C6",
+			"UncoreFrequencyScaling": "Enabled",
+		},
+	},
+	BIOS_PLATFORM_AMD: {
+		BIOS_PROFILE_VIRTUALIZATION: {
+			"SmtControl":           "Auto",
+			"SvmMode":              "Enabled",
+			"Iommu":                "Enabled",
+			"SriovSupport":         "Enabled",
+			"CorePerformanceBoost": "Auto",
+			"DeterminismSlider":    "Performance",
+		},
+		BIOS_PROFILE_LOW_LATENCY: {
+			"SmtControl":           "Disabled",
+			"CorePerformanceBoost": "Auto",
+			"GlobalCStateControl":  "Disabled",
+			"DfCStates":            "Disabled",
+			"ApbDisable":           "Enabled",
+			"DeterminismSlider":    "Performance",
+		},
+		BIOS_PROFILE_POWER_SAVE: {
+			"CorePerformanceBoost": "Disabled",
+			"GlobalCStateControl":  "Enabled",
+			"DfCStates":            "Enabled",
+			"DeterminismSlider":    "Power",
+		},
+	},
+}
+
+// biosPlatformFromProcessorModel returns platform of BIOS profiles matching processor model reported by system.
+func biosPlatformFromProcessorModel(model string) (string, error) {
+	switch {
+	case strings.Contains(model, BIOS_PLATFORM_INTEL):
+		return BIOS_PLATFORM_INTEL, nil
+	case strings.Contains(model, BIOS_PLATFORM_AMD):
+		return BIOS_PLATFORM_AMD, nil
+	default:
+		return "", fmt.Errorf("processor model '%s' does not match any platform of BIOS profiles", model)
+	}
+}
+
+// mergeBiosProfileAttributes adds profile attributes supported by the system into planned attributes,
+// which take precedence over profile. Values are checked against allowable values of enumeration attributes,
+// if system provides them. Returned map contains profile attributes added this way.
+func mergeBiosProfileAttributes(ctx context.Context, profile map[string]string, supported map[string]string,
+	allowable map[string][]string, plannedAttributes map[string]string) map[string]string {
+	profileAttributes := make(map[string]string)
+	for _, key := range slices.Sorted(maps.Keys(profile)) {
+		if _, ok := plannedAttributes[key]; ok {
+			continue
+		}
+
+		if _, ok := supported[key]; !ok {
+			tflog.Info(ctx, fmt.Sprintf("Profile attribute '%s' is not supported by the system, so omit", key))
+			continue
+		}
+
+		if values, ok := allowable[key]; ok && !slices.Contains(values, profile[key]) {
+			tflog.Warn(ctx, fmt.Sprintf("Profile value '%s' of attribute '%s' is not allowed by the system, so omit", profile[key], key))
+			continue
+		}
+
+		plannedAttributes[key] = profile[key]
+		profileAttributes[key] = profile[key]
+	}
+
+	return profileAttributes
+}
+
+// expandBiosProfile merges attributes of profile for platform of system pointed by service into planned attributes.
+func expandBiosProfile(ctx context.Context, service *gofish.Service, profile string, plannedAttributes map[string]string) (
+	profileAttributes map[string]string, diags diag.Diagnostics) {
+	system, err := GetSystemResource(service)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Error while reading /Systems/0", err)...)
+		return nil, diags
+	}
+
+	platform, err := biosPlatformFromProcessorModel(system.ProcessorSummary.Model)
+	if err != nil {
+		diags.AddError("BIOS profile cannot be used", err.Error())
+		return nil, diags
+	}
+
+	rBios, err := system.Bios()
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Error while reading /Systems/0/Bios", err)...)
+		return nil, diags
+	}
+
+	allowable, err := readBiosAttributeAllowableValues(service, rBios)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Error while reading BIOS attribute registry", err)...)
+		return nil, diags
+	}
+
+	supported := convertRedfishAttributesToUnifiedFormat(rBios.Attributes)
+	return mergeBiosProfileAttributes(ctx, biosProfiles[platform][profile], supported, allowable, plannedAttributes), diags
+}
+
+// readBiosAttributeAllowableValues returns allowable values of enumeration attributes described by attribute
+// registry of rBios. Empty map is returned if system does not provide the registry.
+func readBiosAttributeAllowableValues(service *gofish.Service, rBios *redfish.Bios) (map[string][]string, error) {
+	allowable := make(map[string][]string)
+	if len(rBios.AttributeRegistry) == 0 {
+		return allowable, nil
+	}
+
+	files, err := service.Registries()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		// Registry is referenced by BIOS using its full name including version (e.g. BiosAttributeRegistry.1.0.0)
+		if file.ID != rBios.AttributeRegistry && file.Registry != rBios.AttributeRegistry &&
+			!strings.HasPrefix(rBios.AttributeRegistry, file.ID+".") {
+			continue
+		}
+
+		for _, location := range file.Location {
+			if len(location.URI) == 0 {
+				continue
+			}
+
+			registry, err := redfish.GetAttributeRegistry(service.GetClient(), location.URI)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, attribute := range registry.RegistryEntries.Attributes {
+				if attribute.Type != redfish.EnumerationAttributeType {
+					continue
+				}
+
+				for _, value := range attribute.Value {
+					allowable[attribute.AttributeName] = append(allowable[attribute.AttributeName], value.ValueName)
+				}
+			}
+
+			return allowable, nil
+		}
+	}
+
+	return allowable, nil
+}
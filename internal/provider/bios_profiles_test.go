@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+)
+
+func TestBiosPlatformFromProcessorModel(t *testing.T) {
+	tests := map[string]string{
+		"Intel(R) Xeon(R) Gold 6430":  BIOS_PLATFORM_INTEL,
+		"AMD EPYC 9354 32-Core":       BIOS_PLATFORM_AMD,
+		"Unknown processor reference": "",
+	}
+
+	for model, expected := range tests {
+		platform, err := biosPlatformFromProcessorModel(model)
+		if platform != expected || (err != nil) != (len(expected) == 0) {
+			t.Errorf("Model '%s' expected platform '%s', got '%s' (error %v)", model, expected, platform, err)
+		}
+	}
+}
+
+func TestBiosProfilesDefinedForAllPlatforms(t *testing.T) {
+	for _, platform := range []string{BIOS_PLATFORM_INTEL, BIOS_PLATFORM_AMD} {
+		for _, profile := range []string{BIOS_PROFILE_VIRTUALIZATION, BIOS_PROFILE_LOW_LATENCY, BIOS_PROFILE_POWER_SAVE} {
+			if len(biosProfiles[platform][profile]) == 0 {
+				t.Errorf("Profile %s is not defined for platform %s", profile, platform)
+			}
+		}
+	}
+}
+
+func TestExpandBiosProfile(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	system := server.Resource("/redfish/v1/Systems/0")
+	system["ProcessorSummary"] = map[string]interface{}{"Model": "Intel(R) Xeon(R) Gold 6430"}
+	server.Set("/redfish/v1/Systems/0", system)
+	server.Set("/redfish/v1/Systems/0/Bios", map[string]interface{}{
+		"Attributes": map[string]interface{}{
+			"HyperThreading": "Enabled",
+			"TurboMode":      "Disabled",
+			"CpuCStates":     "Enabled",
+		},
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	planned := map[string]string{"CpuCStates": "Enabled"}
+	profileAttributes, diags := expandBiosProfile(context.Background(), api.Service, BIOS_PROFILE_LOW_LATENCY, planned)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics %v", diags)
+	}
+
+	// Unsupported profile attributes are skipped and configured attributes take precedence
+	expected := map[string]string{"HyperThreading": "Disabled", "TurboMode": "Enabled"}
+	if len(profileAttributes) != len(expected) {
+		t.Fatalf("Expected profile attributes %v, got %v", expected, profileAttributes)
+	}
+
+	for key, val := range expected {
+		if profileAttributes[key] != val || planned[key] != val {
+			t.Errorf("Attribute %s expected '%s', got '%s' in profile and '%s' in planned attributes", key, val, profileAttributes[key], planned[key])
+		}
+	}
+
+	if planned["CpuCStates"] != "Enabled" {
+		t.Errorf("Configured attribute overridden by profile: %v", planned)
+	}
+}
+
+func TestExpandBiosProfileAllowableValues(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	root := server.Resource("/redfish/v1")
+	root["Registries"] = mockRedfishLink("/redfish/v1/Registries")
+	server.Set("/redfish/v1", root)
+
+	system := server.Resource("/redfish/v1/Systems/0")
+	system["ProcessorSummary"] = map[string]interface{}{"Model": "Intel(R) Xeon(R) Silver 4110"}
+	server.Set("/redfish/v1/Systems/0", system)
+	server.Set("/redfish/v1/Systems/0/Bios", map[string]interface{}{
+		"AttributeRegistry": "BiosAttributeRegistry.1.0.0",
+		"Attributes": map[string]interface{}{
+			"EnergyPerformance": "BalancedPerformance",
+			"TurboMode":         "Disabled",
+		},
+	})
+
+	server.collection("/redfish/v1/Registries", "/redfish/v1/Registries/BiosAttributeRegistry")
+	server.Set("/redfish/v1/Registries/BiosAttributeRegistry", map[string]interface{}{
+		"Id":       "BiosAttributeRegistry",
+		"Registry": "BiosAttributeRegistry.1.0.0",
+		"Location": []interface{}{map[string]interface{}{"Uri": "/redfish/v1/Registries/BiosAttributeRegistry/Registry"}},
+	})
+	server.Set("/redfish/v1/Registries/BiosAttributeRegistry/Registry", map[string]interface{}{
+		"Id": "BiosAttributeRegistry.1.0.0",
+		"RegistryEntries": map[string]interface{}{
+			"Attributes": []interface{}{
+				map[string]interface{}{
+					"AttributeName": "EnergyPerformance",
+					"Type":          "Enumeration",
+					"Value": []interface{}{
+						map[string]interface{}{"ValueName": "BalancedPerformance"},
+						map[string]interface{}{"ValueName": "EnergyEfficient"},
+					},
+				},
+				map[string]interface{}{
+					"AttributeName": "TurboMode",
+					"Type":          "Enumeration",
+					"Value": []interface{}{
+						map[string]interface{}{"ValueName": "Enabled"},
+						map[string]interface{}{"ValueName": "Disabled"},
+					},
+				},
+			},
+		},
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	// Value 'Performance' of EnergyPerformance is not allowed by older generation, so it is skipped
+	planned := map[string]string{}
+	profileAttributes, diags := expandBiosProfile(context.Background(), api.Service, BIOS_PROFILE_LOW_LATENCY, planned)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics %v", diags)
+	}
+
+	if len(profileAttributes) != 1 || profileAttributes["TurboMode"] != "Enabled" {
+		t.Errorf("Expected only TurboMode in profile attributes, got %v", profileAttributes)
+	}
+}
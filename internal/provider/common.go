@@ -25,35 +25,80 @@ import (
 	"io"
 	"log"
 	"terraform-provider-irmc-redfish/internal/models"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralSchema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/redfish"
 )
 
 const (
-	redfishServerMD        string = "List of server BMCs and their respective user credentials"
-	vmediaName             string = "virtual_media"
-	storageVolumeName      string = "storage_volume"
-	irmcRestart            string = "irmc_reset"
-	bootSourceOverrideName string = "boot_source_override"
-	bootOrderName          string = "boot_order"
-	biosName               string = "bios"
-	userAccount            string = "user_account"
-	simpleUpdate           string = "simple_update"
-	firmwareInventory      string = "firmware_inventory"
-	storageName            string = "storage"
-	systemBoot             string = "system_boot"
-	firmwareUpdate         string = "irmc_firmware_update"
-	iRMCAttributes         string = "irmc_attributes"
-	certificateCaUpdDeploy string = "certificate_ca_upd_deploy"
-	certificateWebServer   string = "certificate_web_server"
-	certificateCaCasSmtp   string = "certificate_ca_cas_smtp"
+	redfishServerMD         string = "List of server BMCs and their respective user credentials. If not defined, server configuration from provider block is used"
+	vmediaName              string = "virtual_media"
+	storageVolumeName       string = "storage_volume"
+	storageVolumesName      string = "storage_volumes"
+	driveSecureEraseName    string = "drive_secure_erase"
+	driveLocateLedName      string = "drive_locate_led"
+	driveModeName           string = "drive_mode"
+	driveFirmwareUpdateName string = "drive_firmware_update"
+	irmcRestart             string = "irmc_reset"
+	factoryResetName        string = "irmc_factory_reset"
+	profileBackupName       string = "irmc_profile_backup"
+	profileRestoreName      string = "irmc_profile_restore"
+	bootSourceOverrideName  string = "boot_source_override"
+	bootOrderName           string = "boot_order"
+	biosName                string = "bios"
+	biosPendingName         string = "bios_pending"
+	userAccount             string = "user_account"
+	simpleUpdate            string = "simple_update"
+	firmwareInventory       string = "firmware_inventory"
+	storageName             string = "storage"
+	storageControllersName  string = "storage_controllers"
+	systemBoot              string = "system_boot"
+	firmwareUpdate          string = "irmc_firmware_update"
+	elcmUpdate              string = "elcm_update"
+	accountPolicyName       string = "account_policy"
+	avrSettingsName         string = "avr_settings"
+	consoleRedirectionName  string = "console_redirection"
+	vmediaSettingsName      string = "vmedia_settings"
+	iRMCAttributes          string = "irmc_attributes"
+	certificateCaUpdDeploy  string = "certificate_ca_upd_deploy"
+	certificateWebServer    string = "certificate_web_server"
+	certificateCaCasSmtp    string = "certificate_ca_cas_smtp"
+	telemetryServiceName    string = "telemetry_service"
+	metricReportDefName     string = "metric_report_definition"
+	aisConnectName          string = "ais_connect"
+	elcmRepositoryName      string = "elcm_repository"
+	postStateName           string = "post_state"
+	irmcWaitName            string = "irmc_wait"
+	redfishResourceName     string = "redfish_resource"
+	redfishRawName          string = "redfish_raw"
+	irmcSecureEraseName     string = "irmc_secure_erase"
+	managerStatusName       string = "irmc_manager_status"
+	storageLayoutName       string = "storage_layout"
+	driveHealthName         string = "drive_health"
+	testAlertName           string = "test_alert"
+	irmcTimeName            string = "irmc_time"
+	raidCapabilitiesName    string = "raid_capabilities"
+	sessionName             string = "session"
+	irmcTasksName           string = "irmc_tasks"
+	irmcChassisName         string = "irmc_chassis"
+	irmcHealthName          string = "irmc_health"
+	irmcPcieDevicesName     string = "irmc_pcie_devices"
+	irmcGpusName            string = "irmc_gpus"
+	fanPolicyName           string = "fan_policy"
+	thermalPolicyName       string = "thermal_policy"
+	frontPanelSecurityName  string = "front_panel_security"
+	bootWatchdogName        string = "boot_watchdog"
+	irmcDnsName             string = "irmc_dns"
 )
 
 const (
@@ -77,11 +122,6 @@ type CommonImportConfig struct {
 	ID string `json:"id"`
 }
 
-const (
-	IRMC_RESET_TIMEOUT             = 600
-	IRMC_RESET_CHECK_INTERVAL_TIME = 10
-)
-
 // RedfishServerDatasourceSchema to construct schema of redfish server.
 func RedfishServerDatasourceSchema() map[string]datasourceSchema.Attribute {
 	return map[string]datasourceSchema.Attribute{
@@ -94,6 +134,16 @@ func RedfishServerDatasourceSchema() map[string]datasourceSchema.Attribute {
 			Description: "User password for login",
 			Sensitive:   true,
 		},
+		"password_wo": datasourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "User password for login. Data sources do not support write-only arguments, so it behaves the same as password",
+			Sensitive:   true,
+		},
+		"session_token": datasourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "Pre-established Redfish session token (X-Auth-Token) used instead of username and password",
+			Sensitive:   true,
+		},
 		"endpoint": datasourceSchema.StringAttribute{
 			Required:    true,
 			Description: "Server BMC IP address or hostname",
@@ -116,6 +166,20 @@ func RedfishServerSchema() map[string]resourceSchema.Attribute {
 			Description: "User password for login",
 			Sensitive:   true,
 		},
+		"password_wo": resourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "User password for login, which is not persisted in Terraform state (requires Terraform 1.11 or later). Since it is not available during refresh and destroy, credentials for these operations must be provided on provider level or in credentials file",
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("password")),
+			},
+		},
+		"session_token": resourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "Pre-established Redfish session token (X-Auth-Token) used instead of username and password",
+			Sensitive:   true,
+		},
 		"endpoint": resourceSchema.StringAttribute{
 			Required:    true,
 			Description: "Server BMC IP address or hostname",
@@ -135,7 +199,6 @@ func RedfishServerDatasourceBlockMap() map[string]datasourceSchema.Block {
 			Description:         redfishServerMD,
 			Validators: []validator.List{
 				listvalidator.SizeAtMost(1),
-				listvalidator.IsRequired(),
 			},
 			NestedObject: datasourceSchema.NestedBlockObject{
 				Attributes: RedfishServerDatasourceSchema(),
@@ -144,6 +207,54 @@ func RedfishServerDatasourceBlockMap() map[string]datasourceSchema.Block {
 	}
 }
 
+// RedfishServerEphemeralSchema to construct schema of redfish server used by ephemeral resources.
+func RedfishServerEphemeralSchema() map[string]ephemeralSchema.Attribute {
+	return map[string]ephemeralSchema.Attribute{
+		"username": ephemeralSchema.StringAttribute{
+			Optional:    true,
+			Description: "User name for login",
+		},
+		"password": ephemeralSchema.StringAttribute{
+			Optional:    true,
+			Description: "User password for login",
+			Sensitive:   true,
+		},
+		"password_wo": ephemeralSchema.StringAttribute{
+			Optional:    true,
+			Description: "User password for login. Ephemeral resources are not persisted, so it behaves the same as password",
+			Sensitive:   true,
+		},
+		"session_token": ephemeralSchema.StringAttribute{
+			Optional:    true,
+			Description: "Pre-established Redfish session token (X-Auth-Token) used instead of username and password",
+			Sensitive:   true,
+		},
+		"endpoint": ephemeralSchema.StringAttribute{
+			Required:    true,
+			Description: "Server BMC IP address or hostname",
+		},
+		"ssl_insecure": ephemeralSchema.BoolAttribute{
+			Optional:    true,
+			Description: "This field indicates whether the SSL/TLS certificate must be verified or not",
+		},
+	}
+}
+
+func RedfishServerEphemeralBlockMap() map[string]ephemeralSchema.Block {
+	return map[string]ephemeralSchema.Block{
+		"server": ephemeralSchema.ListNestedBlock{
+			MarkdownDescription: redfishServerMD,
+			Description:         redfishServerMD,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: ephemeralSchema.NestedBlockObject{
+				Attributes: RedfishServerEphemeralSchema(),
+			},
+		},
+	}
+}
+
 func RedfishServerResourceBlockMap() map[string]resourceSchema.Block {
 	return map[string]resourceSchema.Block{
 		"server": resourceSchema.ListNestedBlock{
@@ -151,7 +262,6 @@ func RedfishServerResourceBlockMap() map[string]resourceSchema.Block {
 			Description:         redfishServerMD,
 			Validators: []validator.List{
 				listvalidator.SizeAtMost(1),
-				listvalidator.IsRequired(),
 			},
 			NestedObject: resourceSchema.NestedBlockObject{
 				Attributes: RedfishServerSchema(),
@@ -160,51 +270,176 @@ func RedfishServerResourceBlockMap() map[string]resourceSchema.Block {
 	}
 }
 
+// RequiredHostStateSchema returns schema of optional attribute, which guards operations
+// depending on host power state.
+func RequiredHostStateSchema() resourceSchema.StringAttribute {
+	return resourceSchema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Power state ('On' or 'Off') host must be in when settings are applied. If defined and host is in different power state, apply fails immediately instead of waiting for `job_timeout`.",
+		Description:         "Power state ('On' or 'Off') host must be in when settings are applied. If defined and host is in different power state, apply fails immediately instead of waiting for job_timeout.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(string(redfish.OnPowerState), string(redfish.OffPowerState)),
+		},
+	}
+}
+
+// resolveRedfishServer returns configuration of the first server block. If server block
+// is not defined or does not define some values, they are taken from provider configuration.
+func resolveRedfishServer(pconfig *IrmcProvider, rserver []models.RedfishServer) models.RedfishServer {
+	var server models.RedfishServer
+	if len(rserver) > 0 {
+		server = rserver[0]
+	}
+
+	if pconfig == nil {
+		return server
+	}
+
+	if len(server.Endpoint.ValueString()) == 0 {
+		server.Endpoint = types.StringValue(pconfig.Endpoint)
+	}
+
+	if server.SslInsecure.IsNull() || server.SslInsecure.IsUnknown() {
+		server.SslInsecure = types.BoolValue(pconfig.SslInsecure)
+	}
+
+	return server
+}
+
+// getServerEndpoint returns endpoint of system managed by resource,
+// used e.g. as a key for synchronization of operations on the same system.
+func getServerEndpoint(pconfig *IrmcProvider, rserver []models.RedfishServer) string {
+	return resolveRedfishServer(pconfig, rserver).Endpoint.ValueString()
+}
+
+// readServerPasswordWO copies write-only password of server block from config into servers,
+// since values of write-only arguments are available only in configuration.
+func readServerPasswordWO(ctx context.Context, config tfsdk.Config, servers []models.RedfishServer) diag.Diagnostics {
+	if len(servers) == 0 {
+		return nil
+	}
+
+	return config.GetAttribute(ctx, path.Root("server").AtListIndex(0).AtName("password_wo"), &servers[0].PasswordWO)
+}
+
+// ConnectTargetSystem returns client connected to system described by rserver. Session is taken
+// from provider session pool, so client must be returned using ReleaseTargetSystem instead of Logout.
 func ConnectTargetSystem(pconfig *IrmcProvider, rserver *[]models.RedfishServer) (*gofish.APIClient, error) {
-	if len(*rserver) == 0 {
-		return nil, fmt.Errorf("no provider block was found")
+	return connectTargetSystem(pconfig, rserver, false)
+}
+
+// ReleaseTargetSystem returns client obtained by ConnectTargetSystem to provider session pool.
+func ReleaseTargetSystem(api *gofish.APIClient) {
+	sessionPool.Release(api)
+}
+
+func connectTargetSystem(pconfig *IrmcProvider, rserver *[]models.RedfishServer, forceNew bool) (*gofish.APIClient, error) {
+	clientConfig, err := getTargetClientConfig(pconfig, rserver)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientConfig.Session != nil {
+		api, err := sessionPool.Acquire(clientConfig, forceNew)
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to redfish API using session token: %w", err)
+		}
+
+		return api, nil
+	}
+
+	api, err := sessionPool.Acquire(clientConfig, forceNew)
+	var changeErr *PasswordChangeRequiredError
+	if errors.As(err, &changeErr) && len(pconfig.NewPassword) > 0 {
+		if err := changeRequiredPassword(clientConfig, changeErr.AccountURI, pconfig.NewPassword); err != nil {
+			return nil, fmt.Errorf("%s: %w", changeErr.Error(), err)
+		}
+		storeChangedPassword(clientConfig.Endpoint, clientConfig.Username, pconfig.NewPassword)
+
+		clientConfig.Password = pconfig.NewPassword
+		api, err = sessionPool.Acquire(clientConfig, forceNew)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to redfish API: %w", err)
+	}
+
+	return api, nil
+}
+
+// getTargetClientConfig returns configuration of client connecting to system described by rserver,
+// which contains either session token or credentials resolved from server block, credentials file
+// and provider configuration.
+func getTargetClientConfig(pconfig *IrmcProvider, rserver *[]models.RedfishServer) (gofish.ClientConfig, error) {
+	// first redfish server block, missing values are taken from provider configuration
+	rserver1 := resolveRedfishServer(pconfig, *rserver)
+	if len(rserver1.Endpoint.ValueString()) == 0 {
+		return gofish.ClientConfig{}, errors.New("error. Either provide endpoint in server block or at provider level. Please check your configuration")
+	}
+
+	// Session token takes precedence over credentials defined on the same level,
+	// credentials defined in server block take precedence over credentials file,
+	// which takes precedence over provider configuration
+	fileCreds, inFile := lookupServerCredentials(pconfig.Credentials, rserver1.Endpoint.ValueString())
+	sessionToken := rserver1.SessionToken.ValueString()
+	serverPassword := rserver1.Password.ValueString()
+	if len(serverPassword) == 0 {
+		serverPassword = rserver1.PasswordWO.ValueString()
 	}
 
-	// first redfish server block
-	if len(*rserver) == 0 {
-		return nil, errors.New("redfish server config not present")
+	if len(sessionToken) == 0 && len(rserver1.User.ValueString()) == 0 && len(serverPassword) == 0 && !inFile {
+		sessionToken = pconfig.SessionToken
 	}
-	rserver1 := (*rserver)[0]
+
+	if len(sessionToken) > 0 {
+		// Session ID is intentionally not set, so session brokered externally
+		// will not be deleted when client is released
+		return gofish.ClientConfig{
+			Endpoint:         rserver1.Endpoint.ValueString(),
+			Session:          &gofish.Session{Token: sessionToken},
+			Insecure:         rserver1.SslInsecure.ValueBool(),
+			HTTPClient:       newRedfishHttpClient(pconfig, rserver1.SslInsecure.ValueBool()),
+			ReuseConnections: redfishReuseConnections(pconfig),
+		}, nil
+	}
+
 	var redfishClientUser, redfishClientPass string
 
 	if len(rserver1.User.ValueString()) > 0 {
 		redfishClientUser = rserver1.User.ValueString()
+	} else if len(fileCreds.Username) > 0 {
+		redfishClientUser = fileCreds.Username
 	} else if len(pconfig.Username) > 0 {
 		redfishClientUser = pconfig.Username
 	} else {
-		return nil, fmt.Errorf("error. Either provide username at provider level or resource level. Please check your configuration")
+		return gofish.ClientConfig{}, fmt.Errorf("error. Either provide username or session_token at provider level or resource level. Please check your configuration")
 	}
 
-	if len(rserver1.Password.ValueString()) > 0 {
-		redfishClientPass = rserver1.Password.ValueString()
+	if len(serverPassword) > 0 {
+		redfishClientPass = serverPassword
+	} else if len(fileCreds.Password) > 0 {
+		redfishClientPass = fileCreds.Password
 	} else if len(pconfig.Password) > 0 {
 		redfishClientPass = pconfig.Password
 	} else {
-		return nil, fmt.Errorf("error. Either provide password at provider level or resource level. Please check your configuration")
+		return gofish.ClientConfig{}, fmt.Errorf("error. Either provide password or session_token at provider level or resource level. Please check your configuration")
 	}
 
 	if len(redfishClientUser) == 0 || len(redfishClientPass) == 0 {
-		return nil, fmt.Errorf("error. Either Redfish client username or password has not been set. Please check your configuration")
+		return gofish.ClientConfig{}, fmt.Errorf("error. Either Redfish client username or password has not been set. Please check your configuration")
 	}
 
-	clientConfig := gofish.ClientConfig{
-		Endpoint:  rserver1.Endpoint.ValueString(),
-		Username:  redfishClientUser,
-		Password:  redfishClientPass,
-		BasicAuth: true,
-		Insecure:  rserver1.SslInsecure.ValueBool(),
-	}
-	api, err := gofish.Connect(clientConfig)
-	if err != nil {
-		return nil, fmt.Errorf("error connecting to redfish API: %w", err)
+	if changed, ok := lookupChangedPassword(rserver1.Endpoint.ValueString(), redfishClientUser); ok {
+		redfishClientPass = changed
 	}
 
-	return api, nil
+	return gofish.ClientConfig{
+		Endpoint:         rserver1.Endpoint.ValueString(),
+		Username:         redfishClientUser,
+		Password:         redfishClientPass,
+		Insecure:         rserver1.SslInsecure.ValueBool(),
+		HTTPClient:       newRedfishHttpClient(pconfig, rserver1.SslInsecure.ValueBool()),
+		ReuseConnections: redfishReuseConnections(pconfig),
+	}, nil
 }
 
 // GetSystemResource returns ComputerSystem resource from target defined by service.
@@ -237,27 +472,7 @@ func difference(a, b []string) []string {
 	return diff
 }
 
-func retryConnectWithTimeout(ctx context.Context, pconfig *IrmcProvider, rserver *[]models.RedfishServer) (*gofish.APIClient, error) {
-	startTime := time.Now()
-	var apiClient *gofish.APIClient
-	var err error
-	timeout := 10 * time.Minute
-
-	for time.Since(startTime) < timeout {
-		apiClient, err = ConnectTargetSystem(pconfig, rserver)
-		if err == nil {
-			tflog.Info(ctx, "Successfully connected to the IRMC system.")
-			return apiClient, nil
-		}
-
-		tflog.Warn(ctx, fmt.Sprintf("failed to connect to the IRMC system: %s. Retrying in 30 seconds...", err.Error()))
-		time.Sleep(30 * time.Second)
-	}
-
-	return nil, fmt.Errorf("connection timed out after 10 minutes: %w", err)
-}
-
-func restartIrmc(ctx context.Context, api *gofish.APIClient, RedfishServer []models.RedfishServer, provider *IrmcProvider) error {
+func restartIrmc(ctx context.Context, api *gofish.APIClient, RedfishServer []models.RedfishServer, provider *IrmcProvider, timeout int64) error {
 	managers, err := api.Service.Managers()
 	if err != nil {
 		return fmt.Errorf("error retrieving Managers resource: %w", err)
@@ -268,16 +483,12 @@ func restartIrmc(ctx context.Context, api *gofish.APIClient, RedfishServer []mod
 		return fmt.Errorf("error resetting iRMC: %w", err)
 	}
 
-	api, err = retryConnectWithTimeout(ctx, provider, &RedfishServer)
-	if err != nil {
-		return fmt.Errorf("service connect target system error: %w", err)
-	}
-
-	err = checkIrmcStatus(ctx, api, IRMC_RESET_CHECK_INTERVAL_TIME, IRMC_RESET_TIMEOUT)
+	api, err = waitForManagerRestart(ctx, provider, &RedfishServer, timeout)
 	if err != nil {
 		return fmt.Errorf("failed to check irmc status after reboot request : %w", err)
 	}
 
+	ReleaseTargetSystem(api)
 	return nil
 }
 
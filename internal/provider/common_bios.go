@@ -19,7 +19,13 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -34,10 +40,189 @@ const (
 	BIOS_SETTINGS_ENDPOINT    = "/redfish/v1/Systems/0/Bios/Settings"
 )
 
+const (
+	BIOS_APPLY_TIME_IMMEDIATE      = "Immediate"
+	BIOS_APPLY_TIME_ON_NEXT_REBOOT = "OnNextReboot"
+	REDFISH_APPLY_TIME_ON_RESET    = "OnReset"
+)
+
+// redfishSettingsMessage is message reported by @Redfish.Settings about last settings apply.
+type redfishSettingsMessage struct {
+	MessageId string `json:"MessageId"`
+	Message   string `json:"Message"`
+	Severity  string `json:"Severity"`
+}
+
+type redfishSettingsObject struct {
+	SettingsObject struct {
+		ODataID string `json:"@odata.id"`
+	} `json:"SettingsObject"`
+	SupportedApplyTimes []string `json:"SupportedApplyTimes"`
+	// Time and Messages describe the last time settings object has been applied
+	Time     string                   `json:"Time"`
+	Messages []redfishSettingsMessage `json:"Messages"`
+}
+
+type biosSettingsAnnotation struct {
+	Settings *redfishSettingsObject `json:"@Redfish.Settings,omitempty"`
+}
+
+// readBiosRedfishSettings reads @Redfish.Settings annotation of /Bios resource.
+// If the annotation is not reported by the system, default settings endpoint is returned.
+func readBiosRedfishSettings(service *gofish.Service) (settings redfishSettingsObject, err error) {
+	res, err := service.GetClient().Get(BIOS_ENDPOINT)
+	if err != nil {
+		return settings, fmt.Errorf("GET on %s finished with error '%w'", BIOS_ENDPOINT, err)
+	}
+
+	defer CloseResource(res.Body)
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return settings, fmt.Errorf("error during read of %s GET response body '%w'", BIOS_ENDPOINT, err)
+	}
+
+	var config biosSettingsAnnotation
+	if err = json.Unmarshal(bodyBytes, &config); err != nil {
+		return settings, fmt.Errorf("error during unmarshal of %s GET response '%w'", BIOS_ENDPOINT, err)
+	}
+
+	if config.Settings != nil {
+		settings = *config.Settings
+	}
+
+	if len(settings.SettingsObject.ODataID) == 0 {
+		settings.SettingsObject.ODataID = BIOS_SETTINGS_ENDPOINT
+	}
+
+	return settings, nil
+}
+
+// isOnResetApplyTimeSupported returns information whether settings object accepts
+// @Redfish.SettingsApplyTime with OnReset value.
+func isOnResetApplyTimeSupported(settings redfishSettingsObject) bool {
+	return slices.Contains(settings.SupportedApplyTimes, REDFISH_APPLY_TIME_ON_RESET)
+}
+
+// readPendingBiosSettings returns raw BIOS attributes which are staged in settings object
+// reported by @Redfish.Settings, but differ from currently applied BIOS attributes.
+func readPendingBiosSettings(service *gofish.Service) (pending redfish.SettingsAttributes, err error) {
+	system, err := GetSystemResource(service)
+	if err != nil {
+		return pending, fmt.Errorf("error while reading /Systems/0: %w", err)
+	}
+
+	rBios, err := system.Bios()
+	if err != nil {
+		return pending, fmt.Errorf("error while reading /Systems/0/Bios: %w", err)
+	}
+
+	settings, err := readBiosRedfishSettings(service)
+	if err != nil {
+		return pending, fmt.Errorf("error while reading @Redfish.Settings of /Systems/0/Bios: %w", err)
+	}
+
+	endpoint := settings.SettingsObject.ODataID
+	res, err := service.GetClient().Get(endpoint)
+	if err != nil {
+		return pending, fmt.Errorf("reading %s failed: %w", endpoint, err)
+	}
+
+	defer CloseResource(res.Body)
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return pending, fmt.Errorf("reading body of %s failed: %w", endpoint, err)
+	}
+
+	var config BiosSettings
+	if err = json.Unmarshal(bodyBytes, &config); err != nil {
+		return pending, fmt.Errorf("failed to unmarshal %s response body: %w", endpoint, err)
+	}
+
+	pending = make(redfish.SettingsAttributes)
+	for key, val := range config.Attributes {
+		if currVal, ok := rBios.Attributes[key]; !ok || !reflect.DeepEqual(currVal, val) {
+			pending[key] = val
+		}
+	}
+
+	return pending, nil
+}
+
+// readPendingBiosAttributes returns BIOS attributes which are staged in settings object
+// reported by @Redfish.Settings, but differ from currently applied BIOS attributes.
+func readPendingBiosAttributes(service *gofish.Service) (pending map[string]string, diags diag.Diagnostics) {
+	staged, err := readPendingBiosSettings(service)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Error while reading pending BIOS settings", err)...)
+		return pending, diags
+	}
+
+	pending = make(map[string]string)
+	for key, val := range convertRedfishAttributesToUnifiedFormat(staged) {
+		if isAttributeSupported(key) {
+			pending[key] = val
+		}
+	}
+
+	return pending, diags
+}
+
+// checkForeignPendingBiosSettings reports conflict when BIOS settings object already contains
+// staged changes of attributes other than managed ones (e.g. staged by another tool),
+// since they would be applied together with requested change.
+func checkForeignPendingBiosSettings(service *gofish.Service, managed []string) (diags diag.Diagnostics) {
+	pending, err := readPendingBiosSettings(service)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Error while reading pending BIOS settings", err)...)
+		return diags
+	}
+
+	var foreign []string
+	for key := range pending {
+		if !slices.Contains(managed, key) {
+			foreign = append(foreign, key)
+		}
+	}
+
+	if len(foreign) == 0 {
+		return diags
+	}
+
+	slices.Sort(foreign)
+	diags.AddError("Conflicting pending BIOS settings",
+		fmt.Sprintf("BIOS settings object already contains staged changes of attributes '%s', which have not been applied yet "+
+			"(e.g. staged by another tool or resource). They would be applied together with requested change. "+
+			"Apply them by host reboot or discard them and try again, pending changes can be inspected using %s data source.",
+			strings.Join(foreign, ", "), "irmc-redfish_"+biosPendingName))
+	return diags
+}
+
+// getBiosSettingsApplyFailure returns description of failure reported by @Redfish.Settings
+// messages of last settings apply. Empty string is returned if apply has not failed.
+func getBiosSettingsApplyFailure(settings redfishSettingsObject) string {
+	var failures []string
+	for _, msg := range settings.Messages {
+		if msg.Severity == "Critical" || msg.Severity == "Warning" {
+			failures = append(failures, fmt.Sprintf("%s (%s)", msg.Message, msg.MessageId))
+		}
+	}
+
+	return strings.Join(failures, "; ")
+}
+
 func waitTillBiosSettingsApplied(ctx context.Context, service *gofish.Service, timeout int64, resetType redfish.ResetType) (diags diag.Diagnostics) {
 	poweredOn, err := isPoweredOn(service)
 	if err != nil {
-		diags.AddError("Could not retrieve current power state", err.Error())
+		diags.Append(redfishErrorDiagnostics("Could not retrieve current power state", err)...)
+		return diags
+	}
+
+	// Time of last settings apply allows to recognize when requested change has been applied
+	before, err := readBiosRedfishSettings(service)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Error while reading @Redfish.Settings of /Systems/0/Bios", err)...)
 		return diags
 	}
 
@@ -47,15 +232,15 @@ func waitTillBiosSettingsApplied(ctx context.Context, service *gofish.Service, t
 	startTime := time.Now().Unix()
 
 	if !poweredOn {
-		err = changePowerState(service, true, timeout)
+		err = changePowerState(ctx, service, true, timeout)
 	} else {
-		err = resetHost(service, resetType, timeout)
+		err = resetHost(ctx, service, resetType, timeout)
 	}
 
 	// Due to BIOS setting change it might happen that host will be powered off after
 	// BIOS POST phase, so to not break the process the error must be omitted
 	if err != nil && err.Error() != "BIOS exited POST but host powered off" {
-		diags.AddError("Host could not be powered on to finish BIOS settings", err.Error())
+		diags.Append(redfishErrorDiagnostics("Host could not be powered on to finish BIOS settings", err)...)
 		return diags
 	}
 
@@ -64,29 +249,43 @@ func waitTillBiosSettingsApplied(ctx context.Context, service *gofish.Service, t
 		return diags
 	}
 
-	for {
-		numberOfKeysInMap, diags := getBiosSettingsFutureAttributesNumber(service)
-		if diags.HasError() {
-			return diags
+	remaining := timeout - (time.Now().Unix() - startTime)
+	return waitTillBiosSettingsFutureAttributesApplied(ctx, service, remaining, before.Time)
+}
+
+// waitTillBiosSettingsFutureAttributesApplied polls BIOS settings until staged attributes
+// will be consumed by BIOS or until timeout (in seconds) will be reached. Settings are treated
+// as applied when @Redfish.Settings reports apply time different from appliedBefore or when
+// settings object does not contain any attribute differing from current BIOS attributes.
+func waitTillBiosSettingsFutureAttributesApplied(ctx context.Context, service *gofish.Service, timeout int64, appliedBefore string) (diags diag.Diagnostics) {
+	err := pollService(ctx, service, timeout, 2*time.Second, func(ctx context.Context) (bool, error) {
+		settings, err := readBiosRedfishSettings(service)
+		if err != nil {
+			return false, err
 		}
 
-		/*
-		   At the moment the only way to check if process is finished is to check
-		   Attributes parameter of /Bios/Settings. In case of parameters not yet applied,
-		   it will contain only these which are planned to be applied. After process complete,
-		   it will contain all writable properties. It's not best mechanism, but the only one known as of now
-		*/
-		if numberOfKeysInMap > 5 {
-			var logMsg = fmt.Sprintf("Number of keys %d", numberOfKeysInMap)
-			tflog.Info(ctx, logMsg)
-			break
+		if len(settings.Time) > 0 && settings.Time != appliedBefore {
+			if failure := getBiosSettingsApplyFailure(settings); len(failure) > 0 {
+				return false, fmt.Errorf("BIOS reported failure while applying settings: %s", failure)
+			}
+
+			tflog.Info(ctx, fmt.Sprintf("BIOS settings have been applied at %s", settings.Time))
+			return true, nil
 		}
 
-		time.Sleep(2 * time.Second)
-		if time.Now().Unix()-startTime > timeout {
-			diags.AddError("Job timeout exceeded while operation has not finished", "Terminate")
-			return diags
+		pending, err := readPendingBiosSettings(service)
+		if err != nil {
+			return false, err
 		}
+
+		tflog.Info(ctx, fmt.Sprintf("Number of pending BIOS attributes %d", len(pending)))
+		return len(pending) == 0, nil
+	})
+
+	if errors.Is(err, errPollTimeout) {
+		diags.AddError("Job timeout exceeded while operation has not finished", "Terminate")
+	} else if err != nil {
+		diags.Append(redfishErrorDiagnostics("Waiting for BIOS settings to be applied has been interrupted", err)...)
 	}
 
 	return diags
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+)
+
+func newMockBiosServer(current map[string]interface{}, staged map[string]interface{}, settings map[string]interface{}) *mockRedfishServer {
+	server := newMockRedfishServer()
+
+	settings["SettingsObject"] = mockRedfishLink(BIOS_SETTINGS_ENDPOINT)
+	server.Set(BIOS_ENDPOINT, map[string]interface{}{
+		"Attributes":        current,
+		"@Redfish.Settings": settings,
+	})
+	server.Set(BIOS_SETTINGS_ENDPOINT, map[string]interface{}{"Attributes": staged})
+	return server
+}
+
+func TestCheckForeignPendingBiosSettings(t *testing.T) {
+	server := newMockBiosServer(
+		map[string]interface{}{"BootMode": "Uefi", "HyperThreading": "Enabled", "NumLock": "On"},
+		map[string]interface{}{"HyperThreading": "Disabled", "NumLock": "On"},
+		map[string]interface{}{},
+	)
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	if diags := checkForeignPendingBiosSettings(api.Service, []string{"HyperThreading"}); diags.HasError() {
+		t.Errorf("pending change of managed attribute must not be reported as conflict: %v", diags)
+	}
+
+	diags := checkForeignPendingBiosSettings(api.Service, []string{PERSISTENT_BOOT_ORDER_KEY})
+	if !diags.HasError() {
+		t.Fatalf("pending change of foreign attribute must be reported as conflict")
+	}
+
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "HyperThreading") || strings.Contains(detail, "NumLock") {
+		t.Errorf("unexpected conflict detail '%s'", detail)
+	}
+}
+
+func TestWaitTillBiosSettingsFutureAttributesApplied(t *testing.T) {
+	tests := []struct {
+		name     string
+		staged   map[string]interface{}
+		settings map[string]interface{}
+		fails    bool
+	}{
+		{
+			name:     "no pending attributes",
+			staged:   map[string]interface{}{"HyperThreading": "Enabled"},
+			settings: map[string]interface{}{},
+		},
+		{
+			name:     "apply time changed",
+			staged:   map[string]interface{}{"HyperThreading": "Disabled"},
+			settings: map[string]interface{}{"Time": "2025-01-01T12:00:00+00:00"},
+		},
+		{
+			name:   "apply failed",
+			staged: map[string]interface{}{"HyperThreading": "Disabled"},
+			settings: map[string]interface{}{
+				"Time": "2025-01-01T12:00:00+00:00",
+				"Messages": []interface{}{map[string]interface{}{
+					"MessageId": "Base.1.12.PropertyValueNotInList",
+					"Message":   "The value Disabled for the property HyperThreading is not in the list of acceptable values.",
+					"Severity":  "Warning",
+				}},
+			},
+			fails: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newMockBiosServer(map[string]interface{}{"HyperThreading": "Enabled"}, test.staged, test.settings)
+			defer server.Close()
+
+			api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err.Error())
+			}
+			defer ReleaseTargetSystem(api)
+
+			diags := waitTillBiosSettingsFutureAttributesApplied(context.Background(), api.Service, 5, "")
+			if diags.HasError() != test.fails {
+				t.Errorf("expected failure %t, got %v", test.fails, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ENV_IRMC_ENDPOINT         = "IRMC_ENDPOINT"
+	ENV_IRMC_USER             = "IRMC_USER"
+	ENV_IRMC_PASSWORD         = "IRMC_PASSWORD"
+	ENV_IRMC_CREDENTIALS_FILE = "IRMC_CREDENTIALS_FILE"
+)
+
+// serverCredentials describes credentials of single system defined in credentials file.
+type serverCredentials struct {
+	Username string `yaml:"username" json:"username"`
+	Password string `yaml:"password" json:"password"`
+}
+
+// loadCredentialsFile reads map of system endpoints and their credentials from JSON or YAML
+// file pointed by path. Endpoints are normalized, so they might be defined with or without scheme.
+func loadCredentialsFile(path string) (map[string]serverCredentials, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read credentials file '%s': %w", path, err)
+	}
+
+	// JSON is a subset of YAML, so the same parser handles both formats
+	var raw map[string]serverCredentials
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("could not parse credentials file '%s': %w", path, err)
+	}
+
+	credentials := make(map[string]serverCredentials, len(raw))
+	for endpoint, creds := range raw {
+		credentials[normalizeEndpoint(endpoint)] = creds
+	}
+
+	return credentials, nil
+}
+
+// lookupServerCredentials returns credentials defined for endpoint in credentials file.
+func lookupServerCredentials(credentials map[string]serverCredentials, endpoint string) (serverCredentials, bool) {
+	creds, ok := credentials[normalizeEndpoint(endpoint)]
+	return creds, ok
+}
+
+func normalizeEndpoint(endpoint string) string {
+	endpoint = strings.ToLower(strings.TrimSpace(endpoint))
+	endpoint = strings.TrimPrefix(endpoint, "https://")
+	endpoint = strings.TrimPrefix(endpoint, "http://")
+	return strings.TrimSuffix(endpoint, "/")
+}
+
+// valueOrEnv returns value if it is not empty, otherwise value of environment variable env.
+func valueOrEnv(value string, env string) string {
+	if len(value) > 0 {
+		return value
+	}
+	return os.Getenv(env)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadCredentialsFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"creds.json": `{"https://10.172.201.205": {"username": "admin", "password": "secret"}}`,
+		"creds.yaml": "10.172.201.205/:\n  username: admin\n  password: secret\n",
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+				t.Fatalf("Could not write credentials file %s", err.Error())
+			}
+
+			credentials, err := loadCredentialsFile(path)
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err.Error())
+			}
+
+			creds, ok := lookupServerCredentials(credentials, "https://10.172.201.205")
+			if !ok || creds.Username != "admin" || creds.Password != "secret" {
+				t.Errorf("Got %v, expected credentials of admin user", creds)
+			}
+
+			if _, ok := lookupServerCredentials(credentials, "https://10.172.201.206"); ok {
+				t.Errorf("Credentials of not defined endpoint should not be found")
+			}
+		})
+	}
+}
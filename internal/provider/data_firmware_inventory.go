@@ -141,14 +141,14 @@ func (d *IrmcFirmwareInventoryDataSource) Read(ctx context.Context, req datasour
 
 	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connection Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connection Error", err)...)
 		return
 	}
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	members, err := GetFirmwareInventoryList(api)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Getting Firmware Inventories", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error Getting Firmware Inventories", err)...)
 		return
 	}
 	data.ID = types.StringValue(FIRMWARE_INVENTORY_ENDPOINT)
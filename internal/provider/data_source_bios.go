@@ -99,10 +99,12 @@ func (d *BiosDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	// Connect to service
 	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
+	defer ReleaseTargetSystem(api)
+
 	diags := readBiosAttributesSettingsToModel(ctx, api.Service, &data.Attributes, true)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
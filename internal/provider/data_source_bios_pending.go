@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BiosPendingDataSource{}
+
+func NewBiosPendingDataSource() datasource.DataSource {
+	return &BiosPendingDataSource{}
+}
+
+// BiosPendingDataSource defines the data source implementation.
+type BiosPendingDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *BiosPendingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + biosPendingName
+}
+
+func BiosPendingDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of BIOS settings object on iRMC.",
+			Description:         "ID of BIOS settings object on iRMC.",
+		},
+		"pending_attributes": schema.MapAttribute{
+			Computed:            true,
+			MarkdownDescription: "Map of BIOS attributes which are staged, but not yet applied.",
+			Description:         "Map of BIOS attributes which are staged, but not yet applied.",
+			ElementType:         types.StringType,
+		},
+		"reboot_required": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Indicates whether host reboot is required to apply pending BIOS attributes.",
+			Description:         "Indicates whether host reboot is required to apply pending BIOS attributes.",
+		},
+	}
+}
+
+func (d *BiosPendingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to read BIOS settings which are staged, but not yet applied.",
+		Description:         "This datasource is used to read BIOS settings which are staged, but not yet applied.",
+		Attributes:          BiosPendingDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *BiosPendingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *BiosPendingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-bios-pending: read starts")
+
+	var data models.BiosPendingDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Connect to service
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	settings, err := readBiosRedfishSettings(api.Service)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while reading @Redfish.Settings of /Systems/0/Bios", err)...)
+		return
+	}
+
+	pending, diags := readPendingBiosAttributes(api.Service)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.PendingAttributes, diags = types.MapValueFrom(ctx, types.StringType, pending)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(settings.SettingsObject.ODataID)
+	data.RebootRequired = types.BoolValue(len(pending) > 0)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-bios-pending: read ends")
+}
@@ -0,0 +1,308 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DriveHealthDataSource{}
+
+func NewDriveHealthDataSource() datasource.DataSource {
+	return &DriveHealthDataSource{}
+}
+
+// DriveHealthDataSource defines the data source implementation.
+type DriveHealthDataSource struct {
+	p *IrmcProvider
+}
+
+// driveHealthObject contains drive properties, which are not distinguishable
+// from zero values in gofish when drive does not report them.
+type driveHealthObject struct {
+	PredictedMediaLifeLeftPercent *float64
+}
+
+// driveMetricsObject represents DriveMetrics resource linked from drive.
+type driveMetricsObject struct {
+	PowerOnHours                   *float64
+	BadBlockCount                  *int64
+	UncorrectableIOReadErrorCount  *int64
+	UncorrectableIOWriteErrorCount *int64
+	NVMeSMART                      *struct {
+		PercentageUsed *float64
+		PowerOnHours   *float64
+	}
+}
+
+func (d *DriveHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + driveHealthName
+}
+
+func DriveHealthDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"storage_controller_serial_number": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Serial number of storage controller.",
+			Description:         "Serial number of storage controller.",
+		},
+		"drives": schema.ListNestedAttribute{
+			MarkdownDescription: "List of drives connected to the storage controller together with their health and endurance",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "ODataId of the drive",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the drive",
+					},
+					"slot": schema.StringAttribute{
+						Computed:    true,
+						Description: "Slot location of the drive, in format used by physical_drives of storage_volume resource",
+					},
+					"model": schema.StringAttribute{
+						Computed:    true,
+						Description: "Model of the drive",
+					},
+					"serial_number": schema.StringAttribute{
+						Computed:    true,
+						Description: "Serial number of the drive",
+					},
+					"media_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Media type of the drive (HDD, SSD, SMR)",
+					},
+					"protocol": schema.StringAttribute{
+						Computed:    true,
+						Description: "Protocol used by the drive (e.g. SAS, SATA, NVMe)",
+					},
+					"health": schema.StringAttribute{
+						Computed:    true,
+						Description: "Health of the drive, which covers also SMART status evaluated by controller",
+					},
+					"state": schema.StringAttribute{
+						Computed:    true,
+						Description: "State of the drive",
+					},
+					"failure_predicted": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Indicates whether failure of the drive is predicted (SMART predictive failure)",
+					},
+					"predicted_media_life_left_percent": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Percentage of reads and writes predicted to be still available for the media (SSD endurance remaining), null if not reported",
+					},
+					"percentage_used": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Percentage of NVMe drive life used as reported by NVMe SMART, null if not reported",
+					},
+					"power_on_hours": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Number of hours the drive has been powered on, null if not reported",
+					},
+					"bad_block_count": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of bad blocks of the drive, null if not reported",
+					},
+					"uncorrectable_error_count": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of uncorrectable read and write errors of the drive, null if not reported",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DriveHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Drive health data source, which lists drives of a storage controller with their health, SSD endurance and SMART data",
+		Attributes:          DriveHealthDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *DriveHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *DriveHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-drive-health: read starts")
+
+	var data models.DriveHealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	storage, err := getSystemStorageFromSerialNumber(api.Service, data.StorageControllerSN.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain storage resource", err)...)
+		return
+	}
+
+	drives, err := storage.Drives()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain drives of storage resource", err)...)
+		return
+	}
+
+	sort.Slice(drives, func(i, j int) bool {
+		return drives[i].ODataID < drives[j].ODataID
+	})
+
+	data.Drives = []models.DriveHealthData{}
+	for _, drive := range drives {
+		var metrics *driveMetricsObject
+		if len(drive.Metrics.ODataID) > 0 {
+			metrics, err = getDriveMetrics(api.Service, drive.Metrics.ODataID)
+			if err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Metrics of drive %s could not be read: %s", drive.ODataID, err.Error()))
+			}
+		}
+
+		data.Drives = append(data.Drives, driveHealthDataFromResource(ctx, drive, metrics))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-drive-health: read ends")
+}
+
+// getDriveMetrics reads DriveMetrics resource pointed by endpoint.
+func getDriveMetrics(service *gofish.Service, endpoint string) (*driveMetricsObject, error) {
+	res, err := service.GetClient().Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	defer CloseResource(res.Body)
+
+	var metrics driveMetricsObject
+	if err := json.NewDecoder(res.Body).Decode(&metrics); err != nil {
+		return nil, err
+	}
+
+	return &metrics, nil
+}
+
+// driveHealthDataFromResource converts drive and its metrics (if available) into data source model.
+func driveHealthDataFromResource(ctx context.Context, drive *redfish.Drive, metrics *driveMetricsObject) models.DriveHealthData {
+	data := models.DriveHealthData{
+		Id:                      types.StringValue(drive.ODataID),
+		Name:                    types.StringValue(drive.Name),
+		Slot:                    types.StringNull(),
+		Model:                   types.StringValue(drive.Model),
+		SerialNumber:            types.StringValue(drive.SerialNumber),
+		MediaType:               types.StringValue(string(drive.MediaType)),
+		Protocol:                types.StringValue(string(drive.Protocol)),
+		Health:                  types.StringValue(string(drive.Status.Health)),
+		State:                   types.StringValue(string(drive.Status.State)),
+		FailurePredicted:        types.BoolValue(drive.FailurePredicted),
+		MediaLifeLeftPercent:    types.Float64Null(),
+		PercentageUsed:          types.Float64Null(),
+		PowerOnHours:            types.Float64Null(),
+		BadBlockCount:           types.Int64Null(),
+		UncorrectableErrorCount: types.Int64Null(),
+	}
+
+	if slot, err := getDriveSlot(drive); err == nil {
+		data.Slot = types.StringValue(slot)
+	} else {
+		tflog.Warn(ctx, fmt.Sprintf("Slot of drive %s could not be determined: %s", drive.ODataID, err.Error()))
+	}
+
+	var raw driveHealthObject
+	if err := json.Unmarshal(drive.RawData, &raw); err == nil && raw.PredictedMediaLifeLeftPercent != nil {
+		data.MediaLifeLeftPercent = types.Float64Value(*raw.PredictedMediaLifeLeftPercent)
+	}
+
+	if metrics == nil {
+		return data
+	}
+
+	if metrics.PowerOnHours != nil {
+		data.PowerOnHours = types.Float64Value(*metrics.PowerOnHours)
+	}
+
+	if metrics.BadBlockCount != nil {
+		data.BadBlockCount = types.Int64Value(*metrics.BadBlockCount)
+	}
+
+	if metrics.UncorrectableIOReadErrorCount != nil || metrics.UncorrectableIOWriteErrorCount != nil {
+		var count int64
+		if metrics.UncorrectableIOReadErrorCount != nil {
+			count += *metrics.UncorrectableIOReadErrorCount
+		}
+		if metrics.UncorrectableIOWriteErrorCount != nil {
+			count += *metrics.UncorrectableIOWriteErrorCount
+		}
+		data.UncorrectableErrorCount = types.Int64Value(count)
+	}
+
+	if metrics.NVMeSMART != nil {
+		if metrics.NVMeSMART.PercentageUsed != nil {
+			data.PercentageUsed = types.Float64Value(*metrics.NVMeSMART.PercentageUsed)
+		}
+		if data.PowerOnHours.IsNull() && metrics.NVMeSMART.PowerOnHours != nil {
+			data.PowerOnHours = types.Float64Value(*metrics.NVMeSMART.PowerOnHours)
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestAccDriveHealthDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDriveHealthDataSourceConfig(creds, os.Getenv("TF_TESTING_STORAGE_SERIAL_NUMBER")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_drive_health.drives", "drives.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestDriveHealthDataFromResource(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	ssd := "/redfish/v1/Chassis/0/Drives/0"
+	drive := server.Resource(ssd)
+	drive["PredictedMediaLifeLeftPercent"] = 87.5
+	drive["FailurePredicted"] = true
+	drive["Metrics"] = mockRedfishLink(ssd + "/Metrics")
+	server.Set(ssd, drive)
+	server.Set(ssd+"/Metrics", map[string]interface{}{
+		"PowerOnHours":                   12345.5,
+		"BadBlockCount":                  3,
+		"UncorrectableIOReadErrorCount":  1,
+		"UncorrectableIOWriteErrorCount": 2,
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	ssdDrive, err := redfish.GetDrive(api.Service.GetClient(), ssd)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	metrics, err := getDriveMetrics(api.Service, ssdDrive.Metrics.ODataID)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	data := driveHealthDataFromResource(context.Background(), ssdDrive, metrics)
+	if !data.FailurePredicted.ValueBool() || data.MediaLifeLeftPercent.ValueFloat64() != 87.5 {
+		t.Errorf("unexpected drive health %v", data)
+	}
+
+	if data.PowerOnHours.ValueFloat64() != 12345.5 || data.BadBlockCount.ValueInt64() != 3 || data.UncorrectableErrorCount.ValueInt64() != 3 {
+		t.Errorf("unexpected drive metrics %v", data)
+	}
+
+	if !data.PercentageUsed.IsNull() {
+		t.Errorf("expected NVMe percentage used not to be reported, got %v", data.PercentageUsed)
+	}
+
+	// Drive without endurance and metrics reports them as null
+	hdd, err := redfish.GetDrive(api.Service.GetClient(), "/redfish/v1/Chassis/0/Drives/1")
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	data = driveHealthDataFromResource(context.Background(), hdd, nil)
+	if data.FailurePredicted.ValueBool() || !data.MediaLifeLeftPercent.IsNull() || !data.PowerOnHours.IsNull() || !data.BadBlockCount.IsNull() {
+		t.Errorf("unexpected drive health %v", data)
+	}
+}
+
+func testAccDriveHealthDataSourceConfig(testingInfo TestingServerCredentials, serial string) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_drive_health" "drives" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		storage_controller_serial_number = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		serial,
+	)
+}
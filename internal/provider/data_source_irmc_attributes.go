@@ -96,13 +96,15 @@ func (d *IrmcAttributesDataSource) Read(ctx context.Context, req datasource.Read
 	// Connect to service
 	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
+	defer ReleaseTargetSystem(api)
+
 	isFsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 
@@ -0,0 +1,221 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IrmcChassisDataSource{}
+
+func NewIrmcChassisDataSource() datasource.DataSource {
+	return &IrmcChassisDataSource{}
+}
+
+// IrmcChassisDataSource defines the data source implementation.
+type IrmcChassisDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *IrmcChassisDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + irmcChassisName
+}
+
+func IrmcChassisDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"chassis": schema.ListNestedAttribute{
+			MarkdownDescription: "List of chassis reported by iRMC",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "ODataId of the chassis",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the chassis",
+					},
+					"chassis_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Physical form factor of the chassis (e.g. RackMount, Blade, Enclosure)",
+					},
+					"manufacturer": schema.StringAttribute{
+						Computed:    true,
+						Description: "Manufacturer of the chassis",
+					},
+					"model": schema.StringAttribute{
+						Computed:    true,
+						Description: "Model name of the chassis",
+					},
+					"sku": schema.StringAttribute{
+						Computed:    true,
+						Description: "Stock keeping unit number of the chassis",
+					},
+					"part_number": schema.StringAttribute{
+						Computed:    true,
+						Description: "Part number of the chassis",
+					},
+					"serial_number": schema.StringAttribute{
+						Computed:    true,
+						Description: "Serial number of the chassis",
+					},
+					"asset_tag": schema.StringAttribute{
+						Computed:    true,
+						Description: "Asset tag of the chassis assigned by the user",
+					},
+					"uuid": schema.StringAttribute{
+						Computed:    true,
+						Description: "UUID of the chassis",
+					},
+					"power_state": schema.StringAttribute{
+						Computed:    true,
+						Description: "Power state of the chassis (On, Off)",
+					},
+					"health": schema.StringAttribute{
+						Computed:    true,
+						Description: "Health of the chassis (OK, Warning, Critical)",
+					},
+					"height_mm": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Height of the chassis in millimeters. Null if not reported",
+					},
+					"width_mm": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Width of the chassis in millimeters. Null if not reported",
+					},
+					"depth_mm": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Depth of the chassis in millimeters. Null if not reported",
+					},
+					"weight_kg": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Weight of the chassis in kilograms. Null if not reported",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IrmcChassisDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Chassis data source, which exposes FRU information of chassis, e.g. for DCIM tools like NetBox",
+		Attributes:          IrmcChassisDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *IrmcChassisDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *IrmcChassisDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-irmc-chassis: read starts")
+
+	var data models.IrmcChassisDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	chassis, err := api.Service.Chassis()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain chassis", err)...)
+		return
+	}
+
+	sort.Slice(chassis, func(i, j int) bool {
+		return chassis[i].ODataID < chassis[j].ODataID
+	})
+
+	data.Chassis = []models.IrmcChassisData{}
+	for _, c := range chassis {
+		data.Chassis = append(data.Chassis, irmcChassisDataFromResource(c))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-irmc-chassis: read ends")
+}
+
+// chassisDimension returns dimension of chassis, which is null if not reported.
+func chassisDimension(value float64) types.Float64 {
+	if value == 0 {
+		return types.Float64Null()
+	}
+
+	return types.Float64Value(value)
+}
+
+// irmcChassisDataFromResource converts chassis into data source model.
+func irmcChassisDataFromResource(chassis *redfish.Chassis) models.IrmcChassisData {
+	return models.IrmcChassisData{
+		Id:           types.StringValue(chassis.ODataID),
+		Name:         types.StringValue(chassis.Name),
+		ChassisType:  types.StringValue(string(chassis.ChassisType)),
+		Manufacturer: types.StringValue(chassis.Manufacturer),
+		Model:        types.StringValue(chassis.Model),
+		SKU:          types.StringValue(chassis.SKU),
+		PartNumber:   types.StringValue(chassis.PartNumber),
+		SerialNumber: types.StringValue(chassis.SerialNumber),
+		AssetTag:     types.StringValue(chassis.AssetTag),
+		UUID:         types.StringValue(chassis.UUID),
+		PowerState:   types.StringValue(string(chassis.PowerState)),
+		Health:       types.StringValue(string(chassis.Status.Health)),
+		HeightMm:     chassisDimension(chassis.HeightMm),
+		WidthMm:      chassisDimension(chassis.WidthMm),
+		DepthMm:      chassisDimension(chassis.DepthMm),
+		WeightKg:     chassisDimension(chassis.WeightKg),
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIrmcChassisDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIrmcChassisDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_irmc_chassis.chassis", "chassis.0.serial_number"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_irmc_chassis.chassis", "chassis.0.model"),
+				),
+			},
+		},
+	})
+}
+
+func TestIrmcChassisDataFromResource(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	server.Set("/redfish/v1/Chassis/0", map[string]interface{}{
+		"Name":         "Computer System Chassis",
+		"ChassisType":  "RackMount",
+		"Manufacturer": "FUJITSU",
+		"Model":        "PRIMERGY RX2540 M7",
+		"PartNumber":   "S26361-K1789-V101",
+		"SerialNumber": "YM5H012345",
+		"PowerState":   "On",
+		"HeightMm":     87.6,
+		"DepthMm":      773,
+		"Status":       map[string]interface{}{"Health": "OK"},
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	chassis, err := api.Service.Chassis()
+	if err != nil || len(chassis) != 1 {
+		t.Fatalf("Unexpected chassis %v, %v", chassis, err)
+	}
+
+	data := irmcChassisDataFromResource(chassis[0])
+	if data.SerialNumber.ValueString() != "YM5H012345" || data.Model.ValueString() != "PRIMERGY RX2540 M7" ||
+		data.PartNumber.ValueString() != "S26361-K1789-V101" || data.PowerState.ValueString() != "On" ||
+		data.ChassisType.ValueString() != "RackMount" || data.Health.ValueString() != "OK" {
+		t.Errorf("Unexpected chassis data %+v", data)
+	}
+
+	if data.HeightMm.ValueFloat64() != 87.6 || data.DepthMm.ValueFloat64() != 773 {
+		t.Errorf("Unexpected dimensions %+v", data)
+	}
+
+	if !data.WidthMm.IsNull() || !data.WeightKg.IsNull() {
+		t.Errorf("Not reported dimensions should be null, got %+v", data)
+	}
+}
+
+func testAccIrmcChassisDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_irmc_chassis" "chassis" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
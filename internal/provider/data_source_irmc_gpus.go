@@ -0,0 +1,268 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IrmcGpusDataSource{}
+
+func NewIrmcGpusDataSource() datasource.DataSource {
+	return &IrmcGpusDataSource{}
+}
+
+// IrmcGpusDataSource defines the data source implementation.
+type IrmcGpusDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *IrmcGpusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + irmcGpusName
+}
+
+func IrmcGpusDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of present GPUs",
+		},
+		"gpus": schema.ListNestedAttribute{
+			MarkdownDescription: "List of present GPUs reported by iRMC as processors of type GPU",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "ODataId of the GPU",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the GPU",
+					},
+					"manufacturer": schema.StringAttribute{
+						Computed:    true,
+						Description: "Manufacturer of the GPU",
+					},
+					"model": schema.StringAttribute{
+						Computed:    true,
+						Description: "Model of the GPU",
+					},
+					"serial_number": schema.StringAttribute{
+						Computed:    true,
+						Description: "Serial number of the GPU",
+					},
+					"part_number": schema.StringAttribute{
+						Computed:    true,
+						Description: "Part number of the GPU",
+					},
+					"firmware_version": schema.StringAttribute{
+						Computed:    true,
+						Description: "Firmware (VBIOS) version of the GPU. Null if not reported",
+					},
+					"memory_mib": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Total capacity of GPU memory in MiB. Null if not reported",
+					},
+					"memory_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Type of GPU memory (e.g. HBM3, GDDR6). Null if not reported",
+					},
+					"temperature_celsius": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Current temperature of the GPU in degrees Celsius. Null if not reported",
+					},
+					"health": schema.StringAttribute{
+						Computed:    true,
+						Description: "Health of the GPU (OK, Warning, Critical)",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IrmcGpusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "GPUs data source, which exposes inventory and health of GPUs, e.g. to verify GPU presence before node joins a cluster",
+		Attributes:          IrmcGpusDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *IrmcGpusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *IrmcGpusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-irmc-gpus: read starts")
+
+	var data models.IrmcGpusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	data.Gpus, err = readIrmcGpus(api.Service)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain GPUs", err)...)
+		return
+	}
+
+	data.Count = types.Int64Value(int64(len(data.Gpus)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-irmc-gpus: read ends")
+}
+
+// readIrmcGpus returns present processors of type GPU ordered by their ODataId.
+func readIrmcGpus(service *gofish.Service) ([]models.IrmcGpuData, error) {
+	system, err := GetSystemResource(service)
+	if err != nil {
+		return nil, err
+	}
+
+	processors, err := system.Processors()
+	if err != nil {
+		return nil, fmt.Errorf("could not obtain processors: %w", err)
+	}
+
+	sort.Slice(processors, func(i, j int) bool {
+		return processors[i].ODataID < processors[j].ODataID
+	})
+
+	gpus := []models.IrmcGpuData{}
+	for _, processor := range processors {
+		if processor.ProcessorType != redfish.GPUProcessorType || processor.Status.State == common.AbsentState {
+			continue
+		}
+
+		metrics, err := processor.EnvironmentMetrics()
+		if err != nil {
+			return nil, fmt.Errorf("could not obtain environment metrics of GPU %s: %w", processor.ODataID, err)
+		}
+
+		memory, err := getGpuProcessorMemory(service, processor.ODataID)
+		if err != nil {
+			return nil, fmt.Errorf("could not obtain memory of GPU %s: %w", processor.ODataID, err)
+		}
+
+		gpus = append(gpus, irmcGpuDataFromResource(processor, memory, metrics))
+	}
+
+	return gpus, nil
+}
+
+// getGpuProcessorMemory reads ProcessorMemory of processor pointed by endpoint.
+// gofish does not decode this property, so it has to be taken from raw resource.
+func getGpuProcessorMemory(service *gofish.Service, endpoint string) ([]redfish.ProcessorMemory, error) {
+	res, err := service.GetClient().Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	defer CloseResource(res.Body)
+
+	var processor struct {
+		ProcessorMemory []redfish.ProcessorMemory
+	}
+	if err := json.NewDecoder(res.Body).Decode(&processor); err != nil {
+		return nil, err
+	}
+
+	return processor.ProcessorMemory, nil
+}
+
+// irmcGpuDataFromResource converts GPU processor, its memory and environment metrics (if any) into data source model.
+func irmcGpuDataFromResource(processor *redfish.Processor, memory []redfish.ProcessorMemory,
+	metrics *redfish.EnvironmentMetrics) models.IrmcGpuData {
+	data := models.IrmcGpuData{
+		Id:                 types.StringValue(processor.ODataID),
+		Name:               types.StringValue(processor.Name),
+		Manufacturer:       types.StringValue(processor.Manufacturer),
+		Model:              types.StringValue(processor.Model),
+		SerialNumber:       types.StringValue(processor.SerialNumber),
+		PartNumber:         types.StringValue(processor.PartNumber),
+		FirmwareVersion:    types.StringNull(),
+		MemoryMiB:          types.Int64Null(),
+		MemoryType:         types.StringNull(),
+		TemperatureCelsius: types.Float64Null(),
+		Health:             types.StringValue(string(processor.Status.Health)),
+	}
+
+	if len(processor.FirmwareVersion) > 0 {
+		data.FirmwareVersion = types.StringValue(processor.FirmwareVersion)
+	}
+
+	var capacity int64
+	for _, m := range memory {
+		capacity += int64(m.CapacityMiB)
+		if data.MemoryType.IsNull() && len(m.MemoryType) > 0 {
+			data.MemoryType = types.StringValue(string(m.MemoryType))
+		}
+	}
+
+	if capacity > 0 {
+		data.MemoryMiB = types.Int64Value(capacity)
+	}
+
+	// Zero reading is reported, when GPU does not provide temperature sensor
+	if metrics != nil && metrics.TemperatureCelsius.Reading != 0 {
+		data.TemperatureCelsius = types.Float64Value(float64(metrics.TemperatureCelsius.Reading))
+	}
+
+	return data
+}
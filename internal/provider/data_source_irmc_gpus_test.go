@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIrmcGpusDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIrmcGpusDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_irmc_gpus.gpus", "count"),
+				),
+			},
+		},
+	})
+}
+
+func TestReadIrmcGpus(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	processors := "/redfish/v1/Systems/0/Processors"
+	system := server.Resource("/redfish/v1/Systems/0")
+	system["Processors"] = mockRedfishLink(processors)
+	server.Set("/redfish/v1/Systems/0", system)
+
+	server.Set(processors, map[string]interface{}{
+		"Members": []interface{}{
+			mockRedfishLink(processors + "/CPU0"),
+			mockRedfishLink(processors + "/GPU1"),
+			mockRedfishLink(processors + "/GPU0"),
+		},
+	})
+	server.Set(processors+"/CPU0", map[string]interface{}{
+		"ProcessorType": "CPU",
+		"Model":         "Intel(R) Xeon(R) Gold 6430",
+		"Status":        map[string]interface{}{"State": "Enabled", "Health": "OK"},
+	})
+	server.Set(processors+"/GPU0", map[string]interface{}{
+		"ProcessorType":   "GPU",
+		"Manufacturer":    "NVIDIA",
+		"Model":           "NVIDIA H100 PCIe",
+		"SerialNumber":    "1650123456789",
+		"FirmwareVersion": "96.00.74.00.01",
+		"ProcessorMemory": []interface{}{
+			map[string]interface{}{"CapacityMiB": 81920, "MemoryType": "HBM2", "IntegratedMemory": true},
+		},
+		"EnvironmentMetrics": mockRedfishLink(processors + "/GPU0/EnvironmentMetrics"),
+		"Status":             map[string]interface{}{"State": "Enabled", "Health": "OK"},
+	})
+	server.Set(processors+"/GPU0/EnvironmentMetrics", map[string]interface{}{
+		"TemperatureCelsius": map[string]interface{}{"Reading": 41.5},
+	})
+	server.Set(processors+"/GPU1", map[string]interface{}{
+		"ProcessorType": "GPU",
+		"Status":        map[string]interface{}{"State": "Absent"},
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	gpus, err := readIrmcGpus(api.Service)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if len(gpus) != 1 {
+		t.Fatalf("Expected only present GPU, got %+v", gpus)
+	}
+
+	gpu := gpus[0]
+	if gpu.Model.ValueString() != "NVIDIA H100 PCIe" || gpu.FirmwareVersion.ValueString() != "96.00.74.00.01" ||
+		gpu.MemoryMiB.ValueInt64() != 81920 || gpu.MemoryType.ValueString() != "HBM2" ||
+		gpu.TemperatureCelsius.ValueFloat64() != 41.5 || gpu.Health.ValueString() != "OK" {
+		t.Errorf("Unexpected GPU data %+v", gpu)
+	}
+}
+
+func testAccIrmcGpusDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_irmc_gpus" "gpus" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
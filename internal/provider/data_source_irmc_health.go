@@ -0,0 +1,297 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IrmcHealthDataSource{}
+
+func NewIrmcHealthDataSource() datasource.DataSource {
+	return &IrmcHealthDataSource{}
+}
+
+// IrmcHealthDataSource defines the data source implementation.
+type IrmcHealthDataSource struct {
+	p *IrmcProvider
+}
+
+// healthComponent is single component of subsystem with its Redfish status.
+type healthComponent struct {
+	name   string
+	status common.Status
+}
+
+// healthSeverity orders Redfish health values, unknown values are treated as not reported.
+var healthSeverity = map[common.Health]int{
+	common.OKHealth:       1,
+	common.WarningHealth:  2,
+	common.CriticalHealth: 3,
+}
+
+func (d *IrmcHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + irmcHealthName
+}
+
+func irmcHealthSubsystemSchema(subsystem string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("Health of %s", subsystem),
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"health": schema.StringAttribute{
+				Computed:    true,
+				Description: "Worst health of components (OK, Warning, Critical). Null if no component reports health",
+			},
+			"total": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of present components",
+			},
+			"unhealthy": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Names of components with health other than OK",
+			},
+		},
+	}
+}
+
+func IrmcHealthDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"health": schema.StringAttribute{
+			Computed:    true,
+			Description: "Worst health of all subsystems (OK, Warning, Critical)",
+		},
+		"health_rollup": schema.StringAttribute{
+			Computed:    true,
+			Description: "Health rollup reported by computer system",
+		},
+		"processors":     irmcHealthSubsystemSchema("processors"),
+		"memory":         irmcHealthSubsystemSchema("memory modules"),
+		"storage":        irmcHealthSubsystemSchema("storage controllers and drives"),
+		"power_supplies": irmcHealthSubsystemSchema("power supplies"),
+		"fans":           irmcHealthSubsystemSchema("fans"),
+		"temperatures":   irmcHealthSubsystemSchema("temperature sensors"),
+	}
+}
+
+func (d *IrmcHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Health data source, which aggregates health of server subsystems, e.g. for preconditions of dependent resources",
+		Attributes:          IrmcHealthDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *IrmcHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *IrmcHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-irmc-health: read starts")
+
+	var data models.IrmcHealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	if err = readIrmcHealth(api.Service, &data); err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain health of server", err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-irmc-health: read ends")
+}
+
+// readIrmcHealth collects Redfish status of all subsystems of server and stores their health into model.
+func readIrmcHealth(service *gofish.Service, data *models.IrmcHealthDataSourceModel) error {
+	system, err := GetSystemResource(service)
+	if err != nil {
+		return err
+	}
+
+	processors, err := system.Processors()
+	if err != nil {
+		return fmt.Errorf("could not obtain processors: %w", err)
+	}
+
+	var cpus []healthComponent
+	for _, p := range processors {
+		cpus = append(cpus, healthComponent{name: p.Name, status: p.Status})
+	}
+
+	memory, err := system.Memory()
+	if err != nil {
+		return fmt.Errorf("could not obtain memory: %w", err)
+	}
+
+	var dimms []healthComponent
+	for _, m := range memory {
+		dimms = append(dimms, healthComponent{name: m.Name, status: m.Status})
+	}
+
+	storage, err := system.Storage()
+	if err != nil {
+		return fmt.Errorf("could not obtain storage: %w", err)
+	}
+
+	var disks []healthComponent
+	for _, s := range storage {
+		disks = append(disks, healthComponent{name: s.Name, status: s.Status})
+
+		drives, err := s.Drives()
+		if err != nil {
+			return fmt.Errorf("could not obtain drives of storage %s: %w", s.ODataID, err)
+		}
+
+		for _, drive := range drives {
+			disks = append(disks, healthComponent{name: drive.Name, status: drive.Status})
+		}
+	}
+
+	chassis, err := service.Chassis()
+	if err != nil {
+		return fmt.Errorf("could not obtain chassis: %w", err)
+	}
+
+	var psus, fans, temperatures []healthComponent
+	for _, c := range chassis {
+		power, err := c.Power()
+		if err != nil {
+			return fmt.Errorf("could not obtain power of chassis %s: %w", c.ODataID, err)
+		}
+
+		if power != nil {
+			for _, psu := range power.PowerSupplies {
+				psus = append(psus, healthComponent{name: psu.Name, status: psu.Status})
+			}
+		}
+
+		thermal, err := c.Thermal()
+		if err != nil {
+			return fmt.Errorf("could not obtain thermal of chassis %s: %w", c.ODataID, err)
+		}
+
+		if thermal != nil {
+			for _, fan := range thermal.Fans {
+				fans = append(fans, healthComponent{name: fan.Name, status: fan.Status})
+			}
+
+			for _, temperature := range thermal.Temperatures {
+				temperatures = append(temperatures, healthComponent{name: temperature.Name, status: temperature.Status})
+			}
+		}
+	}
+
+	data.Processors = healthSubsystem(cpus)
+	data.Memory = healthSubsystem(dimms)
+	data.Storage = healthSubsystem(disks)
+	data.PowerSupplies = healthSubsystem(psus)
+	data.Fans = healthSubsystem(fans)
+	data.Temperatures = healthSubsystem(temperatures)
+
+	data.HealthRollup = types.StringNull()
+	if len(system.Status.HealthRollup) > 0 {
+		data.HealthRollup = types.StringValue(string(system.Status.HealthRollup))
+	}
+
+	data.Health = types.StringNull()
+	for _, subsystem := range []models.IrmcHealthSubsystem{data.Processors, data.Memory, data.Storage,
+		data.PowerSupplies, data.Fans, data.Temperatures} {
+		data.Health = worstHealth(data.Health, subsystem.Health)
+	}
+
+	return nil
+}
+
+// healthSubsystem aggregates health of components of single subsystem. Absent components
+// (e.g. empty memory slots) are skipped.
+func healthSubsystem(components []healthComponent) models.IrmcHealthSubsystem {
+	subsystem := models.IrmcHealthSubsystem{
+		Health:    types.StringNull(),
+		Unhealthy: []types.String{},
+	}
+
+	var total int64
+	for _, component := range components {
+		if component.status.State == common.AbsentState {
+			continue
+		}
+
+		total++
+		if _, ok := healthSeverity[component.status.Health]; !ok {
+			continue
+		}
+
+		health := types.StringValue(string(component.status.Health))
+		subsystem.Health = worstHealth(subsystem.Health, health)
+		if component.status.Health != common.OKHealth {
+			subsystem.Unhealthy = append(subsystem.Unhealthy, types.StringValue(component.name))
+		}
+	}
+
+	subsystem.Total = types.Int64Value(total)
+	return subsystem
+}
+
+// worstHealth returns more severe of two health values, null value means not reported.
+func worstHealth(a, b types.String) types.String {
+	if healthSeverity[common.Health(b.ValueString())] > healthSeverity[common.Health(a.ValueString())] {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIrmcHealthDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIrmcHealthDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_irmc_health.health", "health"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_irmc_health.health", "processors.total"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_irmc_health.health", "fans.total"),
+				),
+			},
+		},
+	})
+}
+
+func TestReadIrmcHealth(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	system := server.Resource("/redfish/v1/Systems/0")
+	system["Processors"] = mockRedfishLink("/redfish/v1/Systems/0/Processors")
+	system["Memory"] = mockRedfishLink("/redfish/v1/Systems/0/Memory")
+	system["Status"] = map[string]interface{}{"State": "Enabled", "Health": "OK", "HealthRollup": "Warning"}
+	server.Set("/redfish/v1/Systems/0", system)
+
+	server.Set("/redfish/v1/Systems/0/Processors", map[string]interface{}{
+		"Members": []interface{}{mockRedfishLink("/redfish/v1/Systems/0/Processors/CPU0")},
+	})
+	server.Set("/redfish/v1/Systems/0/Processors/CPU0", map[string]interface{}{
+		"Name":   "CPU0",
+		"Status": map[string]interface{}{"State": "Enabled", "Health": "OK"},
+	})
+	server.Set("/redfish/v1/Systems/0/Memory", map[string]interface{}{
+		"Members": []interface{}{
+			mockRedfishLink("/redfish/v1/Systems/0/Memory/DIMM0"),
+			mockRedfishLink("/redfish/v1/Systems/0/Memory/DIMM1"),
+		},
+	})
+	server.Set("/redfish/v1/Systems/0/Memory/DIMM0", map[string]interface{}{
+		"Name":   "DIMM-1A",
+		"Status": map[string]interface{}{"State": "Enabled", "Health": "OK"},
+	})
+	server.Set("/redfish/v1/Systems/0/Memory/DIMM1", map[string]interface{}{
+		"Name":   "DIMM-1B",
+		"Status": map[string]interface{}{"State": "Absent"},
+	})
+
+	chassis := server.Resource("/redfish/v1/Chassis/0")
+	chassis["Power"] = mockRedfishLink("/redfish/v1/Chassis/0/Power")
+	chassis["Thermal"] = mockRedfishLink("/redfish/v1/Chassis/0/Thermal")
+	server.Set("/redfish/v1/Chassis/0", chassis)
+
+	server.Set("/redfish/v1/Chassis/0/Power", map[string]interface{}{
+		"PowerSupplies": []interface{}{
+			map[string]interface{}{"MemberId": "0", "Name": "PSU1", "Status": map[string]interface{}{"State": "Enabled", "Health": "OK"}},
+			map[string]interface{}{"MemberId": "1", "Name": "PSU2", "Status": map[string]interface{}{"State": "Enabled", "Health": "Critical"}},
+		},
+	})
+	server.Set("/redfish/v1/Chassis/0/Thermal", map[string]interface{}{
+		"Fans": []interface{}{
+			map[string]interface{}{"MemberId": "0", "Name": "FAN1 SYS", "Status": map[string]interface{}{"State": "Enabled", "Health": "Warning"}},
+		},
+		"Temperatures": []interface{}{
+			map[string]interface{}{"MemberId": "0", "Name": "Ambient", "Status": map[string]interface{}{"State": "Enabled", "Health": "OK"}},
+		},
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	var data models.IrmcHealthDataSourceModel
+	if err = readIrmcHealth(api.Service, &data); err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if data.Health.ValueString() != "Critical" || data.HealthRollup.ValueString() != "Warning" {
+		t.Errorf("Unexpected overall health %s, rollup %s", data.Health, data.HealthRollup)
+	}
+
+	if data.Processors.Health.ValueString() != "OK" || data.Processors.Total.ValueInt64() != 1 {
+		t.Errorf("Unexpected processors health %+v", data.Processors)
+	}
+
+	if data.Memory.Health.ValueString() != "OK" || data.Memory.Total.ValueInt64() != 1 || len(data.Memory.Unhealthy) != 0 {
+		t.Errorf("Absent memory module should be skipped, got %+v", data.Memory)
+	}
+
+	if data.Storage.Health.ValueString() != "OK" || data.Storage.Total.ValueInt64() != 3 {
+		t.Errorf("Unexpected storage health %+v", data.Storage)
+	}
+
+	if data.PowerSupplies.Health.ValueString() != "Critical" || len(data.PowerSupplies.Unhealthy) != 1 ||
+		data.PowerSupplies.Unhealthy[0].ValueString() != "PSU2" {
+		t.Errorf("Unexpected power supplies health %+v", data.PowerSupplies)
+	}
+
+	if data.Fans.Health.ValueString() != "Warning" || data.Fans.Unhealthy[0].ValueString() != "FAN1 SYS" {
+		t.Errorf("Unexpected fans health %+v", data.Fans)
+	}
+
+	if data.Temperatures.Health.ValueString() != "OK" || data.Temperatures.Total.ValueInt64() != 1 {
+		t.Errorf("Unexpected temperatures health %+v", data.Temperatures)
+	}
+}
+
+func testAccIrmcHealthDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_irmc_health" "health" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
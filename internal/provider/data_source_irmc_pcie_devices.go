@@ -0,0 +1,307 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IrmcPcieDevicesDataSource{}
+
+func NewIrmcPcieDevicesDataSource() datasource.DataSource {
+	return &IrmcPcieDevicesDataSource{}
+}
+
+// IrmcPcieDevicesDataSource defines the data source implementation.
+type IrmcPcieDevicesDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *IrmcPcieDevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + irmcPcieDevicesName
+}
+
+func IrmcPcieDevicesDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"pcie_devices": schema.ListNestedAttribute{
+			MarkdownDescription: "List of PCIe devices of the system reported by iRMC",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "ODataId of the PCIe device",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the PCIe device",
+					},
+					"manufacturer": schema.StringAttribute{
+						Computed:    true,
+						Description: "Manufacturer of the PCIe device",
+					},
+					"model": schema.StringAttribute{
+						Computed:    true,
+						Description: "Model of the PCIe device",
+					},
+					"device_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Device type (SingleFunction, MultiFunction, Simulated, Retimer)",
+					},
+					"serial_number": schema.StringAttribute{
+						Computed:    true,
+						Description: "Serial number of the PCIe device",
+					},
+					"part_number": schema.StringAttribute{
+						Computed:    true,
+						Description: "Part number of the PCIe device",
+					},
+					"firmware_version": schema.StringAttribute{
+						Computed:    true,
+						Description: "Firmware version of the PCIe device. Null if not reported",
+					},
+					"slot": schema.StringAttribute{
+						Computed:    true,
+						Description: "Slot of the PCIe device (service label or slot number). Null for onboard devices",
+					},
+					"pcie_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Negotiated PCIe generation of the link (e.g. Gen4). Null if not reported",
+					},
+					"max_pcie_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Highest PCIe generation supported by the device. Null if not reported",
+					},
+					"lanes_in_use": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of PCIe lanes in use (link width). Null if not reported",
+					},
+					"max_lanes": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Maximum number of PCIe lanes supported by the device. Null if not reported",
+					},
+					"health": schema.StringAttribute{
+						Computed:    true,
+						Description: "Health of the PCIe device (OK, Warning, Critical)",
+					},
+					"functions": schema.ListNestedAttribute{
+						Computed:    true,
+						Description: "PCIe functions of the device ordered by function number",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"function_id": schema.Int64Attribute{
+									Computed:    true,
+									Description: "PCIe function number",
+								},
+								"function_type": schema.StringAttribute{
+									Computed:    true,
+									Description: "Function type (Physical, Virtual)",
+								},
+								"device_class": schema.StringAttribute{
+									Computed:    true,
+									Description: "Device class of the function (e.g. NetworkController, DisplayController)",
+								},
+								"class_code": schema.StringAttribute{
+									Computed:    true,
+									Description: "PCI class code of the function",
+								},
+								"vendor_id": schema.StringAttribute{
+									Computed:    true,
+									Description: "PCI vendor ID of the function",
+								},
+								"device_id": schema.StringAttribute{
+									Computed:    true,
+									Description: "PCI device ID of the function",
+								},
+								"subsystem_vendor_id": schema.StringAttribute{
+									Computed:    true,
+									Description: "PCI subsystem vendor ID of the function",
+								},
+								"subsystem_id": schema.StringAttribute{
+									Computed:    true,
+									Description: "PCI subsystem ID of the function",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IrmcPcieDevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "PCIe devices data source, which lists PCIe devices and their functions, e.g. for validation of GPU or NIC layout",
+		Attributes:          IrmcPcieDevicesDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *IrmcPcieDevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *IrmcPcieDevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-irmc-pcie-devices: read starts")
+
+	var data models.IrmcPcieDevicesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	system, err := GetSystemResource(api.Service)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain system", err)...)
+		return
+	}
+
+	devices, err := system.PCIeDevices()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain PCIe devices", err)...)
+		return
+	}
+
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].ODataID < devices[j].ODataID
+	})
+
+	data.PcieDevices = []models.IrmcPcieDeviceData{}
+	for _, device := range devices {
+		functions, err := device.PCIeFunctions()
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics(fmt.Sprintf("Could not obtain functions of PCIe device %s", device.ODataID), err)...)
+			return
+		}
+
+		data.PcieDevices = append(data.PcieDevices, irmcPcieDeviceDataFromResource(device, functions))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-irmc-pcie-devices: read ends")
+}
+
+// pcieOptionalString returns string property of PCIe device, which is null if not reported.
+func pcieOptionalString(value string) types.String {
+	if len(value) == 0 {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// pcieOptionalInt64 returns integer property of PCIe device, which is null if not reported.
+func pcieOptionalInt64(value int) types.Int64 {
+	if value == 0 {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(value))
+}
+
+// pcieDeviceSlot returns slot of PCIe device, either its service label or slot number.
+func pcieDeviceSlot(location common.PartLocation) types.String {
+	if len(location.ServiceLabel) > 0 {
+		return types.StringValue(location.ServiceLabel)
+	}
+
+	if location.LocationType == common.SlotLocationType {
+		return types.StringValue(strconv.Itoa(location.LocationOrdinalValue))
+	}
+
+	return types.StringNull()
+}
+
+// irmcPcieDeviceDataFromResource converts PCIe device with its functions into data source model.
+func irmcPcieDeviceDataFromResource(device *redfish.PCIeDevice, functions []*redfish.PCIeFunction) models.IrmcPcieDeviceData {
+	data := models.IrmcPcieDeviceData{
+		Id:              types.StringValue(device.ODataID),
+		Name:            types.StringValue(device.Name),
+		Manufacturer:    types.StringValue(device.Manufacturer),
+		Model:           types.StringValue(device.Model),
+		DeviceType:      types.StringValue(string(device.DeviceType)),
+		SerialNumber:    types.StringValue(device.SerialNumber),
+		PartNumber:      types.StringValue(device.PartNumber),
+		FirmwareVersion: pcieOptionalString(device.FirmwareVersion),
+		Slot:            pcieDeviceSlot(device.Slot.Location.PartLocation),
+		PcieType:        pcieOptionalString(string(device.PCIeInterface.PCIeType)),
+		MaxPcieType:     pcieOptionalString(string(device.PCIeInterface.MaxPCIeType)),
+		LanesInUse:      pcieOptionalInt64(device.PCIeInterface.LanesInUse),
+		MaxLanes:        pcieOptionalInt64(device.PCIeInterface.MaxLanes),
+		Health:          types.StringValue(string(device.Status.Health)),
+		Functions:       []models.IrmcPcieFunctionData{},
+	}
+
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].FunctionID < functions[j].FunctionID
+	})
+
+	for _, function := range functions {
+		data.Functions = append(data.Functions, models.IrmcPcieFunctionData{
+			FunctionId:        types.Int64Value(int64(function.FunctionID)),
+			FunctionType:      types.StringValue(string(function.FunctionType)),
+			DeviceClass:       types.StringValue(string(function.DeviceClass)),
+			ClassCode:         types.StringValue(function.ClassCode),
+			VendorId:          types.StringValue(function.VendorID),
+			DeviceId:          types.StringValue(function.DeviceID),
+			SubsystemVendorId: types.StringValue(function.SubsystemVendorID),
+			SubsystemId:       types.StringValue(function.SubsystemID),
+		})
+	}
+
+	return data
+}
@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIrmcPcieDevicesDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIrmcPcieDevicesDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_irmc_pcie_devices.pcie", "pcie_devices.0.id"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_irmc_pcie_devices.pcie", "pcie_devices.0.functions.0.vendor_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestIrmcPcieDeviceDataFromResource(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	device := "/redfish/v1/Chassis/0/PCIeDevices/1"
+	system := server.Resource("/redfish/v1/Systems/0")
+	system["PCIeDevices"] = []interface{}{mockRedfishLink(device)}
+	server.Set("/redfish/v1/Systems/0", system)
+
+	server.Set(device, map[string]interface{}{
+		"Name":         "NVIDIA L40S",
+		"Manufacturer": "NVIDIA",
+		"DeviceType":   "MultiFunction",
+		"PCIeInterface": map[string]interface{}{
+			"PCIeType":    "Gen4",
+			"MaxPCIeType": "Gen4",
+			"LanesInUse":  16,
+			"MaxLanes":    16,
+		},
+		"Slot": map[string]interface{}{
+			"Location": map[string]interface{}{"PartLocation": map[string]interface{}{"LocationType": "Slot", "LocationOrdinalValue": 3}},
+		},
+		"Status":        map[string]interface{}{"Health": "OK"},
+		"PCIeFunctions": mockRedfishLink(device + "/PCIeFunctions"),
+	})
+	server.Set(device+"/PCIeFunctions", map[string]interface{}{
+		"Members": []interface{}{mockRedfishLink(device + "/PCIeFunctions/1"), mockRedfishLink(device + "/PCIeFunctions/0")},
+	})
+	server.Set(device+"/PCIeFunctions/0", map[string]interface{}{
+		"FunctionId":  0,
+		"DeviceClass": "DisplayController",
+		"VendorId":    "0x10de",
+		"DeviceId":    "0x26b9",
+	})
+	server.Set(device+"/PCIeFunctions/1", map[string]interface{}{
+		"FunctionId":  1,
+		"DeviceClass": "MultimediaController",
+		"VendorId":    "0x10de",
+		"DeviceId":    "0x22ba",
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	computerSystem, err := GetSystemResource(api.Service)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	devices, err := computerSystem.PCIeDevices()
+	if err != nil || len(devices) != 1 {
+		t.Fatalf("Unexpected PCIe devices %v, %v", devices, err)
+	}
+
+	functions, err := devices[0].PCIeFunctions()
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	data := irmcPcieDeviceDataFromResource(devices[0], functions)
+	if data.Name.ValueString() != "NVIDIA L40S" || data.Slot.ValueString() != "3" || data.PcieType.ValueString() != "Gen4" ||
+		data.LanesInUse.ValueInt64() != 16 || data.Health.ValueString() != "OK" {
+		t.Errorf("Unexpected PCIe device data %+v", data)
+	}
+
+	if !data.FirmwareVersion.IsNull() {
+		t.Errorf("Not reported firmware version should be null, got %s", data.FirmwareVersion)
+	}
+
+	if len(data.Functions) != 2 || data.Functions[0].FunctionId.ValueInt64() != 0 ||
+		data.Functions[0].DeviceId.ValueString() != "0x26b9" || data.Functions[1].DeviceClass.ValueString() != "MultimediaController" {
+		t.Errorf("Unexpected PCIe functions %+v", data.Functions)
+	}
+}
+
+func testAccIrmcPcieDevicesDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_irmc_pcie_devices" "pcie" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IrmcTasksDataSource{}
+
+func NewIrmcTasksDataSource() datasource.DataSource {
+	return &IrmcTasksDataSource{}
+}
+
+// IrmcTasksDataSource defines the data source implementation.
+type IrmcTasksDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *IrmcTasksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + irmcTasksName
+}
+
+func IrmcTasksDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"tasks": schema.ListNestedAttribute{
+			MarkdownDescription: "List of tasks reported by TaskService of iRMC",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "ODataId of the task",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the task",
+					},
+					"task_state": schema.StringAttribute{
+						Computed:    true,
+						Description: "State of the task (e.g. Running, Completed, Exception)",
+					},
+					"task_status": schema.StringAttribute{
+						Computed:    true,
+						Description: "Completion status of the task (OK, Warning, Critical)",
+					},
+					"percent_complete": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Completion progress of the task in percent",
+					},
+					"start_time": schema.StringAttribute{
+						Computed:    true,
+						Description: "Time the task was started",
+					},
+					"end_time": schema.StringAttribute{
+						Computed:    true,
+						Description: "Time the task was completed",
+					},
+					"target_uri": schema.StringAttribute{
+						Computed:    true,
+						Description: "URI of the request which started the task",
+					},
+					"created_by_provider": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Indicates whether the task has been started by request of type used by the provider",
+					},
+					"stale": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Indicates whether the task has not finished within stale_task_age defined in provider configuration",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IrmcTasksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tasks data source, which lists tasks of iRMC, e.g. to find stale tasks left by interrupted operations",
+		Attributes:          IrmcTasksDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *IrmcTasksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *IrmcTasksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-irmc-tasks: read starts")
+
+	var data models.IrmcTasksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	tasks, err := api.Service.Tasks()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain tasks", err)...)
+		return
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].ODataID < tasks[j].ODataID
+	})
+
+	maxAge := time.Duration(STALE_TASK_AGE) * time.Second
+	if d.p != nil && d.p.StaleTaskAge > 0 {
+		maxAge = time.Duration(d.p.StaleTaskAge) * time.Second
+	}
+
+	now := time.Now()
+	data.Tasks = []models.IrmcTaskData{}
+	for _, task := range tasks {
+		data.Tasks = append(data.Tasks, irmcTaskDataFromResource(task, maxAge, now))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-irmc-tasks: read ends")
+}
+
+// irmcTaskDataFromResource converts task into data source model.
+func irmcTaskDataFromResource(task *redfish.Task, maxAge time.Duration, now time.Time) models.IrmcTaskData {
+	return models.IrmcTaskData{
+		Id:                types.StringValue(task.ODataID),
+		Name:              types.StringValue(task.Name),
+		TaskState:         types.StringValue(string(task.TaskState)),
+		TaskStatus:        types.StringValue(string(task.TaskStatus)),
+		PercentComplete:   types.Int64Value(int64(task.PercentComplete)),
+		StartTime:         types.StringValue(task.StartTime),
+		EndTime:           types.StringValue(task.EndTime),
+		TargetUri:         types.StringValue(task.Payload.TargetURI),
+		CreatedByProvider: types.BoolValue(isProviderTask(task)),
+		Stale:             types.BoolValue(isStaleTask(task, maxAge, now)),
+	}
+}
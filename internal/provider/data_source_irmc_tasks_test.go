@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIrmcTasksDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIrmcTasksDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_irmc_tasks.tasks", "tasks.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestIrmcTaskDataFromResource(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	now := time.Now()
+	seedMockStaleTasks(server, now)
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	tasks, err := api.Service.Tasks()
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if len(tasks) != 4 {
+		t.Fatalf("Got %d tasks, expected 4", len(tasks))
+	}
+
+	for _, task := range tasks {
+		data := irmcTaskDataFromResource(task, time.Hour, now)
+		expectedProvider := task.ID != "2"
+		expectedStale := task.ID == "1" || task.ID == "2"
+		if data.CreatedByProvider.ValueBool() != expectedProvider || data.Stale.ValueBool() != expectedStale {
+			t.Errorf("Task %s: got created by provider %t and stale %t", task.ID, data.CreatedByProvider.ValueBool(), data.Stale.ValueBool())
+		}
+	}
+}
+
+func testAccIrmcTasksDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_irmc_tasks" "tasks" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
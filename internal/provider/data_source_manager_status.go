@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ManagerStatusDataSource{}
+
+func NewManagerStatusDataSource() datasource.DataSource {
+	return &ManagerStatusDataSource{}
+}
+
+// ManagerStatusDataSource defines the data source implementation.
+type ManagerStatusDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *ManagerStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + managerStatusName
+}
+
+func ManagerStatusDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the manager resource",
+		},
+		"state": schema.StringAttribute{
+			Computed:    true,
+			Description: "State of iRMC reported by manager resource, e.g. 'Enabled' or 'Starting'",
+		},
+		"health": schema.StringAttribute{
+			Computed:    true,
+			Description: "Health of iRMC itself, e.g. 'OK', 'Warning' or 'Critical'",
+		},
+		"health_rollup": schema.StringAttribute{
+			Computed:    true,
+			Description: "Overall health of iRMC and resources it depends on",
+		},
+		"ready": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Indicates whether iRMC is ready to accept long running operations (state is 'Enabled' or not reported)",
+		},
+		"firmware_version": schema.StringAttribute{
+			Computed:    true,
+			Description: "Firmware version of iRMC",
+		},
+		"licenses": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Names of licenses installed on iRMC (e.g. advanced features, eLCM). Empty if license service is not reported",
+		},
+		"date_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "Current date and time of iRMC",
+		},
+		"date_time_local_offset": schema.StringAttribute{
+			Computed:    true,
+			Description: "Offset of iRMC local time from UTC, e.g. '+01:00'",
+		},
+		"last_reset_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "Date and time when iRMC was last reset or rebooted. Null if not reported",
+		},
+		"uptime": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Time in seconds since last reset of iRMC, computed from date_time and last_reset_time. Null if not reported",
+		},
+	}
+}
+
+func (d *ManagerStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for retrieving health, firmware version, licenses, date/time and uptime of iRMC.",
+		Attributes:          ManagerStatusDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *ManagerStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *ManagerStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-manager_status: read starts")
+
+	var data models.ManagerStatusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	managers, err := api.Service.Managers()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error when accessing Managers resource", err)...)
+		return
+	}
+
+	if len(managers) == 0 {
+		resp.Diagnostics.AddError("Error when accessing Managers resource", "iRMC does not report any manager")
+		return
+	}
+
+	// Missing license service is not treated as an error, since older firmware does not implement it
+	licenses, err := getInstalledLicenses(api.Service)
+	if err != nil {
+		tflog.Warn(ctx, "Could not read licenses", map[string]interface{}{"error": err.Error()})
+	}
+
+	managerStatusToModel(managers[0], licenses, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-manager_status: read ends")
+}
+
+// getInstalledLicenses returns names of licenses reported by license service.
+func getInstalledLicenses(service *gofish.Service) ([]string, error) {
+	licenseService, err := service.LicenseService()
+	if err != nil || licenseService == nil {
+		return nil, err
+	}
+
+	licenses, err := licenseService.Licenses()
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, license := range licenses {
+		if len(license.Name) > 0 {
+			names = append(names, license.Name)
+		} else {
+			names = append(names, license.ID)
+		}
+	}
+
+	return names, nil
+}
+
+// managerStatusToModel fills model with status of iRMC reported by manager resource.
+func managerStatusToModel(manager *redfish.Manager, licenses []string, model *models.ManagerStatusDataSourceModel) {
+	model.Id = types.StringValue(manager.ODataID)
+	model.State = types.StringValue(string(manager.Status.State))
+	model.Health = types.StringValue(string(manager.Status.Health))
+	model.HealthRollup = types.StringValue(string(manager.Status.HealthRollup))
+	model.Ready = types.BoolValue(len(manager.Status.State) == 0 || manager.Status.State == common.EnabledState)
+	model.FirmwareVersion = types.StringValue(manager.FirmwareVersion)
+	model.DateTime = types.StringValue(manager.DateTime)
+	model.DateTimeLocalOffset = types.StringValue(manager.DateTimeLocalOffset)
+
+	values := []attr.Value{}
+	for _, license := range licenses {
+		values = append(values, types.StringValue(license))
+	}
+	model.Licenses = types.ListValueMust(types.StringType, values)
+
+	model.LastResetTime = types.StringNull()
+	model.Uptime = types.Int64Null()
+	if len(manager.LastResetTime) == 0 {
+		return
+	}
+
+	model.LastResetTime = types.StringValue(manager.LastResetTime)
+	now, nowErr := time.Parse(time.RFC3339, manager.DateTime)
+	lastReset, resetErr := time.Parse(time.RFC3339, manager.LastResetTime)
+	if nowErr == nil && resetErr == nil && !now.Before(lastReset) {
+		model.Uptime = types.Int64Value(int64(now.Sub(lastReset).Seconds()))
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestAccManagerStatusDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagerStatusDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.irmc-redfish_irmc_manager_status.ms", "id", MANAGER_ENDPOINT),
+					resource.TestCheckResourceAttr("data.irmc-redfish_irmc_manager_status.ms", "ready", "true"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_irmc_manager_status.ms", "firmware_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestManagerStatusToModel(t *testing.T) {
+	manager := &redfish.Manager{
+		FirmwareVersion: "3.20P",
+		DateTime:        "2025-03-01T12:00:00+01:00",
+		LastResetTime:   "2025-03-01T10:30:00+01:00",
+	}
+	manager.ODataID = MANAGER_ENDPOINT
+	manager.Status.State = common.EnabledState
+	manager.Status.Health = common.OKHealth
+
+	var model models.ManagerStatusDataSourceModel
+	managerStatusToModel(manager, []string{"iRMC Advanced Pack"}, &model)
+	if !model.Ready.ValueBool() || model.Uptime.ValueInt64() != 5400 || len(model.Licenses.Elements()) != 1 {
+		t.Errorf("unexpected model %+v", model)
+	}
+
+	manager.Status.State = common.StartingState
+	manager.LastResetTime = ""
+	managerStatusToModel(manager, nil, &model)
+	if model.Ready.ValueBool() || !model.Uptime.IsNull() || len(model.Licenses.Elements()) != 0 {
+		t.Errorf("unexpected model %+v", model)
+	}
+}
+
+func testAccManagerStatusDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_irmc_manager_status" "ms" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
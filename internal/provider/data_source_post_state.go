@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PostStateDataSource{}
+
+func NewPostStateDataSource() datasource.DataSource {
+	return &PostStateDataSource{}
+}
+
+// PostStateDataSource defines the data source implementation.
+type PostStateDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *PostStateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + postStateName
+}
+
+func PostStateDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the system resource",
+		},
+		"power_state": schema.StringAttribute{
+			Computed:    true,
+			Description: "Current power state of the system",
+		},
+		"boot_progress": schema.StringAttribute{
+			Computed:    true,
+			Description: "Last boot progress state reported by the system (Redfish BootProgress.LastState), e.g. 'SetupEntered', 'OSBootStarted' or 'OSRunning'. Null if not reported",
+		},
+		"boot_progress_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "Time when last boot progress state was reached. Null if not reported",
+		},
+		"bios_in_post": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Indicates whether BIOS is in power on self test (POST) phase. Null if not reported",
+		},
+		"os_running": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Indicates whether operating system is running. Based on boot progress if reported, otherwise on host being powered on and BIOS outside of POST phase",
+		},
+	}
+}
+
+func (d *PostStateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for retrieving power on self test (POST) state and boot progress of the system.",
+		Attributes:          PostStateDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *PostStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *PostStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-post_state: read starts")
+
+	var data models.PostStateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	system, err := GetSystemResource(api.Service)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error Fetching System Resource", err)...)
+		return
+	}
+
+	// Older firmware does not report POST phase, what is not treated as an error
+	var biosInPost *bool
+	inPost, err := isBiosInPOSTPhase(api.Service)
+	if err != nil {
+		tflog.Warn(ctx, "Could not read BIOS POST phase", map[string]interface{}{"error": err.Error()})
+	} else {
+		biosInPost = &inPost
+	}
+
+	postStateToModel(system, biosInPost, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-post_state: read ends")
+}
+
+// postStateToModel fills model with power state, boot progress and POST phase of the system.
+func postStateToModel(system *redfish.ComputerSystem, biosInPost *bool, model *models.PostStateDataSourceModel) {
+	model.Id = types.StringValue(system.ODataID)
+	model.PowerState = types.StringValue(string(system.PowerState))
+	model.BootProgress = types.StringNull()
+	model.BootProgressTime = types.StringNull()
+	model.BiosInPost = types.BoolPointerValue(biosInPost)
+
+	lastState := system.BootProgress.LastState
+	if len(lastState) > 0 {
+		model.BootProgress = types.StringValue(string(lastState))
+		if len(system.BootProgress.LastStateTime) > 0 {
+			model.BootProgressTime = types.StringValue(system.BootProgress.LastStateTime)
+		}
+		model.OsRunning = types.BoolValue(lastState == redfish.OSRunningBootProgressTypes)
+		return
+	}
+
+	model.OsRunning = types.BoolValue(system.PowerState == redfish.OnPowerState && biosInPost != nil && !*biosInPost)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestAccPostStateDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPostStateDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.irmc-redfish_post_state.ps", "id", "/redfish/v1/Systems/0"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_post_state.ps", "power_state"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_post_state.ps", "os_running"),
+				),
+			},
+		},
+	})
+}
+
+func TestPostStateToModel(t *testing.T) {
+	inPost, afterPost := true, false
+	tests := []struct {
+		name       string
+		powerState redfish.PowerState
+		lastState  redfish.BootProgressTypes
+		biosInPost *bool
+		osRunning  bool
+	}{
+		{"os running by boot progress", redfish.OnPowerState, redfish.OSRunningBootProgressTypes, &afterPost, true},
+		{"setup entered", redfish.OnPowerState, redfish.SetupEnteredBootProgressTypes, &inPost, false},
+		{"post finished without boot progress", redfish.OnPowerState, "", &afterPost, true},
+		{"during post without boot progress", redfish.OnPowerState, "", &inPost, false},
+		{"powered off", redfish.OffPowerState, "", &afterPost, false},
+		{"nothing reported", redfish.OnPowerState, "", nil, false},
+	}
+
+	for _, test := range tests {
+		system := &redfish.ComputerSystem{PowerState: test.powerState}
+		system.ODataID = "/redfish/v1/Systems/0"
+		system.BootProgress.LastState = test.lastState
+
+		var model models.PostStateDataSourceModel
+		postStateToModel(system, test.biosInPost, &model)
+		if model.OsRunning.ValueBool() != test.osRunning {
+			t.Errorf("%s: expected os_running %t, got %t", test.name, test.osRunning, model.OsRunning.ValueBool())
+		}
+
+		if model.BootProgress.IsNull() != (len(test.lastState) == 0) || model.BiosInPost.IsNull() != (test.biosInPost == nil) {
+			t.Errorf("%s: unexpected model %+v", test.name, model)
+		}
+	}
+}
+
+func testAccPostStateDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_post_state" "ps" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
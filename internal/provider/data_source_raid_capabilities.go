@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RaidCapabilitiesDataSource{}
+
+func NewRaidCapabilitiesDataSource() datasource.DataSource {
+	return &RaidCapabilitiesDataSource{}
+}
+
+// RaidCapabilitiesDataSource defines the data source implementation.
+type RaidCapabilitiesDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *RaidCapabilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + raidCapabilitiesName
+}
+
+func RaidCapabilitiesDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ODataId of RAIDCapabilities resource of the storage controller",
+		},
+		"storage_controller_serial_number": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Serial number of storage controller.",
+			Description:         "Serial number of storage controller.",
+		},
+		"raid_type": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "RAID type (e.g. RAID1), to which raid_levels are limited. If not defined, all RAID levels supported by controller are listed.",
+			Description:         "RAID type (e.g. RAID1), to which raid_levels are limited. If not defined, all RAID levels supported by controller are listed.",
+		},
+		"raid_levels": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "RAID levels supported by the controller together with their limits, stripe sizes per drive media type and supported volume modes",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: RaidLevelCapabilitySchema(),
+			},
+		},
+	}
+}
+
+func (d *RaidCapabilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "RAID capabilities data source, which lists RAID levels supported by a storage controller together with stripe sizes per drive media type and supported init, read, write and drive cache modes",
+		Attributes:          RaidCapabilitiesDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *RaidCapabilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *RaidCapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-raid-capabilities: read starts")
+
+	var data models.RaidCapabilitiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return
+	}
+
+	storage, err := getSystemStorageFromSerialNumber(api.Service, data.StorageControllerSN.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain storage resource", err)...)
+		return
+	}
+
+	endpoint := getRaidCapabilitiesEndpoint(storage.ODataID, isFsas)
+	capabilities, err := getSystemStorageOemRaidCapabilitiesResource(api.Service, endpoint)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain RAID capabilities of storage controller", err)...)
+		return
+	}
+
+	data.Id = types.StringValue(endpoint)
+	data.RaidLevels = filterRaidLevels(raidLevelsFromCapabilities(capabilities), data.RaidType.ValueString())
+	if !data.RaidType.IsNull() && len(data.RaidLevels) == 0 {
+		resp.Diagnostics.AddError("RAID type not supported",
+			fmt.Sprintf("Storage controller does not support RAID type '%s'", data.RaidType.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-raid-capabilities: read ends")
+}
+
+// filterRaidLevels returns RAID levels of given raidType or all of them if raidType is empty.
+func filterRaidLevels(levels []models.RaidLevelCapabilityData, raidType string) []models.RaidLevelCapabilityData {
+	if len(raidType) == 0 {
+		return levels
+	}
+
+	filtered := []models.RaidLevelCapabilityData{}
+	for _, level := range levels {
+		if level.RaidType.ValueString() == raidType {
+			filtered = append(filtered, level)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRaidCapabilitiesDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRaidCapabilitiesDataSourceConfig(creds, os.Getenv("TF_TESTING_STORAGE_SERIAL_NUMBER"), "RAID1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.irmc-redfish_raid_capabilities.caps", "raid_levels.#", "1"),
+					resource.TestCheckResourceAttr("data.irmc-redfish_raid_capabilities.caps", "raid_levels.0.raid_type", "RAID1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRaidCapabilitiesDataSource_UnsupportedRaidType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRaidCapabilitiesDataSourceConfig(creds, os.Getenv("TF_TESTING_STORAGE_SERIAL_NUMBER"), "RAID99"),
+				ExpectError: regexp.MustCompile("RAID type not supported"),
+			},
+		},
+	})
+}
+
+func TestFilterRaidLevels(t *testing.T) {
+	levels := []models.RaidLevelCapabilityData{
+		{RaidType: types.StringValue("RAID0")},
+		{RaidType: types.StringValue("RAID1")},
+	}
+
+	if filtered := filterRaidLevels(levels, ""); len(filtered) != 2 {
+		t.Errorf("expected all RAID levels, got %v", filtered)
+	}
+
+	if filtered := filterRaidLevels(levels, "RAID1"); len(filtered) != 1 || filtered[0].RaidType.ValueString() != "RAID1" {
+		t.Errorf("expected only RAID1, got %v", filtered)
+	}
+
+	if filtered := filterRaidLevels(levels, "RAID5"); len(filtered) != 0 {
+		t.Errorf("expected no RAID levels, got %v", filtered)
+	}
+}
+
+func testAccRaidCapabilitiesDataSourceConfig(testingInfo TestingServerCredentials, serial string, raidType string) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_raid_capabilities" "caps" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		storage_controller_serial_number = "%s"
+		raid_type                        = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		serial,
+		raidType,
+	)
+}
@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RedfishResourceDataSource{}
+
+func NewRedfishResourceDataSource() datasource.DataSource {
+	return &RedfishResourceDataSource{}
+}
+
+// RedfishResourceDataSource defines the data source implementation.
+type RedfishResourceDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *RedfishResourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + redfishResourceName
+}
+
+func RedfishResourceDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ODataId of the read Redfish resource",
+		},
+		"path": schema.StringAttribute{
+			Required:    true,
+			Description: "Redfish path of the read resource, e.g. '/redfish/v1/Systems/0'",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(redfishPathRegex, "must be Redfish path starting with /redfish/v1"),
+			},
+		},
+		"json": schema.StringAttribute{
+			Computed:    true,
+			Description: "Body of the resource as JSON string, which can be processed using jsondecode function",
+		},
+		"attributes": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Top level properties of the resource. String values are reported as is, other values as JSON strings",
+		},
+	}
+}
+
+func (d *RedfishResourceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for reading arbitrary Redfish resource, which allows to access properties not modeled by the provider.",
+		Attributes:          RedfishResourceDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *RedfishResourceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *RedfishResourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-redfish_resource: read starts")
+
+	var data models.RedfishResourceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint := data.Path.ValueString()
+	res, err := api.Get(endpoint)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics(fmt.Sprintf("GET on %s failed", endpoint), err)...)
+		return
+	}
+
+	defer CloseResource(res.Body)
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Could not read %s GET response body", endpoint), err.Error())
+		return
+	}
+
+	if res.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(fmt.Sprintf("GET on %s failed", endpoint),
+			fmt.Sprintf("Status code %d, response: %s", res.StatusCode, string(body)))
+		return
+	}
+
+	if err = redfishResourceToModel(endpoint, body, &data); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Could not decode %s GET response", endpoint), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-redfish_resource: read ends")
+}
+
+// redfishResourceToModel fills model with body of Redfish resource read from endpoint.
+func redfishResourceToModel(endpoint string, body []byte, model *models.RedfishResourceDataSourceModel) error {
+	var object map[string]interface{}
+	if err := json.Unmarshal(body, &object); err != nil {
+		return err
+	}
+
+	attributes := map[string]attr.Value{}
+	for key, value := range object {
+		attributes[key] = types.StringValue(formatJsonPointerValue(value))
+	}
+
+	model.Id = types.StringValue(endpoint)
+	if id, ok := object["@odata.id"].(string); ok {
+		model.Id = types.StringValue(id)
+	}
+
+	model.Json = types.StringValue(string(body))
+	model.Attributes = types.MapValueMust(types.StringType, attributes)
+	return nil
+}
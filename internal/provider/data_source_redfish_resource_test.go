@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRedfishResourceDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceDataSourceConfig(creds, "/redfish/v1/Systems/0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.irmc-redfish_redfish_resource.rr", "id", "/redfish/v1/Systems/0"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_redfish_resource.rr", "json"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_redfish_resource.rr", "attributes.PowerState"),
+				),
+			},
+		},
+	})
+}
+
+func TestRedfishResourceToModel(t *testing.T) {
+	var model models.RedfishResourceDataSourceModel
+	body := `{"@odata.id": "/redfish/v1/Systems/0", "PowerState": "On", "Status": {"Health": "OK"}, "MemorySummary": {"TotalSystemMemoryGiB": 64}}`
+	if err := redfishResourceToModel("/redfish/v1/Systems/0/", []byte(body), &model); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	if model.Id.ValueString() != "/redfish/v1/Systems/0" || model.Json.ValueString() != body {
+		t.Errorf("unexpected model %+v", model)
+	}
+
+	attributes := model.Attributes.Elements()
+	if attributes["PowerState"].String() != `"On"` || attributes["Status"].String() != `"{\"Health\":\"OK\"}"` {
+		t.Errorf("unexpected attributes %v", attributes)
+	}
+
+	if err := redfishResourceToModel("/redfish/v1/", []byte("not json"), &model); err == nil {
+		t.Errorf("error expected for invalid body")
+	}
+}
+
+func testAccRedfishResourceDataSourceConfig(testingInfo TestingServerCredentials, path string) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_redfish_resource" "rr" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		path = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		path,
+	)
+}
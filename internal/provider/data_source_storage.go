@@ -159,6 +159,11 @@ func StorageDataSourceSchema() map[string]schema.Attribute {
 			MarkdownDescription: "Auto rebuild enabled.",
 			Description:         "Auto rebuild enabled.",
 		},
+		"boot_volume": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ODataId of volume used as boot device of the controller.",
+			Description:         "ODataId of volume used as boot device of the controller.",
+		},
 	}
 }
 
@@ -201,11 +206,11 @@ func (d *StorageDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	api, err := ConnectTargetSystem(d.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	odataid, diags := readStorageControllerSettingsToState(api.Service, &state.StorageSettings)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,308 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StorageControllersDataSource{}
+
+func NewStorageControllersDataSource() datasource.DataSource {
+	return &StorageControllersDataSource{}
+}
+
+// StorageControllersDataSource defines the data source implementation.
+type StorageControllersDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *StorageControllersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + storageControllersName
+}
+
+func RaidLevelCapabilitySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"raid_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "RAID type supported by the controller",
+		},
+		"stripe_sizes": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: "Supported stripe sizes in bytes, if they do not depend on drive media type",
+		},
+		"stripe_sizes_hdd": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: "Supported stripe sizes in bytes for volumes built of HDD drives",
+		},
+		"stripe_sizes_ssd": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: "Supported stripe sizes in bytes for volumes built of SSD drives",
+		},
+		"stripe_sizes_nvme": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: "Supported stripe sizes in bytes for volumes built of NVMe drives",
+		},
+		"minimum_drive_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Minimal number of drives in volume",
+		},
+		"maximum_drive_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Maximal number of drives in volume",
+		},
+		"minimum_span_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Minimal number of drive groups (spans) in volume, 0 if RAID type does not use spans",
+		},
+		"maximum_span_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Maximal number of drive groups (spans) in volume, 0 if RAID type does not use spans",
+		},
+		"supported_init_modes": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Supported volume initialization modes",
+		},
+		"supported_read_modes": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Supported volume read modes",
+		},
+		"supported_write_modes": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Supported volume write modes",
+		},
+		"supported_drive_cache_modes": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Supported drive cache modes of volume",
+		},
+	}
+}
+
+func StorageControllersDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"storage_controllers": schema.ListNestedAttribute{
+			MarkdownDescription: "List of storage controllers available on the system",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "Id of the storage resource",
+					},
+					"odata_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "ODataId of the storage resource",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the storage controller",
+					},
+					"serial_number": schema.StringAttribute{
+						Computed:    true,
+						Description: "Serial number of the storage controller or Id of storage subsystem if its controller does not report serial number (e.g. direct-attached NVMe)",
+					},
+					"model": schema.StringAttribute{
+						Computed:    true,
+						Description: "Model of the storage controller",
+					},
+					"firmware_version": schema.StringAttribute{
+						Computed:    true,
+						Description: "Firmware version of the storage controller",
+					},
+					"raid_levels": schema.ListNestedAttribute{
+						Computed:    true,
+						Description: "RAID levels supported by the controller together with their limits, empty if controller does not report RAID capabilities",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: RaidLevelCapabilitySchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StorageControllersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Storage controllers data source, which lists all storage controllers of the system together with their RAID capabilities",
+		Attributes:          StorageControllersDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *StorageControllersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *StorageControllersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-storage-controllers: read starts")
+
+	var data models.StorageControllersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return
+	}
+
+	system, err := GetSystemResource(api.Service)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not access system resource", err)...)
+		return
+	}
+
+	storages, err := system.Storage()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not access storage collection", err)...)
+		return
+	}
+
+	data.StorageControllers = []models.StorageControllerData{}
+	for _, storage := range storages {
+		controller := storageControllerDataFromResource(storage)
+
+		// Controllers without RAID functionality do not provide RAIDCapabilities resource
+		capabilities, err := getSystemStorageOemRaidCapabilitiesResource(api.Service, getRaidCapabilitiesEndpoint(storage.ODataID, isFsas))
+		if err != nil {
+			tflog.Info(ctx, fmt.Sprintf("RAID capabilities of %s are not available: %s", storage.ODataID, err.Error()))
+		} else {
+			controller.RaidLevels = raidLevelsFromCapabilities(capabilities)
+		}
+
+		data.StorageControllers = append(data.StorageControllers, controller)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-storage-controllers: read ends")
+}
+
+// storageControllerDataFromResource converts storage resource into data source model.
+func storageControllerDataFromResource(storage *redfish.Storage) models.StorageControllerData {
+	controller := models.StorageControllerData{
+		Id:         types.StringValue(storage.ID),
+		ODataId:    types.StringValue(storage.ODataID),
+		Name:       types.StringValue(storage.Name),
+		RaidLevels: []models.RaidLevelCapabilityData{},
+	}
+
+	identity := getStorageControllerIdentity(storage)
+	if identity == (storageControllerIdentity{}) {
+		controller.Model = types.StringNull()
+		controller.FirmwareVersion = types.StringNull()
+	} else {
+		controller.Model = types.StringValue(identity.Model)
+		controller.FirmwareVersion = types.StringValue(identity.FirmwareVersion)
+	}
+
+	// Storage subsystems without serial number of controller (e.g. direct-attached NVMe) are
+	// identified by Id of storage resource, which is accepted as storage_controller_serial_number
+	if len(identity.SerialNumber) > 0 {
+		controller.SerialNumber = types.StringValue(identity.SerialNumber)
+	} else {
+		controller.SerialNumber = types.StringValue(storage.ID)
+	}
+
+	return controller
+}
+
+// raidLevelsFromCapabilities converts RAIDCapabilities reported by controller into data source model.
+func raidLevelsFromCapabilities(capabilities raidCapabilitiesConfig) []models.RaidLevelCapabilityData {
+	toInt64List := func(values []int) []types.Int64 {
+		list := []types.Int64{}
+		for _, value := range values {
+			list = append(list, types.Int64Value(int64(value)))
+		}
+		return list
+	}
+
+	toStringList := func(values []string) []types.String {
+		list := []types.String{}
+		for _, value := range values {
+			list = append(list, types.StringValue(value))
+		}
+		return list
+	}
+
+	levels := []models.RaidLevelCapabilityData{}
+	for _, level := range capabilities.RaidLevelCap {
+		levels = append(levels, models.RaidLevelCapabilityData{
+			RaidType:            types.StringValue(level.RaidType),
+			StripeSizes:         toInt64List(level.StripeSizes),
+			StripeSizesHDD:      toInt64List(level.StripeSizesHDD),
+			StripeSizesSSD:      toInt64List(level.StripeSizesSSD),
+			StripeSizesNVMe:     toInt64List(level.StripeSizesNVMe),
+			MinimumDriveCount:   types.Int64Value(int64(level.MinimumDriveCount)),
+			MaximumDriveCount:   types.Int64Value(int64(level.MaximumDriveCount)),
+			MinimumSpanCount:    types.Int64Value(int64(level.MinimumSpanCount)),
+			MaximumSpanCount:    types.Int64Value(int64(level.MaximumSpanCount)),
+			SupportedInitModes:  toStringList(level.SupportedInitMode),
+			SupportedReadModes:  toStringList(level.SupportedReadMode),
+			SupportedWriteModes: toStringList(level.SupportedWriteMode),
+			SupportedDriveCache: toStringList(level.SupportedDriveCacheMode),
+		})
+	}
+
+	return levels
+}
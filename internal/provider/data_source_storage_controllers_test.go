@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStorageControllersDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageControllersDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.irmc-redfish_storage_controllers.sc", "storage_controllers.*",
+						map[string]string{"serial_number": os.Getenv("TF_TESTING_STORAGE_SERIAL_NUMBER")}),
+				),
+			},
+		},
+	})
+}
+
+func TestRaidLevelsFromCapabilities(t *testing.T) {
+	body := `{
+		"RAIDLevels": [
+			{"RAIDType": "RAID0", "StripeSizes": [65536, 262144], "MinimumDriveCount": 1, "MaximumDriveCount": 32,
+			 "SupportedInitMode": ["Fast", "Normal"], "SupportedReadMode": ["NoReadAhead"], "SupportedWriteMode": ["WriteThrough"]},
+			{"RAIDType": "RAID10", "StripeSizesHDD": [65536], "StripeSizesSSD": [131072], "StripeSizesNVMe": [262144], "MinimumDriveCount": 4,
+			 "MaximumDriveCount": 240, "MinimumSpanCount": 2, "MaximumSpanCount": 8, "SupportedDriveCacheMode": ["Enabled", "Disabled"]}
+		]
+	}`
+
+	var capabilities raidCapabilitiesConfig
+	if err := json.Unmarshal([]byte(body), &capabilities); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	levels := raidLevelsFromCapabilities(capabilities)
+	if len(levels) != 2 {
+		t.Fatalf("expected 2 RAID levels, got %d", len(levels))
+	}
+
+	if levels[0].RaidType.ValueString() != "RAID0" || len(levels[0].StripeSizes) != 2 || levels[0].StripeSizes[1].ValueInt64() != 262144 {
+		t.Errorf("unexpected RAID0 capabilities %v", levels[0])
+	}
+
+	if len(levels[0].SupportedInitModes) != 2 || levels[0].SupportedWriteModes[0].ValueString() != "WriteThrough" {
+		t.Errorf("unexpected RAID0 modes %v", levels[0])
+	}
+
+	raid10 := levels[1]
+	if len(raid10.StripeSizes) != 0 || raid10.StripeSizesSSD[0].ValueInt64() != 131072 || raid10.StripeSizesHDD[0].ValueInt64() != 65536 ||
+		raid10.StripeSizesNVMe[0].ValueInt64() != 262144 {
+		t.Errorf("unexpected RAID10 stripe sizes %v", raid10)
+	}
+
+	if raid10.MinimumDriveCount.ValueInt64() != 4 || raid10.MaximumDriveCount.ValueInt64() != 240 ||
+		raid10.MinimumSpanCount.ValueInt64() != 2 || raid10.MaximumSpanCount.ValueInt64() != 8 {
+		t.Errorf("unexpected RAID10 limits %v", raid10)
+	}
+
+	if len(raid10.SupportedDriveCache) != 2 || raid10.SupportedDriveCache[1].ValueString() != "Disabled" {
+		t.Errorf("unexpected RAID10 drive cache modes %v", raid10)
+	}
+}
+
+func TestGetSystemStorageFromSerialNumberNVMe(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	// Direct-attached NVMe subsystems expose controllers via Controllers collection only,
+	// second one does not report serial number at all
+	server.Set("/redfish/v1/Systems/0/Storage", map[string]interface{}{
+		"Members": []interface{}{
+			mockRedfishLink("/redfish/v1/Systems/0/Storage/0"),
+			mockRedfishLink("/redfish/v1/Systems/0/Storage/NVMe1"),
+			mockRedfishLink("/redfish/v1/Systems/0/Storage/NVMe2"),
+		},
+		"Members@odata.count": 3,
+	})
+	for i, serial := range []string{"NVME000001", ""} {
+		storage := fmt.Sprintf("/redfish/v1/Systems/0/Storage/NVMe%d", i+1)
+		server.Set(storage, map[string]interface{}{
+			"Name":        "NVMe subsystem",
+			"Controllers": mockRedfishLink(storage + "/Controllers"),
+		})
+		server.Set(storage+"/Controllers", map[string]interface{}{
+			"Members":             []interface{}{mockRedfishLink(storage + "/Controllers/0")},
+			"Members@odata.count": 1,
+		})
+		server.Set(storage+"/Controllers/0", map[string]interface{}{
+			"Name":            "NVMe controller",
+			"Model":           "NVMe SSD",
+			"FirmwareVersion": "1.0",
+			"SerialNumber":    serial,
+		})
+	}
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	expected := map[string]string{
+		MOCK_REDFISH_STORAGE_SERIAL: "/redfish/v1/Systems/0/Storage/0",
+		"NVME000001":                "/redfish/v1/Systems/0/Storage/NVMe1",
+		"NVMe2":                     "/redfish/v1/Systems/0/Storage/NVMe2",
+	}
+
+	for serial, endpoint := range expected {
+		storage, err := getSystemStorageFromSerialNumber(api.Service, serial)
+		if err != nil {
+			t.Fatalf("Unexpected error for '%s': %s", serial, err.Error())
+		}
+
+		if storage.ODataID != endpoint {
+			t.Errorf("Serial '%s' matched %s, expected %s", serial, storage.ODataID, endpoint)
+		}
+
+		if controller := storageControllerDataFromResource(storage); controller.SerialNumber.ValueString() != serial {
+			t.Errorf("Storage %s exposes serial number %v, expected %s", endpoint, controller.SerialNumber, serial)
+		}
+	}
+
+	// Id of storage is accepted only when its controller does not report serial number
+	if _, err := getSystemStorageFromSerialNumber(api.Service, "NVMe1"); err == nil {
+		t.Errorf("Expected error for Id of storage with serial number")
+	}
+}
+
+func testAccStorageControllersDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_storage_controllers" "sc" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StorageVolumesDataSource{}
+
+func NewStorageVolumesDataSource() datasource.DataSource {
+	return &StorageVolumesDataSource{}
+}
+
+// StorageVolumesDataSource defines the data source implementation.
+type StorageVolumesDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *StorageVolumesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + storageVolumesName
+}
+
+func StorageVolumesDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"storage_controller_serial_number": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Serial number of storage controller.",
+			Description:         "Serial number of storage controller.",
+		},
+		"volumes": schema.ListNestedAttribute{
+			MarkdownDescription: "List of volumes existing on the storage controller",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "ODataId of the volume, which can be used to import storage_volume resource",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the volume",
+					},
+					"raid_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "RAID type of the volume",
+					},
+					"capacity_bytes": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Capacity of the volume in bytes",
+					},
+					"optimum_io_size_bytes": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Optimum IO size (stripe size) of the volume in bytes",
+					},
+					"drives": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "Slot locations of drives used by the volume, in format used by physical_drives of storage_volume resource",
+					},
+					"health": schema.StringAttribute{
+						Computed:    true,
+						Description: "Health of the volume",
+					},
+					"state": schema.StringAttribute{
+						Computed:    true,
+						Description: "State of the volume",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StorageVolumesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Storage volumes data source, which lists volumes existing on a storage controller",
+		Attributes:          StorageVolumesDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *StorageVolumesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *StorageVolumesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-storage-volumes: read starts")
+
+	var data models.StorageVolumesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	storage, err := getSystemStorageFromSerialNumber(api.Service, data.StorageControllerSN.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain storage resource", err)...)
+		return
+	}
+
+	volumes, err := storage.Volumes()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain volumes of storage resource", err)...)
+		return
+	}
+
+	data.Volumes = []models.StorageVolumeData{}
+	for _, volume := range volumes {
+		drives, err := volume.Drives()
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics(fmt.Sprintf("Could not obtain drives of volume %s", volume.ODataID), err)...)
+			return
+		}
+
+		data.Volumes = append(data.Volumes, storageVolumeDataFromResource(ctx, volume, drives))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-storage-volumes: read ends")
+}
+
+// storageVolumeDataFromResource converts volume and drives used by it into data source model.
+func storageVolumeDataFromResource(ctx context.Context, volume *redfish.Volume, drives []*redfish.Drive) models.StorageVolumeData {
+	data := models.StorageVolumeData{
+		Id:                 types.StringValue(volume.ODataID),
+		Name:               types.StringValue(volume.Name),
+		RaidType:           types.StringValue(string(volume.RAIDType)),
+		CapacityBytes:      types.Int64Value(int64(volume.CapacityBytes)),
+		OptimumIOSizeBytes: types.Int64Value(int64(volume.OptimumIOSizeBytes)),
+		Drives:             []types.String{},
+		Health:             types.StringValue(string(volume.Status.Health)),
+		State:              types.StringValue(string(volume.Status.State)),
+	}
+
+	for _, drive := range drives {
+		slot, err := getDriveSlot(drive)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Slot of drive used by volume %s could not be determined: %s", volume.ODataID, err.Error()))
+			continue
+		}
+		data.Drives = append(data.Drives, types.StringValue(slot))
+	}
+
+	return data
+}
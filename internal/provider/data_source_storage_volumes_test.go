@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestAccStorageVolumesDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageVolumesDataSourceConfig(creds, os.Getenv("TF_TESTING_STORAGE_SERIAL_NUMBER")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_storage_volumes.vol", "volumes.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetDriveSlot(t *testing.T) {
+	tests := []struct {
+		location common.Location
+		expected string
+		fails    bool
+	}{
+		{location: common.Location{Info: "[ 0 : 1 : 3 ]", InfoFormat: "[ System_Id : Controller_Id : Slot_Id ]"}, expected: "3"},
+		{location: common.Location{Info: "[ 0 : 1 : 252 : 5 ]", InfoFormat: "[ System_Id : Controller_Id : Enclosure_Id : Slot_Id ]"}, expected: "252-5"},
+		{location: common.Location{Info: "unknown", InfoFormat: "[ System_Id : Controller_Id : Slot_Id ]"}, fails: true},
+	}
+
+	for _, test := range tests {
+		slot, err := getDriveSlot(&redfish.Drive{Location: []common.Location{test.location}})
+		if test.fails != (err != nil) || slot != test.expected {
+			t.Errorf("location '%s': unexpected result '%s', %v", test.location.Info, slot, err)
+		}
+	}
+
+	if _, err := getDriveSlot(&redfish.Drive{}); err == nil {
+		t.Errorf("expected error for drive without location")
+	}
+}
+
+func TestStorageVolumeDataFromResource(t *testing.T) {
+	volume := &redfish.Volume{
+		Entity:             common.Entity{ODataID: "/redfish/v1/Systems/0/Storage/0/Volumes/0", Name: "system"},
+		RAIDType:           redfish.RAID1RAIDType,
+		CapacityBytes:      479559942144,
+		OptimumIOSizeBytes: 65536,
+		Status:             common.Status{Health: common.OKHealth, State: common.EnabledState},
+	}
+
+	format := "[ System_Id : Controller_Id : Slot_Id ]"
+	drives := []*redfish.Drive{
+		{Location: []common.Location{{Info: "[ 0 : 1 : 0 ]", InfoFormat: format}}},
+		{Location: []common.Location{{Info: "[ 0 : 1 : 1 ]", InfoFormat: format}}},
+		{},
+	}
+
+	data := storageVolumeDataFromResource(context.Background(), volume, drives)
+	if data.Id.ValueString() != volume.ODataID || data.Name.ValueString() != "system" || data.RaidType.ValueString() != "RAID1" {
+		t.Errorf("unexpected volume identification %v", data)
+	}
+
+	if data.CapacityBytes.ValueInt64() != 479559942144 || data.OptimumIOSizeBytes.ValueInt64() != 65536 {
+		t.Errorf("unexpected volume sizes %v", data)
+	}
+
+	if data.Health.ValueString() != "OK" || data.State.ValueString() != "Enabled" {
+		t.Errorf("unexpected volume status %v", data)
+	}
+
+	if len(data.Drives) != 2 || data.Drives[0].ValueString() != "0" || data.Drives[1].ValueString() != "1" {
+		t.Errorf("unexpected volume drives %v", data.Drives)
+	}
+}
+
+func testAccStorageVolumesDataSourceConfig(testingInfo TestingServerCredentials, serial string) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_storage_volumes" "vol" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		storage_controller_serial_number = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		serial,
+	)
+}
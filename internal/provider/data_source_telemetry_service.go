@@ -0,0 +1,199 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TelemetryServiceDataSource{}
+
+func NewTelemetryServiceDataSource() datasource.DataSource {
+	return &TelemetryServiceDataSource{}
+}
+
+// TelemetryServiceDataSource defines the data source implementation.
+type TelemetryServiceDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *TelemetryServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + telemetryServiceName
+}
+
+func MetricReportDefinitionDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Id of the metric report definition",
+		},
+		"odata_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ODataId of the metric report definition",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the metric report definition",
+		},
+		"enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Defines if metric report definition is enabled",
+		},
+		"metric_report_definition_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "When metric report is generated (Periodic, OnChange, OnRequest)",
+		},
+		"recurrence_interval": schema.StringAttribute{
+			Computed:    true,
+			Description: "Interval of periodic metric report as ISO 8601 duration (e.g. PT60S)",
+		},
+		"report_updates": schema.StringAttribute{
+			Computed:    true,
+			Description: "How subsequent metric reports are handled (Overwrite, AppendWrapsWhenFull, AppendStopsWhenFull, NewReport)",
+		},
+		"metric_properties": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "URIs with wildcards and property identifiers of metrics included in the report",
+		},
+		"metric_report": schema.StringAttribute{
+			Computed:    true,
+			Description: "ODataId of the most recent metric report generated by the definition",
+		},
+	}
+}
+
+func TelemetryServiceDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ODataId of the telemetry service",
+		},
+		"service_enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Defines if telemetry service is enabled",
+		},
+		"min_collection_interval": schema.StringAttribute{
+			Computed:    true,
+			Description: "Minimal interval of metric collection supported by the service as ISO 8601 duration",
+		},
+		"max_reports": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Maximal number of metric reports supported by the service",
+		},
+		"supported_collection_functions": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Functions which can be applied over metric collection (e.g. Average, Maximum)",
+		},
+		"metric_report_definitions": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "List of metric report definitions of the telemetry service",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: MetricReportDefinitionDataSchema(),
+			},
+		},
+	}
+}
+
+func (d *TelemetryServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Telemetry service data source, which reads telemetry service settings together with its metric report definitions",
+		Attributes:          TelemetryServiceDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *TelemetryServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *TelemetryServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-telemetry-service: read starts")
+
+	var data models.TelemetryServiceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, err := getTelemetryServiceEndpoint(api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Telemetry service is not available", err)...)
+		return
+	}
+
+	telemetryService, err := getJsonObject(api, endpoint)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not read telemetry service", err)...)
+		return
+	}
+
+	definitions, err := getMetricReportDefinitions(api, telemetryService)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not read metric report definitions", err)...)
+		return
+	}
+
+	data.Id = types.StringValue(endpoint)
+	data.ServiceEnabled = jsonBoolValue(telemetryService, "ServiceEnabled")
+	data.MinCollectionInterval = jsonStringValue(telemetryService, "MinCollectionInterval")
+	data.MaxReports = jsonInt64Value(telemetryService, "MaxReports")
+	data.SupportedCollectionFunctions = jsonStringListValue(telemetryService, "SupportedCollectionFunctions")
+	data.MetricReportDefinitions = []models.MetricReportDefinitionData{}
+	for _, definition := range definitions {
+		data.MetricReportDefinitions = append(data.MetricReportDefinitions, metricReportDefinitionData(definition))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, "data-source-telemetry-service: read ends")
+}
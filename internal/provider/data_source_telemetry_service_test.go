@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTelemetryServiceDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTelemetryServiceDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_telemetry_service.ts", "id"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_telemetry_service.ts", "service_enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestMetricReportDefinitionData(t *testing.T) {
+	body := `{
+		"@odata.id": "/redfish/v1/TelemetryService/MetricReportDefinitions/PowerMetrics",
+		"Id": "PowerMetrics",
+		"Name": "Power metrics",
+		"MetricReportDefinitionEnabled": true,
+		"MetricReportDefinitionType": "Periodic",
+		"Schedule": {"RecurrenceInterval": "PT60S"},
+		"ReportUpdates": "Overwrite",
+		"MetricProperties": ["/redfish/v1/Chassis/0/Power#/PowerControl/0/PowerConsumedWatts"],
+		"MetricReport": {"@odata.id": "/redfish/v1/TelemetryService/MetricReports/PowerMetrics"}
+	}`
+
+	var definition map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &definition); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	data := metricReportDefinitionData(definition)
+	if data.Id.ValueString() != "PowerMetrics" || !data.Enabled.ValueBool() || data.RecurrenceInterval.ValueString() != "PT60S" {
+		t.Errorf("unexpected metric report definition %v", data)
+	}
+
+	if len(data.MetricProperties) != 1 || data.MetricReport.ValueString() != "/redfish/v1/TelemetryService/MetricReports/PowerMetrics" {
+		t.Errorf("unexpected metric properties or report link %v", data)
+	}
+
+	delete(definition, "Schedule")
+	if data = metricReportDefinitionData(definition); !data.RecurrenceInterval.IsNull() {
+		t.Errorf("expected null recurrence interval, got %s", data.RecurrenceInterval.ValueString())
+	}
+}
+
+func testAccTelemetryServiceDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_telemetry_service" "ts" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
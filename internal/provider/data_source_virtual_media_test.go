@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 func TestAccRedfishVirtualMedia_fetch(t *testing.T) {
@@ -36,6 +38,40 @@ func TestAccRedfishVirtualMedia_fetch(t *testing.T) {
 	})
 }
 
+func TestVirtualMediaDataFromResource(t *testing.T) {
+	vmedia := &redfish.VirtualMedia{
+		Entity:               common.Entity{ID: "0", ODataID: "/redfish/v1/Systems/0/VirtualMedia/0"},
+		MediaTypes:           []redfish.VirtualMediaType{redfish.CDMediaType, redfish.DVDMediaType},
+		Image:                "https://10.0.0.1/images/install.iso",
+		Inserted:             true,
+		TransferProtocolType: redfish.HTTPSTransferProtocolType,
+		ConnectedVia:         redfish.URIConnectedVia,
+		WriteProtected:       true,
+	}
+
+	data := virtualMediaDataFromResource(vmedia)
+	if data.Id.ValueString() != "0" || data.ODataId.ValueString() != vmedia.ODataID {
+		t.Errorf("unexpected identification %s, %s", data.Id, data.ODataId)
+	}
+
+	if data.Image.ValueString() != vmedia.Image || !data.Inserted.ValueBool() || !data.WriteProtected.ValueBool() {
+		t.Errorf("unexpected media state %s, %s, %s", data.Image, data.Inserted, data.WriteProtected)
+	}
+
+	if data.TransferProtocolType.ValueString() != "HTTPS" || data.ConnectedVia.ValueString() != "URI" {
+		t.Errorf("unexpected connection %s, %s", data.TransferProtocolType, data.ConnectedVia)
+	}
+
+	if data.MediaType.ValueString() != VMEDIA_TYPE_CD {
+		t.Errorf("unexpected media type %s", data.MediaType)
+	}
+
+	empty := virtualMediaDataFromResource(&redfish.VirtualMedia{Entity: common.Entity{ID: "1"}})
+	if empty.Image.ValueString() != "" || empty.Inserted.ValueBool() || empty.MediaType.ValueString() != "" {
+		t.Errorf("unexpected state of empty slot %v", empty)
+	}
+}
+
 func testAccRedfishDatasourceVirtualMediaConfig(testingInfo TestingServerCredentials) string {
 	return fmt.Sprintf(`
 	data "irmc-redfish_virtual_media" "vm" {
@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -60,6 +61,30 @@ func VirtualMediaDataSourceSchema() map[string]schema.Attribute {
 						Computed:    true,
 						Description: "Id of the virtual media resource",
 					},
+					"image": schema.StringAttribute{
+						Computed:    true,
+						Description: "URI of the image mounted in the slot, empty if no image is attached",
+					},
+					"inserted": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Indicates whether media is inserted into the slot",
+					},
+					"transfer_protocol_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Protocol used to access mounted image",
+					},
+					"media_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Type of media handled by the slot ('CD' or 'HD'), empty if not reported by iRMC",
+					},
+					"connected_via": schema.StringAttribute{
+						Computed:    true,
+						Description: "Way in which media is connected to the slot",
+					},
+					"write_protected": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Indicates whether mounted media is write protected",
+					},
 				},
 			},
 		},
@@ -68,7 +93,7 @@ func VirtualMediaDataSourceSchema() map[string]schema.Attribute {
 
 func (d *IrmcVirtualMediaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Virtual media data source",
+		MarkdownDescription: "Virtual media data source, which lists virtual media slots together with their current state",
 		Attributes:          VirtualMediaDataSourceSchema(),
 		Blocks:              RedfishServerDatasourceBlockMap(),
 	}
@@ -108,30 +133,28 @@ func (d *IrmcVirtualMediaDataSource) Read(ctx context.Context, req datasource.Re
 	// Connect to service
 	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
+	defer ReleaseTargetSystem(api)
+
 	// And look for virtual media resources
 	managers, err := api.Service.Managers()
 	if err != nil {
-		resp.Diagnostics.AddError("Could not connect to the service: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not connect to the service: ", err)...)
 		return
 	}
 
 	vmedia_collection, err := managers[0].VirtualMedia()
 	if err != nil {
-		resp.Diagnostics.AddError("Virtual media does not exist: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Virtual media does not exist: ", err)...)
 		return
 	}
 
 	// Browse collection of vmedia and store its values
 	for _, vmedia := range vmedia_collection {
-		var found_vmedia models.VirtualMediaData
-		found_vmedia.Id = types.StringValue(vmedia.ID)
-		found_vmedia.ODataId = types.StringValue(vmedia.ODataID)
-
-		data.VirtualMediaData = append(data.VirtualMediaData, found_vmedia)
+		data.VirtualMediaData = append(data.VirtualMediaData, virtualMediaDataFromResource(vmedia))
 	}
 
 	// Save data into Terraform state
@@ -139,3 +162,17 @@ func (d *IrmcVirtualMediaDataSource) Read(ctx context.Context, req datasource.Re
 
 	tflog.Info(ctx, "data-source-vmedia: read ends")
 }
+
+// virtualMediaDataFromResource converts state of virtual media slot into data source model.
+func virtualMediaDataFromResource(vmedia *redfish.VirtualMedia) models.VirtualMediaData {
+	return models.VirtualMediaData{
+		Id:                   types.StringValue(vmedia.ID),
+		ODataId:              types.StringValue(vmedia.ODataID),
+		Image:                types.StringValue(vmedia.Image),
+		Inserted:             types.BoolValue(vmedia.Inserted),
+		TransferProtocolType: types.StringValue(string(vmedia.TransferProtocolType)),
+		MediaType:            types.StringValue(getVirtualMediaDeviceType(vmedia)),
+		ConnectedVia:         types.StringValue(string(vmedia.ConnectedVia)),
+		WriteProtected:       types.BoolValue(vmedia.WriteProtected),
+	}
+}
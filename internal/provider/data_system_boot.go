@@ -114,14 +114,14 @@ func (d *IrmcSystemBootDataSource) Read(ctx context.Context, req datasource.Read
 
 	api, err := ConnectTargetSystem(d.p, &data.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connection Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connection Error", err)...)
 		return
 	}
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	system, err := GetSystemResource(api.Service)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Fetching System Resource", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error Fetching System Resource", err)...)
 		return
 	}
 
@@ -134,7 +134,7 @@ func (d *IrmcSystemBootDataSource) Read(ctx context.Context, req datasource.Read
 
 	rBios, err := system.Bios()
 	if err != nil {
-		resp.Diagnostics.AddError("Error Fetching BIOS Resource", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error Fetching BIOS Resource", err)...)
 		return
 	}
 
@@ -145,7 +145,7 @@ func (d *IrmcSystemBootDataSource) Read(ctx context.Context, req datasource.Read
 			bootOrderStr, _ := json.Marshal(currentBootConfigOrder)
 			var bootOrderList []BootEntry
 			if err := json.Unmarshal(bootOrderStr, &bootOrderList); err != nil {
-				resp.Diagnostics.AddError("Error Unmarshalling PersistentBootConfigOrder", err.Error())
+				resp.Diagnostics.Append(redfishErrorDiagnostics("Error Unmarshalling PersistentBootConfigOrder", err)...)
 				return
 			}
 
@@ -0,0 +1,299 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	// Interval in which session is kept alive, it must be shorter than session timeout of iRMC
+	SESSION_EPHEMERAL_RENEW_INTERVAL = 60 * time.Second
+	SESSION_EPHEMERAL_PRIVATE_KEY    = "session"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &SessionEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &SessionEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &SessionEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &SessionEphemeralResource{}
+
+func NewSessionEphemeralResource() ephemeral.EphemeralResource {
+	return &SessionEphemeralResource{}
+}
+
+// SessionEphemeralResource defines the ephemeral resource implementation.
+type SessionEphemeralResource struct {
+	p *IrmcProvider
+}
+
+// ephemeralSession is kept in private data of ephemeral resource, so that session
+// can be kept alive and logged out after Terraform does not need it anymore.
+type ephemeralSession struct {
+	Endpoint  string `json:"endpoint"`
+	Insecure  bool   `json:"insecure"`
+	SessionId string `json:"session_id"`
+	Token     string `json:"token"`
+}
+
+func (r *SessionEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + sessionName
+}
+
+func SessionEphemeralSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"endpoint": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Endpoint of iRMC on which session has been opened.",
+			Description:         "Endpoint of iRMC on which session has been opened.",
+		},
+		"session_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ODataId of opened Redfish session.",
+			Description:         "ODataId of opened Redfish session.",
+		},
+		"token": schema.StringAttribute{
+			Computed:            true,
+			Sensitive:           true,
+			MarkdownDescription: "Token (X-Auth-Token) of opened Redfish session.",
+			Description:         "Token (X-Auth-Token) of opened Redfish session.",
+		},
+	}
+}
+
+func (r *SessionEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The ephemeral resource opens dedicated Redfish session on iRMC, which is kept alive while Terraform needs it and logged out afterwards. Token of the session is never persisted in plan or state.",
+		Description:         "The ephemeral resource opens dedicated Redfish session on iRMC, which is kept alive while Terraform needs it and logged out afterwards. Token of the session is never persisted in plan or state.",
+		Attributes:          SessionEphemeralSchema(),
+		Blocks:              RedfishServerEphemeralBlockMap(),
+	}
+}
+
+func (r *SessionEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *SessionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Info(ctx, "ephemeral-session: open starts")
+
+	var data models.SessionEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := openEphemeralSession(r.p, &data.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Session could not be opened", err)...)
+		return
+	}
+
+	private, err := json.Marshal(session)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Session could not be stored", err)...)
+		resp.Diagnostics.Append(closeEphemeralSession(r.p, session)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, SESSION_EPHEMERAL_PRIVATE_KEY, private)...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(closeEphemeralSession(r.p, session)...)
+		return
+	}
+
+	data.Endpoint = types.StringValue(session.Endpoint)
+	data.SessionId = types.StringValue(session.SessionId)
+	data.Token = types.StringValue(session.Token)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	resp.RenewAt = time.Now().Add(SESSION_EPHEMERAL_RENEW_INTERVAL)
+
+	tflog.Info(ctx, "ephemeral-session: open ends", map[string]interface{}{"session": session.SessionId})
+}
+
+func (r *SessionEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	tflog.Info(ctx, "ephemeral-session: renew starts")
+
+	session, ok := r.readPrivateSession(ctx, req.Private, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if err := renewEphemeralSession(r.p, session); err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Session could not be renewed", err)...)
+		return
+	}
+
+	resp.RenewAt = time.Now().Add(SESSION_EPHEMERAL_RENEW_INTERVAL)
+
+	tflog.Info(ctx, "ephemeral-session: renew ends")
+}
+
+func (r *SessionEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	tflog.Info(ctx, "ephemeral-session: close starts")
+
+	session, ok := r.readPrivateSession(ctx, req.Private, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(closeEphemeralSession(r.p, session)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "ephemeral-session: close ends", map[string]interface{}{"session": session.SessionId})
+}
+
+type privateDataReader interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// readPrivateSession returns session stored by Open in private data of ephemeral resource.
+func (r *SessionEphemeralResource) readPrivateSession(ctx context.Context, private privateDataReader, diags *diag.Diagnostics) (session ephemeralSession, ok bool) {
+	data, d := private.GetKey(ctx, SESSION_EPHEMERAL_PRIVATE_KEY)
+	diags.Append(d...)
+	if diags.HasError() {
+		return session, false
+	}
+
+	if len(data) == 0 {
+		diags.AddError("Session not found", "Private data of ephemeral resource do not contain any session")
+		return session, false
+	}
+
+	if err := json.Unmarshal(data, &session); err != nil {
+		diags.Append(redfishErrorDiagnostics("Session could not be read", err)...)
+		return session, false
+	}
+
+	return session, true
+}
+
+// openEphemeralSession opens dedicated session on system described by rserver. Session is not taken
+// from session pool, since it's owned by the ephemeral resource and must outlive provider operations.
+func openEphemeralSession(pconfig *IrmcProvider, rserver *[]models.RedfishServer) (ephemeralSession, error) {
+	clientConfig, err := getTargetClientConfig(pconfig, rserver)
+	if err != nil {
+		return ephemeralSession{}, err
+	}
+
+	if clientConfig.Session != nil {
+		return ephemeralSession{}, fmt.Errorf("dedicated session can be opened only using username and password, but session_token has been configured")
+	}
+
+	api, err := gofish.Connect(clientConfig)
+	if err != nil {
+		return ephemeralSession{}, err
+	}
+
+	session, err := api.GetSession()
+	if err != nil {
+		api.Logout()
+		return ephemeralSession{}, err
+	}
+
+	return ephemeralSession{
+		Endpoint:  clientConfig.Endpoint,
+		Insecure:  clientConfig.Insecure,
+		SessionId: session.ID,
+		Token:     session.Token,
+	}, nil
+}
+
+// renewEphemeralSession keeps session alive, since any request resets its inactivity timer.
+func renewEphemeralSession(pconfig *IrmcProvider, session ephemeralSession) error {
+	api, err := connectEphemeralSession(pconfig, session)
+	if err != nil {
+		return err
+	}
+
+	defer api.HTTPClient.CloseIdleConnections()
+
+	res, err := api.Get(session.SessionId)
+	if err != nil {
+		return err
+	}
+
+	CloseResource(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET on '%s' returned unexpected status '%d'", session.SessionId, res.StatusCode)
+	}
+
+	return nil
+}
+
+// closeEphemeralSession logs out session opened by openEphemeralSession.
+func closeEphemeralSession(pconfig *IrmcProvider, session ephemeralSession) (diags diag.Diagnostics) {
+	api, err := connectEphemeralSession(pconfig, session)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Session could not be closed", err)...)
+		return diags
+	}
+
+	defer api.HTTPClient.CloseIdleConnections()
+
+	res, err := api.Delete(session.SessionId)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Session could not be closed", err)...)
+		return diags
+	}
+
+	CloseResource(res.Body)
+	return diags
+}
+
+// connectEphemeralSession returns client using session opened by ephemeral resource.
+func connectEphemeralSession(pconfig *IrmcProvider, session ephemeralSession) (*gofish.APIClient, error) {
+	return gofish.Connect(gofish.ClientConfig{
+		Endpoint:   session.Endpoint,
+		Session:    &gofish.Session{ID: session.SessionId, Token: session.Token},
+		Insecure:   session.Insecure,
+		HTTPClient: newRedfishHttpClient(pconfig, session.Insecure),
+	})
+}
@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEphemeralSessionLifecycle(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	pconfig := connectMockRedfishServer(t, server)
+	session, err := openEphemeralSession(pconfig, &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if len(session.Token) == 0 || server.Resource(session.SessionId) == nil {
+		t.Fatalf("Expected opened session, got %v", session)
+	}
+
+	if err := renewEphemeralSession(pconfig, session); err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if diags := closeEphemeralSession(pconfig, session); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if server.Resource(session.SessionId) != nil {
+		t.Errorf("Session %s has not been logged out", session.SessionId)
+	}
+
+	// Session is gone, so it can not be kept alive anymore
+	if err := renewEphemeralSession(pconfig, session); err == nil {
+		t.Errorf("Expected error for closed session")
+	}
+}
+
+func TestEphemeralSessionRequiresCredentials(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	credentials := server.Credentials()
+	servers := []models.RedfishServer{{
+		Endpoint:     types.StringValue("https://" + credentials.Endpoint),
+		SessionToken: types.StringValue("external-token"),
+		SslInsecure:  types.BoolValue(true),
+	}}
+
+	if _, err := openEphemeralSession(connectMockRedfishServer(t, server), &servers); err == nil {
+		t.Errorf("Expected error for session token")
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/stmcginnis/gofish/common"
+)
+
+// Number of attempts to PATCH resource, which has been modified by other client in the meantime.
+const PATCH_ETAG_MAX_ATTEMPTS = 3
+
+// PatchWithEtag sends PATCH request on endpoint with If-Match header containing ETag obtained
+// by GET request on the same endpoint just before (header is omitted if ETag is not reported).
+// If resource has been modified concurrently and PATCH is rejected with 412 Precondition Failed,
+// fresh ETag is obtained and request is repeated. Body of returned response must be closed by the caller.
+func PatchWithEtag(client common.Client, endpoint string, payload interface{}) (*http.Response, error) {
+	var err error
+	for attempt := 1; attempt <= PATCH_ETAG_MAX_ATTEMPTS; attempt++ {
+		var etag string
+		if etag, err = getEtag(client, endpoint); err != nil {
+			return nil, err
+		}
+
+		headers := map[string]string{}
+		if len(etag) > 0 {
+			headers[HTTP_HEADER_IF_MATCH] = etag
+		}
+
+		var resp *http.Response
+		resp, err = client.PatchWithHeaders(endpoint, payload, headers)
+		if err == nil {
+			return resp, nil
+		}
+
+		if !isPreconditionFailed(err) {
+			break
+		}
+	}
+
+	return nil, fmt.Errorf("PATCH on %s finished with error '%w'", endpoint, err)
+}
+
+// getEtag returns ETag reported by GET request on endpoint in ETag header or,
+// if header is missing, in @odata.etag property of the resource.
+func getEtag(client common.Client, endpoint string) (string, error) {
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("GET on %s finished with error '%w'", endpoint, err)
+	}
+
+	defer CloseResource(resp.Body)
+
+	if etag := resp.Header.Get(HTTP_HEADER_ETAG); len(etag) > 0 {
+		return etag, nil
+	}
+
+	var resource struct {
+		Etag string `json:"@odata.etag"`
+	}
+
+	// Body not being JSON object only means that ETag is not known
+	_ = json.NewDecoder(resp.Body).Decode(&resource)
+	return resource.Etag, nil
+}
+
+// isPreconditionFailed checks whether err reports rejection of request due to not matching ETag.
+func isPreconditionFailed(err error) bool {
+	var redfishErr *common.Error
+	return errors.As(err, &redfishErr) && redfishErr.HTTPReturnedStatusCode == http.StatusPreconditionFailed
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stmcginnis/gofish"
+)
+
+func TestPatchWithEtag(t *testing.T) {
+	version := 1
+	rejections := 0
+	var ifMatches []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redfish/v1/":
+			fmt.Fprint(w, `{"@odata.id": "/redfish/v1/"}`)
+		case "/redfish/v1/Systems/0":
+			etag := fmt.Sprintf(`W/"%d"`, version)
+			if r.Method == http.MethodGet {
+				w.Header().Set(HTTP_HEADER_ETAG, etag)
+				fmt.Fprint(w, `{"@odata.id": "/redfish/v1/Systems/0"}`)
+				return
+			}
+
+			ifMatches = append(ifMatches, r.Header.Get(HTTP_HEADER_IF_MATCH))
+			// Simulate concurrent modification done by other client
+			if rejections > 0 {
+				rejections--
+				version++
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case "/redfish/v1/Oem/BootConfig":
+			if r.Method == http.MethodGet {
+				fmt.Fprint(w, `{"@odata.etag": "abc"}`)
+				return
+			}
+			ifMatches = append(ifMatches, r.Header.Get(HTTP_HEADER_IF_MATCH))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	api, err := gofish.Connect(gofish.ClientConfig{Endpoint: server.URL, BasicAuth: true})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	payload := map[string]interface{}{"AssetTag": "terraform"}
+
+	rejections = 1
+	res, err := PatchWithEtag(api, "/redfish/v1/Systems/0", payload)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	CloseResource(res.Body)
+
+	if len(ifMatches) != 2 || ifMatches[0] != `W/"1"` || ifMatches[1] != `W/"2"` {
+		t.Errorf("Expected retry with fresh ETag, got %v", ifMatches)
+	}
+
+	ifMatches = nil
+	rejections = PATCH_ETAG_MAX_ATTEMPTS
+	if _, err = PatchWithEtag(api, "/redfish/v1/Systems/0", payload); err == nil || !isPreconditionFailed(err) {
+		t.Errorf("Expected precondition failed error, got %v", err)
+	}
+
+	if len(ifMatches) != PATCH_ETAG_MAX_ATTEMPTS {
+		t.Errorf("Expected %d attempts, got %d", PATCH_ETAG_MAX_ATTEMPTS, len(ifMatches))
+	}
+
+	ifMatches = nil
+	res, err = PatchWithEtag(api, "/redfish/v1/Oem/BootConfig", payload)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	CloseResource(res.Body)
+
+	if len(ifMatches) != 1 || ifMatches[0] != "abc" {
+		t.Errorf("Expected ETag taken from @odata.etag, got %v", ifMatches)
+	}
+}
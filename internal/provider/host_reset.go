@@ -18,12 +18,16 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/redfish"
 )
@@ -46,9 +50,31 @@ func isPoweredOn(service *gofish.Service) (bool, error) {
 	return false, nil
 }
 
+// checkRequiredHostState verifies that host defined by service is in power state required
+// by the resource (if any), so that operation fails fast instead of waiting for job timeout.
+func checkRequiredHostState(service *gofish.Service, required types.String) (diags diag.Diagnostics) {
+	if required.IsNull() || required.IsUnknown() {
+		return diags
+	}
+
+	system, err := GetSystemResource(service)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not obtain host power state", err)...)
+		return diags
+	}
+
+	if string(system.PowerState) != required.ValueString() {
+		diags.AddAttributeError(path.Root("required_host_state"), "Host is not in required power state",
+			fmt.Sprintf("Operation requires host power state '%s', but current host power state is '%s'.",
+				required.ValueString(), system.PowerState))
+	}
+
+	return diags
+}
+
 // waitUntilHostStateChanged waits with timeout until expectedPoweredOn will be reached
-// by target defined as service.
-func waitUntilHostStateChanged(service *gofish.Service, expectedPoweredOn bool, timeout int64) error {
+// by target defined as service. Waiting is interrupted on cancellation of ctx.
+func waitUntilHostStateChanged(ctx context.Context, service *gofish.Service, expectedPoweredOn bool, timeout int64) error {
 	startTime := time.Now().Unix()
 	for {
 		poweredOn, err := isPoweredOn(service)
@@ -70,7 +96,9 @@ func waitUntilHostStateChanged(service *gofish.Service, expectedPoweredOn bool,
 			return fmt.Errorf("error. Host state has not been changed within given timeout %d", timeout)
 		}
 
-		time.Sleep(2 * time.Second)
+		if err := sleepWithContext(ctx, 2*time.Second); err != nil {
+			return fmt.Errorf("%w (waiting for host power state change)", err)
+		}
 	}
 }
 
@@ -125,10 +153,10 @@ func isBiosInPOSTPhase(service *gofish.Service) (bool, error) {
 
 // waitUntilHostStateChangedEnhanced waits until host will change its state
 // based on BIOS POST phase (exit of the POST phase together with host powered on state
-// is treated as reached powered on state).
-func waitUntilHostStateChangedEnhanced(service *gofish.Service, expectedPoweredOn bool, timeout int64) error {
+// is treated as reached powered on state). Waiting is interrupted on cancellation of ctx.
+func waitUntilHostStateChangedEnhanced(ctx context.Context, service *gofish.Service, expectedPoweredOn bool, timeout int64) error {
 	if !expectedPoweredOn {
-		return waitUntilHostStateChanged(service, expectedPoweredOn, timeout)
+		return waitUntilHostStateChanged(ctx, service, expectedPoweredOn, timeout)
 	}
 
 	startTime := time.Now().Unix()
@@ -145,8 +173,8 @@ func waitUntilHostStateChangedEnhanced(service *gofish.Service, expectedPoweredO
 
 			if biosDuringPOST {
 				break
-			} else {
-				time.Sleep(time.Second)
+			} else if err := sleepWithContext(ctx, time.Second); err != nil {
+				return fmt.Errorf("%w (waiting for POST to start)", err)
 			}
 		}
 
@@ -185,7 +213,9 @@ func waitUntilHostStateChangedEnhanced(service *gofish.Service, expectedPoweredO
 							didPowerOnInTime = true
 							break
 						}
-						time.Sleep(2 * time.Second)
+						if err := sleepWithContext(ctx, 2*time.Second); err != nil {
+							return fmt.Errorf("%w (waiting for host power on after POST)", err)
+						}
 					}
 
 					if didPowerOnInTime {
@@ -194,16 +224,39 @@ func waitUntilHostStateChangedEnhanced(service *gofish.Service, expectedPoweredO
 						return fmt.Errorf("BIOS exited POST but host powered off")
 					}
 				}
-			} else {
-				time.Sleep(2 * time.Second)
+			} else if err := sleepWithContext(ctx, 2*time.Second); err != nil {
+				return fmt.Errorf("%w (waiting for POST to end)", err)
 			}
 		}
 	}
 }
 
+// waitUntilBiosInPOSTPhase waits with timeout until BIOS of host defined by service reports POST phase.
+func waitUntilBiosInPOSTPhase(ctx context.Context, service *gofish.Service, timeout int64) error {
+	startTime := time.Now().Unix()
+	for {
+		biosDuringPOST, err := isBiosInPOSTPhase(service)
+		if err != nil {
+			return err
+		}
+
+		if biosDuringPOST {
+			return nil
+		}
+
+		if time.Now().Unix()-startTime > timeout {
+			return fmt.Errorf("operation not finished within given timeout %d (waiting for POST to start)", timeout)
+		}
+
+		if err := sleepWithContext(ctx, time.Second); err != nil {
+			return fmt.Errorf("%w (waiting for POST to start)", err)
+		}
+	}
+}
+
 // changePowerState tries to change host state to value defined in powerOn with timeout
 // when requested power state should be reached.
-func changePowerState(service *gofish.Service, powerOn bool, timeout int64) error {
+func changePowerState(ctx context.Context, service *gofish.Service, powerOn bool, timeout int64) error {
 	system, err := GetSystemResource(service)
 	if err != nil {
 		return err
@@ -234,7 +287,7 @@ func changePowerState(service *gofish.Service, powerOn bool, timeout int64) erro
 		return err
 	}
 
-	err = waitUntilHostStateChangedEnhanced(service, expectedTargetState, timeout)
+	err = waitUntilHostStateChangedEnhanced(ctx, service, expectedTargetState, timeout)
 	if err != nil {
 		return err
 	}
@@ -243,7 +296,7 @@ func changePowerState(service *gofish.Service, powerOn bool, timeout int64) erro
 }
 
 // resetHost calls host reset using resetType defined by caller.
-func resetHost(service *gofish.Service, resetType redfish.ResetType, timeout int64) error {
+func resetHost(ctx context.Context, service *gofish.Service, resetType redfish.ResetType, timeout int64) error {
 	system, err := GetSystemResource(service)
 	if err != nil {
 		return err
@@ -256,7 +309,7 @@ func resetHost(service *gofish.Service, resetType redfish.ResetType, timeout int
 
 	expectedTargetState := resetType != redfish.GracefulShutdownResetType && resetType != redfish.PushPowerButtonResetType
 
-	err = waitUntilHostStateChangedEnhanced(service, expectedTargetState, timeout)
+	err = waitUntilHostStateChangedEnhanced(ctx, service, expectedTargetState, timeout)
 	if err != nil {
 		return err
 	}
@@ -266,21 +319,42 @@ func resetHost(service *gofish.Service, resetType redfish.ResetType, timeout int
 
 // resetOrPowerOnHostWithPostCheck powers on host if it's currently powered off
 // or performs requested resetType operation if host is on within given timeout.
-func resetOrPowerOnHostWithPostCheck(service *gofish.Service, resetType redfish.ResetType, timeout int64) error {
+func resetOrPowerOnHostWithPostCheck(ctx context.Context, service *gofish.Service, resetType redfish.ResetType, timeout int64) error {
 	poweredOn, err := isPoweredOn(service)
 	if err != nil {
 		return err
 	}
 
 	if !poweredOn {
-		if err = changePowerState(service, true, timeout); err != nil {
+		if err = changePowerState(ctx, service, true, timeout); err != nil {
 			return err
 		}
 	} else {
-		if err = resetHost(service, resetType, timeout); err != nil {
+		if err = resetHost(ctx, service, resetType, timeout); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// resetOrPowerOnHostIntoPOST powers on host if it's currently powered off or performs
+// requested resetType operation if host is on, and waits only until BIOS enters POST phase.
+// It's used when host is expected to stay in POST, e.g. when it boots into BIOS setup.
+func resetOrPowerOnHostIntoPOST(ctx context.Context, service *gofish.Service, resetType redfish.ResetType, timeout int64) error {
+	system, err := GetSystemResource(service)
+	if err != nil {
+		return err
+	}
+
+	operation := resetType
+	if system.PowerState != redfish.OnPowerState {
+		operation = redfish.OnResetType
+	}
+
+	if err = system.Reset(operation); err != nil {
+		return err
+	}
+
+	return waitUntilBiosInPOSTPhase(ctx, service, timeout)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckRequiredHostState(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	// Mock host is powered on
+	tests := []struct {
+		required types.String
+		fails    bool
+	}{
+		{required: types.StringNull(), fails: false},
+		{required: types.StringValue("On"), fails: false},
+		{required: types.StringValue("Off"), fails: true},
+	}
+
+	for _, test := range tests {
+		diags := checkRequiredHostState(api.Service, test.required)
+		if diags.HasError() != test.fails {
+			t.Errorf("Unexpected result for required host state %s: %v", test.required, diags)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+const (
+	HTTP_HEADER_RETRY_AFTER = "Retry-After"
+	HTTP_RETRY_COUNT        = 3
+	HTTP_RETRY_INTERVAL     = 5
+	// Upper limit for delay requested by service in Retry-After header.
+	HTTP_RETRY_AFTER_MAX = 120 * time.Second
+	// Defaults of HTTP client tuning, timeouts in seconds (0 means no limit).
+	HTTP_TIMEOUT               = 0
+	HTTP_TLS_HANDSHAKE_TIMEOUT = 10
+	HTTP_KEEP_ALIVE            = 30
+	HTTP_DIAL_TIMEOUT          = 30 * time.Second
+)
+
+// retryTransport retries idempotent requests on transient errors reported by iRMC
+// (408, 429, 5xx and connection resets). Delay between attempts is taken from
+// Retry-After header if present, otherwise it grows exponentially starting from interval.
+type retryTransport struct {
+	next     http.RoundTripper
+	retries  int
+	interval time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, retries int64, interval int64) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	if retries < 0 {
+		retries = 0
+	}
+
+	return &retryTransport{
+		next:     next,
+		retries:  int(retries),
+		interval: time.Duration(interval) * time.Second,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotentRequest(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	delay := t.interval
+	for attempt := 0; ; attempt++ {
+		res, err := t.next.RoundTrip(req)
+		if attempt >= t.retries || !isRetryableResponse(res, err) {
+			return res, err
+		}
+
+		wait := delay
+		if res != nil {
+			if retryAfter, ok := parseRetryAfter(res.Header.Get(HTTP_HEADER_RETRY_AFTER), time.Now()); ok {
+				wait = retryAfter
+			}
+
+			// body must be drained and closed, so connection can be reused by next attempt
+			_, _ = io.Copy(io.Discard, res.Body)
+			CloseResource(res.Body)
+		}
+
+		if err := sleepWithContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		delay *= 2
+	}
+}
+
+func isIdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return req.Body == nil || req.Body == http.NoBody
+	default:
+		return false
+	}
+}
+
+func isRetryableResponse(res *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+			errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+
+	switch res.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter converts value of Retry-After header (delay in seconds or HTTP date)
+// to duration which should be waited before next attempt.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		wait = date.Sub(now)
+	} else {
+		return 0, false
+	}
+
+	if wait < 0 {
+		wait = 0
+	}
+
+	if wait > HTTP_RETRY_AFTER_MAX {
+		wait = HTTP_RETRY_AFTER_MAX
+	}
+
+	return wait, true
+}
+
+// newRedfishProxyFunc returns function selecting proxy for requests to iRMC. Proxy configured in provider
+// is used for all iRMCs except these matching noProxy, otherwise proxy is taken from environment
+// (HTTP_PROXY, HTTPS_PROXY and NO_PROXY).
+func newRedfishProxyFunc(proxy string, noProxy string) func(*http.Request) (*url.URL, error) {
+	if len(proxy) == 0 {
+		return http.ProxyFromEnvironment
+	}
+
+	// Credentials with reserved characters are encoded, so the proxy is parsed correctly by httpproxy
+	if parsed, err := validators.ParseProxyURL(proxy); err == nil {
+		proxy = parsed.String()
+	}
+
+	config := httpproxy.Config{
+		HTTPProxy:  proxy,
+		HTTPSProxy: proxy,
+		NoProxy:    noProxy,
+	}
+
+	proxyFunc := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+}
+
+// keepAliveTransport keeps connection to iRMC open for next requests. gofish asks for closing
+// of connection after every request (Request.Close) when custom HTTP client is used, even if
+// ReuseConnections is set in its configuration.
+type keepAliveTransport struct {
+	next http.RoundTripper
+}
+
+func (t *keepAliveTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Close {
+		req = req.Clone(req.Context())
+		req.Close = false
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// redfishReuseConnections checks if connections to iRMC are kept open between requests. Without it
+// gofish closes connection after each request regardless of keep-alive settings of the transport.
+func redfishReuseConnections(pconfig *IrmcProvider) bool {
+	if pconfig == nil {
+		return HTTP_KEEP_ALIVE > 0
+	}
+	return pconfig.HttpKeepAlive > 0
+}
+
+// newRedfishHttpClient returns HTTP client used for communication with iRMC,
+// which retries transient errors and applies timeouts according to provider configuration.
+func newRedfishHttpClient(pconfig *IrmcProvider, insecure bool) *http.Client {
+	retries, interval := int64(HTTP_RETRY_COUNT), int64(HTTP_RETRY_INTERVAL)
+	timeout, handshake, keepAlive := int64(HTTP_TIMEOUT), int64(HTTP_TLS_HANDSHAKE_TIMEOUT), int64(HTTP_KEEP_ALIVE)
+	var proxy, noProxy string
+	if pconfig != nil {
+		retries, interval = pconfig.RetryCount, pconfig.RetryInterval
+		timeout, handshake, keepAlive = pconfig.HttpTimeout, pconfig.TlsHandshakeTimeout, pconfig.HttpKeepAlive
+		proxy, noProxy = pconfig.Proxy, pconfig.NoProxy
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   HTTP_DIAL_TIMEOUT,
+		KeepAlive: time.Duration(keepAlive) * time.Second,
+	}
+
+	transport := &http.Transport{
+		Proxy:               newRedfishProxyFunc(proxy, noProxy),
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     newRedfishTlsConfig(pconfig, insecure),
+		TLSHandshakeTimeout: time.Duration(handshake) * time.Second,
+	}
+
+	// Negative value disables TCP keep-alive probes of dialer
+	if keepAlive <= 0 {
+		dialer.KeepAlive = -1
+		transport.DisableKeepAlives = true
+	}
+
+	var auditLog *auditLog
+	var limiter *RequestLimiter
+	if pconfig != nil {
+		auditLog, limiter = pconfig.AuditLog, pconfig.RequestLimiter
+	}
+
+	var base http.RoundTripper = transport
+	if keepAlive > 0 {
+		base = &keepAliveTransport{next: transport}
+	}
+
+	// Rate limit and audit transports are placed below retries, so every attempt
+	// (including task polling) is limited and recorded
+	return &http.Client{
+		Transport: newRetryTransport(newRateLimitTransport(newAuditTransport(base, auditLog), limiter), retries, interval),
+		Timeout:   time.Duration(timeout) * time.Second,
+	}
+}
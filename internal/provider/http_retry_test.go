@@ -0,0 +1,241 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Run("RetriesServiceUnavailable", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				w.Header().Set(HTTP_HEADER_RETRY_AFTER, "0")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: newRetryTransport(nil, 3, 1)}
+		res, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Unexpected error %s", err.Error())
+		}
+		CloseResource(res.Body)
+
+		if res.StatusCode != http.StatusOK || calls != 3 {
+			t.Errorf("Got status %d after %d calls, expected 200 after 3 calls", res.StatusCode, calls)
+		}
+	})
+
+	t.Run("DoesNotRetryPost", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: newRetryTransport(nil, 3, 1)}
+		res, err := client.Post(server.URL, "application/json", nil)
+		if err != nil {
+			t.Fatalf("Unexpected error %s", err.Error())
+		}
+		CloseResource(res.Body)
+
+		if calls != 1 {
+			t.Errorf("Got %d calls, expected 1", calls)
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	if wait, ok := parseRetryAfter("7", now); !ok || wait != 7*time.Second {
+		t.Errorf("Got %s, expected 7s", wait)
+	}
+
+	if wait, ok := parseRetryAfter("Wed, 01 Jan 2025 12:00:30 GMT", now); !ok || wait != 30*time.Second {
+		t.Errorf("Got %s, expected 30s", wait)
+	}
+
+	if wait, ok := parseRetryAfter("3600", now); !ok || wait != HTTP_RETRY_AFTER_MAX {
+		t.Errorf("Got %s, expected %s", wait, HTTP_RETRY_AFTER_MAX)
+	}
+
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Errorf("Invalid value should not be accepted")
+	}
+}
+
+func TestNewRedfishHttpClient(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		client := newRedfishHttpClient(nil, true)
+		transport := client.Transport.(*retryTransport).next.(*keepAliveTransport).next.(*http.Transport)
+
+		if client.Timeout != 0 {
+			t.Errorf("Got timeout %s, expected no limit", client.Timeout)
+		}
+		if transport.TLSHandshakeTimeout != HTTP_TLS_HANDSHAKE_TIMEOUT*time.Second {
+			t.Errorf("Got TLS handshake timeout %s", transport.TLSHandshakeTimeout)
+		}
+		if transport.DisableKeepAlives {
+			t.Errorf("Keep-alive should be enabled by default")
+		}
+	})
+
+	t.Run("ProviderSettings", func(t *testing.T) {
+		pconfig := &IrmcProvider{
+			RetryCount:          1,
+			RetryInterval:       1,
+			HttpTimeout:         1800,
+			TlsHandshakeTimeout: 60,
+			HttpKeepAlive:       0,
+		}
+
+		client := newRedfishHttpClient(pconfig, true)
+		transport := client.Transport.(*retryTransport).next.(*http.Transport)
+
+		if client.Timeout != 1800*time.Second {
+			t.Errorf("Got timeout %s, expected 30m", client.Timeout)
+		}
+		if transport.TLSHandshakeTimeout != 60*time.Second {
+			t.Errorf("Got TLS handshake timeout %s, expected 1m", transport.TLSHandshakeTimeout)
+		}
+		if !transport.DisableKeepAlives {
+			t.Errorf("Keep-alive should be disabled")
+		}
+	})
+}
+
+func TestRedfishConnectionReuse(t *testing.T) {
+	var connections atomic.Int64
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"@odata.id": "/redfish/v1/"}`)
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	for _, tc := range []struct {
+		keepAlive int64
+		expected  int64
+	}{
+		{keepAlive: HTTP_KEEP_ALIVE, expected: 1},
+		{keepAlive: 0, expected: 3},
+	} {
+		t.Run(fmt.Sprintf("KeepAlive%d", tc.keepAlive), func(t *testing.T) {
+			connections.Store(0)
+			pconfig := &IrmcProvider{HttpKeepAlive: tc.keepAlive}
+			rserver := []models.RedfishServer{{
+				Endpoint:     types.StringValue(server.URL),
+				SessionToken: types.StringValue("token"),
+				SslInsecure:  types.BoolValue(true),
+			}}
+
+			config, err := getTargetClientConfig(pconfig, &rserver)
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err.Error())
+			}
+
+			// Connect reads service root, so together with following requests three requests are sent
+			api, err := gofish.Connect(config)
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err.Error())
+			}
+
+			for i := 0; i < 2; i++ {
+				res, err := api.Get("/redfish/v1/")
+				if err != nil {
+					t.Fatalf("Unexpected error %s", err.Error())
+				}
+				CloseResource(res.Body)
+			}
+
+			if connections.Load() != tc.expected {
+				t.Errorf("Got %d connections, expected %d", connections.Load(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestRedfishProxyFunc(t *testing.T) {
+	proxyFunc := newRedfishProxyFunc("socks5://jump.example.com:1080", "10.0.0.0/8,.lab.example.com")
+
+	tests := map[string]string{
+		"https://192.168.1.10/redfish/v1":         "socks5://jump.example.com:1080",
+		"https://irmc01.mgmt.example.com/":        "socks5://jump.example.com:1080",
+		"https://10.172.181.125/redfish/v1":       "",
+		"https://irmc02.lab.example.com/redfish/": "",
+	}
+
+	for target, expected := range tests {
+		req, _ := http.NewRequest(http.MethodGet, target, nil)
+		proxy, err := proxyFunc(req)
+		if err != nil {
+			t.Fatalf("Unexpected error %s", err.Error())
+		}
+
+		if got := proxyString(proxy); got != expected {
+			t.Errorf("Request to %s expected proxy '%s', got '%s'", target, expected, got)
+		}
+	}
+}
+
+func TestRedfishProxyFuncCredentials(t *testing.T) {
+	proxyFunc := newRedfishProxyFunc("http://user:se/cret@proxy.example.com:3128/", "")
+
+	req, _ := http.NewRequest(http.MethodGet, "https://192.168.1.10/redfish/v1", nil)
+	proxy, err := proxyFunc(req)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	password, _ := proxy.User.Password()
+	if proxy.Host != "proxy.example.com:3128" || proxy.User.Username() != "user" || password != "se/cret" {
+		t.Errorf("Got unexpected proxy %s", proxyString(proxy))
+	}
+}
+
+func proxyString(proxy *url.URL) string {
+	if proxy == nil {
+		return ""
+	}
+	return proxy.String()
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceIdentitySchema returns identity schema of resources, which can be imported
+// using import block with identity (Terraform 1.12 or later). Meaning of id is resource specific.
+func ResourceIdentitySchema(idDescription string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"endpoint": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Server BMC IP address or hostname",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       idDescription,
+			},
+		},
+	}
+}
+
+// setResourceIdentity stores identity of the resource. If Terraform does not support
+// resource identity, nothing is done.
+func setResourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, endpoint string, id string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, models.ResourceIdentityModel{
+		Endpoint: types.StringValue(endpoint),
+		Id:       types.StringValue(id),
+	})
+}
+
+// readImportIdentity returns server configuration and id of the resource from identity used
+// during import. Credentials are not part of identity, so they have to be provided on provider level.
+func readImportIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity) (models.RedfishServer, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if identity == nil {
+		diags.AddError("Missing import identifier", "Either import ID or identity must be provided")
+		return models.RedfishServer{}, "", diags
+	}
+
+	var data models.ResourceIdentityModel
+	diags.Append(identity.Get(ctx, &data)...)
+	if diags.HasError() {
+		return models.RedfishServer{}, "", diags
+	}
+
+	server := models.RedfishServer{
+		Endpoint: data.Endpoint,
+	}
+
+	return server, data.Id.ValueString(), diags
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IMPORT_ID_SEPARATOR separates endpoint and resource id in import ID.
+const IMPORT_ID_SEPARATOR = ":"
+
+// importServerConfig is implemented by import configurations embedding ServerConfig.
+type importServerConfig interface {
+	redfishServer() models.RedfishServer
+}
+
+func (c ServerConfig) redfishServer() models.RedfishServer {
+	return models.RedfishServer{
+		User:        types.StringValue(c.Username),
+		Password:    types.StringValue(c.Password),
+		Endpoint:    types.StringValue(c.Endpoint),
+		SslInsecure: types.BoolValue(c.SslInsecure),
+	}
+}
+
+// parseImportID parses import ID of a resource. Import ID is either JSON object with credentials,
+// which is unmarshalled into config, or has format "<endpoint>:<id>" (just "<endpoint>" if id is nil).
+// In the latter case credentials are not part of returned server configuration, so they are taken
+// from provider configuration or credentials file.
+func parseImportID(importID string, config importServerConfig, id *string) (models.RedfishServer, error) {
+	if strings.HasPrefix(strings.TrimSpace(importID), "{") {
+		if err := json.Unmarshal([]byte(importID), config); err != nil {
+			return models.RedfishServer{}, err
+		}
+		return config.redfishServer(), nil
+	}
+
+	endpoint, resourceID, err := splitImportID(importID, id != nil)
+	if err != nil {
+		return models.RedfishServer{}, err
+	}
+
+	if id != nil {
+		*id = resourceID
+	}
+
+	return models.RedfishServer{
+		Endpoint: types.StringValue(endpoint),
+	}, nil
+}
+
+// splitImportID splits import ID in format "<endpoint>:<id>" into endpoint and id. Endpoint may contain
+// scheme and port, if scheme is missing https is used.
+func splitImportID(importID string, withID bool) (string, string, error) {
+	endpoint := strings.TrimSpace(importID)
+	var id string
+
+	if withID {
+		idx := strings.LastIndex(endpoint, IMPORT_ID_SEPARATOR)
+		if idx <= 0 || idx == len(endpoint)-1 || strings.HasPrefix(endpoint[idx:], "://") {
+			return "", "", fmt.Errorf("import ID '%s' is neither JSON object nor in format '<endpoint>:<id>'", importID)
+		}
+
+		id = endpoint[idx+1:]
+		endpoint = endpoint[:idx]
+	}
+
+	if len(endpoint) == 0 {
+		return "", "", fmt.Errorf("import ID '%s' does not contain endpoint", importID)
+	}
+
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+
+	return endpoint, id, nil
+}
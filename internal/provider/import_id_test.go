@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import "testing"
+
+func TestSplitImportID(t *testing.T) {
+	tests := []struct {
+		importID string
+		withID   bool
+		endpoint string
+		id       string
+		wantErr  bool
+	}{
+		{importID: "https://10.172.181.125:3", withID: true, endpoint: "https://10.172.181.125", id: "3"},
+		{importID: "10.172.181.125:3", withID: true, endpoint: "https://10.172.181.125", id: "3"},
+		{importID: "https://irmc.example.com:443:/redfish/v1/Systems/0/Storage/0/Volumes/1", withID: true,
+			endpoint: "https://irmc.example.com:443", id: "/redfish/v1/Systems/0/Storage/0/Volumes/1"},
+		{importID: "https://10.172.181.125:443", withID: false, endpoint: "https://10.172.181.125:443"},
+		{importID: "https://10.172.181.125", withID: true, wantErr: true},
+		{importID: "10.172.181.125:", withID: true, wantErr: true},
+		{importID: ":3", withID: true, wantErr: true},
+		{importID: "", withID: false, wantErr: true},
+	}
+
+	for _, test := range tests {
+		endpoint, id, err := splitImportID(test.importID, test.withID)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected error, got endpoint '%s' and id '%s'", test.importID, endpoint, id)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", test.importID, err.Error())
+			continue
+		}
+
+		if endpoint != test.endpoint || id != test.id {
+			t.Errorf("%s: expected '%s' and '%s', got '%s' and '%s'", test.importID, test.endpoint, test.id, endpoint, id)
+		}
+	}
+}
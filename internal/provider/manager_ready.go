@@ -0,0 +1,203 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	MANAGER_ENDPOINT               = "/redfish/v1/Managers/iRMC"
+	MANAGER_READY_TIMEOUT          = 600
+	MANAGER_RESET_GRACE_PERIOD     = 45 * time.Second
+	MANAGER_READY_INITIAL_INTERVAL = 10 * time.Second
+	MANAGER_READY_MAX_INTERVAL     = 30 * time.Second
+	MANAGER_OFFLINE_POLL_INTERVAL  = 5 * time.Second
+	MANAGER_OFFLINE_PROBE_TIMEOUT  = 10
+)
+
+// getManagerReadyTimeout returns configured timeout for iRMC to become ready or default one.
+func getManagerReadyTimeout(timeout types.Int64) int64 {
+	if timeout.IsNull() || timeout.IsUnknown() || timeout.ValueInt64() <= 0 {
+		return MANAGER_READY_TIMEOUT
+	}
+
+	return timeout.ValueInt64()
+}
+
+// checkManagerReady checks that iRMC responds and reports its manager resource as enabled.
+func checkManagerReady(api *gofish.APIClient) error {
+	resp, err := api.Get(MANAGER_ENDPOINT)
+	if err != nil {
+		return err
+	}
+
+	defer CloseResource(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET on %s finished with status code %d", MANAGER_ENDPOINT, resp.StatusCode)
+	}
+
+	var manager struct {
+		Status struct {
+			State string
+		}
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(&manager); err != nil {
+		return fmt.Errorf("error during decoding of %s GET response '%w'", MANAGER_ENDPOINT, err)
+	}
+
+	if len(manager.Status.State) > 0 && manager.Status.State != "Enabled" {
+		return fmt.Errorf("iRMC reports state '%s'", manager.Status.State)
+	}
+
+	return nil
+}
+
+// WaitForManagerReady connects to iRMC described by rserver in loop until it responds and reports
+// it is ready or timeout (in seconds) is reached. Since sessions established before iRMC restart
+// are not valid anymore, new session is always created. Returned client must be released using
+// ReleaseTargetSystem.
+func WaitForManagerReady(ctx context.Context, pconfig *IrmcProvider, rserver *[]models.RedfishServer, timeout int64) (*gofish.APIClient, error) {
+	var api *gofish.APIClient
+	var lastErr error
+
+	err := taskSupervisor.Poll(ctx, PollOptions{
+		Key:             getServerEndpoint(pconfig, *rserver),
+		Timeout:         time.Duration(timeout) * time.Second,
+		InitialInterval: MANAGER_READY_INITIAL_INTERVAL,
+		MaxInterval:     MANAGER_READY_MAX_INTERVAL,
+	}, func(ctx context.Context) (bool, error) {
+		client, err := connectTargetSystem(pconfig, rserver, true)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Could not connect to iRMC: %s", err.Error()))
+			lastErr = err
+			return false, nil
+		}
+
+		if err = checkManagerReady(client); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("iRMC is not ready yet: %s", err.Error()))
+			ReleaseTargetSystem(client)
+			lastErr = err
+			return false, nil
+		}
+
+		api = client
+		return true, nil
+	})
+
+	if err != nil {
+		if lastErr != nil {
+			return nil, fmt.Errorf("iRMC has not become ready: %w (last error: %s)", err, lastErr.Error())
+		}
+		return nil, fmt.Errorf("iRMC has not become ready: %w", err)
+	}
+
+	tflog.Info(ctx, "iRMC is ready")
+	return api, nil
+}
+
+// waitForManagerRestart waits until iRMC, which has been requested to restart, becomes ready again.
+// Since iRMC does not go down immediately, checks start after grace period.
+func waitForManagerRestart(ctx context.Context, pconfig *IrmcProvider, rserver *[]models.RedfishServer, timeout int64) (*gofish.APIClient, error) {
+	select {
+	case <-time.After(MANAGER_RESET_GRACE_PERIOD):
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for iRMC restart has been cancelled: %w", ctx.Err())
+	}
+
+	return WaitForManagerReady(ctx, pconfig, rserver, timeout)
+}
+
+// ensureManagerReady checks whether iRMC is still reachable after change of settings, which might
+// cause its restart. If it is not, it waits until iRMC becomes ready again.
+func ensureManagerReady(ctx context.Context, pconfig *IrmcProvider, rserver *[]models.RedfishServer, api *gofish.APIClient, timeout int64) error {
+	err := checkManagerReady(api)
+	if err == nil {
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("iRMC is not reachable (%s), most likely it is restarting", err.Error()))
+	api, err = WaitForManagerReady(ctx, pconfig, rserver, timeout)
+	if err != nil {
+		return err
+	}
+
+	ReleaseTargetSystem(api)
+	return nil
+}
+
+// waitForManagerOffline waits until iRMC described by rserver stops responding to Redfish requests
+// (e.g. after secure erase) or timeout (in seconds) is reached. Service root is requested without
+// credentials and retries, since credentials might not be valid anymore.
+func waitForManagerOffline(ctx context.Context, pconfig *IrmcProvider, rserver *[]models.RedfishServer, timeout int64) error {
+	server := resolveRedfishServer(pconfig, *rserver)
+	// Connection settings (proxy, TLS, audit log) are kept, only timeouts and retries are changed
+	var probeConfig IrmcProvider
+	if pconfig != nil {
+		probeConfig = *pconfig
+	}
+	probeConfig.HttpTimeout, probeConfig.TlsHandshakeTimeout = MANAGER_OFFLINE_PROBE_TIMEOUT, MANAGER_OFFLINE_PROBE_TIMEOUT
+	probeConfig.RetryCount, probeConfig.RetryInterval = 0, 0
+	client := newRedfishHttpClient(&probeConfig, server.SslInsecure.ValueBool())
+	serviceRoot := strings.TrimSuffix(server.Endpoint.ValueString(), "/") + "/redfish/v1/"
+
+	err := taskSupervisor.Poll(ctx, PollOptions{
+		Key:             server.Endpoint.ValueString(),
+		Timeout:         time.Duration(timeout) * time.Second,
+		InitialInterval: MANAGER_OFFLINE_POLL_INTERVAL,
+		MaxInterval:     MANAGER_OFFLINE_POLL_INTERVAL,
+	}, func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, serviceRoot, nil)
+		if err != nil {
+			return false, err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			tflog.Info(ctx, fmt.Sprintf("iRMC does not respond: %s", err.Error()))
+			return true, nil
+		}
+		CloseResource(resp.Body)
+
+		if resp.StatusCode >= http.StatusInternalServerError {
+			tflog.Info(ctx, fmt.Sprintf("iRMC responds with status code %d", resp.StatusCode))
+			return true, nil
+		}
+
+		return false, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("iRMC has not gone offline: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+)
+
+func TestCheckManagerReady(t *testing.T) {
+	state := "Starting"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redfish/v1/":
+			fmt.Fprint(w, `{"@odata.id": "/redfish/v1/"}`)
+		case MANAGER_ENDPOINT:
+			fmt.Fprintf(w, `{"@odata.id": "%s", "Status": {"State": "%s"}}`, MANAGER_ENDPOINT, state)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	api, err := gofish.Connect(gofish.ClientConfig{Endpoint: server.URL, BasicAuth: true})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if err = checkManagerReady(api); err == nil {
+		t.Errorf("Expected error for iRMC in state %s", state)
+	}
+
+	state = "Enabled"
+	if err = checkManagerReady(api); err != nil {
+		t.Errorf("Unexpected error %s", err.Error())
+	}
+}
+
+func TestGetManagerReadyTimeout(t *testing.T) {
+	if getManagerReadyTimeout(types.Int64Null()) != MANAGER_READY_TIMEOUT {
+		t.Errorf("Expected default timeout for null value")
+	}
+
+	if getManagerReadyTimeout(types.Int64Value(900)) != 900 {
+		t.Errorf("Expected configured timeout")
+	}
+}
+
+func TestWaitForManagerOfflineProxy(t *testing.T) {
+	// Proxy answers for iRMC, which is reachable only through it and keeps running
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"@odata.id": "/redfish/v1/"}`)
+	}))
+	defer proxy.Close()
+
+	pconfig := &IrmcProvider{Proxy: proxy.URL}
+	rserver := []models.RedfishServer{{Endpoint: types.StringValue("http://irmc.invalid")}}
+
+	if err := waitForManagerOffline(context.Background(), pconfig, &rserver, 1); err == nil {
+		t.Errorf("iRMC responding through proxy must not be reported as offline")
+	}
+}
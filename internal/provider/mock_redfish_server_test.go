@@ -0,0 +1,736 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+)
+
+const (
+	MOCK_REDFISH_USERNAME       = "admin"
+	MOCK_REDFISH_PASSWORD       = "Mock_password1"
+	MOCK_REDFISH_STORAGE_SERIAL = "MOCKCTRL0001"
+	MOCK_REDFISH_TASKS_ENDPOINT = "/redfish/v1/TaskService/Tasks"
+)
+
+// mockRedfishServer is in-memory Redfish service modelling subset of iRMC (ts_fujitsu OEM)
+// used by the provider, so acceptance tests can be executed without hardware.
+//
+// Resources are kept as JSON objects keyed by their @odata.id. GET returns the object,
+// PATCH merges payload into it (honoring If-Match), POST on collection creates new member,
+// DELETE removes member from its collection. Volume creation and deletion are reported
+// as tasks, which are completed immediately, the same applies to Simple Update. Actions are
+// recorded and acknowledged.
+type mockRedfishServer struct {
+	*httptest.Server
+
+	lock      sync.Mutex
+	resources map[string]map[string]interface{}
+	etags     map[string]int
+	sessions  map[string]string
+	nextID    int
+
+	// Actions keeps paths and payloads of invoked actions in order of invocation.
+	Actions []mockRedfishAction
+}
+
+type mockRedfishAction struct {
+	Path    string
+	Payload map[string]interface{}
+}
+
+func newMockRedfishServer() *mockRedfishServer {
+	m := &mockRedfishServer{
+		resources: make(map[string]map[string]interface{}),
+		etags:     make(map[string]int),
+		sessions:  make(map[string]string),
+		nextID:    100,
+	}
+	m.seed()
+	m.Server = httptest.NewTLSServer(http.HandlerFunc(m.serveHTTP))
+	return m
+}
+
+// Credentials returns credentials of the mock usable by acceptance tests.
+func (m *mockRedfishServer) Credentials() TestingServerCredentials {
+	return TestingServerCredentials{
+		Username: MOCK_REDFISH_USERNAME,
+		Password: MOCK_REDFISH_PASSWORD,
+		Endpoint: strings.TrimPrefix(m.URL, "https://"),
+		Insecure: true,
+	}
+}
+
+// Set stores resource under path, replacing existing one.
+func (m *mockRedfishServer) Set(path string, body map[string]interface{}) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.set(path, body)
+}
+
+// Resource returns copy of resource stored under path or nil.
+func (m *mockRedfishServer) Resource(path string) map[string]interface{} {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	res, ok := m.resources[mockRedfishPath(path)]
+	if !ok {
+		return nil
+	}
+	return mockRedfishCopy(res)
+}
+
+// sessionCount returns number of sessions, which have not been logged out.
+func (m *mockRedfishServer) sessionCount() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.sessions)
+}
+
+func (m *mockRedfishServer) set(path string, body map[string]interface{}) {
+	path = mockRedfishPath(path)
+	body["@odata.id"] = path
+	if _, ok := body["Id"]; !ok {
+		body["Id"] = path[strings.LastIndex(path, "/")+1:]
+	}
+	m.resources[path] = body
+	m.etags[path]++
+}
+
+func (m *mockRedfishServer) etag(path string) string {
+	return fmt.Sprintf(`W/"%d"`, m.etags[path])
+}
+
+// collection stores collection resource with members pointing to given paths.
+func (m *mockRedfishServer) collection(path string, members ...string) {
+	list := []interface{}{}
+	for _, member := range members {
+		list = append(list, map[string]interface{}{"@odata.id": member})
+	}
+	m.set(path, map[string]interface{}{"Members": list, "Members@odata.count": len(list)})
+}
+
+func mockRedfishLink(path string) map[string]interface{} {
+	return map[string]interface{}{"@odata.id": path}
+}
+
+func (m *mockRedfishServer) seed() {
+	m.set("/redfish/v1", map[string]interface{}{
+		"RedfishVersion": "1.15.0",
+		"Systems":        mockRedfishLink("/redfish/v1/Systems"),
+		"Managers":       mockRedfishLink("/redfish/v1/Managers"),
+		"Chassis":        mockRedfishLink("/redfish/v1/Chassis"),
+		"AccountService": mockRedfishLink("/redfish/v1/AccountService"),
+		"SessionService": mockRedfishLink("/redfish/v1/SessionService"),
+		"Tasks":          mockRedfishLink("/redfish/v1/TaskService"),
+		"UpdateService":  mockRedfishLink("/redfish/v1/UpdateService"),
+		"Links":          map[string]interface{}{"Sessions": mockRedfishLink(SESSIONS_ENDPOINT)},
+		"Oem":            map[string]interface{}{TS_FUJITSU: map[string]interface{}{}},
+	})
+
+	m.set("/redfish/v1/SessionService", map[string]interface{}{"Sessions": mockRedfishLink(SESSIONS_ENDPOINT)})
+	m.collection(SESSIONS_ENDPOINT)
+
+	// Systems
+	m.collection("/redfish/v1/Systems", "/redfish/v1/Systems/0")
+	m.set("/redfish/v1/Systems/0", map[string]interface{}{
+		"Name":         "PRIMERGY MOCK",
+		"Model":        "PRIMERGY RX2530 M7",
+		"Manufacturer": "Fsas Technologies",
+		"SerialNumber": "MOCK000001",
+		"PowerState":   "On",
+		"Boot": map[string]interface{}{
+			"BootSourceOverrideEnabled": "Disabled",
+			"BootSourceOverrideTarget":  "None",
+			"BootSourceOverrideMode":    "UEFI",
+		},
+		"Bios":    mockRedfishLink("/redfish/v1/Systems/0/Bios"),
+		"Storage": mockRedfishLink("/redfish/v1/Systems/0/Storage"),
+		"Actions": map[string]interface{}{
+			"#ComputerSystem.Reset": map[string]interface{}{"target": "/redfish/v1/Systems/0/Actions/ComputerSystem.Reset"},
+		},
+		"Oem": map[string]interface{}{TS_FUJITSU: map[string]interface{}{}},
+	})
+	m.set("/redfish/v1/Systems/0/Bios", map[string]interface{}{
+		"Attributes": map[string]interface{}{"BootMode": "Uefi"},
+	})
+
+	// Storage with single RAID controller, two drives and no volumes
+	m.collection("/redfish/v1/Systems/0/Storage", "/redfish/v1/Systems/0/Storage/0")
+	drives := []interface{}{}
+	for i := 0; i < 2; i++ {
+		drive := fmt.Sprintf("/redfish/v1/Chassis/0/Drives/%d", i)
+		drives = append(drives, mockRedfishLink(drive))
+		m.set(drive, map[string]interface{}{
+			"Name":          fmt.Sprintf("Drive %d", i),
+			"CapacityBytes": 960197124096,
+			"MediaType":     "SSD",
+			"Protocol":      "SAS",
+			"Status":        map[string]interface{}{"State": "Enabled", "Health": "OK"},
+			"Oem": map[string]interface{}{TS_FUJITSU: map[string]interface{}{
+				"DriveStatus": "Available",
+				"SlotNumber":  i,
+			}},
+		})
+	}
+	m.set("/redfish/v1/Systems/0/Storage/0", map[string]interface{}{
+		"Name": "RAID controller",
+		"StorageControllers": []interface{}{map[string]interface{}{
+			"MemberId":     "0",
+			"Name":         "PRAID EP680i",
+			"SerialNumber": MOCK_REDFISH_STORAGE_SERIAL,
+		}},
+		"Drives":  drives,
+		"Volumes": mockRedfishLink("/redfish/v1/Systems/0/Storage/0/Volumes"),
+		"Oem":     map[string]interface{}{TS_FUJITSU: map[string]interface{}{}},
+	})
+	m.collection("/redfish/v1/Systems/0/Storage/0/Volumes")
+	m.set("/redfish/v1/Systems/0/Storage/0"+STORAGE_RAIDCAPABILITIES_SUFFIX, map[string]interface{}{
+		"Id": "RAIDCapabilities",
+		"RAIDLevels": []interface{}{
+			map[string]interface{}{"RAIDType": "RAID0", "StripeSizes": []int{65536, 131072}, "MinimumDriveCount": 1, "MaximumDriveCount": 32},
+			map[string]interface{}{"RAIDType": "RAID1", "StripeSizes": []int{65536, 131072}, "MinimumDriveCount": 2, "MaximumDriveCount": 2},
+		},
+	})
+
+	m.collection("/redfish/v1/Chassis", "/redfish/v1/Chassis/0")
+	m.set("/redfish/v1/Chassis/0", map[string]interface{}{"ChassisType": "RackMount", "Drives": mockRedfishLink("/redfish/v1/Chassis/0/Drives")})
+	m.set("/redfish/v1/Chassis/0/Thermal", map[string]interface{}{
+		"Temperatures": []interface{}{
+			map[string]interface{}{"MemberId": "0", "Name": "Ambient", "UpperThresholdCritical": 37, "UpperThresholdFatal": 42,
+				"Oem": map[string]interface{}{TS_FUJITSU: map[string]interface{}{"ShutdownAction": "None"}}},
+			map[string]interface{}{"MemberId": "1", "Name": "CPU1", "UpperThresholdCritical": 90, "UpperThresholdFatal": 98,
+				"Oem": map[string]interface{}{TS_FUJITSU: map[string]interface{}{"ShutdownAction": "PowerOff"}}},
+		},
+		"Oem": map[string]interface{}{TS_FUJITSU: map[string]interface{}{FAN_CONTROL_OEM_OBJECT: map[string]interface{}{"Mode": "Normal"}}},
+	})
+	m.collection("/redfish/v1/Chassis/0/Drives", "/redfish/v1/Chassis/0/Drives/0", "/redfish/v1/Chassis/0/Drives/1")
+
+	// Manager and OEM iRMC configuration
+	m.collection("/redfish/v1/Managers", MANAGER_ENDPOINT)
+	m.set(MANAGER_ENDPOINT, map[string]interface{}{
+		"ManagerType":         "BMC",
+		"Model":               "iRMC S6",
+		"FirmwareVersion":     "3.20P",
+		"DateTime":            "2025-01-01T12:00:00+00:00",
+		"DateTimeLocalOffset": "+00:00",
+		"NetworkProtocol":     mockRedfishLink(MANAGER_ENDPOINT + "/NetworkProtocol"),
+		"Status":              map[string]interface{}{"State": "Enabled", "Health": "OK", "HealthRollup": "OK"},
+		"Actions": map[string]interface{}{
+			"#Manager.Reset": map[string]interface{}{"target": MANAGER_ENDPOINT + "/Actions/Manager.Reset"},
+		},
+		"Oem": map[string]interface{}{TS_FUJITSU: map[string]interface{}{
+			"iRMCConfiguration": mockRedfishLink(MANAGER_ENDPOINT + "/Oem/ts_fujitsu/iRMCConfiguration"),
+		}},
+	})
+	m.set(MANAGER_ENDPOINT+"/Oem/ts_fujitsu/iRMCConfiguration", map[string]interface{}{})
+	m.set(MANAGER_ENDPOINT+"/NetworkProtocol", map[string]interface{}{
+		"NTP": map[string]interface{}{"ProtocolEnabled": true, "NTPServers": []interface{}{"pool.ntp.org", ""}},
+	})
+
+	// Accounts, slot 2 is used by administrator
+	m.set("/redfish/v1/AccountService", map[string]interface{}{
+		"Accounts":                        mockRedfishLink(USER_ACCOUNT_ENDPOINT),
+		"AccountLockoutThreshold":         0,
+		"MinPasswordLength":               minPasswordLength,
+		"MaxPasswordLength":               maxPasswordLength,
+		"AccountLockoutDuration":          0,
+		"AccountLockoutCounterResetAfter": 0,
+	})
+	m.collection(USER_ACCOUNT_ENDPOINT, USER_ACCOUNT_ENDPOINT+"/2")
+	m.set(USER_ACCOUNT_ENDPOINT+"/2", map[string]interface{}{
+		"UserName": MOCK_REDFISH_USERNAME,
+		"RoleId":   USER_TYPE_ADMIN,
+		"Enabled":  true,
+	})
+
+	m.set("/redfish/v1/TaskService", map[string]interface{}{"Tasks": mockRedfishLink(MOCK_REDFISH_TASKS_ENDPOINT)})
+	m.collection(MOCK_REDFISH_TASKS_ENDPOINT)
+	m.set("/redfish/v1/UpdateService", map[string]interface{}{"FirmwareInventory": mockRedfishLink("/redfish/v1/UpdateService/FirmwareInventory")})
+	m.collection("/redfish/v1/UpdateService/FirmwareInventory")
+}
+
+// mockRedfishPath normalizes path, so it can be used as key of resource.
+func mockRedfishPath(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path
+}
+
+func mockRedfishCopy(value map[string]interface{}) map[string]interface{} {
+	data, _ := json.Marshal(value)
+	var out map[string]interface{}
+	_ = json.Unmarshal(data, &out)
+	return out
+}
+
+// mockRedfishMerge merges patch into target the same way as Redfish PATCH does.
+// Arrays of objects are patched element by element, empty object leaves element unchanged.
+func mockRedfishMerge(target map[string]interface{}, patch map[string]interface{}) {
+	for key, value := range patch {
+		if nested, ok := value.(map[string]interface{}); ok {
+			if current, ok := target[key].(map[string]interface{}); ok {
+				mockRedfishMerge(current, nested)
+				continue
+			}
+		}
+		if nested, ok := value.([]interface{}); ok {
+			if current, ok := target[key].([]interface{}); ok && mockRedfishMergeArray(current, nested) {
+				continue
+			}
+		}
+		target[key] = value
+	}
+}
+
+// mockRedfishMergeArray patches elements of target array of objects, if patch has the same length.
+func mockRedfishMergeArray(target []interface{}, patch []interface{}) bool {
+	if len(target) != len(patch) {
+		return false
+	}
+
+	for i := range patch {
+		if _, ok := target[i].(map[string]interface{}); !ok {
+			return false
+		}
+		if _, ok := patch[i].(map[string]interface{}); !ok {
+			return false
+		}
+	}
+
+	for i := range patch {
+		mockRedfishMerge(target[i].(map[string]interface{}), patch[i].(map[string]interface{}))
+	}
+
+	return true
+}
+
+func (m *mockRedfishServer) writeJSON(w http.ResponseWriter, status int, path string, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if len(path) > 0 {
+		w.Header().Set(HTTP_HEADER_ETAG, m.etag(path))
+	}
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (m *mockRedfishServer) writeError(w http.ResponseWriter, status int, messageID string, message string) {
+	m.writeJSON(w, status, "", map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    "Base.1.12.GeneralError",
+			"message": message,
+			"@Message.ExtendedInfo": []interface{}{map[string]interface{}{
+				"MessageId": "Base.1.12." + messageID,
+				"Message":   message,
+				"Severity":  "Critical",
+			}},
+		},
+	})
+}
+
+func (m *mockRedfishServer) authorized(r *http.Request) bool {
+	if username, password, ok := r.BasicAuth(); ok {
+		return username == MOCK_REDFISH_USERNAME && password == MOCK_REDFISH_PASSWORD
+	}
+	_, ok := m.sessions[r.Header.Get(HTTP_HEADER_AUTH_TOKEN)]
+	return ok
+}
+
+func (m *mockRedfishServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	path := mockRedfishPath(r.URL.Path)
+
+	var payload map[string]interface{}
+	if r.Body != nil && (r.Method == http.MethodPost || r.Method == http.MethodPatch) {
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+	}
+
+	switch {
+	case r.Method == http.MethodPost && path == SESSIONS_ENDPOINT:
+		m.createSession(w, payload)
+		return
+	case r.Method == http.MethodGet && (path == "/redfish/v1" || path == "/redfish"):
+	case !m.authorized(r):
+		m.writeError(w, http.StatusUnauthorized, "NoValidSession", "There is no valid session established with the implementation.")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		res, ok := m.resources[path]
+		if !ok {
+			m.writeError(w, http.StatusNotFound, "ResourceMissingAtURI", fmt.Sprintf("The resource at the URI %s was not found.", path))
+			return
+		}
+		m.writeJSON(w, http.StatusOK, path, res)
+
+	case http.MethodPatch:
+		res, ok := m.resources[path]
+		if !ok {
+			m.writeError(w, http.StatusNotFound, "ResourceMissingAtURI", fmt.Sprintf("The resource at the URI %s was not found.", path))
+			return
+		}
+		if match := r.Header.Get(HTTP_HEADER_IF_MATCH); len(match) > 0 && match != "*" && match != m.etag(path) {
+			m.writeError(w, http.StatusPreconditionFailed, "PreconditionFailed", "The ETag supplied did not match the ETag required to change this resource.")
+			return
+		}
+		mockRedfishMerge(res, payload)
+		m.etags[path]++
+		m.writeJSON(w, http.StatusOK, path, res)
+
+	case http.MethodPost:
+		m.post(w, path, payload)
+
+	case http.MethodDelete:
+		m.delete(w, path)
+
+	default:
+		m.writeError(w, http.StatusMethodNotAllowed, "OperationNotAllowed", "The HTTP method is not allowed on this resource.")
+	}
+}
+
+func (m *mockRedfishServer) createSession(w http.ResponseWriter, payload map[string]interface{}) {
+	if payload["UserName"] != MOCK_REDFISH_USERNAME || payload["Password"] != MOCK_REDFISH_PASSWORD {
+		m.writeError(w, http.StatusUnauthorized, "InsufficientPrivilege", "Login failed.")
+		return
+	}
+
+	m.nextID++
+	token := fmt.Sprintf("mock-token-%d", m.nextID)
+	path := fmt.Sprintf("%s/%d", SESSIONS_ENDPOINT, m.nextID)
+	m.sessions[token] = path
+	m.set(path, map[string]interface{}{"UserName": MOCK_REDFISH_USERNAME})
+	m.addMember(SESSIONS_ENDPOINT, path)
+
+	w.Header().Set(HTTP_HEADER_AUTH_TOKEN, token)
+	w.Header().Set(HTTP_HEADER_LOCATION, path)
+	m.writeJSON(w, http.StatusCreated, path, m.resources[path])
+}
+
+func (m *mockRedfishServer) post(w http.ResponseWriter, path string, payload map[string]interface{}) {
+	if strings.Contains(path, "/Actions/") {
+		m.Actions = append(m.Actions, mockRedfishAction{Path: path, Payload: payload})
+		if strings.HasSuffix(path, "ComputerSystem.Reset") {
+			m.resetSystem(path[:strings.Index(path, "/Actions/")], payload)
+		}
+		// updates are performed by iRMC asynchronously
+		if strings.HasSuffix(path, "UpdateService.SimpleUpdate") {
+			m.acceptedWithTask(w, "Simple Update", "")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	collection, ok := m.resources[path]
+	if _, isCollection := collection["Members"]; !ok || !isCollection {
+		m.writeError(w, http.StatusMethodNotAllowed, "OperationNotAllowed", "The HTTP method is not allowed on this resource.")
+		return
+	}
+
+	m.nextID++
+	member := fmt.Sprintf("%s/%d", path, m.nextID)
+	if path == USER_ACCOUNT_ENDPOINT {
+		member = m.freeAccountSlot()
+	}
+	if payload == nil {
+		payload = map[string]interface{}{}
+	}
+	delete(payload, "Password")
+	m.set(member, payload)
+	m.addMember(path, member)
+
+	// volumes are created by iRMC asynchronously
+	if strings.HasSuffix(path, "/Volumes") {
+		m.acceptedWithTask(w, "Volume creation", member)
+		return
+	}
+
+	w.Header().Set(HTTP_HEADER_LOCATION, member)
+	m.writeJSON(w, http.StatusCreated, member, m.resources[member])
+}
+
+func (m *mockRedfishServer) delete(w http.ResponseWriter, path string) {
+	if _, ok := m.resources[path]; !ok {
+		m.writeError(w, http.StatusNotFound, "ResourceMissingAtURI", fmt.Sprintf("The resource at the URI %s was not found.", path))
+		return
+	}
+
+	delete(m.resources, path)
+	parent := path[:strings.LastIndex(path, "/")]
+	m.removeMember(parent, path)
+
+	if strings.HasPrefix(path, SESSIONS_ENDPOINT+"/") {
+		for token, session := range m.sessions {
+			if session == path {
+				delete(m.sessions, token)
+			}
+		}
+	}
+
+	if strings.HasSuffix(parent, "/Volumes") {
+		m.acceptedWithTask(w, "Volume deletion", "")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// acceptedWithTask responds with 202 Accepted and location of task, which is already completed.
+// If created is not empty, task links it as created resource.
+func (m *mockRedfishServer) acceptedWithTask(w http.ResponseWriter, name string, created string) {
+	m.nextID++
+	task := fmt.Sprintf("%s/%d", MOCK_REDFISH_TASKS_ENDPOINT, m.nextID)
+	createdResources := []interface{}{}
+	if len(created) > 0 {
+		createdResources = append(createdResources, mockRedfishLink(created))
+	}
+	m.set(task, map[string]interface{}{
+		"Name":            name,
+		"TaskState":       "Completed",
+		"TaskStatus":      "OK",
+		"PercentComplete": 100,
+		"Messages":        []interface{}{},
+		"Links": map[string]interface{}{
+			"CreatedResources":             createdResources,
+			"CreatedResources@odata.count": len(createdResources),
+		},
+	})
+	m.set(task+"/Oem/ts_fujitsu/Logs", map[string]interface{}{"Messages": []interface{}{}})
+	m.addMember(MOCK_REDFISH_TASKS_ENDPOINT, task)
+
+	w.Header().Set(HTTP_HEADER_LOCATION, task)
+	m.writeJSON(w, http.StatusAccepted, task, m.resources[task])
+}
+
+func (m *mockRedfishServer) resetSystem(system string, payload map[string]interface{}) {
+	res, ok := m.resources[system]
+	if !ok {
+		return
+	}
+
+	switch payload["ResetType"] {
+	case "On", "ForceOn":
+		res["PowerState"] = "On"
+	case "ForceOff", "GracefulShutdown", "PushPowerButton":
+		res["PowerState"] = "Off"
+	}
+	m.etags[system]++
+}
+
+func (m *mockRedfishServer) freeAccountSlot() string {
+	for slot := minUserID; slot <= maxUserID; slot++ {
+		path := fmt.Sprintf("%s/%d", USER_ACCOUNT_ENDPOINT, slot)
+		if _, ok := m.resources[path]; !ok {
+			return path
+		}
+	}
+	m.nextID++
+	return fmt.Sprintf("%s/%d", USER_ACCOUNT_ENDPOINT, m.nextID)
+}
+
+func (m *mockRedfishServer) addMember(collection string, member string) {
+	res, ok := m.resources[collection]
+	if !ok {
+		return
+	}
+
+	members, _ := res["Members"].([]interface{})
+	members = append(members, mockRedfishLink(member))
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].(map[string]interface{})["@odata.id"].(string) < members[j].(map[string]interface{})["@odata.id"].(string)
+	})
+	res["Members"] = members
+	res["Members@odata.count"] = len(members)
+	m.etags[collection]++
+}
+
+func (m *mockRedfishServer) removeMember(collection string, member string) {
+	res, ok := m.resources[collection]
+	if !ok {
+		return
+	}
+
+	members, _ := res["Members"].([]interface{})
+	kept := []interface{}{}
+	for _, link := range members {
+		if link.(map[string]interface{})["@odata.id"] != member {
+			kept = append(kept, link)
+		}
+	}
+	res["Members"] = kept
+	res["Members@odata.count"] = len(kept)
+	m.etags[collection]++
+}
+
+func connectMockRedfishServer(t *testing.T, server *mockRedfishServer) *IrmcProvider {
+	t.Helper()
+	credentials := server.Credentials()
+	return &IrmcProvider{
+		Endpoint:    "https://" + credentials.Endpoint,
+		Username:    credentials.Username,
+		Password:    credentials.Password,
+		SslInsecure: credentials.Insecure,
+	}
+}
+
+func TestMockRedfishServerSystem(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	if isFsas, err := IsFsasCheck(context.Background(), api); err != nil || isFsas {
+		t.Errorf("Mock is expected to expose ts_fujitsu OEM, got Fsas %t (%v)", isFsas, err)
+	}
+
+	system, err := GetSystemResource(api.Service)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if string(system.PowerState) != "On" {
+		t.Errorf("Got power state '%s', expected 'On'", system.PowerState)
+	}
+
+	resp, err := api.Post(system.ODataID+"/Actions/ComputerSystem.Reset", map[string]interface{}{"ResetType": "ForceOff"})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	resp.Body.Close()
+
+	if state := server.Resource(system.ODataID)["PowerState"]; state != "Off" {
+		t.Errorf("Got power state '%v' after reset, expected 'Off'", state)
+	}
+}
+
+func TestMockRedfishServerPatchWithEtag(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	resp, err := PatchWithEtag(api.Service.GetClient(), USER_ACCOUNT_ENDPOINT+"/2", map[string]interface{}{"Enabled": false})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	resp.Body.Close()
+
+	if enabled := server.Resource(USER_ACCOUNT_ENDPOINT + "/2")["Enabled"]; enabled != false {
+		t.Errorf("Got Enabled '%v', expected false", enabled)
+	}
+
+	// stale etag must be rejected
+	req, _ := http.NewRequest(http.MethodPatch, server.URL+USER_ACCOUNT_ENDPOINT+"/2", strings.NewReader(`{"Enabled": true}`))
+	req.SetBasicAuth(MOCK_REDFISH_USERNAME, MOCK_REDFISH_PASSWORD)
+	req.Header.Set(HTTP_HEADER_IF_MATCH, `W/"1"`)
+	resp, err = server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("Got status %d, expected %d", resp.StatusCode, http.StatusPreconditionFailed)
+	}
+}
+
+func TestMockRedfishServerVolumeTask(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	storage, err := getSystemStorageFromSerialNumber(api.Service, MOCK_REDFISH_STORAGE_SERIAL)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	resp, err := api.Post(storage.ODataID+"/Volumes", map[string]interface{}{"Name": "mock", "RAIDType": "RAID1"})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("Got status %d, expected %d", resp.StatusCode, http.StatusAccepted)
+	}
+
+	ok, err := WaitForRedfishTaskEnd(context.Background(), api.Service, resp.Header.Get(HTTP_HEADER_LOCATION), 30)
+	if !ok || err != nil {
+		t.Fatalf("Task did not finish successfully: %v", err)
+	}
+
+	volumes, err := storage.Volumes()
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if len(volumes) != 1 || volumes[0].Name != "mock" {
+		t.Fatalf("Got %d volumes, expected single volume 'mock'", len(volumes))
+	}
+
+	created, err := GetRedfishTaskCreatedResources(api.Service, resp.Header.Get(HTTP_HEADER_LOCATION))
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if len(created) != 1 || created[0] != volumes[0].ODataID {
+		t.Errorf("Got created resources %v, expected %s", created, volumes[0].ODataID)
+	}
+}
+
+func TestMockRedfishServerUnauthorized(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	pconfig := connectMockRedfishServer(t, server)
+	pconfig.Password = "wrong"
+
+	if _, err := ConnectTargetSystem(pconfig, &[]models.RedfishServer{}); err == nil {
+		t.Errorf("Expected error when connecting with wrong password")
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+)
+
+// getIrmcConfigurationEndpoint returns endpoint of OEM iRMC configuration object with given name.
+func getIrmcConfigurationEndpoint(isFsas bool, name string) string {
+	if isFsas {
+		return fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/%s", FSAS, name)
+	}
+
+	return fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/%s", TS_FUJITSU, name)
+}
+
+// applySettings patches endpoint with payload (if not empty), reads endpoint back and checks
+// that all values from payload have been applied. Returns read object.
+//
+// Resources managing OEM iRMC configuration objects send only values, which are defined in plan
+// and differ from state, and take all remaining values from returned object. These objects always
+// exist on iRMC, so destroying such resource only removes it from state.
+func applySettings(api *gofish.APIClient, endpoint string, payload map[string]interface{}) (map[string]interface{}, error) {
+	if len(payload) > 0 {
+		if err := patchEndpointWithEtag(api, endpoint, payload); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// iRMC might ignore or adjust requested value, what would be reported as inconsistent result by Terraform
+	if err = verifyAppliedSettings("", payload, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// verifyAppliedSettings checks that all values from payload (including nested objects) are reported in data.
+func verifyAppliedSettings(prefix string, payload map[string]interface{}, data map[string]interface{}) error {
+	for key, requested := range payload {
+		if nested, ok := requested.(map[string]interface{}); ok {
+			current, _ := data[key].(map[string]interface{})
+			if err := verifyAppliedSettings(prefix+key+".", nested, current); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if fmt.Sprint(requested) != fmt.Sprint(normalizeJsonValue(data[key])) {
+			return fmt.Errorf("requested value of %s%s is '%v', but iRMC reports '%v'", prefix, key, requested, data[key])
+		}
+	}
+
+	return nil
+}
+
+// normalizeJsonValue converts integral JSON numbers to int64, so they can be compared with payload values.
+func normalizeJsonValue(value interface{}) interface{} {
+	if val, ok := value.(float64); ok && val == float64(int64(val)) {
+		return int64(val)
+	}
+
+	return value
+}
+
+// patchEndpointWithEtag patches endpoint using ETag obtained by GET request on the same endpoint.
+func patchEndpointWithEtag(api *gofish.APIClient, endpoint string, payload map[string]interface{}) error {
+	resp, err := PatchWithEtag(api, endpoint, payload)
+	if err != nil {
+		return err
+	}
+
+	defer CloseResource(resp.Body)
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("PATCH on %s finished with status code %d", endpoint, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// getJsonObject returns body of response to GET request on endpoint decoded as JSON object.
+func getJsonObject(api *gofish.APIClient, endpoint string) (map[string]interface{}, error) {
+	resp, err := api.Get(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("GET on %s finished with error '%w'", endpoint, err)
+	}
+
+	defer CloseResource(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET on %s finished with status code %d", endpoint, resp.StatusCode)
+	}
+
+	var data map[string]interface{}
+	if err = json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("error during decoding of %s GET response '%w'", endpoint, err)
+	}
+
+	return data, nil
+}
+
+// addInt64ToPayload adds value to payload under key, if value is known and differs from current one.
+func addInt64ToPayload(payload map[string]interface{}, key string, value types.Int64, current types.Int64) {
+	if value.IsNull() || value.IsUnknown() || value.Equal(current) {
+		return
+	}
+
+	payload[key] = value.ValueInt64()
+}
+
+// addBoolToPayload adds value to payload under key, if value is known and differs from current one.
+func addBoolToPayload(payload map[string]interface{}, key string, value types.Bool, current types.Bool) {
+	if value.IsNull() || value.IsUnknown() || value.Equal(current) {
+		return
+	}
+
+	payload[key] = value.ValueBool()
+}
+
+// addStringToPayload adds value to payload under key, if value is known and differs from current one.
+func addStringToPayload(payload map[string]interface{}, key string, value types.String, current types.String) {
+	if value.IsNull() || value.IsUnknown() || value.Equal(current) {
+		return
+	}
+
+	payload[key] = value.ValueString()
+}
+
+// addObjectToPayload adds nested object to payload under key, if it is not empty.
+func addObjectToPayload(payload map[string]interface{}, key string, object map[string]interface{}) {
+	if len(object) == 0 {
+		return
+	}
+
+	payload[key] = object
+}
+
+// jsonObjectValue returns nested object of key from decoded JSON object or empty object if it is not present.
+func jsonObjectValue(data map[string]interface{}, key string) map[string]interface{} {
+	if val, ok := data[key].(map[string]interface{}); ok {
+		return val
+	}
+
+	return map[string]interface{}{}
+}
+
+// jsonInt64Value returns numeric value of key from decoded JSON object or null if it is not present.
+func jsonInt64Value(data map[string]interface{}, key string) types.Int64 {
+	if val, ok := data[key].(float64); ok {
+		return types.Int64Value(int64(val))
+	}
+
+	return types.Int64Null()
+}
+
+// jsonBoolValue returns boolean value of key from decoded JSON object or null if it is not present.
+func jsonBoolValue(data map[string]interface{}, key string) types.Bool {
+	if val, ok := data[key].(bool); ok {
+		return types.BoolValue(val)
+	}
+
+	return types.BoolNull()
+}
+
+// jsonStringValue returns string value of key from decoded JSON object or null if it is not present.
+func jsonStringValue(data map[string]interface{}, key string) types.String {
+	if val, ok := data[key].(string); ok {
+		return types.StringValue(val)
+	}
+
+	return types.StringNull()
+}
+
+// addStringListToPayload adds value to payload under key, if value is known and differs from current one.
+func addStringListToPayload(ctx context.Context, payload map[string]interface{}, key string, value types.List, current types.List) diag.Diagnostics {
+	if value.IsNull() || value.IsUnknown() || value.Equal(current) {
+		return nil
+	}
+
+	items := []string{}
+	diags := value.ElementsAs(ctx, &items, false)
+	if !diags.HasError() {
+		payload[key] = items
+	}
+
+	return diags
+}
+
+// jsonStringListAttrValue returns list attribute of strings of key from decoded JSON object or null if it is not present.
+func jsonStringListAttrValue(data map[string]interface{}, key string) types.List {
+	values, ok := data[key].([]interface{})
+	if !ok {
+		return types.ListNull(types.StringType)
+	}
+
+	items := []attr.Value{}
+	for _, value := range values {
+		if item, ok := value.(string); ok {
+			items = append(items, types.StringValue(item))
+		}
+	}
+
+	return types.ListValueMust(types.StringType, items)
+}
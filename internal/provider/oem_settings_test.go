@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAddToPayload(t *testing.T) {
+	payload := map[string]interface{}{}
+	addInt64ToPayload(payload, "Changed", types.Int64Value(10), types.Int64Value(5))
+	addInt64ToPayload(payload, "Unchanged", types.Int64Value(5), types.Int64Value(5))
+	addInt64ToPayload(payload, "Unknown", types.Int64Unknown(), types.Int64Null())
+	addInt64ToPayload(payload, "Null", types.Int64Null(), types.Int64Value(5))
+	addBoolToPayload(payload, "Enabled", types.BoolValue(false), types.BoolNull())
+	addStringToPayload(payload, "Mode", types.StringValue("Automatic"), types.StringValue("Manual"))
+
+	if len(payload) != 3 || payload["Changed"] != int64(10) || payload["Enabled"] != false || payload["Mode"] != "Automatic" {
+		t.Errorf("unexpected payload %v", payload)
+	}
+}
+
+func TestVerifyAppliedSettings(t *testing.T) {
+	data := map[string]interface{}{
+		"RemoteMountEnabled": true,
+		"CDImage": map[string]interface{}{
+			"MaximumNumberOfDevices": float64(2),
+			"NumberOfFreeDevices":    float64(1),
+		},
+	}
+
+	payload := map[string]interface{}{"RemoteMountEnabled": true}
+	addObjectToPayload(payload, "CDImage", map[string]interface{}{"MaximumNumberOfDevices": int64(2)})
+	addObjectToPayload(payload, "HDImage", map[string]interface{}{})
+	if _, ok := payload["HDImage"]; ok {
+		t.Errorf("empty object must not be added to payload")
+	}
+
+	if err := verifyAppliedSettings("", payload, data); err != nil {
+		t.Errorf("unexpected error %s", err.Error())
+	}
+
+	payload["CDImage"] = map[string]interface{}{"MaximumNumberOfDevices": int64(4)}
+	err := verifyAppliedSettings("", payload, data)
+	if err == nil || !strings.Contains(err.Error(), "CDImage.MaximumNumberOfDevices") {
+		t.Errorf("expected error about CDImage.MaximumNumberOfDevices, got %v", err)
+	}
+}
+
+func TestJsonValues(t *testing.T) {
+	data := map[string]interface{}{
+		"Port":    float64(5900),
+		"Enabled": true,
+		"Mode":    "Manual",
+	}
+
+	if jsonInt64Value(data, "Port").ValueInt64() != 5900 || !jsonInt64Value(data, "Missing").IsNull() {
+		t.Errorf("unexpected int64 value")
+	}
+
+	if !jsonBoolValue(data, "Enabled").ValueBool() || !jsonBoolValue(data, "Port").IsNull() {
+		t.Errorf("unexpected bool value")
+	}
+
+	if jsonStringValue(data, "Mode").ValueString() != "Manual" || !jsonStringValue(data, "Enabled").IsNull() {
+		t.Errorf("unexpected string value")
+	}
+
+	if normalizeJsonValue(float64(5900)) != int64(5900) || normalizeJsonValue(float64(1.5)) != float64(1.5) {
+		t.Errorf("unexpected normalized value")
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+)
+
+const (
+	ENV_IRMC_NEW_PASSWORD = "IRMC_NEW_PASSWORD"
+
+	// Endpoint requested after login to find out whether the session is restricted
+	// to password change only.
+	PASSWORD_CHANGE_PROBE_ENDPOINT = "/redfish/v1/Systems"
+	PASSWORD_CHANGE_MESSAGE_ID     = "PasswordChangeRequired"
+)
+
+// PasswordChangeRequiredError is returned when iRMC (newer firmware) accepts login,
+// but requires password of the account to be changed before any other operation.
+type PasswordChangeRequiredError struct {
+	Endpoint   string
+	Username   string
+	AccountURI string
+}
+
+func (e *PasswordChangeRequiredError) Error() string {
+	return fmt.Sprintf("iRMC '%s' requires password of user '%s' to be changed before first use. "+
+		"Log in to iRMC web interface and change the password, then update credentials in configuration, "+
+		"or define new_password in provider block, so the password is changed automatically", e.Endpoint, e.Username)
+}
+
+// changedPasswords keeps passwords changed by provider during the run, so following
+// connections with original credentials use the new password.
+var changedPasswords = struct {
+	lock      sync.Mutex
+	passwords map[string]string
+}{passwords: make(map[string]string)}
+
+func changedPasswordKey(endpoint string, username string) string {
+	return normalizeEndpoint(endpoint) + "|" + username
+}
+
+func lookupChangedPassword(endpoint string, username string) (string, bool) {
+	changedPasswords.lock.Lock()
+	defer changedPasswords.lock.Unlock()
+	password, ok := changedPasswords.passwords[changedPasswordKey(endpoint, username)]
+	return password, ok
+}
+
+func storeChangedPassword(endpoint string, username string, password string) {
+	changedPasswords.lock.Lock()
+	defer changedPasswords.lock.Unlock()
+	changedPasswords.passwords[changedPasswordKey(endpoint, username)] = password
+}
+
+// passwordChangeRequired checks whether session of api is restricted to password change.
+// Returned string is URI of account which password must be changed, if reported by iRMC.
+func passwordChangeRequired(api *gofish.APIClient) (string, bool) {
+	res, err := api.Get(PASSWORD_CHANGE_PROBE_ENDPOINT)
+	if err == nil {
+		CloseResource(res.Body)
+		return "", false
+	}
+
+	var redfishErr *common.Error
+	if !errors.As(err, &redfishErr) || redfishErr.HTTPReturnedStatusCode != http.StatusForbidden {
+		return "", false
+	}
+
+	for _, info := range redfishErr.ExtendedInfos {
+		if strings.HasSuffix(info.MessageID, "."+PASSWORD_CHANGE_MESSAGE_ID) || info.MessageID == PASSWORD_CHANGE_MESSAGE_ID {
+			if len(info.MessageArgs) > 0 {
+				return info.MessageArgs[0], true
+			}
+			return "", true
+		}
+	}
+
+	return "", false
+}
+
+// changeRequiredPassword logs in using config and changes password of account pointed
+// by accountURI to newPassword.
+func changeRequiredPassword(config gofish.ClientConfig, accountURI string, newPassword string) error {
+	if len(accountURI) == 0 {
+		return fmt.Errorf("iRMC did not report account which password must be changed")
+	}
+
+	api, err := gofish.Connect(config)
+	if err != nil {
+		return fmt.Errorf("error connecting to redfish API: %w", err)
+	}
+	defer api.Logout()
+
+	res, err := api.Patch(accountURI, map[string]string{"Password": newPassword})
+	if err != nil {
+		return fmt.Errorf("change of password on %s finished with error '%w'", accountURI, err)
+	}
+	CloseResource(res.Body)
+
+	return nil
+}
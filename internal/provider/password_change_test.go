@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stmcginnis/gofish"
+	"terraform-provider-irmc-redfish/internal/models"
+)
+
+const testAccountURI = "/redfish/v1/AccountService/Accounts/2"
+
+func newPasswordChangeServer(password *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && (r.URL.Path == "/redfish/v1/" || r.URL.Path == "/redfish/v1"):
+			w.Write([]byte(`{"Links": {"Sessions": {"@odata.id": "` + SESSIONS_ENDPOINT + `"}}}`))
+		case r.Method == http.MethodPost && r.URL.Path == SESSIONS_ENDPOINT:
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["Password"] != *password {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set(HTTP_HEADER_AUTH_TOKEN, "token-"+body["Password"])
+			w.Header().Set(HTTP_HEADER_LOCATION, SESSIONS_ENDPOINT+"/1")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case r.Header.Get(HTTP_HEADER_AUTH_TOKEN) == "token-initial" && r.Method == http.MethodPatch && r.URL.Path == testAccountURI:
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			*password = body["Password"]
+			w.WriteHeader(http.StatusNoContent)
+		case r.Header.Get(HTTP_HEADER_AUTH_TOKEN) == "token-initial":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": {"code": "Base.1.12.PasswordChangeRequired", "message": "Password change required",
+				"@Message.ExtendedInfo": [{"MessageId": "Base.1.12.PasswordChangeRequired", "MessageArgs": ["` + testAccountURI + `"]}]}}`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	}))
+}
+
+func TestSessionPoolPasswordChangeRequired(t *testing.T) {
+	password := "initial"
+	server := newPasswordChangeServer(&password)
+	defer server.Close()
+
+	pool := InitSessionPoolInstance(0)
+	_, err := pool.Acquire(gofish.ClientConfig{
+		Endpoint:   server.URL,
+		Username:   "admin",
+		Password:   "initial",
+		HTTPClient: &http.Client{},
+	}, false)
+
+	var changeErr *PasswordChangeRequiredError
+	if !errors.As(err, &changeErr) {
+		t.Fatalf("Got error '%v', expected password change required", err)
+	}
+
+	if changeErr.AccountURI != testAccountURI {
+		t.Errorf("Got account '%s', expected '%s'", changeErr.AccountURI, testAccountURI)
+	}
+}
+
+func TestConnectTargetSystemChangesRequiredPassword(t *testing.T) {
+	password := "initial"
+	server := newPasswordChangeServer(&password)
+	defer server.Close()
+
+	pconfig := &IrmcProvider{
+		Endpoint:    server.URL,
+		Username:    "admin",
+		Password:    "initial",
+		NewPassword: "changed",
+	}
+
+	api, err := ConnectTargetSystem(pconfig, &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	ReleaseTargetSystem(api)
+
+	if password != "changed" {
+		t.Errorf("Got password '%s', expected 'changed'", password)
+	}
+
+	// following connections with original credentials use changed password
+	api, err = ConnectTargetSystem(pconfig, &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	ReleaseTargetSystem(api)
+}
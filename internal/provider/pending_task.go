@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	PENDING_TASK_PRIVATE_KEY = "pending_task"
+
+	PENDING_TASK_FIRMWARE_UPDATE = "firmware_update"
+	PENDING_TASK_VOLUME_CREATION = "volume_creation"
+)
+
+// pendingTask describes task started by the provider, whose supervision has been interrupted
+// while the task keeps running on iRMC. It's kept in private state of the resource, so next
+// apply resumes monitoring of the task instead of issuing the operation once again.
+type pendingTask struct {
+	Location  string `json:"location"`
+	Operation string `json:"operation"`
+	StartedAt string `json:"started_at"`
+	// Resources existing before the task has been started, used to find resource
+	// created by the task if the task does not report it.
+	Resources []string `json:"resources,omitempty"`
+}
+
+type privateDataWriter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// newPendingTask returns pending task of operation monitored under location.
+func newPendingTask(operation, location string, now time.Time) pendingTask {
+	return pendingTask{
+		Location:  location,
+		Operation: operation,
+		StartedAt: now.UTC().Format(time.RFC3339),
+	}
+}
+
+// savePendingTask stores task in private state of the resource.
+func savePendingTask(ctx context.Context, private privateDataWriter, task pendingTask) (diags diag.Diagnostics) {
+	data, err := json.Marshal(task)
+	if err != nil {
+		diags.AddError("Pending task could not be stored", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, PENDING_TASK_PRIVATE_KEY, data)
+}
+
+// readPendingTask returns task stored in private state of the resource by savePendingTask.
+// If there is no such task, found is returned as false.
+func readPendingTask(ctx context.Context, private privateDataReader) (task pendingTask, found bool, diags diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, PENDING_TASK_PRIVATE_KEY)
+	if diags.HasError() || len(data) == 0 {
+		return task, false, diags
+	}
+
+	if err := json.Unmarshal(data, &task); err != nil {
+		diags.AddError("Pending task could not be read", err.Error())
+		return task, false, diags
+	}
+
+	return task, true, diags
+}
+
+// clearPendingTask removes task stored in private state of the resource.
+func clearPendingTask(ctx context.Context, private privateDataWriter) diag.Diagnostics {
+	return private.SetKey(ctx, PENDING_TASK_PRIVATE_KEY, nil)
+}
+
+// pendingTaskDiagnostics reports interrupted supervision of task as warning, so the resource
+// is stored in state without being tainted and next apply can resume monitoring of the task.
+func pendingTaskDiagnostics(summary string, task pendingTask) (diags diag.Diagnostics) {
+	diags.AddWarning(summary, fmt.Sprintf("Supervision of task %s has been interrupted, but the task keeps running on iRMC. "+
+		"Next apply resumes monitoring of the task instead of issuing the operation again.", task.Location))
+	return diags
+}
+
+// getPendingTaskState returns current state of pending task without waiting for its end.
+func getPendingTaskState(service *gofish.Service, task pendingTask) (redfish.TaskState, error) {
+	redfishTask, _, err := getRedfishTask(service.GetClient(), task.Location)
+	if err != nil {
+		return "", err
+	}
+
+	return redfishTask.TaskState, nil
+}
+
+// planPendingTaskResume marks id of the resource as unknown if resource has pending task,
+// so in-place update is planned and resumes monitoring of the task.
+func planPendingTaskResume(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resume during creation or destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	task, found, diags := readPendingTask(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.AddWarning("Pending task will be resumed",
+		fmt.Sprintf("Monitoring of %s task %s started at %s will be resumed.", task.Operation, task.Location, task.StartedAt))
+}
@@ -0,0 +1,199 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// mockPrivateData keeps private state of resource in memory.
+type mockPrivateData map[string][]byte
+
+func (m mockPrivateData) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return m[key], nil
+}
+
+func (m mockPrivateData) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(m, key)
+	} else {
+		m[key] = value
+	}
+	return nil
+}
+
+func TestPendingTaskPrivateState(t *testing.T) {
+	private := mockPrivateData{}
+	if _, found, diags := readPendingTask(context.Background(), private); found || diags.HasError() {
+		t.Fatalf("No pending task expected, got %t, %v", found, diags)
+	}
+
+	task := newPendingTask(PENDING_TASK_VOLUME_CREATION, MOCK_REDFISH_TASKS_ENDPOINT+"/7",
+		time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC))
+	task.Resources = []string{"/redfish/v1/Systems/0/Storage/0/Volumes/0"}
+	if diags := savePendingTask(context.Background(), private, task); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	stored, found, diags := readPendingTask(context.Background(), private)
+	if !found || diags.HasError() {
+		t.Fatalf("Pending task expected, got %t, %v", found, diags)
+	}
+	if stored.Location != task.Location || stored.Operation != task.Operation ||
+		stored.StartedAt != "2025-03-01T10:00:00Z" || len(stored.Resources) != 1 {
+		t.Errorf("Unexpected pending task %+v", stored)
+	}
+
+	if diags := clearPendingTask(context.Background(), private); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+	if _, found, _ := readPendingTask(context.Background(), private); found {
+		t.Errorf("Pending task should be removed")
+	}
+}
+
+func TestSuperviseVolumeCreationTaskInterrupted(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	location := MOCK_REDFISH_TASKS_ENDPOINT + "/1"
+	volume := "/redfish/v1/Systems/0/Storage/0/Volumes/5"
+	server.Set(location, map[string]interface{}{"Name": "Volume creation", "TaskState": "Running", "PercentComplete": 10})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	endpoint, interrupted, diags := superviseVolumeCreationTask(ctx, api.Service, location, false, 600)
+	if !interrupted || !diags.HasError() || len(endpoint) != 0 {
+		t.Fatalf("Expected interrupted supervision, got %t, %s, %v", interrupted, endpoint, diags)
+	}
+
+	// Resumed supervision of the same task reports volume created in the meantime
+	server.Set(location, map[string]interface{}{
+		"Name":            "Volume creation",
+		"TaskState":       "Completed",
+		"PercentComplete": 100,
+		"Links": map[string]interface{}{
+			"CreatedResources": []interface{}{mockRedfishLink(volume)},
+		},
+	})
+
+	endpoint, interrupted, diags = superviseVolumeCreationTask(context.Background(), api.Service, location, false, 30)
+	if interrupted || diags.HasError() {
+		t.Fatalf("Unexpected result %t, %v", interrupted, diags)
+	}
+	if endpoint != volume {
+		t.Errorf("Got endpoint %s, expected %s", endpoint, volume)
+	}
+
+	state, err := getPendingTaskState(api.Service, pendingTask{Location: location})
+	if err != nil || state != "Completed" {
+		t.Errorf("Unexpected task state %s, %v", state, err)
+	}
+}
+
+func TestPendingStorageVolumeState(t *testing.T) {
+	plan := models.StorageVolumeResourceModel{
+		Id:            types.StringUnknown(),
+		RaidType:      types.StringValue("RAID1"),
+		CapacityBytes: models.CapacityByteValue{Int64Value: types.Int64Unknown()},
+		VolumeName:    types.StringUnknown(),
+		InitPercent:   types.Int64Unknown(),
+		WaitForInit:   types.BoolValue(true),
+		ReadMode: &models.StorageVolumeDynamicParam{
+			Requested: types.StringValue("ReadAhead"),
+			Actual:    types.StringUnknown(),
+		},
+	}
+
+	state := pendingStorageVolumeState(plan)
+	if state.Id.ValueString() != "" || state.Id.IsUnknown() {
+		t.Errorf("Id should be empty, got %s", state.Id)
+	}
+	if !state.CapacityBytes.IsNull() || !state.VolumeName.IsNull() || !state.InitPercent.IsNull() || !state.ReadMode.Actual.IsNull() {
+		t.Errorf("Unknown values should be null in %+v", state)
+	}
+	if state.RaidType.ValueString() != "RAID1" || !state.WaitForInit.ValueBool() || state.ReadMode.Requested.ValueString() != "ReadAhead" {
+		t.Errorf("Known values should be kept in %+v", state)
+	}
+	if !plan.ReadMode.Actual.IsUnknown() {
+		t.Errorf("Plan should not be modified")
+	}
+}
+
+func TestDeletePendingStorageVolume(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	collection, err := getVolumesCollectionUrl(api.Service, MOCK_REDFISH_STORAGE_SERIAL)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	// Supervision of creation task has been interrupted, so state knows only the task
+	res, err := api.Post(collection, map[string]interface{}{"Name": "pending", "RAIDType": "RAID1"})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	CloseResource(res.Body)
+
+	task := newPendingTask(PENDING_TASK_VOLUME_CREATION, res.Header.Get(HTTP_HEADER_LOCATION), time.Now())
+	created, err := GetRedfishTaskCreatedResources(api.Service, task.Location)
+	if err != nil || len(created) != 1 {
+		t.Fatalf("Unexpected created resources %v, %v", created, err)
+	}
+
+	state := pendingStorageVolumeState(models.StorageVolumeResourceModel{
+		StorageControllerSN: types.StringValue(MOCK_REDFISH_STORAGE_SERIAL),
+		RaidType:            types.StringValue("RAID1"),
+		JobTimeout:          types.Int64Value(30),
+		WaitForInit:         types.BoolValue(true),
+	})
+
+	if diags := deletePendingStorageVolume(context.Background(), api, state, task); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+	if server.Resource(created[0]) != nil {
+		t.Errorf("Volume %s created by pending task should be deleted", created[0])
+	}
+
+	// Failed task has not created any volume
+	server.Set(task.Location, map[string]interface{}{"Name": "Volume creation", "TaskState": "Exception"})
+	if diags := deletePendingStorageVolume(context.Background(), api, state, task); diags.HasError() {
+		t.Errorf("Unexpected error %v", diags)
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	PROFILE_SECTION_BIOS = "BiosConfig"
+	PROFILE_SECTION_IRMC = "IrmcConfig"
+	PROFILE_SECTION_RAID = "RAIDAdapter"
+	PROFILE_JOB_TIMEOUT  = 600
+)
+
+// profileSectionPaths maps supported profile sections to their path in the profile document.
+var profileSectionPaths = map[string][]string{
+	PROFILE_SECTION_BIOS: {"Server", "SystemConfig", "BiosConfig"},
+	PROFILE_SECTION_IRMC: {"Server", "SystemConfig", "IrmcConfig"},
+	PROFILE_SECTION_RAID: {"Server", "HWConfigurationIrmc", "Adapters", "RAIDAdapter"},
+}
+
+type profileManagementEndpoints struct {
+	getActionEndpoint string
+	setActionEndpoint string
+	profilesEndpoint  string
+}
+
+func getProfileManagementEndpoints(isFsas bool) profileManagementEndpoints {
+	if isFsas {
+		return profileManagementEndpoints{
+			getActionEndpoint: "/redfish/v1/Managers/iRMC/Actions/Oem/FsasManager.ProfileManagementGet",
+			setActionEndpoint: "/redfish/v1/Managers/iRMC/Actions/Oem/FsasManager.ProfileManagementSet",
+			profilesEndpoint:  fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/ProfileManagement/Profiles", FSAS),
+		}
+	}
+
+	return profileManagementEndpoints{
+		getActionEndpoint: "/redfish/v1/Managers/iRMC/Actions/Oem/FTSManager.ProfileManagementGet",
+		setActionEndpoint: "/redfish/v1/Managers/iRMC/Actions/Oem/FTSManager.ProfileManagementSet",
+		profilesEndpoint:  fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/ProfileManagement/Profiles", TS_FUJITSU),
+	}
+}
+
+// profileSectionNames returns sorted list of supported profile sections.
+func profileSectionNames() []string {
+	names := make([]string, 0, len(profileSectionPaths))
+	for name := range profileSectionPaths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// profileChecksum returns hex encoded SHA256 checksum of profile file content.
+func profileChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// postProfileAction triggers profile management action with payload and returns location of created task.
+func postProfileAction(api *gofish.APIClient, actionEndpoint string, payload interface{}) (string, error) {
+	res, err := api.Post(actionEndpoint, payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	defer CloseResource(res.Body)
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusAccepted {
+		return "", fmt.Errorf("POST on %s finished with status code: %d", actionEndpoint, res.StatusCode)
+	}
+
+	taskLocation := res.Header.Get(HTTP_HEADER_LOCATION)
+	if taskLocation == "" {
+		return "", fmt.Errorf("task Location Missing. Location header not found in response")
+	}
+
+	return taskLocation, nil
+}
+
+// exportProfileSection requests iRMC to create profile of given section, downloads it and removes
+// it from iRMC afterwards.
+func exportProfileSection(ctx context.Context, api *gofish.APIClient, section string, timeout int64, isFsas bool) (map[string]interface{}, error) {
+	endpoints := getProfileManagementEndpoints(isFsas)
+	payload := map[string]interface{}{
+		"ProfilePath": strings.Join(profileSectionPaths[section], "/"),
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Requesting profile of section %s", section))
+	location, err := postProfileAction(api, endpoints.getActionEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkElcmTaskStatus(ctx, api.Service, location, timeout, isFsas); err != nil {
+		return nil, err
+	}
+
+	profileEndpoint := fmt.Sprintf("%s/%s", endpoints.profilesEndpoint, section)
+	profile, err := getJsonObject(api, profileEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Profile is kept on iRMC until it is deleted, failure of cleanup does not invalidate the backup
+	res, err := api.Delete(profileEndpoint)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not delete profile %s from iRMC: %s", profileEndpoint, err.Error()))
+	} else {
+		CloseResource(res.Body)
+	}
+
+	return profile, nil
+}
+
+// importProfile sends profile to iRMC to be applied and waits until applying task finishes.
+func importProfile(ctx context.Context, api *gofish.APIClient, profile map[string]interface{}, timeout int64, isFsas bool) error {
+	endpoints := getProfileManagementEndpoints(isFsas)
+	location, err := postProfileAction(api, endpoints.setActionEndpoint, profile)
+	if err != nil {
+		return err
+	}
+
+	return checkElcmTaskStatus(ctx, api.Service, location, timeout, isFsas)
+}
+
+// getProfileSubtree returns value placed in profile under given path.
+func getProfileSubtree(profile map[string]interface{}, path []string) (interface{}, bool) {
+	var value interface{} = profile
+	for _, key := range path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = object[key]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// setProfileSubtree places value in profile under given path, creating intermediate objects if needed.
+func setProfileSubtree(profile map[string]interface{}, path []string, value interface{}) {
+	object := profile
+	for _, key := range path[:len(path)-1] {
+		next, ok := object[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			object[key] = next
+		}
+		object = next
+	}
+
+	object[path[len(path)-1]] = value
+}
+
+// mergeProfileSection copies section of src profile into dst profile.
+func mergeProfileSection(dst map[string]interface{}, src map[string]interface{}, section string) error {
+	path := profileSectionPaths[section]
+	value, ok := getProfileSubtree(src, path)
+	if !ok {
+		return fmt.Errorf("profile does not contain section %s (%s)", section, strings.Join(path, "/"))
+	}
+
+	setProfileSubtree(dst, path, value)
+	return nil
+}
+
+// profileSectionsPresent returns sorted list of supported sections found in profile.
+func profileSectionsPresent(profile map[string]interface{}) []string {
+	var sections []string
+	for _, section := range profileSectionNames() {
+		if _, ok := getProfileSubtree(profile, profileSectionPaths[section]); ok {
+			sections = append(sections, section)
+		}
+	}
+
+	return sections
+}
+
+// filterProfileSections returns profile which contains only requested sections of given profile.
+func filterProfileSections(profile map[string]interface{}, sections []string) (map[string]interface{}, error) {
+	filtered := map[string]interface{}{}
+	for _, section := range sections {
+		if err := mergeProfileSection(filtered, profile, section); err != nil {
+			return nil, err
+		}
+	}
+
+	return filtered, nil
+}
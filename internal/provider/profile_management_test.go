@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+const testProfile = `{
+	"Server": {
+		"SystemConfig": {
+			"BiosConfig": {"BiosVersion": "V1.0.0.0 R1.2.0", "CpuConfig": {"HyperThreadingEnabled": true}},
+			"IrmcConfig": {"NetworkServices": {"WebServerHttpsPort": 443}}
+		},
+		"@Version": "1.01"
+	}
+}`
+
+func TestPrepareProfileToRestore(t *testing.T) {
+	profile, sections, err := prepareProfileToRestore([]byte(testProfile), nil)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	if !slices.Equal(sections, []string{PROFILE_SECTION_BIOS, PROFILE_SECTION_IRMC}) {
+		t.Errorf("unexpected sections %v", sections)
+	}
+
+	if _, ok := getProfileSubtree(profile, []string{"Server", "@Version"}); ok {
+		t.Errorf("unsupported section should not be restored")
+	}
+
+	profile, _, err = prepareProfileToRestore([]byte(testProfile), []string{PROFILE_SECTION_IRMC})
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	if _, ok := getProfileSubtree(profile, profileSectionPaths[PROFILE_SECTION_BIOS]); ok {
+		t.Errorf("BIOS section should not be restored")
+	}
+
+	port, ok := getProfileSubtree(profile, []string{"Server", "SystemConfig", "IrmcConfig", "NetworkServices", "WebServerHttpsPort"})
+	if !ok || port != float64(443) {
+		t.Errorf("iRMC section has not been restored correctly: %v", port)
+	}
+
+	if _, _, err = prepareProfileToRestore([]byte(testProfile), []string{PROFILE_SECTION_RAID}); err == nil {
+		t.Errorf("expected error for section missing in profile")
+	}
+
+	if _, _, err = prepareProfileToRestore([]byte(`{"Server": {}}`), nil); err == nil {
+		t.Errorf("expected error for profile without supported sections")
+	}
+
+	if _, _, err = prepareProfileToRestore([]byte(`not a profile`), nil); err == nil {
+		t.Errorf("expected error for invalid JSON")
+	}
+}
@@ -19,19 +19,33 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure IrmcProvider satisfies various provider interfaces.
 var _ provider.Provider = &IrmcProvider{}
+var _ provider.ProviderWithEphemeralResources = &IrmcProvider{}
 
 var mutexPool = InitSyncPoolInstance()
+var sessionPool = InitSessionPoolInstance(SESSION_POOL_IDLE_TIMEOUT)
+var taskSupervisor = InitTaskSupervisorInstance(TASK_POLL_MAX_PER_ENDPOINT)
 
 // IrmcProvider defines the provider implementation.
 type IrmcProvider struct {
@@ -40,14 +54,73 @@ type IrmcProvider struct {
 	// testing.
 	version string
 
-	Username string
-	Password string
+	Endpoint      string
+	SslInsecure   bool
+	Username      string
+	Password      string
+	NewPassword   string
+	SessionToken  string
+	Credentials   map[string]serverCredentials
+	RetryCount    int64
+	RetryInterval int64
+
+	HttpTimeout         int64
+	TlsHandshakeTimeout int64
+	HttpKeepAlive       int64
+
+	// Proxy used for connections to iRMC instead of proxy defined by environment
+	Proxy   string
+	NoProxy string
+
+	// Maximum number of scoped operations running in parallel against one iRMC
+	MaxConcurrentOperations int64
+
+	// Limits of requests sent to one iRMC (0 means no limit)
+	MaxRequestsPerSecond  float64
+	MaxConcurrentRequests int64
+	RequestLimiter        *RequestLimiter
+
+	// Allowed difference between requested and actual volume capacity
+	CapacityTolerance models.CapacityTolerance
+
+	RootCAs            *x509.CertPool
+	ClientCertificates []tls.Certificate
+
+	// Cancellation of tasks left running by interrupted operations
+	CancelStaleTasks bool
+	StaleTaskAge     int64
+
+	// Local file recording every request sent to iRMC (nil if not configured)
+	AuditLog *auditLog
 }
 
 // IrmcProviderModel describes the provider data model.
 type IrmcProviderModel struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Endpoint        types.String  `tfsdk:"endpoint"`
+	SslInsecure     types.Bool    `tfsdk:"ssl_insecure"`
+	Username        types.String  `tfsdk:"username"`
+	Password        types.String  `tfsdk:"password"`
+	NewPassword     types.String  `tfsdk:"new_password"`
+	SessionToken    types.String  `tfsdk:"session_token"`
+	CredentialsFile types.String  `tfsdk:"credentials_file"`
+	RetryCount      types.Int64   `tfsdk:"retry_count"`
+	RetryInterval   types.Int64   `tfsdk:"retry_interval"`
+	HttpTimeout     types.Int64   `tfsdk:"http_timeout"`
+	TlsHandshake    types.Int64   `tfsdk:"tls_handshake_timeout"`
+	HttpKeepAlive   types.Int64   `tfsdk:"http_keep_alive"`
+	Proxy           types.String  `tfsdk:"proxy"`
+	NoProxy         types.String  `tfsdk:"no_proxy"`
+	MaxConcurrent   types.Int64   `tfsdk:"max_concurrent_operations"`
+	MaxRequestsRate types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxRequests     types.Int64   `tfsdk:"max_concurrent_requests"`
+	CapacityBytes   types.Int64   `tfsdk:"capacity_tolerance_bytes"`
+	CapacityPercent types.Float64 `tfsdk:"capacity_tolerance_percent"`
+	CaCertFile      types.String  `tfsdk:"ca_cert_file"`
+	ClientCertFile  types.String  `tfsdk:"client_cert_file"`
+	ClientKeyFile   types.String  `tfsdk:"client_key_file"`
+	CancelStale     types.Bool    `tfsdk:"cancel_stale_tasks"`
+	StaleTaskAge    types.Int64   `tfsdk:"stale_task_age"`
+	AuditLogFile    types.String  `tfsdk:"audit_log_file"`
 }
 
 func (p *IrmcProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -61,15 +134,178 @@ func (p *IrmcProvider) Metadata(ctx context.Context, req provider.MetadataReques
 func (p *IrmcProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Default server BMC IP address or hostname used by resources and data sources without server block. Can be also defined by IRMC_ENDPOINT environment variable",
+				Description:         "Default server BMC IP address or hostname used by resources and data sources without server block. Can be also defined by IRMC_ENDPOINT environment variable",
+				Optional:            true,
+			},
+			"ssl_insecure": schema.BoolAttribute{
+				MarkdownDescription: "Default value indicating whether the SSL/TLS certificate must be verified or not, used if not defined in server block",
+				Description:         "Default value indicating whether the SSL/TLS certificate must be verified or not, used if not defined in server block",
+				Optional:            true,
+			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "Username accessing Redfish API",
-				Description:         "Username accessing Redfish API",
+				MarkdownDescription: "Username accessing Redfish API. Can be also defined by IRMC_USER environment variable",
+				Description:         "Username accessing Redfish API. Can be also defined by IRMC_USER environment variable",
 				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "Password related to given user name accessing Redfish API",
-				Description:         "Password related to given user name accessing Redfish API",
+				MarkdownDescription: "Password related to given user name accessing Redfish API. Can be also defined by IRMC_PASSWORD environment variable",
+				Description:         "Password related to given user name accessing Redfish API. Can be also defined by IRMC_PASSWORD environment variable",
+				Optional:            true,
+			},
+			"new_password": schema.StringAttribute{
+				MarkdownDescription: "New password set automatically, if iRMC requires password of the user to be changed at first login (newer iRMC firmware). Credentials in configuration must be updated afterwards. Can be also defined by IRMC_NEW_PASSWORD environment variable",
+				Description:         "New password set automatically, if iRMC requires password of the user to be changed at first login (newer iRMC firmware). Credentials in configuration must be updated afterwards. Can be also defined by IRMC_NEW_PASSWORD environment variable",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"session_token": schema.StringAttribute{
+				MarkdownDescription: "Pre-established Redfish session token (X-Auth-Token) used instead of username and password, e.g. obtained from external secrets broker",
+				Description:         "Pre-established Redfish session token (X-Auth-Token) used instead of username and password, e.g. obtained from external secrets broker",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"audit_log_file": schema.StringAttribute{
+				MarkdownDescription: "Path to local file, to which method, path and status of every Redfish request sent to iRMC are appended as JSON lines (e.g. as evidence for change management). Payloads and headers are not recorded. Can be also defined by IRMC_AUDIT_LOG_FILE environment variable",
+				Description:         "Path to local file, to which method, path and status of every Redfish request sent to iRMC are appended as JSON lines (e.g. as evidence for change management). Payloads and headers are not recorded. Can be also defined by IRMC_AUDIT_LOG_FILE environment variable",
+				Optional:            true,
+			},
+			"credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path to JSON or YAML file with map of server endpoints and their credentials (username, password), used if server block does not define credentials. Can be also defined by IRMC_CREDENTIALS_FILE environment variable",
+				Description:         "Path to JSON or YAML file with map of server endpoints and their credentials (username, password), used if server block does not define credentials. Can be also defined by IRMC_CREDENTIALS_FILE environment variable",
+				Optional:            true,
+			},
+			"retry_count": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of retries of idempotent requests (e.g. GET, task polling) failed due to transient errors (408, 429, 5xx, connection reset). Default is %d.", HTTP_RETRY_COUNT),
+				Description:         fmt.Sprintf("Number of retries of idempotent requests (e.g. GET, task polling) failed due to transient errors (408, 429, 5xx, connection reset). Default is %d.", HTTP_RETRY_COUNT),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"retry_interval": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Initial interval in seconds between retries, doubled with every attempt. Retry-After header returned by iRMC takes precedence. Default is %d.", HTTP_RETRY_INTERVAL),
+				Description:         fmt.Sprintf("Initial interval in seconds between retries, doubled with every attempt. Retry-After header returned by iRMC takes precedence. Default is %d.", HTTP_RETRY_INTERVAL),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+			"http_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds of single HTTP request to iRMC including transfer of request and response body (e.g. upload of firmware binary). Value 0 means no limit. Default is 0.",
+				Description:         "Timeout in seconds of single HTTP request to iRMC including transfer of request and response body (e.g. upload of firmware binary). Value 0 means no limit. Default is 0.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"tls_handshake_timeout": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Timeout in seconds of TLS handshake with iRMC. Default is %d.", HTTP_TLS_HANDSHAKE_TIMEOUT),
+				Description:         fmt.Sprintf("Timeout in seconds of TLS handshake with iRMC. Default is %d.", HTTP_TLS_HANDSHAKE_TIMEOUT),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 300),
+				},
+			},
+			"http_keep_alive": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Interval in seconds of TCP keep-alive probes sent on connections to iRMC. Value 0 disables keep-alive, so connections are not reused between requests. Default is %d.", HTTP_KEEP_ALIVE),
+				Description:         fmt.Sprintf("Interval in seconds of TCP keep-alive probes sent on connections to iRMC. Value 0 disables keep-alive, so connections are not reused between requests. Default is %d.", HTTP_KEEP_ALIVE),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 3600),
+				},
+			},
+			"proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of proxy (`http://`, `https://` or `socks5://`, optionally with user credentials) used for all connections to iRMC including file uploads, e.g. jump proxy to management network. If not defined, proxy is taken from HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables",
+				Description:         "URL of proxy (http://, https:// or socks5://, optionally with user credentials) used for all connections to iRMC including file uploads, e.g. jump proxy to management network. If not defined, proxy is taken from HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					validators.IsProxyURL(),
+				},
+			},
+			"no_proxy": schema.StringAttribute{
+				MarkdownDescription: "Comma separated list of iRMC hosts, domains (e.g. `.mgmt.example.com`) or CIDR ranges, which are accessed directly instead of using `proxy`",
+				Description:         "Comma separated list of iRMC hosts, domains (e.g. .mgmt.example.com) or CIDR ranges, which are accessed directly instead of using proxy",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("proxy")),
+				},
+			},
+			"max_concurrent_operations": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of operations running in parallel against one iRMC, which lock only part of the server (e.g. storage changes on different storage controllers). Operations affecting whole server are always serialized. Limit is shared by all provider configurations (aliases), so they must not define different values. Default is %d.", SYNC_POOL_MAX_CONCURRENT_OPERATIONS),
+				Description:         fmt.Sprintf("Maximum number of operations running in parallel against one iRMC, which lock only part of the server (e.g. storage changes on different storage controllers). Operations affecting whole server are always serialized. Limit is shared by all provider configurations (aliases), so they must not define different values. Default is %d.", SYNC_POOL_MAX_CONCURRENT_OPERATIONS),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of requests per second sent to one iRMC (including retries and task polling), e.g. to not trip DoS protection of iRMC during large applies. Default is no limit.",
+				Description:         "Maximum number of requests per second sent to one iRMC (including retries and task polling), e.g. to not trip DoS protection of iRMC during large applies. Default is no limit.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0.1, 100),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests sent in parallel to one iRMC (including retries and task polling). Default is no limit.",
+				Description:         "Maximum number of requests sent in parallel to one iRMC (including retries and task polling). Default is no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+			},
+			"capacity_tolerance_bytes": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Allowed difference in bytes between requested and actual capacity of storage volume, which is not treated as change (controllers round capacity differently). Default is %d.", models.CAPACITY_TOLERANCE_BYTES),
+				Description:         fmt.Sprintf("Allowed difference in bytes between requested and actual capacity of storage volume, which is not treated as change (controllers round capacity differently). Default is %d.", models.CAPACITY_TOLERANCE_BYTES),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"capacity_tolerance_percent": schema.Float64Attribute{
+				MarkdownDescription: "Allowed difference in percent of requested capacity between requested and actual capacity of storage volume. If both tolerances are defined, the bigger one is used. Default is 0.",
+				Description:         "Allowed difference in percent of requested capacity between requested and actual capacity of storage volume. If both tolerances are defined, the bigger one is used. Default is 0.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to PEM file with CA certificates used to verify iRMC certificate (in addition to system trust store), alternative to ssl_insecure=true for iRMCs with certificates issued by private CA",
+				Description:         "Path to PEM file with CA certificates used to verify iRMC certificate (in addition to system trust store), alternative to ssl_insecure=true for iRMCs with certificates issued by private CA",
+				Optional:            true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to PEM file with client certificate used for mutual TLS authentication to iRMC",
+				Description:         "Path to PEM file with client certificate used for mutual TLS authentication to iRMC",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to PEM file with private key of client certificate used for mutual TLS authentication to iRMC",
+				Description:         "Path to PEM file with private key of client certificate used for mutual TLS authentication to iRMC",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_file")),
+				},
+			},
+			"cancel_stale_tasks": schema.BoolAttribute{
+				MarkdownDescription: "If true, tasks whose supervision has been interrupted (pending firmware update or volume creation tasks kept in state of the resource) are cancelled on next refresh once they have run longer than `stale_task_age`, and the operation is repeated. Tasks started by other tools are never cancelled. Requires `stale_task_age`. Default is false.",
+				Description:         "If true, tasks whose supervision has been interrupted (pending firmware update or volume creation tasks kept in state of the resource) are cancelled on next refresh once they have run longer than stale_task_age, and the operation is repeated. Tasks started by other tools are never cancelled. Requires stale_task_age. Default is false.",
+				Optional:            true,
+			},
+			"stale_task_age": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Time in seconds after which unfinished task is considered stale. Tasks data source uses default %d if not defined, cancellation by `cancel_stale_tasks` requires explicit value.", STALE_TASK_AGE),
+				Description:         fmt.Sprintf("Time in seconds after which unfinished task is considered stale. Tasks data source uses default %d if not defined, cancellation by cancel_stale_tasks requires explicit value.", STALE_TASK_AGE),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
 			},
 		},
 	}
@@ -99,11 +335,120 @@ func (p *IrmcProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
-	p.Username = data.Username.ValueString()
-	p.Password = data.Password.ValueString()
+	p.Endpoint = valueOrEnv(data.Endpoint.ValueString(), ENV_IRMC_ENDPOINT)
+	p.SslInsecure = data.SslInsecure.ValueBool()
+	p.Username = valueOrEnv(data.Username.ValueString(), ENV_IRMC_USER)
+	p.Password = valueOrEnv(data.Password.ValueString(), ENV_IRMC_PASSWORD)
+	p.NewPassword = valueOrEnv(data.NewPassword.ValueString(), ENV_IRMC_NEW_PASSWORD)
+	p.SessionToken = data.SessionToken.ValueString()
+
+	p.RetryCount = HTTP_RETRY_COUNT
+	if !data.RetryCount.IsNull() && !data.RetryCount.IsUnknown() {
+		p.RetryCount = data.RetryCount.ValueInt64()
+	}
+
+	p.RetryInterval = HTTP_RETRY_INTERVAL
+	if !data.RetryInterval.IsNull() && !data.RetryInterval.IsUnknown() {
+		p.RetryInterval = data.RetryInterval.ValueInt64()
+	}
+
+	p.HttpTimeout = HTTP_TIMEOUT
+	if !data.HttpTimeout.IsNull() && !data.HttpTimeout.IsUnknown() {
+		p.HttpTimeout = data.HttpTimeout.ValueInt64()
+	}
+
+	p.TlsHandshakeTimeout = HTTP_TLS_HANDSHAKE_TIMEOUT
+	if !data.TlsHandshake.IsNull() && !data.TlsHandshake.IsUnknown() {
+		p.TlsHandshakeTimeout = data.TlsHandshake.ValueInt64()
+	}
+
+	p.HttpKeepAlive = HTTP_KEEP_ALIVE
+	if !data.HttpKeepAlive.IsNull() && !data.HttpKeepAlive.IsUnknown() {
+		p.HttpKeepAlive = data.HttpKeepAlive.ValueInt64()
+	}
+
+	p.Proxy = data.Proxy.ValueString()
+	p.NoProxy = data.NoProxy.ValueString()
+
+	// Locks are shared by all provider configurations, so only explicitly configured limit is applied
+	p.MaxConcurrentOperations = SYNC_POOL_MAX_CONCURRENT_OPERATIONS
+	if !data.MaxConcurrent.IsNull() && !data.MaxConcurrent.IsUnknown() {
+		p.MaxConcurrentOperations = data.MaxConcurrent.ValueInt64()
+		if err := mutexPool.SetConcurrencyLimit(int(p.MaxConcurrentOperations)); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("max_concurrent_operations"), "Conflicting concurrency limit", err.Error())
+			return
+		}
+	}
+
+	p.MaxRequestsPerSecond = data.MaxRequestsRate.ValueFloat64()
+	p.MaxConcurrentRequests = data.MaxRequests.ValueInt64()
+	p.RequestLimiter = InitRequestLimiterInstance(p.MaxRequestsPerSecond, int(p.MaxConcurrentRequests))
+
+	p.CapacityTolerance = models.DefaultCapacityTolerance
+	if !data.CapacityBytes.IsNull() && !data.CapacityBytes.IsUnknown() {
+		p.CapacityTolerance.Bytes = data.CapacityBytes.ValueInt64()
+	}
+
+	if !data.CapacityPercent.IsNull() && !data.CapacityPercent.IsUnknown() {
+		p.CapacityTolerance.Percent = data.CapacityPercent.ValueFloat64()
+	}
+
+	p.CancelStaleTasks = data.CancelStale.ValueBool()
+	if p.CancelStaleTasks && data.StaleTaskAge.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("stale_task_age"), "Missing stale task age",
+			"stale_task_age must be defined when cancel_stale_tasks is enabled, so tasks are never cancelled by default threshold.")
+		return
+	}
+
+	p.StaleTaskAge = STALE_TASK_AGE
+	if !data.StaleTaskAge.IsNull() && !data.StaleTaskAge.IsUnknown() {
+		p.StaleTaskAge = data.StaleTaskAge.ValueInt64()
+	}
+
+	auditLogFile := valueOrEnv(data.AuditLogFile.ValueString(), ENV_IRMC_AUDIT_LOG_FILE)
+	if len(auditLogFile) > 0 && (p.AuditLog == nil || p.AuditLog.path != auditLogFile) {
+		auditLog, err := openAuditLog(auditLogFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("audit_log_file"), "Unable to open audit log file", err.Error())
+			return
+		}
+		if p.AuditLog != nil {
+			_ = p.AuditLog.Close()
+		}
+		p.AuditLog = auditLog
+	}
+
+	credentialsFile := valueOrEnv(data.CredentialsFile.ValueString(), ENV_IRMC_CREDENTIALS_FILE)
+	if len(credentialsFile) > 0 {
+		credentials, err := loadCredentialsFile(credentialsFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("credentials_file"), "Invalid credentials file", err.Error())
+			return
+		}
+		p.Credentials = credentials
+	}
+
+	if len(data.CaCertFile.ValueString()) > 0 {
+		rootCAs, err := loadRootCAs(data.CaCertFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ca_cert_file"), "Invalid CA certificate bundle", err.Error())
+			return
+		}
+		p.RootCAs = rootCAs
+	}
+
+	if len(data.ClientCertFile.ValueString()) > 0 {
+		cert, err := loadClientCertificate(data.ClientCertFile.ValueString(), data.ClientKeyFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("client_cert_file"), "Invalid client certificate", err.Error())
+			return
+		}
+		p.ClientCertificates = []tls.Certificate{cert}
+	}
 
 	resp.ResourceData = p
 	resp.DataSourceData = p
+	resp.EphemeralResourceData = p
 
 	tflog.Trace(ctx, "Finished configuring the provider")
 
@@ -120,6 +465,9 @@ func (p *IrmcProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewVirtualMediaResource,
 		NewPowerResource,
 		NewIrmcRestartResource,
+		NewFactoryResetResource,
+		NewProfileBackupResource,
+		NewProfileRestoreResource,
 		NewBootSourceOverrideResource,
 		NewBootOrderResource,
 		NewBiosResource,
@@ -127,11 +475,34 @@ func (p *IrmcProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewSimpleUpdateResource,
 		NewStorageResource,
 		NewStorageVolumeResource,
+		NewDriveSecureEraseResource,
+		NewDriveLocateLedResource,
+		NewDriveModeResource,
+		NewDriveFirmwareUpdateResource,
 		NewIrmcFirmwareUpdateResource,
+		NewElcmUpdateResource,
+		NewAccountPolicyResource,
+		NewAvrSettingsResource,
+		NewConsoleRedirectionResource,
+		NewVmediaSettingsResource,
 		NewIrmcAttributesResource,
 		NewIrmcCertificateCaUpdDeployResource,
 		NewIrmcCertificateWebServerResource,
 		NewIrmcCertificateCaCasSmtpResource,
+		NewMetricReportDefinitionResource,
+		NewAisConnectResource,
+		NewElcmRepositoryResource,
+		NewIrmcWaitResource,
+		NewRedfishRawResource,
+		NewIrmcSecureEraseResource,
+		NewStorageLayoutResource,
+		NewTestAlertResource,
+		NewIrmcTimeResource,
+		NewFanPolicyResource,
+		NewThermalPolicyResource,
+		NewFrontPanelSecurityResource,
+		NewBootWatchdogResource,
+		NewIrmcDnsResource,
 	}
 }
 
@@ -139,17 +510,50 @@ func (p *IrmcProvider) DataSources(ctx context.Context) []func() datasource.Data
 	return []func() datasource.DataSource{
 		NewVirtualMediaDataSource,
 		NewBiosDataSource,
+		NewBiosPendingDataSource,
 		NewFirmwareInventoryDataSource,
 		NewStorageDataSource,
+		NewStorageControllersDataSource,
+		NewStorageVolumesDataSource,
 		NewSystemBootDataSource,
 		NewIrmcAttributesDataSource,
+		NewTelemetryServiceDataSource,
+		NewPostStateDataSource,
+		NewRedfishResourceDataSource,
+		NewManagerStatusDataSource,
+		NewDriveHealthDataSource,
+		NewRaidCapabilitiesDataSource,
+		NewIrmcTasksDataSource,
+		NewIrmcChassisDataSource,
+		NewIrmcHealthDataSource,
+		NewIrmcPcieDevicesDataSource,
+		NewIrmcGpusDataSource,
+	}
+}
+
+func (p *IrmcProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSessionEphemeralResource,
 	}
 }
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &IrmcProvider{
-			version: version,
+			version:                 version,
+			RetryCount:              HTTP_RETRY_COUNT,
+			RetryInterval:           HTTP_RETRY_INTERVAL,
+			HttpTimeout:             HTTP_TIMEOUT,
+			TlsHandshakeTimeout:     HTTP_TLS_HANDSHAKE_TIMEOUT,
+			HttpKeepAlive:           HTTP_KEEP_ALIVE,
+			MaxConcurrentOperations: SYNC_POOL_MAX_CONCURRENT_OPERATIONS,
 		}
 	}
 }
+
+// Shutdown logs out Redfish sessions and closes audit logs kept by the provider,
+// it is called when provider server stops.
+func Shutdown() {
+	sessionPool.Close()
+	closeAuditLogs()
+}
@@ -39,6 +39,9 @@ var (
 	creds TestingServerCredentials
 )
 
+// mockServer is started instead of real endpoint when TF_TESTING_MOCK is set.
+var mockServer *mockRedfishServer
+
 type TestingServerCredentials struct {
 	Username string
 	Password string
@@ -196,7 +199,7 @@ func testChangePowerHostState(creds TestingServerCredentials, poweredOn bool) {
 		log.Printf("Connect to %s reported error %s", clientConfig.Endpoint, err.Error())
 		return
 	}
-	if err = changePowerState(api.Service, poweredOn, 100); err != nil {
+	if err = changePowerState(context.Background(), api.Service, poweredOn, 100); err != nil {
 		log.Printf("Could not change power state %s", err.Error())
 	}
 }
@@ -213,4 +216,11 @@ func init() {
 		Endpoint: os.Getenv("TF_TESTING_ENDPOINT"),
 		Insecure: false,
 	}
+
+	// Acceptance tests may be executed against in-memory Redfish service
+	// when no real iRMC endpoint is configured.
+	if len(os.Getenv("TF_TESTING_MOCK")) > 0 && len(creds.Endpoint) == 0 {
+		mockServer = newMockRedfishServer()
+		creds = mockServer.Credentials()
+	}
 }
@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// endpointRateLimit spaces requests to single iRMC according to requests per second limit
+// and limits number of requests in flight.
+type endpointRateLimit struct {
+	lock     sync.Mutex
+	interval time.Duration
+	next     time.Time
+	slots    chan struct{}
+}
+
+// acquire waits until request may be sent. Returned function must be called when response has been received.
+func (l *endpointRateLimit) acquire(ctx context.Context) (release func(), err error) {
+	release = func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = func() { <-l.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if l.interval > 0 {
+		l.lock.Lock()
+		start := time.Now()
+		if l.next.After(start) {
+			start = l.next
+		}
+		l.next = start.Add(l.interval)
+		l.lock.Unlock()
+
+		if wait := time.Until(start); wait > 0 {
+			if err := sleepWithContext(ctx, wait); err != nil {
+				release()
+				return nil, err
+			}
+		}
+	}
+
+	return release, nil
+}
+
+// RequestLimiter limits rate and concurrency of requests sent to every iRMC, so that large applies
+// do not trip DoS protection of the controller. Zero limits mean no limitation.
+type RequestLimiter struct {
+	lock              sync.Mutex
+	endpoints         map[string]*endpointRateLimit
+	requestsPerSecond float64
+	concurrent        int
+}
+
+// InitRequestLimiterInstance returns limiter allowing requestsPerSecond and concurrent requests in flight per iRMC.
+func InitRequestLimiterInstance(requestsPerSecond float64, concurrent int) *RequestLimiter {
+	return &RequestLimiter{
+		endpoints:         make(map[string]*endpointRateLimit),
+		requestsPerSecond: max(requestsPerSecond, 0),
+		concurrent:        max(concurrent, 0),
+	}
+}
+
+func (rl *RequestLimiter) isEnabled() bool {
+	return rl.requestsPerSecond > 0 || rl.concurrent > 0
+}
+
+func (rl *RequestLimiter) getEndpointRateLimit(endpoint string) *endpointRateLimit {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	l, ok := rl.endpoints[endpoint]
+	if !ok {
+		l = &endpointRateLimit{}
+		if rl.requestsPerSecond > 0 {
+			l.interval = time.Duration(float64(time.Second) / rl.requestsPerSecond)
+		}
+		if rl.concurrent > 0 {
+			l.slots = make(chan struct{}, rl.concurrent)
+		}
+		rl.endpoints[endpoint] = l
+	}
+
+	return l
+}
+
+// rateLimitTransport applies limits of RequestLimiter per host of the request.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *RequestLimiter
+}
+
+func newRateLimitTransport(next http.RoundTripper, limiter *RequestLimiter) http.RoundTripper {
+	if limiter == nil || !limiter.isEnabled() {
+		return next
+	}
+
+	return &rateLimitTransport{next: next, limiter: limiter}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.getEndpointRateLimit(req.URL.Host).acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+	return t.next.RoundTrip(req)
+}
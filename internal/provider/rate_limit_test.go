@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimitTransport(t *testing.T) {
+	t.Run("LimitsConcurrentRequests", func(t *testing.T) {
+		var inFlight, maxInFlight int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := atomic.AddInt32(&inFlight, 1)
+			for {
+				seen := atomic.LoadInt32(&maxInFlight)
+				if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+					break
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		limiter := InitRequestLimiterInstance(0, 2)
+		client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, limiter)}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 6; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := client.Get(server.URL)
+				if err != nil {
+					t.Errorf("Unexpected error %s", err.Error())
+					return
+				}
+				CloseResource(res.Body)
+			}()
+		}
+		wg.Wait()
+
+		if maxInFlight > 2 {
+			t.Errorf("Got %d requests in flight, expected at most 2", maxInFlight)
+		}
+	})
+
+	t.Run("SpacesRequests", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		limiter := InitRequestLimiterInstance(10, 0)
+		client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, limiter)}
+
+		start := time.Now()
+		for i := 0; i < 4; i++ {
+			res, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatalf("Unexpected error %s", err.Error())
+			}
+			CloseResource(res.Body)
+		}
+
+		// First request is sent immediately, following ones 100ms apart
+		if elapsed := time.Since(start); elapsed < 300*time.Millisecond {
+			t.Errorf("4 requests took %s, expected at least 300ms", elapsed)
+		}
+	})
+	t.Run("LimitsOfProviderInstance", func(t *testing.T) {
+		limited := &IrmcProvider{RequestLimiter: InitRequestLimiterInstance(0, 1)}
+		unlimited := &IrmcProvider{RequestLimiter: InitRequestLimiterInstance(0, 0)}
+
+		// Clients of provider instance (e.g. alias) use only its own limits
+		if _, ok := newRedfishHttpClient(limited, true).Transport.(*retryTransport).next.(*rateLimitTransport); !ok {
+			t.Errorf("Client of limited provider does not limit requests")
+		}
+		if _, ok := newRedfishHttpClient(unlimited, true).Transport.(*retryTransport).next.(*rateLimitTransport); ok {
+			t.Errorf("Client of unlimited provider limits requests")
+		}
+	})
+}
@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stmcginnis/gofish/common"
+)
+
+// ExtendedInfoMsg represents single entry of @Message.ExtendedInfo reported by Redfish service.
+type ExtendedInfoMsg struct {
+	MessageId       string   `json:"MessageId"`
+	Message         string   `json:"Message"`
+	MessageArgs     []string `json:"MessageArgs,omitempty"`
+	Severity        string   `json:"Severity,omitempty"`
+	MessageSeverity string   `json:"MessageSeverity,omitempty"`
+	Resolution      string   `json:"Resolution,omitempty"`
+}
+
+type RedfishExtendedInfoResponse struct {
+	ExtendedInfo []ExtendedInfoMsg `json:"@Message.ExtendedInfo"`
+}
+
+// getSeverity returns severity of the message, MessageSeverity is preferred over deprecated Severity.
+func (m ExtendedInfoMsg) getSeverity() string {
+	if len(m.MessageSeverity) > 0 {
+		return m.MessageSeverity
+	}
+
+	return m.Severity
+}
+
+// isWarning reports whether message should not by itself fail the operation.
+func (m ExtendedInfoMsg) isWarning() bool {
+	severity := m.getSeverity()
+	return strings.EqualFold(severity, "OK") || strings.EqualFold(severity, "Warning")
+}
+
+func (m ExtendedInfoMsg) detail() string {
+	detail := m.Message
+	if len(m.MessageId) > 0 {
+		detail += fmt.Sprintf("\nMessageId: %s", m.MessageId)
+	}
+
+	if severity := m.getSeverity(); len(severity) > 0 {
+		detail += fmt.Sprintf("\nSeverity: %s", severity)
+	}
+
+	if len(m.Resolution) > 0 {
+		detail += fmt.Sprintf("\nResolution: %s", m.Resolution)
+	}
+
+	return detail
+}
+
+// redfishErrorDiagnostics converts err into diagnostics. If err carries Redfish error response,
+// every @Message.ExtendedInfo entry is reported separately with its MessageId, severity and resolution
+// (entries with OK or Warning severity as warnings), otherwise err is reported as single error.
+// Cancelled waiting is reported together with note that operation might be still in progress.
+func redfishErrorDiagnostics(summary string, err error) (diags diag.Diagnostics) {
+	if errors.Is(err, errPollCancelled) {
+		diags.AddError(summary, fmt.Sprintf("%s. Operation might be still in progress on iRMC and its partial "+
+			"result is not stored in Terraform state, verify the system before next apply.", err.Error()))
+		return diags
+	}
+
+	var redfishErr *common.Error
+	if !errors.As(err, &redfishErr) || (len(redfishErr.ExtendedInfos) == 0 && len(redfishErr.Code) == 0) {
+		diags.AddError(summary, err.Error())
+		return diags
+	}
+
+	anyError := false
+	for _, info := range redfishErr.ExtendedInfos {
+		msg := ExtendedInfoMsg{
+			MessageId:   info.MessageID,
+			Message:     info.Message,
+			MessageArgs: info.MessageArgs,
+			Severity:    info.Severity,
+			Resolution:  info.Resolution,
+		}
+
+		if msg.isWarning() {
+			diags.AddWarning(summary, msg.detail())
+		} else {
+			diags.AddError(summary, msg.detail())
+			anyError = true
+		}
+	}
+
+	if !anyError {
+		detail := fmt.Sprintf("Request finished with HTTP status %d", redfishErr.HTTPReturnedStatusCode)
+		if len(redfishErr.Message) > 0 {
+			detail += fmt.Sprintf(": %s", redfishErr.Message)
+		}
+
+		if len(redfishErr.Code) > 0 {
+			detail += fmt.Sprintf("\nMessageId: %s", redfishErr.Code)
+		}
+
+		diags.AddError(summary, detail)
+	}
+
+	return diags
+}
+
+// redfishResponseWarnings converts @Message.ExtendedInfo of successful response body into warnings,
+// since such messages usually mean that part of the request has been ignored by the service.
+func redfishResponseWarnings(summary string, body []byte) (diags diag.Diagnostics) {
+	var response RedfishExtendedInfoResponse
+	if len(body) == 0 || json.Unmarshal(body, &response) != nil {
+		return diags
+	}
+
+	for _, msg := range response.ExtendedInfo {
+		// Plain success message does not carry any information worth reporting
+		if strings.HasSuffix(msg.MessageId, ".Success") {
+			continue
+		}
+
+		diags.AddWarning(summary, msg.detail())
+	}
+
+	return diags
+}
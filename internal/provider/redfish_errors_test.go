@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stmcginnis/gofish/common"
+)
+
+func TestRedfishErrorDiagnostics(t *testing.T) {
+	err := common.ConstructError(400, []byte(`{"error": {"code": "Base.1.8.GeneralError", "message": "A general error has occurred.",
+		"@Message.ExtendedInfo": [
+			{"MessageId": "Base.1.8.PropertyValueNotInList", "Message": "The value 'Foo' for the property PatrolRead is not in the list of acceptable values.",
+			 "Severity": "Warning", "Resolution": "Choose a value from the enumeration list."},
+			{"MessageId": "Base.1.8.ActionNotSupported", "Message": "The action is not supported.", "MessageSeverity": "Critical",
+			 "Resolution": "Check the action name."}
+		]}}`))
+
+	diags := redfishErrorDiagnostics("PATCH failed", err)
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 error and 1 warning, got %d errors and %d warnings", diags.ErrorsCount(), diags.WarningsCount())
+	}
+
+	detail := diags.Errors()[0].Detail()
+	for _, expected := range []string{"The action is not supported.", "MessageId: Base.1.8.ActionNotSupported", "Resolution: Check the action name."} {
+		if !strings.Contains(detail, expected) {
+			t.Errorf("expected detail to contain '%s', got '%s'", expected, detail)
+		}
+	}
+}
+
+func TestRedfishErrorDiagnostics_onlyWarnings(t *testing.T) {
+	err := common.ConstructError(409, []byte(`{"error": {"code": "Base.1.8.GeneralError", "message": "A general error has occurred.",
+		"@Message.ExtendedInfo": [{"MessageId": "Base.1.8.ResourceInUse", "Message": "Resource is in use.", "Severity": "Warning"}]}}`))
+
+	diags := redfishErrorDiagnostics("PATCH failed", err)
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 error and 1 warning, got %d errors and %d warnings", diags.ErrorsCount(), diags.WarningsCount())
+	}
+
+	if !strings.Contains(diags.Errors()[0].Detail(), "409") {
+		t.Errorf("expected HTTP status in detail, got '%s'", diags.Errors()[0].Detail())
+	}
+}
+
+func TestRedfishErrorDiagnostics_plainError(t *testing.T) {
+	expected := diag.Diagnostics{diag.NewErrorDiagnostic("Request failed", "connection refused")}
+	if diags := redfishErrorDiagnostics("Request failed", errors.New("connection refused")); !diags.Equal(expected) {
+		t.Errorf("unexpected diagnostics %v", diags)
+	}
+
+	if diags := redfishErrorDiagnostics("Request failed", common.ConstructError(500, []byte("Internal error"))); diags.ErrorsCount() != 1 {
+		t.Errorf("expected single error for not Redfish formatted body, got %v", diags)
+	}
+}
+
+func TestRedfishResponseWarnings(t *testing.T) {
+	diags := redfishResponseWarnings("PATCH", []byte(`{"@Message.ExtendedInfo": [
+		{"MessageId": "Base.1.8.Success", "Message": "Successfully Completed Request"},
+		{"MessageId": "Base.1.8.PropertyNotWritable", "Message": "The property BGIRate is a read only property."}
+	]}`))
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("expected single warning, got %v", diags)
+	}
+
+	if diags := redfishResponseWarnings("PATCH", []byte("not a json")); len(diags) != 0 {
+		t.Errorf("expected no diagnostics for not parsable body, got %v", diags)
+	}
+}
@@ -0,0 +1,353 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	ACCOUNT_SERVICE_ENDPOINT = "/redfish/v1/AccountService"
+	SESSION_SERVICE_ENDPOINT = "/redfish/v1/SessionService"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccountPolicyResource{}
+var _ resource.ResourceWithImportState = &AccountPolicyResource{}
+
+func NewAccountPolicyResource() resource.Resource {
+	return &AccountPolicyResource{}
+}
+
+// AccountPolicyResource defines the resource implementation.
+type AccountPolicyResource struct {
+	p *IrmcProvider
+}
+
+func (r *AccountPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + accountPolicyName
+}
+
+func AccountPolicySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of account service resource on iRMC.",
+			Description:         "ID of account service resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"min_password_length": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Minimum length of password of user accounts.",
+			Description:         "Minimum length of password of user accounts.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, maxPasswordLength),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"password_expiration_days": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Number of days after which password of user accounts expires. Value 0 means that password never expires.",
+			Description:         "Number of days after which password of user accounts expires. Value 0 means that password never expires.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"account_lockout_threshold": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Number of failed login attempts after which user account is locked. Value 0 means that account is never locked.",
+			Description:         "Number of failed login attempts after which user account is locked. Value 0 means that account is never locked.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"account_lockout_duration": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Time in seconds for which user account stays locked after account_lockout_threshold has been reached.",
+			Description:         "Time in seconds for which user account stays locked after account_lockout_threshold has been reached.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"session_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Time in seconds of inactivity after which Redfish session is closed.",
+			Description:         "Time in seconds of inactivity after which Redfish session is closed.",
+			Validators: []validator.Int64{
+				int64validator.Between(30, 86400),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *AccountPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) account policy (password, lockout and session settings) of iRMC.",
+		Description:         "The resource is used to control (read, modify or import) account policy (password, lockout and session settings) of iRMC.",
+		Attributes:          AccountPolicySchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *AccountPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *AccountPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-account_policy: create starts")
+
+	var plan models.AccountPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-account_policy: create ends")
+}
+
+func (r *AccountPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-account_policy: read starts")
+
+	var state models.AccountPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	if err = readAccountPolicy(api, &state); err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not read account policy", err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-account_policy: read ends")
+}
+
+func (r *AccountPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-account_policy: update starts")
+
+	var plan models.AccountPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.AccountPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-account_policy: update ends")
+}
+
+func (r *AccountPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-account_policy: delete starts")
+	// Account policy can not be removed, so resource is only removed from state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-account_policy: delete ends")
+}
+
+func (r *AccountPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-account_policy: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.AccountPolicyResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	if err = readAccountPolicy(api, &state); err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not read account policy", err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-account_policy: import ends")
+}
+
+// apply sends account policy values, which are defined in plan and differ from state (if any),
+// to iRMC and reads back all policy values into plan.
+func (r *AccountPolicyResource) apply(ctx context.Context, plan *models.AccountPolicyResourceModel, state *models.AccountPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-account_policy"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	var current models.AccountPolicyResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	accountPayload := map[string]interface{}{}
+	addInt64ToPayload(accountPayload, "MinPasswordLength", plan.MinPasswordLength, current.MinPasswordLength)
+	addInt64ToPayload(accountPayload, "PasswordExpirationDays", plan.PasswordExpirationDays, current.PasswordExpirationDays)
+	addInt64ToPayload(accountPayload, "AccountLockoutThreshold", plan.AccountLockoutThreshold, current.AccountLockoutThreshold)
+	addInt64ToPayload(accountPayload, "AccountLockoutDuration", plan.AccountLockoutDuration, current.AccountLockoutDuration)
+
+	sessionPayload := map[string]interface{}{}
+	addInt64ToPayload(sessionPayload, "SessionTimeout", plan.SessionTimeout, current.SessionTimeout)
+
+	if len(accountPayload) > 0 {
+		tflog.Info(ctx, "Changing account service settings", map[string]interface{}{"payload": accountPayload})
+	}
+
+	account, err := applySettings(api, ACCOUNT_SERVICE_ENDPOINT, accountPayload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply account service settings", err)...)
+		return diags
+	}
+
+	if len(sessionPayload) > 0 {
+		tflog.Info(ctx, "Changing session service settings", map[string]interface{}{"payload": sessionPayload})
+	}
+
+	session, err := applySettings(api, SESSION_SERVICE_ENDPOINT, sessionPayload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply session service settings", err)...)
+		return diags
+	}
+
+	accountPolicyToModel(account, session, plan)
+	return diags
+}
+
+// readAccountPolicy reads account policy values from AccountService and SessionService into model.
+func readAccountPolicy(api *gofish.APIClient, model *models.AccountPolicyResourceModel) error {
+	account, err := getJsonObject(api, ACCOUNT_SERVICE_ENDPOINT)
+	if err != nil {
+		return err
+	}
+
+	session, err := getJsonObject(api, SESSION_SERVICE_ENDPOINT)
+	if err != nil {
+		return err
+	}
+
+	accountPolicyToModel(account, session, model)
+	return nil
+}
+
+// accountPolicyToModel copies account policy values from AccountService and SessionService objects
+// into model. Values not reported by iRMC are set to null.
+func accountPolicyToModel(account map[string]interface{}, session map[string]interface{}, model *models.AccountPolicyResourceModel) {
+	model.Id = types.StringValue(ACCOUNT_SERVICE_ENDPOINT)
+	model.MinPasswordLength = jsonInt64Value(account, "MinPasswordLength")
+	model.PasswordExpirationDays = jsonInt64Value(account, "PasswordExpirationDays")
+	model.AccountLockoutThreshold = jsonInt64Value(account, "AccountLockoutThreshold")
+	model.AccountLockoutDuration = jsonInt64Value(account, "AccountLockoutDuration")
+	model.SessionTimeout = jsonInt64Value(session, "SessionTimeout")
+}
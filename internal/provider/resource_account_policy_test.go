@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const account_policy_name = "irmc-redfish_account_policy.policy"
+
+func TestAccRedfishAccountPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceAccountPolicyConfig(creds, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(account_policy_name, "id", ACCOUNT_SERVICE_ENDPOINT),
+					resource.TestCheckResourceAttr(account_policy_name, "session_timeout", "600"),
+					resource.TestCheckResourceAttrSet(account_policy_name, "min_password_length"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceAccountPolicyConfig(creds, 1800),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(account_policy_name, "session_timeout", "1800"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceAccountPolicyConfig(testingInfo TestingServerCredentials, timeout int) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_account_policy" "policy" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		session_timeout = %d
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		timeout,
+	)
+}
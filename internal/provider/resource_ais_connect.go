@@ -0,0 +1,387 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+// AIS_CONNECT_CONFIGURATION is name of OEM iRMC configuration object holding AIS Connect (remote support) settings.
+const AIS_CONNECT_CONFIGURATION = "AISConnect"
+
+var scheduleTimeRegex = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AisConnectResource{}
+var _ resource.ResourceWithImportState = &AisConnectResource{}
+
+func NewAisConnectResource() resource.Resource {
+	return &AisConnectResource{}
+}
+
+// AisConnectResource defines the resource implementation.
+type AisConnectResource struct {
+	p *IrmcProvider
+}
+
+func (r *AisConnectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + aisConnectName
+}
+
+func AisConnectSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of AIS Connect settings resource on iRMC.",
+			Description:         "ID of AIS Connect settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if AIS Connect remote support is enabled.",
+			Description:         "Specifies if AIS Connect remote support is enabled.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"proxy_server": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Address of proxy server used to connect to remote support backend. Empty value means direct connection.",
+			Description:         "Address of proxy server used to connect to remote support backend. Empty value means direct connection.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"proxy_port": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "TCP port of proxy server.",
+			Description:         "TCP port of proxy server.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"proxy_user": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "User name used for authentication to proxy server.",
+			Description:         "User name used for authentication to proxy server.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"proxy_password": schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			MarkdownDescription: "Password used for authentication to proxy server. iRMC does not report it, so it is only sent when changed in configuration.",
+			Description:         "Password used for authentication to proxy server. iRMC does not report it, so it is only sent when changed in configuration.",
+		},
+		"scheduled_connection": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if connection to remote support backend is established only within daily time window defined by schedule_start and schedule_end instead of permanently.",
+			Description:         "Specifies if connection to remote support backend is established only within daily time window defined by schedule_start and schedule_end instead of permanently.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"schedule_start": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Start of daily connection time window in format HH:MM.",
+			Description:         "Start of daily connection time window in format HH:MM.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(scheduleTimeRegex, "must be time in format HH:MM"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"schedule_end": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "End of daily connection time window in format HH:MM.",
+			Description:         "End of daily connection time window in format HH:MM.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(scheduleTimeRegex, "must be time in format HH:MM"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *AisConnectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) AIS Connect (remote support) settings of iRMC.",
+		Description:         "The resource is used to control (read, modify or import) AIS Connect (remote support) settings of iRMC.",
+		Attributes:          AisConnectSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *AisConnectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *AisConnectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-ais_connect: create starts")
+
+	var plan models.AisConnectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-ais_connect: create ends")
+}
+
+func (r *AisConnectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-ais_connect: read starts")
+
+	var state models.AisConnectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-ais_connect: read ends")
+}
+
+func (r *AisConnectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-ais_connect: update starts")
+
+	var plan, state models.AisConnectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-ais_connect: update ends")
+}
+
+func (r *AisConnectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-ais_connect: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-ais_connect: delete ends")
+}
+
+func (r *AisConnectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-ais_connect: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.AisConnectResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+		ProxyPassword: types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-ais_connect: import ends")
+}
+
+// read reads current AIS Connect settings from iRMC into model.
+func (r *AisConnectResource) read(ctx context.Context, model *models.AisConnectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	api, err := ConnectTargetSystem(r.p, &model.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, err := getAisConnectEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read AIS Connect settings", err)...)
+		return diags
+	}
+
+	aisConnectToModel(endpoint, data, model)
+	return diags
+}
+
+func (r *AisConnectResource) apply(ctx context.Context, plan *models.AisConnectResourceModel, state *models.AisConnectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-ais_connect"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	aisEndpoint, err := getAisConnectEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	var current models.AisConnectResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	// Password is not reported back by iRMC, so it is sent on its own and not verified
+	passwordPayload := map[string]interface{}{}
+	addStringToPayload(passwordPayload, "Password", plan.ProxyPassword, current.ProxyPassword)
+	if len(passwordPayload) > 0 {
+		tflog.Info(ctx, "Applying AIS Connect proxy password")
+		if err = patchEndpointWithEtag(api, aisEndpoint, map[string]interface{}{"Proxy": passwordPayload}); err != nil {
+			diags.Append(redfishErrorDiagnostics("Could not apply AIS Connect proxy password", err)...)
+			return diags
+		}
+	}
+
+	proxy := map[string]interface{}{}
+	addStringToPayload(proxy, "Server", plan.ProxyServer, current.ProxyServer)
+	addInt64ToPayload(proxy, "Port", plan.ProxyPort, current.ProxyPort)
+	addStringToPayload(proxy, "UserName", plan.ProxyUser, current.ProxyUser)
+
+	schedule := map[string]interface{}{}
+	addBoolToPayload(schedule, "Enabled", plan.ScheduledConnection, current.ScheduledConnection)
+	addStringToPayload(schedule, "StartTime", plan.ScheduleStart, current.ScheduleStart)
+	addStringToPayload(schedule, "EndTime", plan.ScheduleEnd, current.ScheduleEnd)
+
+	payload := map[string]interface{}{}
+	addBoolToPayload(payload, "Enabled", plan.Enabled, current.Enabled)
+	addObjectToPayload(payload, "Proxy", proxy)
+	addObjectToPayload(payload, "Schedule", schedule)
+
+	tflog.Info(ctx, "Applying AIS Connect settings", map[string]interface{}{"payload": payload})
+	data, err := applySettings(api, aisEndpoint, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply AIS Connect settings", err)...)
+		return diags
+	}
+
+	aisConnectToModel(aisEndpoint, data, plan)
+	return diags
+}
+
+func getAisConnectEndpoint(ctx context.Context, api *gofish.APIClient) (string, error) {
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		return "", err
+	}
+
+	return getIrmcConfigurationEndpoint(isFsas, AIS_CONNECT_CONFIGURATION), nil
+}
+
+// aisConnectToModel copies AIS Connect settings from OEM configuration object into model.
+// Proxy password is not reported by iRMC, so it is left untouched.
+func aisConnectToModel(endpoint string, data map[string]interface{}, model *models.AisConnectResourceModel) {
+	proxy := jsonObjectValue(data, "Proxy")
+	schedule := jsonObjectValue(data, "Schedule")
+
+	model.Id = types.StringValue(endpoint)
+	model.Enabled = jsonBoolValue(data, "Enabled")
+	model.ProxyServer = jsonStringValue(proxy, "Server")
+	model.ProxyPort = jsonInt64Value(proxy, "Port")
+	model.ProxyUser = jsonStringValue(proxy, "UserName")
+	model.ScheduledConnection = jsonBoolValue(schedule, "Enabled")
+	model.ScheduleStart = jsonStringValue(schedule, "StartTime")
+	model.ScheduleEnd = jsonStringValue(schedule, "EndTime")
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const ais_connect_name = "irmc-redfish_ais_connect.ais"
+
+func TestAccRedfishAisConnect_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceAisConnectConfig(creds, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(ais_connect_name, "id"),
+					resource.TestCheckResourceAttr(ais_connect_name, "enabled", "true"),
+					resource.TestCheckResourceAttr(ais_connect_name, "scheduled_connection", "false"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceAisConnectConfig(creds, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(ais_connect_name, "scheduled_connection", "true"),
+					resource.TestCheckResourceAttr(ais_connect_name, "schedule_start", "22:00"),
+					resource.TestCheckResourceAttr(ais_connect_name, "schedule_end", "23:30"),
+				),
+			},
+			{
+				ResourceName:            ais_connect_name,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("{\"username\":\"%s\",\"password\":\"%s\",\"endpoint\":\"https://%s\",\"ssl_insecure\":true}", creds.Username, creds.Password, creds.Endpoint),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server"},
+			},
+		},
+	})
+}
+
+func TestAisConnectToModel(t *testing.T) {
+	model := models.AisConnectResourceModel{ProxyPassword: types.StringValue("secret")}
+	aisConnectToModel("/endpoint", map[string]interface{}{
+		"Enabled":  true,
+		"Proxy":    map[string]interface{}{"Server": "proxy.example.com", "Port": float64(3128), "UserName": "support"},
+		"Schedule": map[string]interface{}{"Enabled": false, "StartTime": "22:00", "EndTime": "23:30"},
+	}, &model)
+
+	if !model.Enabled.ValueBool() || model.ProxyServer.ValueString() != "proxy.example.com" || model.ProxyPort.ValueInt64() != 3128 ||
+		model.ProxyUser.ValueString() != "support" || model.ScheduledConnection.ValueBool() ||
+		model.ScheduleStart.ValueString() != "22:00" || model.ScheduleEnd.ValueString() != "23:30" {
+		t.Errorf("Unexpected model %+v", model)
+	}
+
+	if model.ProxyPassword.ValueString() != "secret" {
+		t.Errorf("Proxy password should not be changed by read")
+	}
+}
+
+func TestScheduleTimeRegex(t *testing.T) {
+	for _, value := range []string{"00:00", "09:30", "23:59"} {
+		if !scheduleTimeRegex.MatchString(value) {
+			t.Errorf("%s should be accepted", value)
+		}
+	}
+
+	for _, value := range []string{"24:00", "9:30", "12:60", "12:00:00"} {
+		if scheduleTimeRegex.MatchString(value) {
+			t.Errorf("%s should not be accepted", value)
+		}
+	}
+}
+
+func testAccRedfishResourceAisConnectConfig(testingInfo TestingServerCredentials, scheduled bool) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_ais_connect" "ais" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		enabled              = true
+		scheduled_connection = %t
+		schedule_start       = "22:00"
+		schedule_end         = "23:30"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		scheduled,
+	)
+}
@@ -0,0 +1,323 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+// AVR_CONFIGURATION is name of OEM iRMC configuration object holding Advanced Video Redirection settings.
+const AVR_CONFIGURATION = "VideoRedirection"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AvrSettingsResource{}
+var _ resource.ResourceWithImportState = &AvrSettingsResource{}
+
+func NewAvrSettingsResource() resource.Resource {
+	return &AvrSettingsResource{}
+}
+
+// AvrSettingsResource defines the resource implementation.
+type AvrSettingsResource struct {
+	p *IrmcProvider
+}
+
+func (r *AvrSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + avrSettingsName
+}
+
+func AvrSettingsSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of AVR settings resource on iRMC.",
+			Description:         "ID of AVR settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if Advanced Video Redirection (KVM) is enabled.",
+			Description:         "Specifies if Advanced Video Redirection (KVM) is enabled.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"max_sessions": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Maximum number of concurrent AVR sessions.",
+			Description:         "Maximum number of concurrent AVR sessions.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 2),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"port": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "TCP port used by AVR.",
+			Description:         "TCP port used by AVR.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"local_monitor_off_policy": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Policy of switching off local monitor of the server during AVR session. Available values are 'Disabled', 'Manual' and 'Automatic'.",
+			Description:         "Policy of switching off local monitor of the server during AVR session. Available values are 'Disabled', 'Manual' and 'Automatic'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("Disabled", "Manual", "Automatic"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *AvrSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) Advanced Video Redirection (KVM) settings of iRMC.",
+		Description:         "The resource is used to control (read, modify or import) Advanced Video Redirection (KVM) settings of iRMC.",
+		Attributes:          AvrSettingsSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *AvrSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *AvrSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-avr_settings: create starts")
+
+	var plan models.AvrSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-avr_settings: create ends")
+}
+
+func (r *AvrSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-avr_settings: read starts")
+
+	var state models.AvrSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-avr_settings: read ends")
+}
+
+func (r *AvrSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-avr_settings: update starts")
+
+	var plan, state models.AvrSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-avr_settings: update ends")
+}
+
+func (r *AvrSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-avr_settings: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-avr_settings: delete ends")
+}
+
+func (r *AvrSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-avr_settings: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.AvrSettingsResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-avr_settings: import ends")
+}
+
+// read reads current AVR settings from iRMC into model.
+func (r *AvrSettingsResource) read(ctx context.Context, model *models.AvrSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	api, err := ConnectTargetSystem(r.p, &model.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, err := getAvrSettingsEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read AVR settings", err)...)
+		return diags
+	}
+
+	avrSettingsToModel(endpoint, data, model)
+	return diags
+}
+
+func (r *AvrSettingsResource) apply(ctx context.Context, plan *models.AvrSettingsResourceModel, state *models.AvrSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-avr_settings"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	avrEndpoint, err := getAvrSettingsEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	var current models.AvrSettingsResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	payload := map[string]interface{}{}
+	addBoolToPayload(payload, "Enabled", plan.Enabled, current.Enabled)
+	addInt64ToPayload(payload, "MaxSessions", plan.MaxSessions, current.MaxSessions)
+	addInt64ToPayload(payload, "Port", plan.Port, current.Port)
+	addStringToPayload(payload, "LocalMonitorOff", plan.LocalMonitorOffPolicy, current.LocalMonitorOffPolicy)
+
+	tflog.Info(ctx, "Applying AVR settings", map[string]interface{}{"payload": payload})
+	data, err := applySettings(api, avrEndpoint, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply AVR settings", err)...)
+		return diags
+	}
+
+	avrSettingsToModel(avrEndpoint, data, plan)
+	return diags
+}
+
+func getAvrSettingsEndpoint(ctx context.Context, api *gofish.APIClient) (string, error) {
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		return "", err
+	}
+
+	return getIrmcConfigurationEndpoint(isFsas, AVR_CONFIGURATION), nil
+}
+
+// avrSettingsToModel copies AVR settings from OEM configuration object into model.
+func avrSettingsToModel(endpoint string, data map[string]interface{}, model *models.AvrSettingsResourceModel) {
+	model.Id = types.StringValue(endpoint)
+	model.Enabled = jsonBoolValue(data, "Enabled")
+	model.MaxSessions = jsonInt64Value(data, "MaxSessions")
+	model.Port = jsonInt64Value(data, "Port")
+	model.LocalMonitorOffPolicy = jsonStringValue(data, "LocalMonitorOff")
+}
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const avr_settings_name = "irmc-redfish_avr_settings.avr"
+
+func TestAccRedfishAvrSettings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceAvrSettingsConfig(creds, "Manual"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(avr_settings_name, "id"),
+					resource.TestCheckResourceAttr(avr_settings_name, "enabled", "true"),
+					resource.TestCheckResourceAttr(avr_settings_name, "local_monitor_off_policy", "Manual"),
+					resource.TestCheckResourceAttrSet(avr_settings_name, "port"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceAvrSettingsConfig(creds, "Automatic"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(avr_settings_name, "local_monitor_off_policy", "Automatic"),
+				),
+			},
+			{
+				ResourceName:            avr_settings_name,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("{\"username\":\"%s\",\"password\":\"%s\",\"endpoint\":\"https://%s\",\"ssl_insecure\":true}", creds.Username, creds.Password, creds.Endpoint),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server"},
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceAvrSettingsConfig(testingInfo TestingServerCredentials, policy string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_avr_settings" "avr" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		enabled                  = true
+		local_monitor_off_policy = "%s"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		policy,
+	)
+}
@@ -19,8 +19,9 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 
 	"terraform-provider-irmc-redfish/internal/models"
@@ -34,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -45,6 +47,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BiosResource{}
 var _ resource.ResourceWithImportState = &BiosResource{}
+var _ resource.ResourceWithModifyPlan = &BiosResource{}
 
 func NewBiosResource() resource.Resource {
 	return &BiosResource{}
@@ -67,14 +70,33 @@ func BiosSchema() map[string]schema.Attribute {
 			Description:         "ID of BIOS settings resource on iRMC.",
 		},
 		"attributes": schema.MapAttribute{
-			Required:            true,
-			MarkdownDescription: "Map of BIOS attributes.",
-			Description:         "Map of BIOS attributes.",
+			Optional:            true,
+			MarkdownDescription: "Map of BIOS attributes. Attributes defined here take precedence over attributes of `profile`.",
+			Description:         "Map of BIOS attributes. Attributes defined here take precedence over attributes of profile.",
 			ElementType:         types.StringType,
 			Validators: []validator.Map{
 				mapvalidator.SizeAtLeast(1),
+				mapvalidator.AtLeastOneOf(tkpath.MatchRoot("profile")),
 			},
 		},
+		"profile": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Built-in BIOS profile, which expands to set of attributes for processor vendor (Intel or AMD) of the system.",
+			Description:         "Built-in BIOS profile, which expands to set of attributes for processor vendor (Intel or AMD) of the system.",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					BIOS_PROFILE_VIRTUALIZATION,
+					BIOS_PROFILE_LOW_LATENCY,
+					BIOS_PROFILE_POWER_SAVE,
+				}...),
+			},
+		},
+		"profile_attributes": schema.MapAttribute{
+			Computed:            true,
+			MarkdownDescription: "Attributes applied because of `profile` (supported by the system and not overridden by `attributes`) with their current values. Values differing from profile are reported as drift and applied again.",
+			Description:         "Attributes applied because of profile (supported by the system and not overridden by attributes) with their current values. Values differing from profile are reported as drift and applied again.",
+			ElementType:         types.StringType,
+		},
 		"system_reset_type": schema.StringAttribute{
 			Required:            true,
 			MarkdownDescription: "Control how system will be reset to finish BIOS settings change (if host is powered on).",
@@ -87,6 +109,19 @@ func BiosSchema() map[string]schema.Attribute {
 				}...),
 			},
 		},
+		"apply_time": schema.StringAttribute{
+			Computed:            true,
+			Optional:            true,
+			Default:             stringdefault.StaticString(BIOS_APPLY_TIME_IMMEDIATE),
+			MarkdownDescription: "Defines when BIOS settings will be applied. 'Immediate' resets the host using system_reset_type, 'OnNextReboot' only stages settings which will be applied during next host reboot.",
+			Description:         "Defines when BIOS settings will be applied. 'Immediate' resets the host using system_reset_type, 'OnNextReboot' only stages settings which will be applied during next host reboot.",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					BIOS_APPLY_TIME_IMMEDIATE,
+					BIOS_APPLY_TIME_ON_NEXT_REBOOT,
+				}...),
+			},
+		},
 		"job_timeout": schema.Int64Attribute{
 			Computed:            true,
 			Optional:            true,
@@ -97,6 +132,7 @@ func BiosSchema() map[string]schema.Attribute {
 				int64validator.AtLeast(240),
 			},
 		},
+		"required_host_state": RequiredHostStateSchema(),
 	}
 }
 
@@ -136,12 +172,13 @@ func (r *BiosResource) Create(ctx context.Context, req resource.CreateRequest, r
 	var plan models.BiosResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-bios"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -149,14 +186,19 @@ func (r *BiosResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
-	var plannedAttributes map[string]string
-	diags = plan.Attributes.ElementsAs(ctx, &plannedAttributes, true)
+	// Fail fast if host is not in power state required by configuration
+	resp.Diagnostics.Append(checkRequiredHostState(api.Service, plan.RequiredHostState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plannedAttributes, diags := plannedBiosAttributes(ctx, api.Service, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -168,20 +210,32 @@ func (r *BiosResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	diags = applyBiosAttributes(api.Service, adjustedAttributes)
+	// BIOS settings change must not be mixed with settings staged by someone else
+	diags = checkForeignPendingBiosSettings(api.Service, slices.Collect(maps.Keys(adjustedAttributes)))
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
-	diags = waitTillBiosSettingsApplied(ctx, api.Service, plan.JobTimeout.ValueInt64(),
-		redfish.ResetType(plan.SystemResetType.ValueString()))
-
+	staged := plan.ApplyTime.ValueString() == BIOS_APPLY_TIME_ON_NEXT_REBOOT
+	diags = applyBiosAttributes(api.Service, adjustedAttributes, staged)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
+	if staged {
+		tflog.Info(ctx, "BIOS settings have been staged and will be applied during next host reboot")
+	} else {
+		diags = waitTillBiosSettingsApplied(ctx, api.Service, plan.JobTimeout.ValueInt64(),
+			redfish.ResetType(plan.SystemResetType.ValueString()))
+
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+	}
+
 	plan.Id = types.StringValue(BIOS_SETTINGS_ENDPOINT)
 
 	diags = resp.State.Set(ctx, &plan)
@@ -205,20 +259,40 @@ func (r *BiosResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
-	diags := readBiosAttributesSettingsToModel(ctx, api.Service, &state.Attributes, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if state.ApplyTime.IsNull() {
+		state.ApplyTime = types.StringValue(BIOS_APPLY_TIME_IMMEDIATE)
 	}
 
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	// Attributes are left out of configuration, if only profile is used (and vice versa)
+	for _, attributes := range []*types.Map{&state.Attributes, &state.ProfileAttributes} {
+		if attributes.IsNull() {
+			continue
+		}
+
+		diags := readBiosAttributesSettingsToModel(ctx, api.Service, attributes, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Staged settings are not visible in /Bios until host reboot, so they are
+		// taken from settings object to not report them as a drift
+		if state.ApplyTime.ValueString() == BIOS_APPLY_TIME_ON_NEXT_REBOOT {
+			diags = mergePendingBiosAttributesToModel(ctx, api.Service, attributes)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	tflog.Info(ctx, "resource-bios: read ends")
 }
@@ -230,6 +304,7 @@ func (r *BiosResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	var plan models.BiosResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -237,21 +312,26 @@ func (r *BiosResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
+
+	// Fail fast if host is not in power state required by configuration
+	resp.Diagnostics.Append(checkRequiredHostState(api.Service, plan.RequiredHostState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	isFsas, err := IsFsasCheck(ctx, api)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 	endp := getIrmcAttributesEndpoints(isFsas)
-	var plannedAttributes map[string]string
-	diags = plan.Attributes.ElementsAs(ctx, &plannedAttributes, true)
+	plannedAttributes, diags := plannedBiosAttributes(ctx, api.Service, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -263,20 +343,32 @@ func (r *BiosResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	diags = applyBiosAttributes(api.Service, adjustedAttributes)
+	// BIOS settings change must not be mixed with settings staged by someone else
+	diags = checkForeignPendingBiosSettings(api.Service, slices.Collect(maps.Keys(adjustedAttributes)))
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
-	diags = waitTillBiosSettingsApplied(ctx, api.Service, plan.JobTimeout.ValueInt64(),
-		redfish.ResetType(plan.SystemResetType.ValueString()))
-
+	staged := plan.ApplyTime.ValueString() == BIOS_APPLY_TIME_ON_NEXT_REBOOT
+	diags = applyBiosAttributes(api.Service, adjustedAttributes, staged)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
+	if staged {
+		tflog.Info(ctx, "BIOS settings have been staged and will be applied during next host reboot")
+	} else {
+		diags = waitTillBiosSettingsApplied(ctx, api.Service, plan.JobTimeout.ValueInt64(),
+			redfish.ResetType(plan.SystemResetType.ValueString()))
+
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+	}
+
 	plan.Id = types.StringValue(BIOS_SETTINGS_ENDPOINT)
 
 	diags = resp.State.Set(ctx, &plan)
@@ -294,23 +386,59 @@ func (r *BiosResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	tflog.Info(ctx, "resource-bios: delete ends")
 }
 
+// ModifyPlan expands profile for the system, so that drift of current values of profile attributes
+// from values of profile is reported during plan. Expansion is skipped if iRMC cannot be reached at plan time.
+func (r *BiosResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to expand during destruction or if provider has not been configured yet
+	if req.Plan.Raw.IsNull() || r.p == nil {
+		return
+	}
+
+	var plan models.BiosResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Profile.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tkpath.Root("profile_attributes"), types.MapNull(types.StringType))...)
+		return
+	}
+
+	if plan.Profile.IsUnknown() || plan.Attributes.IsUnknown() || !isRedfishServerKnown(plan.RedfishServer) {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		tflog.Warn(ctx, "resource-bios: profile could not be expanded during plan", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	_, diags := plannedBiosAttributes(ctx, api.Service, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tkpath.Root("profile_attributes"), plan.ProfileAttributes)...)
+}
+
 func (r *BiosResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "resource-bios: import starts")
 
 	var config CommonImportConfig
-	err := json.Unmarshal([]byte(req.ID), &config)
+	server, err := parseImportID(req.ID, &config, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
 		return
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
-	}
-
 	creds := []models.RedfishServer{server}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
@@ -318,28 +446,81 @@ func (r *BiosResource) ImportState(ctx context.Context, req resource.ImportState
 	tflog.Info(ctx, "resource-bios: import ends")
 }
 
-func applyBiosAttributes(service *gofish.Service, adjustedAttributes map[string]interface{}) (diags diag.Diagnostics) {
-	client := service.GetClient()
-	res, err := client.Get(BIOS_SETTINGS_ENDPOINT)
-	if err != nil {
-		diags.AddError("Reading /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
-		return diags
+// plannedBiosAttributes returns attributes configured in plan together with attributes of selected profile.
+// Attributes added because of profile are stored in plan as well.
+func plannedBiosAttributes(ctx context.Context, service *gofish.Service, plan *models.BiosResourceModel) (
+	plannedAttributes map[string]string, diags diag.Diagnostics) {
+	plannedAttributes = make(map[string]string)
+	if !plan.Attributes.IsNull() {
+		diags.Append(plan.Attributes.ElementsAs(ctx, &plannedAttributes, true)...)
+		if diags.HasError() {
+			return nil, diags
+		}
 	}
 
-	defer CloseResource(res.Body)
+	plan.ProfileAttributes = types.MapNull(types.StringType)
+	if plan.Profile.IsNull() {
+		return plannedAttributes, diags
+	}
+
+	profileAttributes, diags := expandBiosProfile(ctx, service, plan.Profile.ValueString(), plannedAttributes)
+	if diags.HasError() {
+		return nil, diags
+	}
 
+	plan.ProfileAttributes, diags = types.MapValueFrom(ctx, types.StringType, profileAttributes)
+	return plannedAttributes, diags
+}
+
+// applyBiosAttributes patches BIOS settings object with adjustedAttributes. If staged is requested
+// and system supports it, settings are marked to be applied during next host reset.
+func applyBiosAttributes(service *gofish.Service, adjustedAttributes map[string]interface{}, staged bool) (diags diag.Diagnostics) {
 	payload := map[string]interface{}{
 		"Attributes": adjustedAttributes,
 	}
 
-	_, err = client.PatchWithHeaders(BIOS_SETTINGS_ENDPOINT, payload,
-		map[string]string{HTTP_HEADER_IF_MATCH: res.Header.Get(HTTP_HEADER_ETAG)})
+	if staged {
+		settings, err := readBiosRedfishSettings(service)
+		if err != nil {
+			diags.Append(redfishErrorDiagnostics("Error while reading @Redfish.Settings of /Systems/0/Bios", err)...)
+			return diags
+		}
 
+		if isOnResetApplyTimeSupported(settings) {
+			payload["@Redfish.SettingsApplyTime"] = map[string]interface{}{
+				"ApplyTime": REDFISH_APPLY_TIME_ON_RESET,
+			}
+		}
+	}
+
+	res, err := PatchWithEtag(service.GetClient(), BIOS_SETTINGS_ENDPOINT, payload)
 	if err != nil {
-		diags.AddError("Changing /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
+		diags.Append(redfishErrorDiagnostics("Changing /redfish/v1/Systems/0/Bios/Settings failed", err)...)
+		return diags
+	}
+
+	CloseResource(res.Body)
+	return diags
+}
+
+// mergePendingBiosAttributesToModel overrides configured attributes in attrMap with values
+// which are staged in BIOS settings object, but not yet applied.
+func mergePendingBiosAttributesToModel(ctx context.Context, service *gofish.Service, attrMap *types.Map) (diags diag.Diagnostics) {
+	pending, diags := readPendingBiosAttributes(service)
+	if diags.HasError() {
 		return diags
 	}
 
+	attributesIntoModel := attrMap.Elements()
+	for key, val := range pending {
+		if _, ok := attributesIntoModel[key]; ok {
+			var log = fmt.Sprintf("Attribute '%s' has pending value '%s'", key, val)
+			tflog.Info(ctx, log)
+			attributesIntoModel[key] = types.StringValue(val)
+		}
+	}
+
+	*attrMap, diags = types.MapValueFrom(ctx, types.StringType, attributesIntoModel)
 	return diags
 }
 
@@ -348,13 +529,13 @@ func applyBiosAttributes(service *gofish.Service, adjustedAttributes map[string]
 func validateAndAdjustPlannedAttributes(ctx context.Context, service *gofish.Service, plannedAttributes map[string]string) (adjustedAttributes map[string]interface{}, diags diag.Diagnostics) {
 	system, err := GetSystemResource(service)
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error while reading /Systems/0", err)...)
 		return adjustedAttributes, diags
 	}
 
 	rBios, err := system.Bios()
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0/Bios", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error while reading /Systems/0/Bios", err)...)
 		return adjustedAttributes, diags
 	}
 
@@ -447,13 +628,13 @@ func isAttributeSupported(key string) bool {
 func readBiosAttributesSettingsToModel(ctx context.Context, service *gofish.Service, attrMap *types.Map, updateAll bool) (diags diag.Diagnostics) {
 	system, err := GetSystemResource(service)
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error while reading /Systems/0", err)...)
 		return diags
 	}
 
 	rBios, err := system.Bios()
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0/Bios", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error while reading /Systems/0/Bios", err)...)
 		return diags
 	}
 
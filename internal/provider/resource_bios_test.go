@@ -45,6 +45,21 @@ func TestAccRedfishBios(t *testing.T) {
 	})
 }
 
+func TestAccRedfishBios_onNextReboot(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceBiosConfig_staged(creds, "StagedAssetTag"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(bios_name, "apply_time", "OnNextReboot"),
+					resource.TestCheckResourceAttr(bios_name, "attributes.AssetTag", "StagedAssetTag"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRedfishBios_negative(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -132,3 +147,28 @@ func testAccRedfishResourceBiosConfig_notSupportedBootSources(testingInfo Testin
 		reset_type,
 	)
 }
+
+func testAccRedfishResourceBiosConfig_staged(testingInfo TestingServerCredentials, asset_tag string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_bios" "bios" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+        attributes = {
+            "AssetTag": "%s"
+        }
+        system_reset_type = "ForceRestart"
+        apply_time = "OnNextReboot"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		asset_tag,
+	)
+}
@@ -21,9 +21,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"strings"
-	"time"
 
 	"terraform-provider-irmc-redfish/internal/models"
 
@@ -36,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -44,6 +43,18 @@ import (
 	"github.com/stmcginnis/gofish/redfish"
 )
 
+const (
+	BOOT_ORDER_MODE_FULL   = "full"
+	BOOT_ORDER_MODE_PREFIX = "prefix"
+)
+
+const (
+	BOOT_ORDER_APPLY_MODE_IMMEDIATE = "immediate"
+	BOOT_ORDER_APPLY_MODE_STAGED    = "staged"
+)
+
+const BOOT_ORDER_JOB_DEFAULT_TIMEOUT int64 = 600
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BootOrderResource{}
 var _ resource.ResourceWithImportState = &BootOrderResource{}
@@ -80,17 +91,55 @@ func BootOrderSchema() map[string]schema.Attribute {
 		},
 		"boot_order": schema.ListAttribute{
 			Required:            true,
-			MarkdownDescription: "Boot devices order in BIOS.",
-			Description:         "Boot devices order in BIOS.",
+			MarkdownDescription: "Boot devices order in BIOS. Every entry might be defined as StructuredBootString or DeviceName of the boot device.",
+			Description:         "Boot devices order in BIOS. Every entry might be defined as StructuredBootString or DeviceName of the boot device.",
 			ElementType:         types.StringType,
 			Validators: []validator.List{
 				listvalidator.SizeAtLeast(1),
 			},
 		},
+		"boot_order_device_names": schema.ListAttribute{
+			Computed:            true,
+			MarkdownDescription: "Human-readable names (DeviceName) of boot devices in order defined by boot_order.",
+			Description:         "Human-readable names (DeviceName) of boot devices in order defined by boot_order.",
+			ElementType:         types.StringType,
+		},
+		"mode": schema.StringAttribute{
+			Computed:            true,
+			Optional:            true,
+			Default:             stringdefault.StaticString(BOOT_ORDER_MODE_FULL),
+			MarkdownDescription: "Defines how boot_order is interpreted. In 'full' mode boot_order must contain all boot devices of the system. In 'prefix' mode listed devices are moved to the front while remaining devices keep their current order.",
+			Description:         "Defines how boot_order is interpreted. In 'full' mode boot_order must contain all boot devices of the system. In 'prefix' mode listed devices are moved to the front while remaining devices keep their current order.",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					BOOT_ORDER_MODE_FULL,
+					BOOT_ORDER_MODE_PREFIX,
+				}...),
+			},
+		},
+		"apply_mode": schema.StringAttribute{
+			Computed:            true,
+			Optional:            true,
+			Default:             stringdefault.StaticString(BOOT_ORDER_APPLY_MODE_IMMEDIATE),
+			MarkdownDescription: "Defines how boot order change is applied. In 'immediate' mode host is reset using system_reset_type (or powered on) and the resource waits till the change is finished. In 'staged' mode boot order is only written into BIOS settings and will be applied during next host reboot (e.g. by power resource or in maintenance window).",
+			Description:         "Defines how boot order change is applied. In 'immediate' mode host is reset using system_reset_type (or powered on) and the resource waits till the change is finished. In 'staged' mode boot order is only written into BIOS settings and will be applied during next host reboot (e.g. by power resource or in maintenance window).",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					BOOT_ORDER_APPLY_MODE_IMMEDIATE,
+					BOOT_ORDER_APPLY_MODE_STAGED,
+				}...),
+			},
+		},
+		"pending_boot_order": schema.ListAttribute{
+			Computed:            true,
+			MarkdownDescription: "Boot order (StructuredBootString entries) staged in BIOS settings, which will be applied during next host reboot. Null if there is no pending boot order change.",
+			Description:         "Boot order (StructuredBootString entries) staged in BIOS settings, which will be applied during next host reboot. Null if there is no pending boot order change.",
+			ElementType:         types.StringType,
+		},
 		"system_reset_type": schema.StringAttribute{
-			Required:            true,
-			MarkdownDescription: "Control how system will be reset to finish boot order change (if host is powered on).",
-			Description:         "Control how system will be reset to finish boot order change (if host is powered on).",
+			Optional:            true,
+			MarkdownDescription: "Control how system will be reset to finish boot order change (if host is powered on). Required when apply_mode is 'immediate'.",
+			Description:         "Control how system will be reset to finish boot order change (if host is powered on). Required when apply_mode is 'immediate'.",
 			Validators: []validator.String{
 				stringvalidator.OneOf([]string{
 					"ForceRestart",
@@ -102,13 +151,14 @@ func BootOrderSchema() map[string]schema.Attribute {
 		"job_timeout": schema.Int64Attribute{
 			Computed:            true,
 			Optional:            true,
-			Default:             int64default.StaticInt64(600),
+			Default:             int64default.StaticInt64(BOOT_ORDER_JOB_DEFAULT_TIMEOUT),
 			Description:         "Timeout in seconds for boot order change to finish.",
 			MarkdownDescription: "Timeout in seconds for boot order change to finish.",
 			Validators: []validator.Int64{
 				int64validator.AtLeast(240),
 			},
 		},
+		"required_host_state": RequiredHostStateSchema(),
 	}
 }
 
@@ -148,12 +198,14 @@ func (r *BootOrderResource) Create(ctx context.Context, req resource.CreateReque
 	var plan models.BootOrderResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	resp.Diagnostics.Append(validateBootOrderApplyMode(plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-boot_order"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -161,11 +213,17 @@ func (r *BootOrderResource) Create(ctx context.Context, req resource.CreateReque
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
+
+	// Fail fast if host is not in power state required by configuration
+	resp.Diagnostics.Append(checkRequiredHostState(api.Service, plan.RequiredHostState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Compare planned changes in boot order with current boot order options
 	var plannedBootOrder []string
@@ -177,27 +235,52 @@ func (r *BootOrderResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Fetch current boot order and check if planned boot order
 	// contains all requested devices
-	currentBootOrder, diags := validateBootOrderPlan(api.Service, plannedBootOrder)
+	prefixMode := plan.Mode.ValueString() == BOOT_ORDER_MODE_PREFIX
+	currentBootOrder, structuredBootOrder, diags := validateBootOrderPlan(api.Service, plannedBootOrder, prefixMode)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	// Boot order change must not be mixed with BIOS settings staged by someone else
+	diags = checkForeignPendingBiosSettings(api.Service, []string{PERSISTENT_BOOT_ORDER_KEY})
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
 	// Apply boot order change
-	diags = applyBootOrderPlan(api.Service, currentBootOrder, plannedBootOrder)
+	staged := plan.ApplyMode.ValueString() == BOOT_ORDER_APPLY_MODE_STAGED
+	diags = applyBootOrderPlan(api.Service, currentBootOrder, structuredBootOrder, staged)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
-	diags = waitTillBiosSettingsApplied(ctx, api.Service, plan.JobTimeout.ValueInt64(),
-		redfish.ResetType(plan.SystemResetType.ValueString()))
+	plan.BootOrderDeviceNames, diags = getBootOrderDeviceNames(currentBootOrder, structuredBootOrder[:len(plannedBootOrder)])
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
 
+	plan.PendingBootOrder, diags = getPendingBootOrderValue(structuredBootOrder, staged)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
+	if staged {
+		tflog.Info(ctx, "Boot order has been staged and will be applied during next host reboot")
+	} else {
+		diags = waitTillBiosSettingsApplied(ctx, api.Service, plan.JobTimeout.ValueInt64(),
+			redfish.ResetType(plan.SystemResetType.ValueString()))
+
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+	}
+
 	plan.Id = types.StringValue(BIOS_SETTINGS_ENDPOINT)
 
 	diags = resp.State.Set(ctx, &plan)
@@ -213,7 +296,7 @@ func (r *BootOrderResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Info(ctx, "resource-boot_order: read starts")
 
 	// Read Terraform prior state data into the model
-	var currState, newState models.BootOrderResourceModel
+	var currState models.BootOrderResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &currState)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -222,26 +305,93 @@ func (r *BootOrderResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &currState.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
-	diags := readCurrentBootOrder(api.Service, &newState)
+	defer ReleaseTargetSystem(api)
+
+	newState, diags := readBootOrderResourceState(ctx, api.Service, currState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-boot_order: read ends")
+}
+
+// readBootOrderResourceState reads current and pending boot order into new state. Settings which
+// are not reported by iRMC are taken from currState or set to their defaults, if not known (e.g. after import).
+func readBootOrderResourceState(ctx context.Context, service *gofish.Service,
+	currState models.BootOrderResourceModel) (newState models.BootOrderResourceModel, diags diag.Diagnostics) {
+
+	var priorBootOrder []string
+	if !currState.BootOrder.IsNull() && !currState.BootOrder.IsUnknown() {
+		diags.Append(currState.BootOrder.ElementsAs(ctx, &priorBootOrder, true)...)
+		if diags.HasError() {
+			return newState, diags
+		}
+	}
+
+	newState.Mode = currState.Mode
+	if newState.Mode.IsNull() {
+		newState.Mode = types.StringValue(BOOT_ORDER_MODE_FULL)
+	}
+
+	newState.ApplyMode = currState.ApplyMode
+	if newState.ApplyMode.IsNull() {
+		newState.ApplyMode = types.StringValue(BOOT_ORDER_APPLY_MODE_IMMEDIATE)
+	}
+
+	newState.BootOrder = types.ListNull(types.StringType)
+	newState.BootOrderDeviceNames = types.ListNull(types.StringType)
+	diags.Append(readCurrentBootOrder(service, priorBootOrder, &newState)...)
+	if diags.HasError() {
+		return newState, diags
+	}
+
+	// Staged boot order is not visible in /Bios until host reboot, so in staged mode
+	// it is reported as configured one to not report it as a drift
+	pendingBootOrder, d := readPendingBootOrder(service)
+	diags.Append(d...)
+	if diags.HasError() {
+		return newState, diags
+	}
+
+	newState.PendingBootOrder = types.ListNull(types.StringType)
+	if len(pendingBootOrder) > 0 {
+		var structuredBootOrder BootOrder
+		for _, item := range pendingBootOrder {
+			structuredBootOrder = append(structuredBootOrder, item.StructuredBootString)
+		}
+
+		newState.PendingBootOrder, d = getPendingBootOrderValue(structuredBootOrder, true)
+		diags.Append(d...)
+		if diags.HasError() {
+			return newState, diags
+		}
+
+		if newState.ApplyMode.ValueString() == BOOT_ORDER_APPLY_MODE_STAGED {
+			diags.Append(setBootOrderState(pendingBootOrder, priorBootOrder, &newState)...)
+			if diags.HasError() {
+				return newState, diags
+			}
+		}
+	}
+
 	newState.JobTimeout = currState.JobTimeout
+	if newState.JobTimeout.IsNull() {
+		newState.JobTimeout = types.Int64Value(BOOT_ORDER_JOB_DEFAULT_TIMEOUT)
+	}
+
 	newState.RedfishServer = currState.RedfishServer
 	newState.SystemResetType = currState.SystemResetType
 	newState.Id = types.StringValue(BIOS_SETTINGS_ENDPOINT)
 
-	diags = resp.State.Set(ctx, &newState)
-	resp.Diagnostics.Append(diags...)
-
-	tflog.Info(ctx, "resource-boot_order: read ends")
+	return newState, diags
 }
 
 func (r *BootOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -251,6 +401,8 @@ func (r *BootOrderResource) Update(ctx context.Context, req resource.UpdateReque
 	var plan models.BootOrderResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	resp.Diagnostics.Append(validateBootOrderApplyMode(plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -258,11 +410,17 @@ func (r *BootOrderResource) Update(ctx context.Context, req resource.UpdateReque
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
+
+	// Fail fast if host is not in power state required by configuration
+	resp.Diagnostics.Append(checkRequiredHostState(api.Service, plan.RequiredHostState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Compare planned changes in boot order with current boot order options
 	var plannedBootOrder []string
@@ -274,25 +432,51 @@ func (r *BootOrderResource) Update(ctx context.Context, req resource.UpdateReque
 
 	// Fetch current boot order and check if planned boot order
 	// contains all requested devices
-	currentBootOrder, diags := validateBootOrderPlan(api.Service, plannedBootOrder)
+	prefixMode := plan.Mode.ValueString() == BOOT_ORDER_MODE_PREFIX
+	currentBootOrder, structuredBootOrder, diags := validateBootOrderPlan(api.Service, plannedBootOrder, prefixMode)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	// Boot order change must not be mixed with BIOS settings staged by someone else
+	diags = checkForeignPendingBiosSettings(api.Service, []string{PERSISTENT_BOOT_ORDER_KEY})
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
 	// Apply boot order change
-	diags = applyBootOrderPlan(api.Service, currentBootOrder, plannedBootOrder)
+	staged := plan.ApplyMode.ValueString() == BOOT_ORDER_APPLY_MODE_STAGED
+	diags = applyBootOrderPlan(api.Service, currentBootOrder, structuredBootOrder, staged)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
-	diags = waitTillBootOrderApplied(ctx, api.Service, plan)
+	plan.BootOrderDeviceNames, diags = getBootOrderDeviceNames(currentBootOrder, structuredBootOrder[:len(plannedBootOrder)])
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
+	plan.PendingBootOrder, diags = getPendingBootOrderValue(structuredBootOrder, staged)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if staged {
+		tflog.Info(ctx, "Boot order has been staged and will be applied during next host reboot")
+	} else {
+		diags = waitTillBiosSettingsApplied(ctx, api.Service, plan.JobTimeout.ValueInt64(),
+			redfish.ResetType(plan.SystemResetType.ValueString()))
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+	}
+
 	plan.Id = types.StringValue("/redfish/v1/Systems/0/Bios/Settings")
 
 	diags = resp.State.Set(ctx, &plan)
@@ -314,22 +498,38 @@ func (r *BootOrderResource) ImportState(ctx context.Context, req resource.Import
 	tflog.Info(ctx, "resource-boot_order: import starts")
 
 	var config CommonImportConfig
-	err := json.Unmarshal([]byte(req.ID), &config)
+	server, err := parseImportID(req.ID, &config, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
 		return
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
+	currState := models.BootOrderResourceModel{
+		RedfishServer:   []models.RedfishServer{server},
+		BootOrder:       types.ListNull(types.StringType),
+		Mode:            types.StringNull(),
+		ApplyMode:       types.StringNull(),
+		SystemResetType: types.StringNull(),
+		JobTimeout:      types.Int64Null(),
+	}
+
+	api, err := ConnectTargetSystem(r.p, &currState.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
 	}
 
-	creds := []models.RedfishServer{server}
+	defer ReleaseTargetSystem(api)
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+	// Current boot order is read the same way as in Read, so that configuration
+	// describing current boot order does not show any difference after import
+	newState, diags := readBootOrderResourceState(ctx, api.Service, currState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 
 	tflog.Info(ctx, "resource-boot_order: import ends")
 }
@@ -344,137 +544,114 @@ func getDeviceNameFromStructureBootString(currentBootOrder []BootOrderEntry, str
 	return "NotFound" // FIXME/TODO
 }
 
-// applyBootOrderPlan tries to apply plannedBootOrder collecting required DeviceName
-// from structured boot string which is part of plannedBootOrder into system
-// pointed by service.
-func applyBootOrderPlan(service *gofish.Service, currentBootOrder []BootOrderEntry, plannedBootOrder BootOrder) (diags diag.Diagnostics) {
-	client := service.GetClient()
-	res, err := client.Get(BIOS_SETTINGS_ENDPOINT)
-	if err != nil {
-		diags.AddError("Reading /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
-		return diags
-	}
-
-	CloseResource(res.Body)
-
-	var v [][]string
+// translateBootOrderPlan converts entries of plannedBootOrder defined as DeviceName
+// into StructuredBootString using currentBootOrder. Entries already defined as
+// StructuredBootString or not known to the system are returned unchanged.
+func translateBootOrderPlan(currentBootOrder []BootOrderEntry, plannedBootOrder BootOrder) BootOrder {
+	structuredBootOrder := make(BootOrder, 0, len(plannedBootOrder))
 	for _, item := range plannedBootOrder {
-		entry := make([]string, 0, 2)
-		entry = append(entry, item)
-
-		// DeviceName must be obtained from current boot order setup
-		deviceName := getDeviceNameFromStructureBootString(currentBootOrder, item)
-		entry = append(entry, deviceName)
+		if isBootEntryInBootOrder(item, currentBootOrder) {
+			structuredBootOrder = append(structuredBootOrder, item)
+			continue
+		}
 
-		v = append(v, entry)
-	}
+		translated := item
+		for _, v := range currentBootOrder {
+			if v.DeviceName == item {
+				translated = v.StructuredBootString
+				break
+			}
+		}
 
-	payload := map[string]interface{}{
-		"Attributes": map[string]interface{}{
-			PERSISTENT_BOOT_ORDER_KEY: v,
-		},
+		structuredBootOrder = append(structuredBootOrder, translated)
 	}
 
-	res, err = client.PatchWithHeaders(BIOS_SETTINGS_ENDPOINT, payload,
-		map[string]string{HTTP_HEADER_IF_MATCH: res.Header.Get(HTTP_HEADER_ETAG)})
+	return structuredBootOrder
+}
 
-	if err != nil {
-		diags.AddError("Changing /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
+// validateBootOrderApplyMode checks that system_reset_type is defined, when boot order
+// change is applied immediately.
+func validateBootOrderApplyMode(plan models.BootOrderResourceModel) (diags diag.Diagnostics) {
+	if plan.ApplyMode.ValueString() == BOOT_ORDER_APPLY_MODE_STAGED {
 		return diags
 	}
 
-	CloseResource(res.Body)
-	return diags
-}
-
-// getBiosSettingsFutureAttributesNumber reads property Attributes from /Bios/Settings
-// and returns number of elements inside of the property or error information during processing
-// over diags.
-func getBiosSettingsFutureAttributesNumber(service *gofish.Service) (length int, diags diag.Diagnostics) {
-	client := service.GetClient()
-	res, err := client.Get(BIOS_SETTINGS_ENDPOINT)
-	if err != nil {
-		diags.AddError("Reading /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
-		return 0, diags
+	if plan.SystemResetType.IsNull() || len(plan.SystemResetType.ValueString()) == 0 {
+		diags.AddAttributeError(tkpath.Root("system_reset_type"), "Missing system_reset_type",
+			"system_reset_type must be defined when apply_mode is 'immediate'.")
 	}
 
-	defer CloseResource(res.Body)
+	return diags
+}
 
-	var config BiosSettings
-	bodyBytes, err := io.ReadAll(res.Body)
-	if err != nil {
-		diags.AddError("Reading body of /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
-		return 0, diags
+// getPendingBootOrderValue returns value of pending_boot_order for structuredBootOrder,
+// which is null when boot order change is not staged.
+func getPendingBootOrderValue(structuredBootOrder BootOrder, staged bool) (types.List, diag.Diagnostics) {
+	if !staged {
+		return types.ListNull(types.StringType), nil
 	}
 
-	err = json.Unmarshal(bodyBytes, &config)
-	if err != nil {
-		diags.AddError("Failed to unmarshal /redfish/v1/Systems/0/Bios/Settings response body", err.Error())
-		return 0, diags
+	entries := []attr.Value{}
+	for _, item := range structuredBootOrder {
+		entries = append(entries, types.StringValue(item))
 	}
 
-	return len(config.Attributes), diags
+	return types.ListValue(types.StringType, entries)
 }
 
-// waitTillBootOrderApplied supervises applying boot order from plan
-// and return possible errors during processing using diags.
-func waitTillBootOrderApplied(ctx context.Context, service *gofish.Service, plan models.BootOrderResourceModel) (diags diag.Diagnostics) {
-	poweredOn, err := isPoweredOn(service)
-	if err != nil {
-		diags.AddError("Could not retrieve current power state", err.Error())
-		return diags
+// getBootOrderDeviceNames returns list of DeviceName values matching structuredBootOrder.
+func getBootOrderDeviceNames(currentBootOrder []BootOrderEntry, structuredBootOrder BootOrder) (types.List, diag.Diagnostics) {
+	deviceNames := []attr.Value{}
+	for _, item := range structuredBootOrder {
+		deviceNames = append(deviceNames, types.StringValue(getDeviceNameFromStructureBootString(currentBootOrder, item)))
 	}
 
-	timeout := plan.JobTimeout.ValueInt64()
-	var logMsg = fmt.Sprintf("Process will wait with %d seconds timeout to finish", timeout)
-	tflog.Info(ctx, logMsg)
+	return types.ListValue(types.StringType, deviceNames)
+}
 
-	startTime := time.Now().Unix()
+// applyBootOrderPlan tries to apply plannedBootOrder collecting required DeviceName
+// from structured boot string which is part of plannedBootOrder into system
+// pointed by service. If staged, change is requested to be applied on next reset.
+func applyBootOrderPlan(service *gofish.Service, currentBootOrder []BootOrderEntry, plannedBootOrder BootOrder, staged bool) (diags diag.Diagnostics) {
+	var v [][]string
+	for _, item := range plannedBootOrder {
+		entry := make([]string, 0, 2)
+		entry = append(entry, item)
 
-	if !poweredOn {
-		err = changePowerState(service, true, timeout)
-	} else {
-		resetType := (redfish.ResetType)(plan.SystemResetType.ValueString())
-		err = resetHost(service, resetType, timeout)
-	}
+		// DeviceName must be obtained from current boot order setup
+		deviceName := getDeviceNameFromStructureBootString(currentBootOrder, item)
+		entry = append(entry, deviceName)
 
-	// Due to BIOS setting change it might happen that host will be powered off after
-	// BIOS POST phase, so to not break the process the error must be omitted
-	if err.Error() != "BIOS exited POST but host powered off" {
-		diags.AddError("Host could not be powered on to finish BIOS settings", err.Error())
-		return diags
+		v = append(v, entry)
 	}
 
-	if time.Now().Unix()-startTime > timeout {
-		diags.AddError("Job timeout exceeded after reset/power on while operation has not finished", "Terminate")
-		return diags
+	payload := map[string]interface{}{
+		"Attributes": map[string]interface{}{
+			PERSISTENT_BOOT_ORDER_KEY: v,
+		},
 	}
 
-	for {
-		numberOfKeysInMap, diags := getBiosSettingsFutureAttributesNumber(service)
-		if diags.HasError() {
+	if staged {
+		settings, err := readBiosRedfishSettings(service)
+		if err != nil {
+			diags.Append(redfishErrorDiagnostics("Error while reading @Redfish.Settings of /Systems/0/Bios", err)...)
 			return diags
 		}
 
-		/*
-		   At the moment the only way to check if process is finished is to check
-		   Attributes parameter of /Bios/Settings. In case of parameters not yet applied,
-		   it will contain only these which are planned to be applied. After process complete,
-		   it will contain all writable properties. It's not best mechanism, but the only one known as of now
-		*/
-		if numberOfKeysInMap > 5 {
-			var logMsg = fmt.Sprintf("Number of keys %d", numberOfKeysInMap)
-			tflog.Info(ctx, logMsg)
-			break
+		if isOnResetApplyTimeSupported(settings) {
+			payload["@Redfish.SettingsApplyTime"] = map[string]interface{}{
+				"ApplyTime": REDFISH_APPLY_TIME_ON_RESET,
+			}
 		}
+	}
 
-		time.Sleep(2 * time.Second)
-		if time.Now().Unix()-startTime > timeout {
-			diags.AddError("Job timeout exceeded while operation has not finished", "Terminate")
-			return diags
-		}
+	res, err := PatchWithEtag(service.GetClient(), BIOS_SETTINGS_ENDPOINT, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Changing /redfish/v1/Systems/0/Bios/Settings failed", err)...)
+		return diags
 	}
 
+	CloseResource(res.Body)
 	return diags
 }
 
@@ -523,24 +700,40 @@ func findAvailableAndNotPlannedBootEntries(currentBootOrder []BootOrderEntry, pl
 	return diff
 }
 
+// findDuplicatedBootEntries returns entries which occur more than once in bootOrder.
+func findDuplicatedBootEntries(bootOrder BootOrder) []string {
+	seen := make(map[string]struct{}, len(bootOrder))
+	var duplicates []string
+	for _, x := range bootOrder {
+		if _, found := seen[x]; found {
+			duplicates = append(duplicates, x)
+			continue
+		}
+		seen[x] = struct{}{}
+	}
+	return duplicates
+}
+
 // validateBootOrderPlan serves for validation of plannedBootOrder vs currently configuration boot order
-// As a result it returns obtained currentBootOrder and diagnostic logs.
-func validateBootOrderPlan(service *gofish.Service, plannedBootOrder BootOrder) (currentBootOrder []BootOrderEntry, diags diag.Diagnostics) {
+// As a result it returns obtained currentBootOrder, plannedBootOrder translated to StructuredBootString
+// entries and diagnostic logs. In prefixMode plannedBootOrder might contain only subset of boot entries,
+// which are placed in front of remaining entries kept in current order.
+func validateBootOrderPlan(service *gofish.Service, plannedBootOrder BootOrder, prefixMode bool) (currentBootOrder []BootOrderEntry, structuredBootOrder BootOrder, diags diag.Diagnostics) {
 	system, err := GetSystemResource(service)
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0", err.Error())
-		return currentBootOrder, diags
+		diags.Append(redfishErrorDiagnostics("Error while reading /Systems/0", err)...)
+		return currentBootOrder, structuredBootOrder, diags
 	}
 
 	rBios, err := system.Bios()
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0/Bios", err.Error())
-		return currentBootOrder, diags
+		diags.Append(redfishErrorDiagnostics("Error while reading /Systems/0/Bios", err)...)
+		return currentBootOrder, structuredBootOrder, diags
 	}
 
 	if len(rBios.Attributes) == 0 {
 		diags.AddError("No BIOS data for BIOS attributes yet", rBios.ODataID)
-		return currentBootOrder, diags
+		return currentBootOrder, structuredBootOrder, diags
 	}
 
 	// Read current boot order
@@ -548,8 +741,8 @@ func validateBootOrderPlan(service *gofish.Service, plannedBootOrder BootOrder)
 		bootOrderStr, _ := json.Marshal(currentBootConfigOrder)
 		var bootOrderList []BootEntry
 		if err := json.Unmarshal(bootOrderStr, &bootOrderList); err != nil {
-			diags.AddError("PersistentBootConfigOrder could not be unmarshalled", err.Error())
-			return currentBootOrder, diags
+			diags.Append(redfishErrorDiagnostics("PersistentBootConfigOrder could not be unmarshalled", err)...)
+			return currentBootOrder, structuredBootOrder, diags
 		}
 
 		for _, item := range bootOrderList {
@@ -559,6 +752,10 @@ func validateBootOrderPlan(service *gofish.Service, plannedBootOrder BootOrder)
 			currentBootOrder = append(currentBootOrder, entry)
 		}
 
+		// Entries might be defined by DeviceName, so they have to be translated before validation
+		plannedBootOrder = translateBootOrderPlan(currentBootOrder, plannedBootOrder)
+		structuredBootOrder = plannedBootOrder
+
 		// If any planned option does not exist on currently configured boot order, raise error
 		for _, v := range plannedBootOrder {
 			if !isBootEntryInBootOrder(v, currentBootOrder) {
@@ -568,7 +765,21 @@ func validateBootOrderPlan(service *gofish.Service, plannedBootOrder BootOrder)
 		}
 
 		if diags.HasError() {
-			return currentBootOrder, diags
+			return currentBootOrder, structuredBootOrder, diags
+		}
+
+		if prefixMode {
+			if duplicates := findDuplicatedBootEntries(plannedBootOrder); len(duplicates) > 0 {
+				var details = fmt.Sprintf("Planned boot order contains duplicated boot options '%s'",
+					strings.Join(duplicates, ", "))
+				diags.AddError("Planned boot order contains duplicated boot options", details)
+				return currentBootOrder, structuredBootOrder, diags
+			}
+
+			structuredBootOrder = append(BootOrder{}, plannedBootOrder...)
+			structuredBootOrder = append(structuredBootOrder,
+				findAvailableAndNotPlannedBootEntries(currentBootOrder, plannedBootOrder)...)
+			return currentBootOrder, structuredBootOrder, diags
 		}
 
 		// If planned configuration does not contain all options for the system, stop
@@ -576,34 +787,53 @@ func validateBootOrderPlan(service *gofish.Service, plannedBootOrder BootOrder)
 			var details = fmt.Sprintf("Planned boot order has length of %d, while current length of %d",
 				len(plannedBootOrder), len(currentBootOrder))
 			diags.AddError("Planned boot order has different length than currently configured boot order", details)
-			return currentBootOrder, diags
+			return currentBootOrder, structuredBootOrder, diags
 		}
 
 		if diff := findAvailableAndNotPlannedBootEntries(currentBootOrder, plannedBootOrder); len(diff) > 0 {
 			var details = fmt.Sprintf("Planned boot order does not contain available boot options '%s'",
 				strings.Join(diff, ""))
 			diags.AddError("Planned boot order does not contain all available boot options", details)
-			return currentBootOrder, diags
+			return currentBootOrder, structuredBootOrder, diags
 		}
 
-		return currentBootOrder, diags
+		return currentBootOrder, structuredBootOrder, diags
 	} else {
 		diags.AddError("Missing PersistentBootConfigOrder parameter in attribute", "Server returned unexpected content")
-		return currentBootOrder, diags
+		return currentBootOrder, structuredBootOrder, diags
+	}
+}
+
+// parseBootOrderAttribute returns boot order stored in BIOS attributes. If attributes
+// do not contain boot order, false is returned.
+func parseBootOrderAttribute(attributes redfish.SettingsAttributes) ([]BootEntry, bool, error) {
+	value, ok := attributes[PERSISTENT_BOOT_ORDER_KEY]
+	if !ok {
+		return nil, false, nil
+	}
+
+	bootOrderStr, _ := json.Marshal(value)
+	var bootOrderList []BootEntry
+	if err := json.Unmarshal(bootOrderStr, &bootOrderList); err != nil {
+		return nil, false, fmt.Errorf("PersistentBootConfigOrder could not be unmarshalled: %w", err)
 	}
+
+	return bootOrderList, true, nil
 }
 
 // readCurrentBootOrder reads currently configured boot order and save it to state.
-func readCurrentBootOrder(service *gofish.Service, state *models.BootOrderResourceModel) (diags diag.Diagnostics) {
+// Entries are stored using the same notation (StructuredBootString or DeviceName)
+// as used by priorBootOrder.
+func readCurrentBootOrder(service *gofish.Service, priorBootOrder BootOrder, state *models.BootOrderResourceModel) (diags diag.Diagnostics) {
 	system, err := GetSystemResource(service)
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error while reading /Systems/0", err)...)
 		return diags
 	}
 
 	rBios, err := system.Bios()
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0/Bios", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error while reading /Systems/0/Bios", err)...)
 		return diags
 	}
 
@@ -613,24 +843,68 @@ func readCurrentBootOrder(service *gofish.Service, state *models.BootOrderResour
 	}
 
 	// Read current boot order
-	if currentBootConfigOrder, persistentBiosConfigExists := rBios.Attributes[PERSISTENT_BOOT_ORDER_KEY]; persistentBiosConfigExists {
-		bootOrderStr, _ := json.Marshal(currentBootConfigOrder)
-		var bootOrderList []BootEntry
-		if err := json.Unmarshal(bootOrderStr, &bootOrderList); err != nil {
-			diags.AddError("PersistentBootConfigOrder could not be unmarshalled", err.Error())
-			return diags
-		}
+	bootOrderList, found, err := parseBootOrderAttribute(rBios.Attributes)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("PersistentBootConfigOrder could not be read", err)...)
+		return diags
+	}
 
-		bootOrder := []attr.Value{}
-		for _, item := range bootOrderList {
+	if found {
+		return setBootOrderState(bootOrderList, priorBootOrder, state)
+	}
+
+	return diags
+}
+
+// readPendingBootOrder returns boot order staged in BIOS settings object, which differs from
+// currently applied boot order. Empty list is returned, if there is no pending boot order change.
+func readPendingBootOrder(service *gofish.Service) (pending []BootEntry, diags diag.Diagnostics) {
+	staged, err := readPendingBiosSettings(service)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Error while reading pending BIOS settings", err)...)
+		return pending, diags
+	}
+
+	pending, _, err = parseBootOrderAttribute(staged)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Pending PersistentBootConfigOrder could not be read", err)...)
+	}
+
+	return pending, diags
+}
+
+// setBootOrderState saves bootOrderList into state. Entries are stored using the same
+// notation (StructuredBootString or DeviceName) as used by priorBootOrder.
+func setBootOrderState(bootOrderList []BootEntry, priorBootOrder BootOrder, state *models.BootOrderResourceModel) (diags diag.Diagnostics) {
+	prior := make(map[string]struct{}, len(priorBootOrder))
+	for _, x := range priorBootOrder {
+		prior[x] = struct{}{}
+	}
+
+	// In prefix mode only leading entries are managed by the resource
+	if state.Mode.ValueString() == BOOT_ORDER_MODE_PREFIX && len(priorBootOrder) < len(bootOrderList) {
+		bootOrderList = bootOrderList[:len(priorBootOrder)]
+	}
+
+	bootOrder := []attr.Value{}
+	deviceNames := []attr.Value{}
+	for _, item := range bootOrderList {
+		_, structuredUsed := prior[item.StructuredBootString]
+		_, deviceNameUsed := prior[item.DeviceName]
+		if deviceNameUsed && !structuredUsed {
+			bootOrder = append(bootOrder, types.StringValue(item.DeviceName))
+		} else {
 			bootOrder = append(bootOrder, types.StringValue(item.StructuredBootString))
 		}
 
-		state.BootOrder, diags = types.ListValue(types.StringType, bootOrder)
-		if diags.HasError() {
-			return diags
-		}
+		deviceNames = append(deviceNames, types.StringValue(item.DeviceName))
+	}
+
+	state.BootOrder, diags = types.ListValue(types.StringType, bootOrder)
+	if diags.HasError() {
+		return diags
 	}
 
+	state.BootOrderDeviceNames, diags = types.ListValue(types.StringType, deviceNames)
 	return diags
 }
@@ -18,11 +18,15 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
 	"testing"
 
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -82,6 +86,176 @@ func TestAccRedfishBootOrder_negative_wrongBootOrder(t *testing.T) {
 	})
 }
 
+func TestAccRedfishBootOrder_prefix(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceBootOrderPrefixConfig(
+					creds, os.Getenv("TF_TESTING_BOOT_ORDER_LIST_TOO_SHORT"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(bo_name, "mode", "prefix"),
+					resource.TestCheckResourceAttr(bo_name, "id", "/redfish/v1/Systems/0/Bios/Settings"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishBootOrder_staged(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceBootOrderStagedConfig(
+					creds, os.Getenv("TF_TESTING_BOOT_ORDER_LIST_OPPOSITE"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(bo_name, "apply_mode", "staged"),
+					resource.TestCheckNoResourceAttr(bo_name, "system_reset_type"),
+					resource.TestCheckResourceAttrSet(bo_name, "pending_boot_order.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateBootOrderApplyMode(t *testing.T) {
+	plan := models.BootOrderResourceModel{
+		ApplyMode:       types.StringValue(BOOT_ORDER_APPLY_MODE_IMMEDIATE),
+		SystemResetType: types.StringNull(),
+	}
+
+	if diags := validateBootOrderApplyMode(plan); !diags.HasError() {
+		t.Errorf("missing system_reset_type must be reported in immediate mode")
+	}
+
+	plan.ApplyMode = types.StringValue(BOOT_ORDER_APPLY_MODE_STAGED)
+	if diags := validateBootOrderApplyMode(plan); diags.HasError() {
+		t.Errorf("system_reset_type must not be required in staged mode")
+	}
+}
+
+func TestReadPendingBootOrder(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	current := []interface{}{
+		[]interface{}{"NIC.LOM.1.2.IPv4PXE", "PCI LAN: IPv4 PXE (LOM 1)"},
+		[]interface{}{"HD.Emb.0.5", "UEFI: Embedded HDD"},
+	}
+	staged := []interface{}{current[1], current[0]}
+
+	server.Set(BIOS_ENDPOINT, map[string]interface{}{
+		"Attributes":        map[string]interface{}{PERSISTENT_BOOT_ORDER_KEY: current},
+		"@Redfish.Settings": map[string]interface{}{"SettingsObject": mockRedfishLink(BIOS_SETTINGS_ENDPOINT)},
+	})
+	server.Set(BIOS_SETTINGS_ENDPOINT, map[string]interface{}{
+		"Attributes": map[string]interface{}{PERSISTENT_BOOT_ORDER_KEY: current},
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	pending, diags := readPendingBootOrder(api.Service)
+	if diags.HasError() || len(pending) != 0 {
+		t.Fatalf("expected no pending boot order, got %v (%v)", pending, diags)
+	}
+
+	server.Set(BIOS_SETTINGS_ENDPOINT, map[string]interface{}{
+		"Attributes": map[string]interface{}{PERSISTENT_BOOT_ORDER_KEY: staged},
+	})
+
+	pending, diags = readPendingBootOrder(api.Service)
+	if diags.HasError() || len(pending) != 2 || pending[0].StructuredBootString != "HD.Emb.0.5" {
+		t.Fatalf("expected staged boot order, got %v (%v)", pending, diags)
+	}
+
+	state := models.BootOrderResourceModel{Mode: types.StringValue(BOOT_ORDER_MODE_FULL)}
+	if diags = setBootOrderState(pending, BootOrder{"UEFI: Embedded HDD", "NIC.LOM.1.2.IPv4PXE"}, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	if first := state.BootOrder.Elements()[0].(types.String).ValueString(); first != "UEFI: Embedded HDD" {
+		t.Errorf("expected staged entry in notation of prior boot order, got '%s'", first)
+	}
+}
+
+func TestReadBootOrderResourceStateAfterImport(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	current := []interface{}{
+		[]interface{}{"NIC.LOM.1.2.IPv4PXE", "PCI LAN: IPv4 PXE (LOM 1)"},
+		[]interface{}{"HD.Emb.0.5", "UEFI: Embedded HDD"},
+	}
+
+	server.Set(BIOS_ENDPOINT, map[string]interface{}{
+		"Attributes":        map[string]interface{}{PERSISTENT_BOOT_ORDER_KEY: current},
+		"@Redfish.Settings": map[string]interface{}{"SettingsObject": mockRedfishLink(BIOS_SETTINGS_ENDPOINT)},
+	})
+	server.Set(BIOS_SETTINGS_ENDPOINT, map[string]interface{}{
+		"Attributes": map[string]interface{}{PERSISTENT_BOOT_ORDER_KEY: current},
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	// State after import contains only server configuration
+	state, diags := readBootOrderResourceState(context.Background(), api.Service, models.BootOrderResourceModel{
+		BootOrder:       types.ListNull(types.StringType),
+		Mode:            types.StringNull(),
+		ApplyMode:       types.StringNull(),
+		SystemResetType: types.StringNull(),
+		JobTimeout:      types.Int64Null(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	if len(state.BootOrder.Elements()) != 2 || state.BootOrder.Elements()[0].(types.String).ValueString() != "NIC.LOM.1.2.IPv4PXE" {
+		t.Errorf("expected current boot order, got %v", state.BootOrder)
+	}
+
+	if state.Mode.ValueString() != BOOT_ORDER_MODE_FULL || state.ApplyMode.ValueString() != BOOT_ORDER_APPLY_MODE_IMMEDIATE ||
+		state.JobTimeout.ValueInt64() != BOOT_ORDER_JOB_DEFAULT_TIMEOUT || !state.PendingBootOrder.IsNull() {
+		t.Errorf("expected default settings, got %v", state)
+	}
+}
+
+func TestFindDuplicatedBootEntries(t *testing.T) {
+	duplicates := findDuplicatedBootEntries(BootOrder{"HD.Emb.0.5", "NIC.LOM.1.2.IPv4PXE", "HD.Emb.0.5"})
+	if len(duplicates) != 1 || duplicates[0] != "HD.Emb.0.5" {
+		t.Errorf("unexpected duplicates %v", duplicates)
+	}
+}
+
+func TestTranslateBootOrderPlan(t *testing.T) {
+	current := []BootOrderEntry{
+		{StructuredBootString: "NIC.LOM.1.2.IPv4PXE", DeviceName: "PCI LAN: IPv4 PXE (LOM 1)"},
+		{StructuredBootString: "HD.Emb.0.5", DeviceName: "UEFI: Embedded HDD"},
+	}
+
+	translated := translateBootOrderPlan(current, BootOrder{"UEFI: Embedded HDD", "NIC.LOM.1.2.IPv4PXE", "Unknown"})
+	expected := BootOrder{"HD.Emb.0.5", "NIC.LOM.1.2.IPv4PXE", "Unknown"}
+	if len(translated) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(translated))
+	}
+
+	for i := range expected {
+		if translated[i] != expected[i] {
+			t.Errorf("entry %d: expected '%s', got '%s'", i, expected[i], translated[i])
+		}
+	}
+}
+
 func testAccRedfishResourceBootOrderConfig(testingInfo TestingServerCredentials,
 	boot_order string,
 ) string {
@@ -105,3 +279,52 @@ func testAccRedfishResourceBootOrderConfig(testingInfo TestingServerCredentials,
 		boot_order,
 	)
 }
+
+func testAccRedfishResourceBootOrderStagedConfig(testingInfo TestingServerCredentials,
+	boot_order string,
+) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_boot_order" "bo" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+        apply_mode = "staged"
+        boot_order = %s
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		boot_order,
+	)
+}
+
+func testAccRedfishResourceBootOrderPrefixConfig(testingInfo TestingServerCredentials,
+	boot_order string,
+) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_boot_order" "bo" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+        mode = "prefix"
+        boot_order = %s
+        system_reset_type = "ForceRestart"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		boot_order,
+	)
+}
@@ -22,32 +22,34 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
+	"slices"
 
 	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/redfish"
 )
 
-type bootSourceOverrideEndpoints struct {
-	bootConfigOemEndpoint string
-}
+const (
+	BOOT_OVERRIDE_TARGET   = "boot_source_override_target"
+	BOOT_OVERRIDE_UEFIHTTP = "UefiHttp"
+	// Misspelled value accepted by first versions of the resource, it is handled as Continuous.
+	BOOT_OVERRIDE_CONTINUES = "Continues"
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BootSourceOverrideResource{}
+var _ resource.ResourceWithUpgradeState = &BootSourceOverrideResource{}
 
 func NewBootSourceOverrideResource() resource.Resource {
 	return &BootSourceOverrideResource{}
@@ -66,43 +68,47 @@ func BootSourceOverrideSchema() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Computed:            true,
-			MarkdownDescription: "ID of boot source override resource resource on iRMC.",
-			Description:         "ID of boot source override resource resource on iRMC.",
+			MarkdownDescription: "ID of boot source override resource on iRMC.",
+			Description:         "ID of boot source override resource on iRMC.",
 		},
-		"boot_source_override_target": schema.StringAttribute{
+		BOOT_OVERRIDE_TARGET: schema.StringAttribute{
 			Required:            true,
-			MarkdownDescription: "Requested boot source override target device instead of normal boot device.",
-			Description:         "Requested boot source override target device instead of normal boot device.",
+			MarkdownDescription: "Boot device used instead of the device defined by persistent boot order.",
+			Description:         "Boot device used instead of the device defined by persistent boot order.",
 			Validators: []validator.String{
 				stringvalidator.OneOf([]string{
-					"Pxe",
-					"Cd",
-					"Hdd",
-					"BiosSetup",
+					string(redfish.PxeBootSourceOverrideTarget),
+					string(redfish.CdBootSourceOverrideTarget),
+					string(redfish.HddBootSourceOverrideTarget),
+					string(redfish.BiosSetupBootSourceOverrideTarget),
+					BOOT_OVERRIDE_UEFIHTTP,
 				}...),
 			},
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.RequiresReplace(),
-			},
 		},
 		"boot_source_override_enabled": schema.StringAttribute{
 			Required:            true,
-			MarkdownDescription: "Requested boot source override timeline.",
-			Description:         "Requested boot source override timeline.",
+			MarkdownDescription: "Defines whether override is valid only for next boot or until it is disabled. Value 'Continues' is accepted as deprecated spelling of 'Continuous'.",
+			Description:         "Defines whether override is valid only for next boot or until it is disabled. Value 'Continues' is accepted as deprecated spelling of 'Continuous'.",
 			Validators: []validator.String{
 				stringvalidator.OneOf([]string{
-					"Once",
-					"Continues",
+					string(redfish.OnceBootSourceOverrideEnabled),
+					string(redfish.ContinuousBootSourceOverrideEnabled),
+					BOOT_OVERRIDE_CONTINUES,
 				}...),
 			},
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.RequiresReplace(),
+		},
+		"http_boot_uri": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "URI of the boot image used by UEFI HTTP boot. Required if boot_source_override_target is 'UefiHttp'.",
+			Description:         "URI of the boot image used by UEFI HTTP boot. Required if boot_source_override_target is 'UefiHttp'.",
+			Validators: []validator.String{
+				validators.ChangeToRequired(BOOT_OVERRIDE_TARGET, BOOT_OVERRIDE_UEFIHTTP),
 			},
 		},
 		"system_reset_type": schema.StringAttribute{
-			Required:            true,
-			MarkdownDescription: "Control how system will be reset to finish boot source override change (if host is powered on).",
-			Description:         "Control how system will be reset to finish boot source override change (if host is powered on).",
+			Optional:            true,
+			MarkdownDescription: "Control how system will be reset immediately after override change. If not set, override is applied during next boot.",
+			Description:         "Control how system will be reset immediately after override change. If not set, override is applied during next boot.",
 			Validators: []validator.String{
 				stringvalidator.OneOf([]string{
 					"ForceRestart",
@@ -110,16 +116,13 @@ func BootSourceOverrideSchema() map[string]schema.Attribute {
 					"PowerCycle",
 				}...),
 			},
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.RequiresReplace(),
-			},
 		},
 		"job_timeout": schema.Int64Attribute{
 			Computed:            true,
 			Optional:            true,
 			Default:             int64default.StaticInt64(600),
-			Description:         "Timeout in seconds for boot source override change to finish.",
-			MarkdownDescription: "Timeout in seconds for boot source override change to finish.",
+			Description:         "Timeout in seconds for host reset after boot source override change to finish.",
+			MarkdownDescription: "Timeout in seconds for host reset after boot source override change to finish.",
 			Validators: []validator.Int64{
 				int64validator.AtLeast(240),
 			},
@@ -129,11 +132,74 @@ func BootSourceOverrideSchema() map[string]schema.Attribute {
 
 func (r *BootSourceOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "The resource is used to control (read or modify) boot source override settings on Fujitsu server equipped with iRMC controller.",
-		Description:         "The resource is used to control (read or modify) boot source override settings on Fujitsu server equipped with iRMC controller.",
+		MarkdownDescription: "The resource is used to control (read or modify) one-time or continuous boot source override (Redfish Boot.BootSourceOverrideTarget) on Fujitsu server equipped with iRMC controller.",
+		Description:         "The resource is used to control (read or modify) one-time or continuous boot source override (Redfish Boot.BootSourceOverrideTarget) on Fujitsu server equipped with iRMC controller.",
 		Attributes:          BootSourceOverrideSchema(),
 		Blocks:              RedfishServerResourceBlockMap(),
+		Version:             1,
+	}
+}
+
+// bootSourceOverrideSchemaV0 returns attributes of schema version 0, in which override has been
+// configured through OEM BootConfig endpoint and every change required replacement of the resource.
+func bootSourceOverrideSchemaV0() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		BOOT_OVERRIDE_TARGET: schema.StringAttribute{
+			Required: true,
+		},
+		"boot_source_override_enabled": schema.StringAttribute{
+			Required: true,
+		},
+		"system_reset_type": schema.StringAttribute{
+			Required: true,
+		},
+		"job_timeout": schema.Int64Attribute{
+			Computed: true,
+			Optional: true,
+		},
+	}
+}
+
+// UpgradeState moves state of schema version 0 to the standard Boot property of the system.
+func (r *BootSourceOverrideResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: bootSourceOverrideSchemaV0(),
+				Blocks:     RedfishServerResourceBlockMap(),
+			},
+			StateUpgrader: upgradeBootSourceOverrideStateV0,
+		},
+	}
+}
+
+// upgradeBootSourceOverrideStateV0 converts state of schema version 0. Id of the OEM BootConfig endpoint
+// is kept until next refresh replaces it by id of the system.
+func upgradeBootSourceOverrideStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior models.BootSourceOverrideResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := models.BootSourceOverrideResourceModel{
+		Id:                        prior.Id,
+		RedfishServer:             prior.RedfishServer,
+		BootSourceOverrideTarget:  prior.BootSourceOverrideTarget,
+		BootSourceOverrideEnabled: prior.BootSourceOverrideEnabled,
+		HttpBootUri:               types.StringNull(),
+		SystemResetType:           prior.SystemResetType,
+		JobTimeout:                prior.JobTimeout,
 	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.AddWarning("Boot source override moved to Boot property of the system",
+		"Override is now managed through standard Redfish Boot property of the system instead of OEM BootConfig endpoint. "+
+			"Changes are applied in place, one-time override consumed during boot is not reported as drift and "+
+			"destroying the resource disables the override. See documentation of the resource for migration notes.")
 }
 
 func (r *BootSourceOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -163,12 +229,13 @@ func (r *BootSourceOverrideResource) Create(ctx context.Context, req resource.Cr
 	var plan models.BootSourceOverrideResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-boot_source_override"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -176,35 +243,26 @@ func (r *BootSourceOverrideResource) Create(ctx context.Context, req resource.Cr
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: %s", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	id, err := bootOverrideApply(api.Service, plan.BootSourceOverrideTarget.ValueString(),
+		bootOverrideEnabledValue(plan.BootSourceOverrideEnabled.ValueString()), plan.HttpBootUri.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error reported by apply procedure", err)...)
 		return
 	}
 
-	endp := getBootSourceOverrideEndpoints(isFsas)
-
-	err = bootSourceOverrideApply(api, &plan, endp.bootConfigOemEndpoint)
+	err = bootOverrideReset(ctx, api.Service, &plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reported by apply procedure %s", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error reported by reset procedure", err)...)
 		return
 	}
 
-	resetType := (redfish.ResetType)(plan.SystemResetType.ValueString())
-	timeout := plan.JobTimeout.ValueInt64()
-	err = resetOrPowerOnHostWithPostCheck(api.Service, resetType, timeout)
-	if err != nil {
-		resp.Diagnostics.AddError("Error reported by reset procedure %s", err.Error())
-		return
-	}
-
-	plan.Id = types.StringValue(endp.bootConfigOemEndpoint)
+	plan.Id = types.StringValue(id)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -217,73 +275,250 @@ func (r *BootSourceOverrideResource) Create(ctx context.Context, req resource.Cr
 
 func (r *BootSourceOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Info(ctx, "resource-boot_source_override: read starts")
+
+	var state models.BootSourceOverrideResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	boot, id, err := readSystemBootProperties(api.Service)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while reading boot properties of the system", err)...)
+		return
+	}
+
+	// One-time override is consumed by the host during next boot and system reports
+	// it as disabled afterwards. It's expected behavior, so state must not be changed.
+	consumed := state.BootSourceOverrideEnabled.ValueString() == string(redfish.OnceBootSourceOverrideEnabled) &&
+		boot.BootSourceOverrideEnabled == string(redfish.DisabledBootSourceOverrideEnabled)
+	if !consumed {
+		state.BootSourceOverrideTarget = types.StringValue(boot.BootSourceOverrideTarget)
+		if bootOverrideEnabledValue(state.BootSourceOverrideEnabled.ValueString()) != boot.BootSourceOverrideEnabled {
+			state.BootSourceOverrideEnabled = types.StringValue(boot.BootSourceOverrideEnabled)
+		}
+		if !state.HttpBootUri.IsNull() && boot.HttpBootUri != nil {
+			state.HttpBootUri = types.StringValue(*boot.HttpBootUri)
+		}
+	}
+
+	state.Id = types.StringValue(id)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Info(ctx, "resource-boot_source_override: read ends")
 }
 
 func (r *BootSourceOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Info(ctx, "resource-boot_source_override: update starts")
+
+	var plan, state models.BootSourceOverrideResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-boot_source_override"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	// Only change of override itself requires communication with the system
+	if !plan.BootSourceOverrideTarget.Equal(state.BootSourceOverrideTarget) ||
+		!plan.BootSourceOverrideEnabled.Equal(state.BootSourceOverrideEnabled) ||
+		!plan.HttpBootUri.Equal(state.HttpBootUri) {
+		api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+			return
+		}
+
+		defer ReleaseTargetSystem(api)
+
+		_, err = bootOverrideApply(api.Service, plan.BootSourceOverrideTarget.ValueString(),
+			bootOverrideEnabledValue(plan.BootSourceOverrideEnabled.ValueString()), plan.HttpBootUri.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Error reported by apply procedure", err)...)
+			return
+		}
+
+		err = bootOverrideReset(ctx, api.Service, &plan)
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Error reported by reset procedure", err)...)
+			return
+		}
+	}
+
+	plan.Id = state.Id
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Info(ctx, "resource-boot_source_override: update ends")
 }
 
 func (r *BootSourceOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "resource-boot_source_override: delete starts")
+
+	var state models.BootSourceOverrideResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, state.RedfishServer)
+	var resource_name = "resource-boot_source_override"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	// Removal of the resource restores boot from persistent boot order
+	_, err = bootOverrideApply(api.Service, "", string(redfish.DisabledBootSourceOverrideEnabled), "")
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while disabling boot override", err)...)
+		return
+	}
+
 	resp.State.RemoveResource(ctx)
 	tflog.Info(ctx, "resource-boot_source_override: delete ends")
 }
 
-type bootConfig struct {
-	BootDevice          string `json:"BootDevice"`
-	NextBootOnlyEnabled bool   `json:"NextBootOnlyEnabled"`
-	Etag                string `json:"@odata.etag"`
+type systemBootProperties struct {
+	BootSourceOverrideTarget  string   `json:"BootSourceOverrideTarget"`
+	BootSourceOverrideEnabled string   `json:"BootSourceOverrideEnabled"`
+	HttpBootUri               *string  `json:"HttpBootUri,omitempty"`
+	AllowableTargets          []string `json:"BootSourceOverrideTarget@Redfish.AllowableValues"`
+}
+
+type systemBootObject struct {
+	Boot systemBootProperties `json:"Boot"`
 }
 
-func bootSourceOverrideApply(api *gofish.APIClient, plan *models.BootSourceOverrideResourceModel, bootConfigOemEndpoint string) error {
-	resp, err := api.Get(bootConfigOemEndpoint)
+// readSystemBootProperties reads Boot property of system accessed by service.
+// As a result Boot content and ODataID of the system are returned.
+func readSystemBootProperties(service *gofish.Service) (boot systemBootProperties, id string, err error) {
+	system, err := GetSystemResource(service)
 	if err != nil {
-		return fmt.Errorf("GET on /BootConfig finished with error '%w'", err)
+		return boot, id, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("GET on /BootConfig finished with status code %d", resp.StatusCode)
+	id = system.ODataID
+	res, err := service.GetClient().Get(id)
+	if err != nil {
+		return boot, id, fmt.Errorf("GET on %s finished with error '%w'", id, err)
 	}
 
-	bodyBytes, err := io.ReadAll(resp.Body)
+	defer CloseResource(res.Body)
+
+	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return fmt.Errorf("error during read of /BootConfig GET response body '%w'", err)
+		return boot, id, fmt.Errorf("error during read of %s GET response body '%w'", id, err)
 	}
 
-	CloseResource(resp.Body)
-
-	var config bootConfig
+	var config systemBootObject
 	if err = json.Unmarshal(bodyBytes, &config); err != nil {
-		return fmt.Errorf("error during unmarshal of /BootConfig GET response '%w'", err)
+		return boot, id, fmt.Errorf("error during unmarshal of %s GET response '%w'", id, err)
 	}
 
-	config.BootDevice = plan.BootSourceOverrideTarget.ValueString()
-	if plan.BootSourceOverrideEnabled.ValueString() == "Once" {
-		config.NextBootOnlyEnabled = true
-	} else {
-		config.NextBootOnlyEnabled = false
+	return config.Boot, id, nil
+}
+
+// isHttpBootSupported checks whether system reports support for UEFI HTTP boot
+// with configurable boot URI.
+func isHttpBootSupported(boot systemBootProperties) bool {
+	if boot.HttpBootUri == nil {
+		return false
 	}
 
-	headers := map[string]string{HTTP_HEADER_IF_MATCH: config.Etag}
-	resp, err = api.PatchWithHeaders(bootConfigOemEndpoint, config, headers)
-	if err != nil {
-		return fmt.Errorf("error during Patch of /BootConfig '%s'", err.Error())
+	// Not every firmware reports allowable values, so their absence is not treated as lack of support
+	if len(boot.AllowableTargets) > 0 {
+		return slices.Contains(boot.AllowableTargets, BOOT_OVERRIDE_UEFIHTTP)
 	}
 
-	CloseResource(resp.Body)
-	return nil
+	return true
 }
 
-func getBootSourceOverrideEndpoints(isFsas bool) bootSourceOverrideEndpoints {
-	if isFsas {
-		return bootSourceOverrideEndpoints{
-			bootConfigOemEndpoint: fmt.Sprintf("/redfish/v1/Systems/0/Oem/%s/BootConfig", FSAS),
-		}
-	} else {
-		return bootSourceOverrideEndpoints{
-			bootConfigOemEndpoint: fmt.Sprintf("/redfish/v1/Systems/0/Oem/%s/BootConfig", TS_FUJITSU),
+// bootOverrideApply sets Boot.BootSourceOverrideTarget and Boot.BootSourceOverrideEnabled
+// of system accessed by service. Empty target is not sent to the system. In case of UEFI HTTP
+// target, httpBootUri is applied if BIOS supports it. As a result ODataID of modified system is returned.
+func bootOverrideApply(service *gofish.Service, target string, enabled string, httpBootUri string) (string, error) {
+	current, id, err := readSystemBootProperties(service)
+	if err != nil {
+		return "", err
+	}
+
+	boot := map[string]interface{}{
+		"BootSourceOverrideEnabled": enabled,
+	}
+
+	if len(target) > 0 {
+		boot["BootSourceOverrideTarget"] = target
+	}
+
+	if target == BOOT_OVERRIDE_UEFIHTTP {
+		if !isHttpBootSupported(current) {
+			return "", fmt.Errorf("system BIOS does not support UEFI HTTP boot with HttpBootUri")
 		}
+
+		boot["HttpBootUri"] = httpBootUri
+	}
+
+	payload := map[string]interface{}{
+		"Boot": boot,
 	}
+
+	res, err := PatchWithEtag(service.GetClient(), id, payload)
+	if err != nil {
+		return "", err
+	}
+
+	CloseResource(res.Body)
+	return id, nil
+}
+
+// bootOverrideReset resets (or powers on) host if plan requests immediate reset.
+// Host booting into BIOS setup never leaves POST, so in that case reset is treated
+// as finished as soon as BIOS enters POST phase.
+func bootOverrideReset(ctx context.Context, service *gofish.Service, plan *models.BootSourceOverrideResourceModel) error {
+	if plan.SystemResetType.IsNull() || plan.SystemResetType.IsUnknown() {
+		return nil
+	}
+
+	resetType := redfish.ResetType(plan.SystemResetType.ValueString())
+	if plan.BootSourceOverrideTarget.ValueString() == string(redfish.BiosSetupBootSourceOverrideTarget) {
+		return resetOrPowerOnHostIntoPOST(ctx, service, resetType, plan.JobTimeout.ValueInt64())
+	}
+
+	return resetOrPowerOnHostWithPostCheck(ctx, service, resetType, plan.JobTimeout.ValueInt64())
+}
+
+// bootOverrideEnabledValue returns value of Boot.BootSourceOverrideEnabled sent to the system,
+// deprecated spelling 'Continues' is translated to 'Continuous'.
+func bootOverrideEnabledValue(enabled string) string {
+	if enabled == BOOT_OVERRIDE_CONTINUES {
+		return string(redfish.ContinuousBootSourceOverrideEnabled)
+	}
+
+	return enabled
 }
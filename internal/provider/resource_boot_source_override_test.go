@@ -18,9 +18,16 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"terraform-provider-irmc-redfish/internal/models"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -33,14 +40,14 @@ func TestAccRedfishBootSourceOverride_basic(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRedfishResourceBootSourceOverrideConfig(creds, "Cd", "Once", "PowerCycle"),
+				Config: testAccRedfishResourceBootSourceOverrideResetConfig(creds, "Cd", "Once", "PowerCycle"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(resource_boot_source_override, "boot_source_override_target", "Cd"),
 					resource.TestCheckResourceAttr(resource_boot_source_override, "boot_source_override_enabled", "Once"),
 				),
 			},
 			{
-				Config: testAccRedfishResourceBootSourceOverrideConfig(creds, "Hdd", "Continues", "ForceRestart"),
+				Config: testAccRedfishResourceBootSourceOverrideResetConfig(creds, "Hdd", "Continues", "ForceRestart"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(resource_boot_source_override, "boot_source_override_target", "Hdd"),
 					resource.TestCheckResourceAttr(resource_boot_source_override, "boot_source_override_enabled", "Continues"),
@@ -50,14 +57,190 @@ func TestAccRedfishBootSourceOverride_basic(t *testing.T) {
 	})
 }
 
+func TestAccRedfishBootSourceOverride_withoutReset(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceBootSourceOverrideConfig(creds, "BiosSetup", "Continuous"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_boot_source_override, "boot_source_override_target", "BiosSetup"),
+					resource.TestCheckResourceAttr(resource_boot_source_override, "boot_source_override_enabled", "Continuous"),
+					resource.TestCheckNoResourceAttr(resource_boot_source_override, "system_reset_type"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceBootSourceOverrideConfig(creds, "Pxe", "Once"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_boot_source_override, "boot_source_override_target", "Pxe"),
+					resource.TestCheckResourceAttr(resource_boot_source_override, "boot_source_override_enabled", "Once"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishBootSourceOverride_biosSetupWithReset(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { testChangePowerHostState(creds, true) },
+				Config:    testAccRedfishResourceBootSourceOverrideResetConfig(creds, "BiosSetup", "Once", "ForceRestart"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_boot_source_override, "boot_source_override_target", "BiosSetup"),
+					resource.TestCheckResourceAttr(resource_boot_source_override, "system_reset_type", "ForceRestart"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishBootSourceOverride_uefiHttp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceBootSourceOverrideHttpConfig(creds, "http://10.172.181.125:8080/boot.iso"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_boot_source_override, "boot_source_override_target", "UefiHttp"),
+					resource.TestCheckResourceAttr(resource_boot_source_override, "http_boot_uri", "http://10.172.181.125:8080/boot.iso"),
+				),
+			},
+		},
+	})
+}
+
+func TestBootSourceOverrideApply(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	id, err := bootOverrideApply(api.Service, "Hdd", bootOverrideEnabledValue(BOOT_OVERRIDE_CONTINUES), "")
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	boot := server.Resource(id)["Boot"].(map[string]interface{})
+	if boot["BootSourceOverrideTarget"] != "Hdd" || boot["BootSourceOverrideEnabled"] != "Continuous" {
+		t.Errorf("Unexpected boot settings on server %v", boot)
+	}
+
+	if _, err = bootOverrideApply(api.Service, BOOT_OVERRIDE_UEFIHTTP, "Once", "http://10.0.0.1/boot.iso"); err == nil {
+		t.Errorf("Expected error for system not supporting HttpBootUri")
+	}
+}
+
+func TestBootSourceOverrideUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := BootSourceOverrideResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	prior := tfsdk.State{
+		Schema: *upgrader.PriorSchema,
+		Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+	}
+	if diags := prior.Set(ctx, &models.BootSourceOverrideResourceModelV0{
+		Id:                        types.StringValue("/redfish/v1/Systems/0/Oem/ts_fujitsu/BootConfig"),
+		RedfishServer:             []models.RedfishServer{{Endpoint: types.StringValue("https://10.0.0.1")}},
+		BootSourceOverrideTarget:  types.StringValue("Pxe"),
+		BootSourceOverrideEnabled: types.StringValue(BOOT_OVERRIDE_CONTINUES),
+		SystemResetType:           types.StringValue("ForceRestart"),
+		JobTimeout:                types.Int64Value(600),
+	}); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	resp := fwresource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	upgrader.StateUpgrader(ctx, fwresource.UpgradeStateRequest{State: &prior}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error %v", resp.Diagnostics)
+	}
+
+	var state models.BootSourceOverrideResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if state.BootSourceOverrideTarget.ValueString() != "Pxe" || state.BootSourceOverrideEnabled.ValueString() != BOOT_OVERRIDE_CONTINUES ||
+		state.SystemResetType.ValueString() != "ForceRestart" || !state.HttpBootUri.IsNull() ||
+		len(state.RedfishServer) != 1 || state.RedfishServer[0].Endpoint.ValueString() != "https://10.0.0.1" {
+		t.Errorf("Unexpected upgraded state %+v", state)
+	}
+}
+
+func testAccRedfishResourceBootSourceOverrideHttpConfig(testingInfo TestingServerCredentials,
+	httpBootUri string,
+) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_boot_source_override" "bso" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		boot_source_override_target = "UefiHttp"
+		boot_source_override_enabled = "Once"
+		http_boot_uri = "%s"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		httpBootUri,
+	)
+}
+
 func testAccRedfishResourceBootSourceOverrideConfig(testingInfo TestingServerCredentials,
 	overrideTarget string,
 	overrideEnabled string,
+) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_boot_source_override" "bso" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		boot_source_override_target = "%s"
+		boot_source_override_enabled = "%s"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		overrideTarget,
+		overrideEnabled,
+	)
+}
+
+func testAccRedfishResourceBootSourceOverrideResetConfig(testingInfo TestingServerCredentials,
+	overrideTarget string,
+	overrideEnabled string,
 	resetType string,
 ) string {
 	return fmt.Sprintf(`
 	resource "irmc-redfish_boot_source_override" "bso" {
-	  
+
 		server {
 		  username     = "%s"
 		  password     = "%s"
@@ -65,7 +248,7 @@ func testAccRedfishResourceBootSourceOverrideConfig(testingInfo TestingServerCre
 		  ssl_insecure = true
 		}
 
-        boot_source_override_target = "%s"
+		boot_source_override_target = "%s"
 		boot_source_override_enabled = "%s"
 		system_reset_type = "%s"
 	  }
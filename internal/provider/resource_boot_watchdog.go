@@ -0,0 +1,318 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	// ASR_CONFIGURATION is name of OEM iRMC configuration object holding Automatic Server Restart & Recovery settings.
+	ASR_CONFIGURATION = "ASR"
+	// BOOT_WATCHDOG_OBJECT is name of object of ASR&R settings holding boot watchdog settings.
+	BOOT_WATCHDOG_OBJECT = "BootWatchdog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BootWatchdogResource{}
+var _ resource.ResourceWithImportState = &BootWatchdogResource{}
+
+func NewBootWatchdogResource() resource.Resource {
+	return &BootWatchdogResource{}
+}
+
+// BootWatchdogResource defines the resource implementation.
+type BootWatchdogResource struct {
+	p *IrmcProvider
+}
+
+func (r *BootWatchdogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + bootWatchdogName
+}
+
+func BootWatchdogSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of ASR&R settings resource on iRMC.",
+			Description:         "ID of ASR&R settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if boot watchdog is enabled.",
+			Description:         "Specifies if boot watchdog is enabled.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"timeout_minutes": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Time in minutes within which operating system must be booted before `action` is performed.",
+			Description:         "Time in minutes within which operating system must be booted before action is performed.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 100),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"action": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Action performed by iRMC when boot watchdog expires. Available values are 'Continue', 'Reset' and 'PowerCycle'.",
+			Description:         "Action performed by iRMC when boot watchdog expires. Available values are 'Continue', 'Reset' and 'PowerCycle'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("Continue", "Reset", "PowerCycle"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *BootWatchdogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) boot watchdog of Automatic Server Restart & Recovery (ASR&R) settings of the server.",
+		Description:         "The resource is used to control (read, modify or import) boot watchdog of Automatic Server Restart & Recovery (ASR&R) settings of the server.",
+		Attributes:          BootWatchdogSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *BootWatchdogResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *BootWatchdogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-boot_watchdog: create starts")
+
+	var plan models.BootWatchdogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-boot_watchdog: create ends")
+}
+
+func (r *BootWatchdogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-boot_watchdog: read starts")
+
+	var state models.BootWatchdogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-boot_watchdog: read ends")
+}
+
+func (r *BootWatchdogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-boot_watchdog: update starts")
+
+	var plan, state models.BootWatchdogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-boot_watchdog: update ends")
+}
+
+func (r *BootWatchdogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-boot_watchdog: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-boot_watchdog: delete ends")
+}
+
+func (r *BootWatchdogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-boot_watchdog: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.BootWatchdogResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-boot_watchdog: import ends")
+}
+
+// read reads current boot watchdog settings from iRMC into model.
+func (r *BootWatchdogResource) read(ctx context.Context, model *models.BootWatchdogResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	api, err := ConnectTargetSystem(r.p, &model.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, err := getAsrSettingsEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read boot watchdog settings", err)...)
+		return diags
+	}
+
+	bootWatchdogToModel(endpoint, data, model)
+	return diags
+}
+
+func (r *BootWatchdogResource) apply(ctx context.Context, plan *models.BootWatchdogResourceModel, state *models.BootWatchdogResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-boot_watchdog"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	asrEndpoint, err := getAsrSettingsEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	var current models.BootWatchdogResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	watchdog := map[string]interface{}{}
+	addBoolToPayload(watchdog, "Enabled", plan.Enabled, current.Enabled)
+	addInt64ToPayload(watchdog, "TimeoutMinutes", plan.TimeoutMinutes, current.TimeoutMinutes)
+	addStringToPayload(watchdog, "Action", plan.Action, current.Action)
+
+	payload := map[string]interface{}{}
+	addObjectToPayload(payload, BOOT_WATCHDOG_OBJECT, watchdog)
+
+	tflog.Info(ctx, "Applying boot watchdog settings", map[string]interface{}{"payload": payload})
+	data, err := applySettings(api, asrEndpoint, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply boot watchdog settings", err)...)
+		return diags
+	}
+
+	bootWatchdogToModel(asrEndpoint, data, plan)
+	return diags
+}
+
+func getAsrSettingsEndpoint(ctx context.Context, api *gofish.APIClient) (string, error) {
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		return "", err
+	}
+
+	return getIrmcConfigurationEndpoint(isFsas, ASR_CONFIGURATION), nil
+}
+
+// bootWatchdogToModel copies boot watchdog settings from OEM ASR&R configuration object into model.
+func bootWatchdogToModel(endpoint string, data map[string]interface{}, model *models.BootWatchdogResourceModel) {
+	watchdog := jsonObjectValue(data, BOOT_WATCHDOG_OBJECT)
+
+	model.Id = types.StringValue(endpoint)
+	model.Enabled = jsonBoolValue(watchdog, "Enabled")
+	model.TimeoutMinutes = jsonInt64Value(watchdog, "TimeoutMinutes")
+	model.Action = jsonStringValue(watchdog, "Action")
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const boot_watchdog_name = "irmc-redfish_boot_watchdog.watchdog"
+
+func TestAccRedfishBootWatchdog_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceBootWatchdogConfig(creds, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(boot_watchdog_name, "id"),
+					resource.TestCheckResourceAttr(boot_watchdog_name, "enabled", "true"),
+					resource.TestCheckResourceAttr(boot_watchdog_name, "timeout_minutes", "10"),
+					resource.TestCheckResourceAttrSet(boot_watchdog_name, "action"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceBootWatchdogConfig(creds, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(boot_watchdog_name, "timeout_minutes", "20"),
+				),
+			},
+			{
+				ResourceName:            boot_watchdog_name,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("{\"username\":\"%s\",\"password\":\"%s\",\"endpoint\":\"https://%s\",\"ssl_insecure\":true}", creds.Username, creds.Password, creds.Endpoint),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server"},
+			},
+		},
+	})
+}
+
+func TestBootWatchdogApply(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	endpoint := getIrmcConfigurationEndpoint(false, ASR_CONFIGURATION)
+	server.Set(endpoint, map[string]interface{}{
+		BOOT_WATCHDOG_OBJECT: map[string]interface{}{
+			"Enabled":        false,
+			"TimeoutMinutes": 5,
+			"Action":         "Continue",
+		},
+	})
+
+	r := BootWatchdogResource{p: connectMockRedfishServer(t, server)}
+	plan := models.BootWatchdogResourceModel{
+		Enabled:        types.BoolValue(true),
+		TimeoutMinutes: types.Int64Value(15),
+		Action:         types.StringUnknown(),
+	}
+
+	if diags := r.apply(context.Background(), &plan, nil); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if plan.Id.ValueString() != endpoint || !plan.Enabled.ValueBool() || plan.TimeoutMinutes.ValueInt64() != 15 ||
+		plan.Action.ValueString() != "Continue" {
+		t.Errorf("Unexpected boot watchdog settings %+v", plan)
+	}
+
+	watchdog := server.Resource(endpoint)[BOOT_WATCHDOG_OBJECT].(map[string]interface{})
+	if watchdog["Enabled"] != true || watchdog["Action"] != "Continue" {
+		t.Errorf("Unexpected boot watchdog settings on server %v", watchdog)
+	}
+}
+
+func testAccRedfishResourceBootWatchdogConfig(testingInfo TestingServerCredentials, timeout int) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_boot_watchdog" "watchdog" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		enabled         = true
+		timeout_minutes = %d
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		timeout,
+	)
+}
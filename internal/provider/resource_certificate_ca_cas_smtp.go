@@ -107,26 +107,27 @@ func (r *IrmcCertificateCaCasSmtpResource) Create(ctx context.Context, req resou
 	var plan models.CertificateCaCasSmtpResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "certificate_ca_cas_smtp"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
 
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connection Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connection Error", err)...)
 		return
 	}
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	isFsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 
@@ -134,7 +135,7 @@ func (r *IrmcCertificateCaCasSmtpResource) Create(ctx context.Context, req resou
 
 	err = caCertificateUpload(api, &plan, certsEndp.certificateCaCasCmtpEndpoint, certsEndp.certificateCaCasCmtpUploadEndpoint)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to upload public certificate", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to upload public certificate", err)...)
 		return
 	}
 
@@ -140,26 +140,27 @@ func (r *IrmcCertificateCaUpdDeployResource) Create(ctx context.Context, req res
 	var plan models.CertificateCaUpdDeployResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "certificate_ca_upd_deploy"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
 
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connection Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connection Error", err)...)
 		return
 	}
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	isFsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 
@@ -169,13 +170,13 @@ func (r *IrmcCertificateCaUpdDeployResource) Create(ctx context.Context, req res
 	case CERTIFICATE_UPLOAD_TYPE_FILE:
 		err := handleFileCertificate(api, &plan, endp.certificateEndpoint)
 		if err != nil {
-			resp.Diagnostics.AddError("File Certificate Upload failed.", err.Error())
+			resp.Diagnostics.Append(redfishErrorDiagnostics("File Certificate Upload failed.", err)...)
 			return
 		}
 	case CERTIFICATE_UPLOAD_TYPE_TEXT:
 		err := handleTextCertificate(api, &plan, endp.certificateEndpoint)
 		if err != nil {
-			resp.Diagnostics.AddError("Text Certificate Upload failed.", err.Error())
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Text Certificate Upload failed.", err)...)
 			return
 		}
 	}
@@ -228,10 +229,10 @@ func (r *IrmcCertificateCaUpdDeployResource) Delete(ctx context.Context, req res
 
 	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connection Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connection Error", err)...)
 		return
 	}
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	if state.Id.IsNull() || state.Id.ValueString() == "" {
 		resp.Diagnostics.AddError("Missing Certificate ID", "Cannot delete certificate without a valid ID.")
@@ -242,7 +243,7 @@ func (r *IrmcCertificateCaUpdDeployResource) Delete(ctx context.Context, req res
 
 	deleteRes, err := api.Service.GetClient().Delete(certURL)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to delete certificate", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to delete certificate", err)...)
 		return
 	}
 
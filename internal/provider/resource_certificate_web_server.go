@@ -26,10 +26,12 @@ import (
 	"os"
 	"terraform-provider-irmc-redfish/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stmcginnis/gofish"
@@ -80,6 +82,14 @@ func IrmcCertificateWebServerSchema() map[string]schema.Attribute {
 				stringplanmodifier.RequiresReplaceIfConfigured(),
 			},
 		},
+		"ready_timeout": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Timeout in seconds for iRMC to become ready after restart, which is required to activate the certificate (default 600s).",
+			Description:         "Timeout in seconds for iRMC to become ready after restart, which is required to activate the certificate (default 600s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(60),
+			},
+		},
 	}
 }
 
@@ -116,26 +126,27 @@ func (r *IrmcCertificateWebServerResource) Create(ctx context.Context, req resou
 	var plan models.CertificateWebServerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "certificate_web_server"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
 
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connection Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connection Error", err)...)
 		return
 	}
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	isFsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 
@@ -143,25 +154,25 @@ func (r *IrmcCertificateWebServerResource) Create(ctx context.Context, req resou
 
 	err = sendCertificateUpdate(api, plan.CertPublicKey.ValueString(), certWebServerEndp.uploadCertEndpoint)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to upload public certificate", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to upload public certificate", err)...)
 		return
 	}
 
 	err = sendCertificateUpdate(api, plan.CertPrivateKey.ValueString(), certWebServerEndp.uploadCertEndpoint)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to upload private key", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to upload private key", err)...)
 		return
 	}
 
 	err = verifyCertificateCompliance(api, certWebServerEndp.verifyCertEndpoint)
 	if err != nil {
-		resp.Diagnostics.AddError("Certificate verification failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Certificate verification failed", err)...)
 		return
 	}
 
-	err = restartIrmc(ctx, api, plan.RedfishServer, r.p)
+	err = restartIrmc(ctx, api, plan.RedfishServer, r.p, getManagerReadyTimeout(plan.ReadyTimeout))
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to restart iRMC", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to restart iRMC", err)...)
 		return
 	}
 
@@ -194,11 +205,14 @@ func (r *IrmcCertificateWebServerResource) Read(ctx context.Context, req resourc
 
 // Update modifies the resource state but returns an error if triggered, as updates are not supported.
 func (r *IrmcCertificateWebServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// This function should not be called since updates are not supported; the resource should be recreated instead.
-	resp.Diagnostics.AddError(
-		"Unsupported Update Operation for IRMC Web Server Certificate",
-		"The IRMC Web Server Certificate resource does not support in-place updates. It is intended to be destroyed and recreated if changes are required.",
-	)
+	// Change of certificate requires replacement of the resource, only ready_timeout can be changed in place
+	var plan models.CertificateWebServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
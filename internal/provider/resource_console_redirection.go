@@ -0,0 +1,323 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+// CONSOLE_REDIRECTION_CONFIGURATION is name of OEM iRMC configuration object holding Serial-over-LAN settings.
+const CONSOLE_REDIRECTION_CONFIGURATION = "ConsoleRedirection"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConsoleRedirectionResource{}
+var _ resource.ResourceWithImportState = &ConsoleRedirectionResource{}
+
+func NewConsoleRedirectionResource() resource.Resource {
+	return &ConsoleRedirectionResource{}
+}
+
+// ConsoleRedirectionResource defines the resource implementation.
+type ConsoleRedirectionResource struct {
+	p *IrmcProvider
+}
+
+func (r *ConsoleRedirectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + consoleRedirectionName
+}
+
+func ConsoleRedirectionSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of console redirection settings resource on iRMC.",
+			Description:         "ID of console redirection settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if Serial-over-LAN (text console redirection) is enabled.",
+			Description:         "Specifies if Serial-over-LAN (text console redirection) is enabled.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"port": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Serial port of the server redirected to the console. Available values are 'Serial1' and 'Serial2'.",
+			Description:         "Serial port of the server redirected to the console. Available values are 'Serial1' and 'Serial2'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("Serial1", "Serial2"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"baud_rate": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Baud rate of the redirected serial port. Available values are 9600, 19200, 38400, 57600 and 115200.",
+			Description:         "Baud rate of the redirected serial port. Available values are 9600, 19200, 38400, 57600 and 115200.",
+			Validators: []validator.Int64{
+				int64validator.OneOf(9600, 19200, 38400, 57600, 115200),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"terminal_emulation": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Terminal type emulated by text console. Available values are 'VT100', 'VT100+', 'VT-UTF8' and 'ANSI'.",
+			Description:         "Terminal type emulated by text console. Available values are 'VT100', 'VT100+', 'VT-UTF8' and 'ANSI'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("VT100", "VT100+", "VT-UTF8", "ANSI"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *ConsoleRedirectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) Serial-over-LAN (text console redirection) settings of iRMC.",
+		Description:         "The resource is used to control (read, modify or import) Serial-over-LAN (text console redirection) settings of iRMC.",
+		Attributes:          ConsoleRedirectionSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *ConsoleRedirectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *ConsoleRedirectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-console_redirection: create starts")
+
+	var plan models.ConsoleRedirectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-console_redirection: create ends")
+}
+
+func (r *ConsoleRedirectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-console_redirection: read starts")
+
+	var state models.ConsoleRedirectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-console_redirection: read ends")
+}
+
+func (r *ConsoleRedirectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-console_redirection: update starts")
+
+	var plan, state models.ConsoleRedirectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-console_redirection: update ends")
+}
+
+func (r *ConsoleRedirectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-console_redirection: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-console_redirection: delete ends")
+}
+
+func (r *ConsoleRedirectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-console_redirection: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.ConsoleRedirectionResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-console_redirection: import ends")
+}
+
+// read reads current console redirection settings from iRMC into model.
+func (r *ConsoleRedirectionResource) read(ctx context.Context, model *models.ConsoleRedirectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	api, err := ConnectTargetSystem(r.p, &model.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, err := getConsoleRedirectionEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read console redirection settings", err)...)
+		return diags
+	}
+
+	consoleRedirectionToModel(endpoint, data, model)
+	return diags
+}
+
+func (r *ConsoleRedirectionResource) apply(ctx context.Context, plan *models.ConsoleRedirectionResourceModel, state *models.ConsoleRedirectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-console_redirection"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	consoleEndpoint, err := getConsoleRedirectionEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	var current models.ConsoleRedirectionResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	payload := map[string]interface{}{}
+	addBoolToPayload(payload, "Enabled", plan.Enabled, current.Enabled)
+	addStringToPayload(payload, "Port", plan.Port, current.Port)
+	addInt64ToPayload(payload, "BaudRate", plan.BaudRate, current.BaudRate)
+	addStringToPayload(payload, "TerminalType", plan.TerminalEmulation, current.TerminalEmulation)
+
+	tflog.Info(ctx, "Applying console redirection settings", map[string]interface{}{"payload": payload})
+	data, err := applySettings(api, consoleEndpoint, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply console redirection settings", err)...)
+		return diags
+	}
+
+	consoleRedirectionToModel(consoleEndpoint, data, plan)
+	return diags
+}
+
+func getConsoleRedirectionEndpoint(ctx context.Context, api *gofish.APIClient) (string, error) {
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		return "", err
+	}
+
+	return getIrmcConfigurationEndpoint(isFsas, CONSOLE_REDIRECTION_CONFIGURATION), nil
+}
+
+// consoleRedirectionToModel copies console redirection settings from OEM configuration object into model.
+func consoleRedirectionToModel(endpoint string, data map[string]interface{}, model *models.ConsoleRedirectionResourceModel) {
+	model.Id = types.StringValue(endpoint)
+	model.Enabled = jsonBoolValue(data, "Enabled")
+	model.Port = jsonStringValue(data, "Port")
+	model.BaudRate = jsonInt64Value(data, "BaudRate")
+	model.TerminalEmulation = jsonStringValue(data, "TerminalType")
+}
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const console_redirection_name = "irmc-redfish_console_redirection.sol"
+
+func TestAccRedfishConsoleRedirection_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceConsoleRedirectionConfig(creds, "VT100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(console_redirection_name, "id"),
+					resource.TestCheckResourceAttr(console_redirection_name, "enabled", "true"),
+					resource.TestCheckResourceAttr(console_redirection_name, "terminal_emulation", "VT100"),
+					resource.TestCheckResourceAttrSet(console_redirection_name, "baud_rate"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceConsoleRedirectionConfig(creds, "VT-UTF8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(console_redirection_name, "terminal_emulation", "VT-UTF8"),
+				),
+			},
+			{
+				ResourceName:            console_redirection_name,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("{\"username\":\"%s\",\"password\":\"%s\",\"endpoint\":\"https://%s\",\"ssl_insecure\":true}", creds.Username, creds.Password, creds.Endpoint),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server"},
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceConsoleRedirectionConfig(testingInfo TestingServerCredentials, terminal string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_console_redirection" "sol" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		enabled            = true
+		terminal_emulation = "%s"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		terminal,
+	)
+}
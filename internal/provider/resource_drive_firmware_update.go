@@ -0,0 +1,395 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	DRIVE_FIRMWARE_UPDATE_TIMEOUT = 1800
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DriveFirmwareUpdateResource{}
+
+func NewDriveFirmwareUpdateResource() resource.Resource {
+	return &DriveFirmwareUpdateResource{}
+}
+
+// DriveFirmwareUpdateResource defines the resource implementation.
+type DriveFirmwareUpdateResource struct {
+	p *IrmcProvider
+}
+
+func (r *DriveFirmwareUpdateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + driveFirmwareUpdateName
+}
+
+func DriveFirmwareUpdateSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ODataId of updated drive.",
+			Description:         "ODataId of updated drive.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_serial_number": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Serial number of storage controller to which the drive is attached.",
+			Description:         "Serial number of storage controller to which the drive is attached.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"slot": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Slot location of the drive in format used by physical_drives of storage_volume resource, e.g. '3' or '252-3' for drive in enclosure.",
+			Description:         "Slot location of the drive in format used by physical_drives of storage_volume resource, e.g. '3' or '252-3' for drive in enclosure.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("slot"), path.MatchRoot("durable_name")),
+			},
+		},
+		"durable_name": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Durable name of the drive (e.g. its WWN), as reported in Identifiers of the drive.",
+			Description:         "Durable name of the drive (e.g. its WWN), as reported in Identifiers of the drive.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"transfer_protocol": schema.StringAttribute{
+			MarkdownDescription: "Protocol used by iRMC to download `update_image`. Supported values: http, https, ftp.",
+			Description:         "Protocol used by iRMC to download update_image. Supported values: http, https, ftp.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					PROTOCOL_HTTP,
+					PROTOCOL_HTTPS,
+					PROTOCOL_FTP),
+				stringvalidator.AlsoRequires(path.MatchRoot("update_image")),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"update_image": schema.StringAttribute{
+			MarkdownDescription: "URI of the drive firmware image, downloaded by iRMC. Example: \"10.172.200.100/binaries/drive.bin\"",
+			Description:         "URI of the drive firmware image, downloaded by iRMC. Example: \"10.172.200.100/binaries/drive.bin\"",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("transfer_protocol")),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"update_file": schema.StringAttribute{
+			MarkdownDescription: "Path to local drive firmware image, which is uploaded to iRMC using multipart HTTP push update. Exactly one of `update_image` and `update_file` must be defined.",
+			Description:         "Path to local drive firmware image, which is uploaded to iRMC using multipart HTTP push update. Exactly one of `update_image` and `update_file` must be defined.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("update_image")),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"upload_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Maximum duration in seconds of `update_file` upload to iRMC. Value 0 means no limit.",
+			Description:         "Maximum duration in seconds of `update_file` upload to iRMC. Value 0 means no limit.",
+			Computed:            true,
+			Optional:            true,
+			Default:             int64default.StaticInt64(FIRMWARE_UPLOAD_TIMEOUT),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"update_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Maximum duration in seconds to wait for the drive firmware update task to finish (default 1800s).",
+			Description:         "Maximum duration in seconds to wait for the drive firmware update task to finish (default 1800s).",
+			Computed:            true,
+			Optional:            true,
+			Default:             int64default.StaticInt64(DRIVE_FIRMWARE_UPDATE_TIMEOUT),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(60),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"drive_serial_number": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Serial number of updated drive.",
+			Description:         "Serial number of updated drive.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"previous_firmware_version": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Firmware revision of the drive before the update.",
+			Description:         "Firmware revision of the drive before the update.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"firmware_version": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Firmware revision reported by the drive after the update. Some drives report new revision only after host power cycle.",
+			Description:         "Firmware revision reported by the drive after the update. Some drives report new revision only after host power cycle.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *DriveFirmwareUpdateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to update firmware of a physical drive attached to storage controller.",
+		Description:         "This resource is used to update firmware of a physical drive attached to storage controller.",
+		Attributes:          DriveFirmwareUpdateSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *DriveFirmwareUpdateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *DriveFirmwareUpdateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-drive-firmware-update: create starts")
+
+	var plan models.DriveFirmwareUpdateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateDriveFirmware(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-drive-firmware-update: create ends")
+}
+
+func (r *DriveFirmwareUpdateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-drive-firmware-update: read starts")
+	// Update is one time operation, so there is nothing to be read from iRMC
+	var state models.DriveFirmwareUpdateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-drive-firmware-update: read ends")
+}
+
+// Update modifies the resource state. Changes which require firmware update cause replacement
+// of the resource, so only attributes which do not trigger update (e.g. server) are updated in place.
+func (r *DriveFirmwareUpdateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.DriveFirmwareUpdateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.DriveSerialNumber = state.DriveSerialNumber
+	plan.PreviousFirmwareVersion = state.PreviousFirmwareVersion
+	plan.FirmwareVersion = state.FirmwareVersion
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DriveFirmwareUpdateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-drive-firmware-update: delete starts")
+	// Firmware update can not be reverted, so resource is only removed from state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-drive-firmware-update: delete ends")
+}
+
+// updateDriveFirmware finds drive defined by plan, requests update of its firmware targeted only
+// to the drive and supervises the update task. Drive details are stored into plan.
+func (r *DriveFirmwareUpdateResource) updateDriveFirmware(ctx context.Context, plan *models.DriveFirmwareUpdateResourceModel) (diags diag.Diagnostics) {
+	// Update service handles single update at a time
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	mutexPool.Lock(ctx, endpoint, UPDATE_SERVICE_LOCK_NAME)
+	defer mutexPool.Unlock(ctx, endpoint, UPDATE_SERVICE_LOCK_NAME)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	storage, err := getSystemStorageFromSerialNumber(api.Service, plan.StorageControllerSN.ValueString())
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not obtain storage resource", err)...)
+		return diags
+	}
+
+	drives, err := storage.Drives()
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not obtain drives of storage resource", err)...)
+		return diags
+	}
+
+	drive, err := findStorageDrive(drives, plan.Slot.ValueString(), plan.DurableName.ValueString())
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Drive to be updated has not been found", err)...)
+		return diags
+	}
+
+	if err = checkDriveFirmwareUpdateTarget(api.Service, drive.ODataID); err != nil {
+		diags.AddError("Drive firmware can not be updated", err.Error())
+		return diags
+	}
+
+	plan.Id = types.StringValue(drive.ODataID)
+	plan.DriveSerialNumber = types.StringValue(drive.SerialNumber)
+	plan.PreviousFirmwareVersion = types.StringValue(drive.Revision)
+
+	targets := map[string]interface{}{
+		"Targets": []string{drive.ODataID},
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Requesting firmware update of drive %s (revision %s)", drive.ODataID, drive.Revision))
+	var taskLocation string
+	var updateDiags diag.Diagnostics
+	if len(plan.UpdateFile.ValueString()) > 0 {
+		_, taskLocation, updateDiags = pushSimpleUpdateFile(ctx, api, plan.UpdateFile.ValueString(),
+			targets, plan.UploadTimeout.ValueInt64())
+	} else {
+		taskLocation, updateDiags = ConfigSimpleUpd(ctx, api, plan.UpdateImage.ValueString(),
+			plan.Protocol.ValueString(), targets)
+	}
+
+	diags.Append(updateDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err = WaitForRedfishTaskEnd(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64())
+	if err != nil {
+		diags.Append(taskFailureDiagnostics(api.Service, taskLocation, isFsas, "Task for drive firmware update reported error", err)...)
+		return diags
+	}
+
+	// Drive is read again to report revision after the update
+	drives, err = storage.Drives()
+	if err == nil {
+		drive, err = findStorageDrive(drives, plan.Slot.ValueString(), plan.DurableName.ValueString())
+	}
+
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read drive after firmware update", err)...)
+		return diags
+	}
+
+	plan.FirmwareVersion = types.StringValue(drive.Revision)
+	tflog.Info(ctx, fmt.Sprintf("Firmware of drive %s updated, reported revision %s", drive.ODataID, drive.Revision))
+	return diags
+}
+
+// checkDriveFirmwareUpdateTarget verifies that drive can be targeted by Simple Update. If UpdateService
+// announces allowed targets of Simple Update, the drive must be one of them.
+func checkDriveFirmwareUpdateTarget(service *gofish.Service, driveODataId string) error {
+	res, err := service.GetClient().Get(UPDATE_SERVICE_ENDPOINT)
+	if err != nil {
+		return fmt.Errorf("could not read UpdateService: %w", err)
+	}
+
+	defer CloseResource(res.Body)
+
+	var updateService struct {
+		Actions struct {
+			SimpleUpdate struct {
+				AllowableTargets []string `json:"Targets@Redfish.AllowableValues"`
+			} `json:"#UpdateService.SimpleUpdate"`
+		}
+	}
+
+	if err = json.NewDecoder(res.Body).Decode(&updateService); err != nil {
+		return fmt.Errorf("could not parse UpdateService: %w", err)
+	}
+
+	targets := updateService.Actions.SimpleUpdate.AllowableTargets
+	if len(targets) > 0 && !slices.Contains(targets, driveODataId) {
+		return fmt.Errorf("firmware update of drive %s is not supported by iRMC, allowed update targets are %v", driveODataId, targets)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRedfishDriveFirmwareUpdate_MissingImage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceDriveFirmwareUpdateConfig(creds),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func TestDriveFirmwareUpdate(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	drive := "/redfish/v1/Chassis/0/Drives/1"
+	driveData := server.Resource(drive)
+	driveData["SerialNumber"] = "S4NDNA0M123456"
+	driveData["Revision"] = "GXA7"
+	driveData["Identifiers"] = []interface{}{map[string]interface{}{"DurableName": "5000C500A1B2C3D1", "DurableNameFormat": "NAA"}}
+	server.Set(drive, driveData)
+
+	server.Set(UPDATE_SERVICE_ENDPOINT, map[string]interface{}{
+		"Actions": map[string]interface{}{
+			"#UpdateService.SimpleUpdate": map[string]interface{}{
+				"target":                          SIMPLE_UPDATE_ENDPOINT,
+				"Targets@Redfish.AllowableValues": []interface{}{drive},
+			},
+		},
+	})
+
+	r := DriveFirmwareUpdateResource{p: connectMockRedfishServer(t, server)}
+	plan := models.DriveFirmwareUpdateResourceModel{
+		StorageControllerSN: types.StringValue(MOCK_REDFISH_STORAGE_SERIAL),
+		DurableName:         types.StringValue("5000C500A1B2C3D1"),
+		Protocol:            types.StringValue(PROTOCOL_HTTP),
+		UpdateImage:         types.StringValue("10.172.200.100/binaries/drive.bin"),
+		UpdateTimeout:       types.Int64Value(60),
+	}
+
+	if diags := r.updateDriveFirmware(context.Background(), &plan); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if plan.Id.ValueString() != drive || plan.DriveSerialNumber.ValueString() != "S4NDNA0M123456" ||
+		plan.PreviousFirmwareVersion.ValueString() != "GXA7" || plan.FirmwareVersion.ValueString() != "GXA7" {
+		t.Errorf("Unexpected drive firmware update state %+v", plan)
+	}
+
+	action := server.Actions[len(server.Actions)-1]
+	targets, _ := action.Payload["Targets"].([]interface{})
+	if action.Path != SIMPLE_UPDATE_ENDPOINT || len(targets) != 1 || targets[0] != drive ||
+		action.Payload["ImageURI"] != "http://10.172.200.100/binaries/drive.bin" {
+		t.Errorf("Unexpected update request %+v", action)
+	}
+
+	// Drive, which is not allowed target of Simple Update, must not be updated
+	plan.DurableName = types.StringNull()
+	plan.Slot = types.StringValue("0")
+	driveData = server.Resource("/redfish/v1/Chassis/0/Drives/0")
+	driveData["Location"] = []interface{}{map[string]interface{}{"Info": "[ 0 : 0 : 0 ]", "InfoFormat": "[ System_Id : Controller_Id : Slot_Id ]"}}
+	server.Set("/redfish/v1/Chassis/0/Drives/0", driveData)
+
+	actions := len(server.Actions)
+	diags := r.updateDriveFirmware(context.Background(), &plan)
+	if !diags.HasError() || diags[0].Summary() != "Drive firmware can not be updated" || len(server.Actions) != actions {
+		t.Errorf("Update of not supported drive should fail without update request, got %v", diags)
+	}
+}
+
+func testAccRedfishResourceDriveFirmwareUpdateConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_drive_firmware_update" "drive_fw" {
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		storage_controller_serial_number = "unknown"
+		slot                             = "0"
+		transfer_protocol                = "http"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
@@ -0,0 +1,324 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DriveLocateLedResource{}
+
+func NewDriveLocateLedResource() resource.Resource {
+	return &DriveLocateLedResource{}
+}
+
+// DriveLocateLedResource defines the resource implementation.
+type DriveLocateLedResource struct {
+	p *IrmcProvider
+}
+
+func (r *DriveLocateLedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + driveLocateLedName
+}
+
+func DriveLocateLedSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ODataId of the drive.",
+			Description:         "ODataId of the drive.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_serial_number": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Serial number of storage controller to which the drive is attached.",
+			Description:         "Serial number of storage controller to which the drive is attached.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"slot": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Slot location of the drive in format used by physical_drives of storage_volume resource, e.g. '3' or '252-3' for drive in enclosure.",
+			Description:         "Slot location of the drive in format used by physical_drives of storage_volume resource, e.g. '3' or '252-3' for drive in enclosure.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("slot"), path.MatchRoot("durable_name")),
+			},
+		},
+		"durable_name": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Durable name of the drive (e.g. its WWN), as reported in Identifiers of the drive.",
+			Description:         "Durable name of the drive (e.g. its WWN), as reported in Identifiers of the drive.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Required:            true,
+			MarkdownDescription: "State of locate LED of the drive.",
+			Description:         "State of locate LED of the drive.",
+		},
+	}
+}
+
+func (r *DriveLocateLedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to control locate LED of a physical drive attached to storage controller.",
+		Description:         "This resource is used to control locate LED of a physical drive attached to storage controller.",
+		Attributes:          DriveLocateLedSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *DriveLocateLedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *DriveLocateLedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-drive-locate-led: create starts")
+
+	var plan models.DriveLocateLedResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-drive-locate-led"
+	mutexPool.LockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+	defer mutexPool.UnlockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	storage, err := getSystemStorageFromSerialNumber(api.Service, plan.StorageControllerSN.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain storage resource", err)...)
+		return
+	}
+
+	drives, err := storage.Drives()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain drives of storage resource", err)...)
+		return
+	}
+
+	drive, err := findStorageDrive(drives, plan.Slot.ValueString(), plan.DurableName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Drive has not been found", err)...)
+		return
+	}
+
+	if err = setDriveLocateLed(drive, plan.Enabled.ValueBool()); err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not change state of drive locate LED", err)...)
+		return
+	}
+
+	plan.Id = types.StringValue(drive.ODataID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-drive-locate-led: create ends")
+}
+
+func (r *DriveLocateLedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-drive-locate-led: read starts")
+
+	var state models.DriveLocateLedResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	drive, err := redfish.GetDrive(api.Service.GetClient(), state.Id.ValueString())
+	if err != nil {
+		tflog.Info(ctx, fmt.Sprintf("Drive %s could not be read, removing resource: %s", state.Id.ValueString(), err.Error()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Enabled = types.BoolValue(isDriveLocateLedActive(drive))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-drive-locate-led: read ends")
+}
+
+func (r *DriveLocateLedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-drive-locate-led: update starts")
+
+	var plan, state models.DriveLocateLedResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-drive-locate-led"
+	mutexPool.LockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+	defer mutexPool.UnlockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	drive, err := redfish.GetDrive(api.Service.GetClient(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain drive resource", err)...)
+		return
+	}
+
+	if err = setDriveLocateLed(drive, plan.Enabled.ValueBool()); err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not change state of drive locate LED", err)...)
+		return
+	}
+
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-drive-locate-led: update ends")
+}
+
+func (r *DriveLocateLedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-drive-locate-led: delete starts")
+
+	var state models.DriveLocateLedResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, state.RedfishServer)
+	var resource_name = "resource-drive-locate-led"
+	mutexPool.LockScope(ctx, endpoint, state.StorageControllerSN.ValueString(), resource_name)
+	defer mutexPool.UnlockScope(ctx, endpoint, state.StorageControllerSN.ValueString(), resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	// LED is switched off, so drive is not pointed to anymore once resource is removed
+	drive, err := redfish.GetDrive(api.Service.GetClient(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning("Drive could not be read, locate LED has not been switched off", err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err = setDriveLocateLed(drive, false); err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not switch off drive locate LED", err)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-drive-locate-led: delete ends")
+}
+
+// driveSupportsLocationIndicator checks whether drive reports LocationIndicatorActive property.
+// Older firmware reports only deprecated IndicatorLED property.
+func driveSupportsLocationIndicator(drive *redfish.Drive) bool {
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(drive.RawData, &properties); err != nil {
+		return false
+	}
+
+	_, ok := properties["LocationIndicatorActive"]
+	return ok
+}
+
+// isDriveLocateLedActive returns state of drive locate LED.
+func isDriveLocateLedActive(drive *redfish.Drive) bool {
+	if driveSupportsLocationIndicator(drive) {
+		return drive.LocationIndicatorActive
+	}
+
+	return drive.IndicatorLED == common.BlinkingIndicatorLED || drive.IndicatorLED == common.LitIndicatorLED
+}
+
+// setDriveLocateLed switches drive locate LED on or off using property supported by the drive.
+func setDriveLocateLed(drive *redfish.Drive, enabled bool) error {
+	if driveSupportsLocationIndicator(drive) {
+		drive.LocationIndicatorActive = enabled
+	} else if enabled {
+		drive.IndicatorLED = common.BlinkingIndicatorLED
+	} else {
+		drive.IndicatorLED = common.OffIndicatorLED
+	}
+
+	return drive.Update()
+}
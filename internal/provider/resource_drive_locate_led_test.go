@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestIsDriveLocateLedActive(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected bool
+	}{
+		{body: `{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Drives/0", "LocationIndicatorActive": true, "IndicatorLED": "Off"}`, expected: true},
+		{body: `{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Drives/0", "LocationIndicatorActive": false}`, expected: false},
+		{body: `{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Drives/0", "IndicatorLED": "Blinking"}`, expected: true},
+		{body: `{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Drives/0", "IndicatorLED": "Off"}`, expected: false},
+	}
+
+	for _, test := range tests {
+		var drive redfish.Drive
+		if err := json.Unmarshal([]byte(test.body), &drive); err != nil {
+			t.Fatalf("unexpected error %s", err.Error())
+		}
+
+		if active := isDriveLocateLedActive(&drive); active != test.expected {
+			t.Errorf("drive %s: expected %t, got %t", test.body, test.expected, active)
+		}
+	}
+}
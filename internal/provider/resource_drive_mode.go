@@ -0,0 +1,388 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	DRIVE_MODE_JBOD              = "JBOD"
+	DRIVE_MODE_UNCONFIGURED_GOOD = "UnconfiguredGood"
+	DRIVE_MODE_TIMEOUT           = 300
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DriveModeResource{}
+
+func NewDriveModeResource() resource.Resource {
+	return &DriveModeResource{}
+}
+
+// DriveModeResource defines the resource implementation.
+type DriveModeResource struct {
+	p *IrmcProvider
+}
+
+func (r *DriveModeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + driveModeName
+}
+
+func DriveModeSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ODataId of the drive.",
+			Description:         "ODataId of the drive.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_serial_number": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Serial number of storage controller to which the drive is attached.",
+			Description:         "Serial number of storage controller to which the drive is attached.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"slot": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Slot location of the drive in format used by physical_drives of storage_volume resource, e.g. '3' or '252-3' for drive in enclosure.",
+			Description:         "Slot location of the drive in format used by physical_drives of storage_volume resource, e.g. '3' or '252-3' for drive in enclosure.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("slot"), path.MatchRoot("durable_name")),
+			},
+		},
+		"durable_name": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Durable name of the drive (e.g. its WWN), as reported in Identifiers of the drive.",
+			Description:         "Durable name of the drive (e.g. its WWN), as reported in Identifiers of the drive.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"mode": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Mode of the drive. Applicable values are: 'JBOD' (drive is exposed directly to the host), 'UnconfiguredGood' (drive can be used for RAID volume).",
+			Description:         "Mode of the drive. Applicable values are: 'JBOD' (drive is exposed directly to the host), 'UnconfiguredGood' (drive can be used for RAID volume).",
+			Validators: []validator.String{
+				stringvalidator.OneOf(DRIVE_MODE_JBOD, DRIVE_MODE_UNCONFIGURED_GOOD),
+			},
+		},
+		"job_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(DRIVE_MODE_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for mode change to be reflected by the drive (default 300s).",
+			Description:         "Timeout in seconds for mode change to be reflected by the drive (default 300s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(30),
+			},
+		},
+	}
+}
+
+func (r *DriveModeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to switch physical drive between JBOD and Unconfigured Good modes on controllers supporting it.",
+		Description:         "This resource is used to switch physical drive between JBOD and Unconfigured Good modes on controllers supporting it.",
+		Attributes:          DriveModeSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *DriveModeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *DriveModeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-drive-mode: create starts")
+
+	var plan models.DriveModeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-drive-mode"
+	mutexPool.LockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+	defer mutexPool.UnlockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	storage, err := getSystemStorageFromSerialNumber(api.Service, plan.StorageControllerSN.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain storage resource", err)...)
+		return
+	}
+
+	drives, err := storage.Drives()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain drives of storage resource", err)...)
+		return
+	}
+
+	drive, err := findStorageDrive(drives, plan.Slot.ValueString(), plan.DurableName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Drive has not been found", err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(changeDriveMode(ctx, api, drive, plan.Mode.ValueString(), plan.JobTimeout.ValueInt64())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(drive.ODataID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-drive-mode: create ends")
+}
+
+func (r *DriveModeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-drive-mode: read starts")
+
+	var state models.DriveModeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	drive, err := redfish.GetDrive(api.Service.GetClient(), state.Id.ValueString())
+	if err != nil {
+		tflog.Info(ctx, fmt.Sprintf("Drive %s could not be read, removing resource: %s", state.Id.ValueString(), err.Error()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Drive used by volume reports neither of supported modes, what is reported as drift
+	if mode, err := getDriveMode(drive); err == nil {
+		state.Mode = types.StringValue(mode)
+	} else {
+		tflog.Warn(ctx, fmt.Sprintf("Mode of drive %s could not be read: %s", state.Id.ValueString(), err.Error()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-drive-mode: read ends")
+}
+
+func (r *DriveModeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-drive-mode: update starts")
+
+	var plan, state models.DriveModeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-drive-mode"
+	mutexPool.LockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+	defer mutexPool.UnlockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	drive, err := redfish.GetDrive(api.Service.GetClient(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain drive resource", err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(changeDriveMode(ctx, api, drive, plan.Mode.ValueString(), plan.JobTimeout.ValueInt64())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-drive-mode: update ends")
+}
+
+func (r *DriveModeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-drive-mode: delete starts")
+	// Drive is left in its current mode, since changing it might make data on the drive inaccessible
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-drive-mode: delete ends")
+}
+
+type driveModeOem struct {
+	DriveMode string `json:"DriveMode"`
+}
+
+type driveModeOemObject struct {
+	OemFsas    *driveModeOem `json:"Fsas,omitempty"`
+	OemFujitsu *driveModeOem `json:"ts_fujitsu,omitempty"`
+}
+
+// getDriveMode returns mode of the drive reported in its OEM section.
+func getDriveMode(drive *redfish.Drive) (string, error) {
+	var oem driveModeOemObject
+	if len(drive.Oem) == 0 {
+		return "", fmt.Errorf("drive does not report OEM section")
+	}
+
+	if err := json.Unmarshal(drive.Oem, &oem); err != nil {
+		return "", fmt.Errorf("could not unmarshal drive OEM object: %w", err)
+	}
+
+	var mode string
+	if oem.OemFsas != nil {
+		mode = oem.OemFsas.DriveMode
+	} else if oem.OemFujitsu != nil {
+		mode = oem.OemFujitsu.DriveMode
+	}
+
+	if mode != DRIVE_MODE_JBOD && mode != DRIVE_MODE_UNCONFIGURED_GOOD {
+		return "", fmt.Errorf("drive reports mode '%s', which can not be managed", mode)
+	}
+
+	return mode, nil
+}
+
+// getDriveModeActionEndpoint returns endpoint of OEM action changing mode of the drive.
+func getDriveModeActionEndpoint(driveODataId string, isFsas bool) string {
+	if isFsas {
+		return driveODataId + "/Actions/Oem/FsasDrive.ChangeDriveMode"
+	}
+
+	return driveODataId + "/Actions/Oem/FTSDrive.ChangeDriveMode"
+}
+
+// changeDriveMode switches drive into requested mode, if it is not already in it, and waits
+// until the drive reports the new mode.
+func changeDriveMode(ctx context.Context, api *gofish.APIClient, drive *redfish.Drive, mode string, timeout int64) (diags diag.Diagnostics) {
+	current, err := getDriveMode(drive)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Mode of the drive can not be changed", err)...)
+		return diags
+	}
+
+	if current == mode {
+		tflog.Info(ctx, fmt.Sprintf("Drive %s is already in mode %s", drive.ODataID, mode))
+		return diags
+	}
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	actionEndpoint := getDriveModeActionEndpoint(drive.ODataID, isFsas)
+	res, err := api.Post(actionEndpoint, map[string]interface{}{"DriveMode": mode})
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Request to change drive mode reported error", err)...)
+		return diags
+	}
+
+	defer CloseResource(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusAccepted:
+		task_location := res.Header.Get(HTTP_HEADER_LOCATION)
+		if _, err := WaitForRedfishTaskEnd(ctx, api.Service, task_location, timeout); err != nil {
+			diags.Append(redfishErrorDiagnostics("Task for drive mode change reported error", err)...)
+			return diags
+		}
+	case http.StatusOK, http.StatusNoContent:
+	default:
+		diags.AddError("Drive mode change request finished with error", fmt.Sprintf("POST on %s finished with status code %d", actionEndpoint, res.StatusCode))
+		return diags
+	}
+
+	// Controller might report new mode with a delay
+	err = pollService(ctx, api.Service, timeout, TASK_POLL_INITIAL_INTERVAL, func(ctx context.Context) (bool, error) {
+		updated, err := redfish.GetDrive(api.Service.GetClient(), drive.ODataID)
+		if err != nil {
+			return false, nil
+		}
+
+		reported, err := getDriveMode(updated)
+		return err == nil && reported == mode, nil
+	})
+	if err != nil {
+		diags.AddError("Drive has not reported requested mode", fmt.Sprintf("drive %s is not in mode %s: %s", drive.ODataID, mode, err.Error()))
+	}
+
+	return diags
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestGetDriveMode(t *testing.T) {
+	tests := []struct {
+		oem      string
+		expected string
+		fails    bool
+	}{
+		{oem: `{"ts_fujitsu": {"DriveMode": "JBOD"}}`, expected: DRIVE_MODE_JBOD},
+		{oem: `{"Fsas": {"DriveMode": "UnconfiguredGood"}}`, expected: DRIVE_MODE_UNCONFIGURED_GOOD},
+		{oem: `{"Fsas": {"DriveMode": "Online"}}`, fails: true},
+		{oem: `{}`, fails: true},
+		{oem: ``, fails: true},
+	}
+
+	for _, test := range tests {
+		mode, err := getDriveMode(&redfish.Drive{Oem: []byte(test.oem)})
+		if test.fails != (err != nil) || mode != test.expected {
+			t.Errorf("OEM '%s': unexpected result '%s', %v", test.oem, mode, err)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	DRIVE_SECURE_ERASE_TIMEOUT = 3600
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DriveSecureEraseResource{}
+
+func NewDriveSecureEraseResource() resource.Resource {
+	return &DriveSecureEraseResource{}
+}
+
+// DriveSecureEraseResource defines the resource implementation.
+type DriveSecureEraseResource struct {
+	p *IrmcProvider
+}
+
+func (r *DriveSecureEraseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + driveSecureEraseName
+}
+
+func DriveSecureEraseSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ODataId of erased drive.",
+			Description:         "ODataId of erased drive.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_serial_number": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Serial number of storage controller to which the drive is attached.",
+			Description:         "Serial number of storage controller to which the drive is attached.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"slot": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Slot location of the drive in format used by physical_drives of storage_volume resource, e.g. '3' or '252-3' for drive in enclosure.",
+			Description:         "Slot location of the drive in format used by physical_drives of storage_volume resource, e.g. '3' or '252-3' for drive in enclosure.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("slot"), path.MatchRoot("durable_name")),
+			},
+		},
+		"durable_name": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Durable name of the drive (e.g. its WWN), as reported in Identifiers of the drive.",
+			Description:         "Durable name of the drive (e.g. its WWN), as reported in Identifiers of the drive.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"confirm_erase": schema.BoolAttribute{
+			Required:            true,
+			MarkdownDescription: "Explicit confirmation of secure erase, must be set to true. Data on the drive can not be recovered.",
+			Description:         "Explicit confirmation of secure erase, must be set to true. Data on the drive can not be recovered.",
+		},
+		"drive_serial_number": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Serial number of erased drive.",
+			Description:         "Serial number of erased drive.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"job_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(DRIVE_SECURE_ERASE_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for secure erase to finish (default 3600s).",
+			Description:         "Timeout in seconds for secure erase to finish (default 3600s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(60),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Arbitrary map of values, change of which causes the drive to be erased again.",
+			Description:         "Arbitrary map of values, change of which causes the drive to be erased again.",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *DriveSecureEraseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to securely erase (sanitize) a physical drive attached to storage controller.",
+		Description:         "This resource is used to securely erase (sanitize) a physical drive attached to storage controller.",
+		Attributes:          DriveSecureEraseSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *DriveSecureEraseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *DriveSecureEraseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-drive-secure-erase: create starts")
+
+	var plan models.DriveSecureEraseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ConfirmErase.ValueBool() {
+		resp.Diagnostics.AddError("Secure erase can not be performed", "secure erase has not been confirmed, confirm_erase must be set to true")
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-drive-secure-erase"
+	mutexPool.LockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+	defer mutexPool.UnlockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return
+	}
+
+	storage, err := getSystemStorageFromSerialNumber(api.Service, plan.StorageControllerSN.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain storage resource", err)...)
+		return
+	}
+
+	drives, err := storage.Drives()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain drives of storage resource", err)...)
+		return
+	}
+
+	drive, err := findStorageDrive(drives, plan.Slot.ValueString(), plan.DurableName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Drive to be erased has not been found", err)...)
+		return
+	}
+
+	// Drive which is member of volume would break the volume, so it has to be deleted first
+	volumes, err := drive.Volumes()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not obtain volumes of the drive", err)...)
+		return
+	}
+
+	if len(volumes) > 0 {
+		resp.Diagnostics.AddError("Drive can not be erased",
+			fmt.Sprintf("drive %s is used by volume %s, which must be deleted first", drive.ODataID, volumes[0].ODataID))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Requesting secure erase of drive %s", drive.ODataID))
+	resp.Diagnostics.Append(secureEraseDrive(ctx, api.Service, drive, isFsas, plan.JobTimeout.ValueInt64())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(drive.ODataID)
+	plan.DriveSerialNumber = types.StringValue(drive.SerialNumber)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-drive-secure-erase: create ends")
+}
+
+func (r *DriveSecureEraseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-drive-secure-erase: read starts")
+	// Erase is one time operation, so there is nothing to be read from iRMC
+	var state models.DriveSecureEraseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-drive-secure-erase: read ends")
+}
+
+// Update modifies the resource state. Changes which require erase cause replacement of the resource,
+// so only attributes which do not trigger erase are updated in place.
+func (r *DriveSecureEraseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.DriveSecureEraseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.DriveSerialNumber = state.DriveSerialNumber
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DriveSecureEraseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-drive-secure-erase: delete starts")
+	// Erase can not be reverted, so resource is only removed from state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-drive-secure-erase: delete ends")
+}
+
+// secureEraseDrive triggers secure erase action of drive and supervises the task created for it.
+func secureEraseDrive(ctx context.Context, service *gofish.Service, drive *redfish.Drive, isFsas bool, timeout int64) (diags diag.Diagnostics) {
+	actionEndpoint := drive.ODataID + "/Actions/Drive.SecureErase"
+	res, err := service.GetClient().Post(actionEndpoint, map[string]interface{}{})
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Request to securely erase drive reported error", err)...)
+		return diags
+	}
+
+	defer CloseResource(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusAccepted:
+		task_location := res.Header.Get(HTTP_HEADER_LOCATION)
+		_, err := WaitForRedfishTaskEnd(ctx, service, task_location, timeout)
+		if err != nil {
+			diags.Append(taskFailureDiagnostics(service, task_location, isFsas, "Task for drive secure erase reported error", err)...)
+		}
+	case http.StatusOK, http.StatusNoContent:
+		// Erase finished synchronously
+	default:
+		diags.AddError("Secure erase request finished with error", fmt.Sprintf("POST on %s finished with status code %d", actionEndpoint, res.StatusCode))
+	}
+
+	return diags
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestAccRedfishDriveSecureErase_NotConfirmed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceDriveSecureEraseConfig(creds, false),
+				ExpectError: regexp.MustCompile("secure erase has not been confirmed"),
+			},
+		},
+	})
+}
+
+func TestFindStorageDrive(t *testing.T) {
+	format := "[ System_Id : Controller_Id : Enclosure_Id : Slot_Id ]"
+	drives := []*redfish.Drive{
+		{
+			Entity:      common.Entity{ODataID: "/redfish/v1/Systems/0/Storage/0/Drives/0"},
+			Location:    []common.Location{{Info: "[ 0 : 1 : 252 : 0 ]", InfoFormat: format}},
+			Identifiers: []common.Identifier{{DurableName: "5000C500A1B2C3D0", DurableNameFormat: common.NAADurableNameFormat}},
+		},
+		{
+			Entity:      common.Entity{ODataID: "/redfish/v1/Systems/0/Storage/0/Drives/1"},
+			Location:    []common.Location{{Info: "[ 0 : 1 : 252 : 1 ]", InfoFormat: format}},
+			Identifiers: []common.Identifier{{DurableName: "5000C500A1B2C3D1", DurableNameFormat: common.NAADurableNameFormat}},
+		},
+	}
+
+	if drive, err := findStorageDrive(drives, "252-1", ""); err != nil || drive != drives[1] {
+		t.Errorf("expected drive in slot 252-1, got %v, %v", drive, err)
+	}
+
+	if drive, err := findStorageDrive(drives, "", "5000C500A1B2C3D0"); err != nil || drive != drives[0] {
+		t.Errorf("expected drive with durable name 5000C500A1B2C3D0, got %v, %v", drive, err)
+	}
+
+	if _, err := findStorageDrive(drives, "1", ""); err == nil {
+		t.Errorf("expected error for slot not matching enclosure format")
+	}
+
+	if _, err := findStorageDrive(drives, "", "unknown"); err == nil {
+		t.Errorf("expected error for unknown durable name")
+	}
+}
+
+func testAccRedfishResourceDriveSecureEraseConfig(testingInfo TestingServerCredentials, confirm bool) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_drive_secure_erase" "erase" {
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		storage_controller_serial_number = "unknown"
+		slot                             = "0"
+		confirm_erase                    = %t
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		confirm,
+	)
+}
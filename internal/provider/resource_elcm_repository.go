@@ -0,0 +1,388 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ElcmRepositoryResource{}
+var _ resource.ResourceWithImportState = &ElcmRepositoryResource{}
+
+func NewElcmRepositoryResource() resource.Resource {
+	return &ElcmRepositoryResource{}
+}
+
+// ElcmRepositoryResource defines the resource implementation.
+type ElcmRepositoryResource struct {
+	p *IrmcProvider
+}
+
+func (r *ElcmRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + elcmRepositoryName
+}
+
+func ElcmRepositorySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of eLCM repository settings resource on iRMC.",
+			Description:         "ID of eLCM repository settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"repository_server": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Address of update repository server used by eLCM.",
+			Description:         "Address of update repository server used by eLCM.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"repository_path": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Path of update repository on repository server used by eLCM.",
+			Description:         "Path of update repository on repository server used by eLCM.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"proxy_server": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Address of proxy server used to access update repository. Empty value means direct connection.",
+			Description:         "Address of proxy server used to access update repository. Empty value means direct connection.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"proxy_port": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "TCP port of proxy server.",
+			Description:         "TCP port of proxy server.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"proxy_user": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "User name used for authentication to proxy server.",
+			Description:         "User name used for authentication to proxy server.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"proxy_password": schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			MarkdownDescription: "Password used for authentication to proxy server. iRMC does not report it, so it is only sent when changed in configuration.",
+			Description:         "Password used for authentication to proxy server. iRMC does not report it, so it is only sent when changed in configuration.",
+		},
+		"update_check_enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if eLCM periodically checks update repository for available updates.",
+			Description:         "Specifies if eLCM periodically checks update repository for available updates.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"update_check_interval": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Interval of update checks. Available values are 'Daily', 'Weekly' and 'Monthly'.",
+			Description:         "Interval of update checks. Available values are 'Daily', 'Weekly' and 'Monthly'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("Daily", "Weekly", "Monthly"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"update_check_time": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Time of update check in format HH:MM.",
+			Description:         "Time of update check in format HH:MM.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(scheduleTimeRegex, "must be time in format HH:MM"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *ElcmRepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) eLCM update repository settings of iRMC, which are prerequisite of eLCM updates.",
+		Description:         "The resource is used to control (read, modify or import) eLCM update repository settings of iRMC, which are prerequisite of eLCM updates.",
+		Attributes:          ElcmRepositorySchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *ElcmRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *ElcmRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-elcm_repository: create starts")
+
+	var plan models.ElcmRepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-elcm_repository: create ends")
+}
+
+func (r *ElcmRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-elcm_repository: read starts")
+
+	var state models.ElcmRepositoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-elcm_repository: read ends")
+}
+
+func (r *ElcmRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-elcm_repository: update starts")
+
+	var plan, state models.ElcmRepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-elcm_repository: update ends")
+}
+
+func (r *ElcmRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-elcm_repository: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-elcm_repository: delete ends")
+}
+
+func (r *ElcmRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-elcm_repository: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.ElcmRepositoryResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+		ProxyPassword: types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-elcm_repository: import ends")
+}
+
+// read reads current eLCM repository settings from iRMC into model.
+func (r *ElcmRepositoryResource) read(ctx context.Context, model *models.ElcmRepositoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	api, err := ConnectTargetSystem(r.p, &model.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, err := getElcmRepositoryEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read eLCM repository settings", err)...)
+		return diags
+	}
+
+	elcmRepositoryToModel(endpoint, data, model)
+	return diags
+}
+
+func (r *ElcmRepositoryResource) apply(ctx context.Context, plan *models.ElcmRepositoryResourceModel, state *models.ElcmRepositoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-elcm_repository"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	elcmEndpoint, err := getElcmRepositoryEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	var current models.ElcmRepositoryResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	// Password is not reported back by iRMC, so it is sent on its own and not verified
+	passwordPayload := map[string]interface{}{}
+	addStringToPayload(passwordPayload, "ProxyPassword", plan.ProxyPassword, current.ProxyPassword)
+	if len(passwordPayload) > 0 {
+		tflog.Info(ctx, "Applying eLCM repository proxy password")
+		if err = patchEndpointWithEtag(api, elcmEndpoint, passwordPayload); err != nil {
+			diags.Append(redfishErrorDiagnostics("Could not apply eLCM repository proxy password", err)...)
+			return diags
+		}
+	}
+
+	updateCheck := map[string]interface{}{}
+	addBoolToPayload(updateCheck, "Enabled", plan.UpdateCheckEnabled, current.UpdateCheckEnabled)
+	addStringToPayload(updateCheck, "Interval", plan.UpdateCheckInterval, current.UpdateCheckInterval)
+	addStringToPayload(updateCheck, "Time", plan.UpdateCheckTime, current.UpdateCheckTime)
+
+	payload := map[string]interface{}{}
+	addStringToPayload(payload, "RepositoryServer", plan.RepositoryServer, current.RepositoryServer)
+	addStringToPayload(payload, "RepositoryPath", plan.RepositoryPath, current.RepositoryPath)
+	addStringToPayload(payload, "ProxyServer", plan.ProxyServer, current.ProxyServer)
+	addInt64ToPayload(payload, "ProxyPort", plan.ProxyPort, current.ProxyPort)
+	addStringToPayload(payload, "ProxyUser", plan.ProxyUser, current.ProxyUser)
+	addObjectToPayload(payload, "UpdateCheck", updateCheck)
+
+	tflog.Info(ctx, "Applying eLCM repository settings", map[string]interface{}{"payload": payload})
+	data, err := applySettings(api, elcmEndpoint, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply eLCM repository settings", err)...)
+		return diags
+	}
+
+	elcmRepositoryToModel(elcmEndpoint, data, plan)
+	return diags
+}
+
+func getElcmRepositoryEndpoint(ctx context.Context, api *gofish.APIClient) (string, error) {
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		return "", err
+	}
+
+	return getElcmUpdateEndpoints(isFsas).elcmUpdateConfigEndpoint, nil
+}
+
+// elcmRepositoryToModel copies eLCM repository settings from OEM configuration object into model.
+// Proxy password is not reported by iRMC, so it is left untouched.
+func elcmRepositoryToModel(endpoint string, data map[string]interface{}, model *models.ElcmRepositoryResourceModel) {
+	updateCheck := jsonObjectValue(data, "UpdateCheck")
+
+	model.Id = types.StringValue(endpoint)
+	model.RepositoryServer = jsonStringValue(data, "RepositoryServer")
+	model.RepositoryPath = jsonStringValue(data, "RepositoryPath")
+	model.ProxyServer = jsonStringValue(data, "ProxyServer")
+	model.ProxyPort = jsonInt64Value(data, "ProxyPort")
+	model.ProxyUser = jsonStringValue(data, "ProxyUser")
+	model.UpdateCheckEnabled = jsonBoolValue(updateCheck, "Enabled")
+	model.UpdateCheckInterval = jsonStringValue(updateCheck, "Interval")
+	model.UpdateCheckTime = jsonStringValue(updateCheck, "Time")
+}
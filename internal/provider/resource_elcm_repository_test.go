@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const elcm_repository_name = "irmc-redfish_elcm_repository.repo"
+
+func TestAccRedfishElcmRepository_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceElcmRepositoryConfig(creds, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(elcm_repository_name, "id"),
+					resource.TestCheckResourceAttr(elcm_repository_name, "repository_server", "https://support.ts.fujitsu.com"),
+					resource.TestCheckResourceAttr(elcm_repository_name, "update_check_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceElcmRepositoryConfig(creds, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(elcm_repository_name, "update_check_enabled", "true"),
+					resource.TestCheckResourceAttr(elcm_repository_name, "update_check_interval", "Weekly"),
+					resource.TestCheckResourceAttr(elcm_repository_name, "update_check_time", "02:00"),
+				),
+			},
+			{
+				ResourceName:            elcm_repository_name,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("{\"username\":\"%s\",\"password\":\"%s\",\"endpoint\":\"https://%s\",\"ssl_insecure\":true}", creds.Username, creds.Password, creds.Endpoint),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server"},
+			},
+		},
+	})
+}
+
+func TestElcmRepositoryToModel(t *testing.T) {
+	model := models.ElcmRepositoryResourceModel{ProxyPassword: types.StringValue("secret")}
+	elcmRepositoryToModel("/endpoint", map[string]interface{}{
+		"RepositoryServer": "https://support.ts.fujitsu.com",
+		"RepositoryPath":   "DownloadManager/globalflash",
+		"ProxyServer":      "proxy.example.com",
+		"ProxyPort":        float64(3128),
+		"ProxyUser":        "updates",
+		"UpdateCheck":      map[string]interface{}{"Enabled": true, "Interval": "Weekly", "Time": "02:00"},
+	}, &model)
+
+	if model.RepositoryServer.ValueString() != "https://support.ts.fujitsu.com" || model.RepositoryPath.ValueString() != "DownloadManager/globalflash" ||
+		model.ProxyServer.ValueString() != "proxy.example.com" || model.ProxyPort.ValueInt64() != 3128 || model.ProxyUser.ValueString() != "updates" ||
+		!model.UpdateCheckEnabled.ValueBool() || model.UpdateCheckInterval.ValueString() != "Weekly" || model.UpdateCheckTime.ValueString() != "02:00" {
+		t.Errorf("Unexpected model %+v", model)
+	}
+
+	if model.ProxyPassword.ValueString() != "secret" {
+		t.Errorf("Proxy password should not be changed by read")
+	}
+}
+
+func testAccRedfishResourceElcmRepositoryConfig(testingInfo TestingServerCredentials, updateCheck bool) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_elcm_repository" "repo" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		repository_server     = "https://support.ts.fujitsu.com"
+		repository_path       = "DownloadManager/globalflash"
+		update_check_enabled  = %t
+		update_check_interval = "Weekly"
+		update_check_time     = "02:00"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		updateCheck,
+	)
+}
@@ -0,0 +1,347 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	ELCM_PREPARE_TIMEOUT = 1800
+	ELCM_UPDATE_TIMEOUT  = 7200
+)
+
+type elcmUpdateEndpoints struct {
+	elcmUpdateConfigEndpoint  string
+	elcmPrepareUpdateEndpoint string
+	elcmOfflineUpdateEndpoint string
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ElcmUpdateResource{}
+
+func NewElcmUpdateResource() resource.Resource {
+	return &ElcmUpdateResource{}
+}
+
+// ElcmUpdateResource defines the resource implementation.
+type ElcmUpdateResource struct {
+	p *IrmcProvider
+}
+
+func (r *ElcmUpdateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + elcmUpdate
+}
+
+func ElcmUpdateSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of eLCM update resource on iRMC.",
+			Description:         "ID of eLCM update resource on iRMC.",
+		},
+		"repository_server": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Address of update repository server used by eLCM. If not set, repository currently configured on iRMC (e.g. by irmc-redfish_elcm_repository resource) is used.",
+			Description:         "Address of update repository server used by eLCM. If not set, repository currently configured on iRMC (e.g. by irmc-redfish_elcm_repository resource) is used.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"repository_path": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Path of update repository on repository server used by eLCM.",
+			Description:         "Path of update repository on repository server used by eLCM.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"system_reset_type": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("GracefulRestart"),
+			MarkdownDescription: "Control how system will be reset to start offline update (if host is powered on).",
+			Description:         "Control how system will be reset to start offline update (if host is powered on).",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					"ForceRestart",
+					"GracefulRestart",
+					"PowerCycle",
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"prepare_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(ELCM_PREPARE_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for preparation of update repository (download of update packages) to finish.",
+			Description:         "Timeout in seconds for preparation of update repository (download of update packages) to finish.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(300),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"update_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(ELCM_UPDATE_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for offline update (including host reboots) to finish.",
+			Description:         "Timeout in seconds for offline update (including host reboots) to finish.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(600),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *ElcmUpdateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to run eLCM offline update of server components (e.g. NIC, RAID controller, PSU firmware) on Fujitsu server equipped with iRMC controller.",
+		Description:         "This resource is used to run eLCM offline update of server components (e.g. NIC, RAID controller, PSU firmware) on Fujitsu server equipped with iRMC controller.",
+		Attributes:          ElcmUpdateSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *ElcmUpdateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *ElcmUpdateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-elcm_update: create starts")
+
+	var plan models.ElcmUpdateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-elcm_update"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connection Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return
+	}
+
+	endp := getElcmUpdateEndpoints(isFsas)
+
+	// Step 1: configure repository if requested
+	err = configureElcmRepository(api, &plan, endp.elcmUpdateConfigEndpoint)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to configure eLCM update repository", err)...)
+		return
+	}
+
+	// Step 2: prepare update repository (download of update packages by eLCM)
+	tflog.Info(ctx, "Preparing eLCM update repository")
+	taskLocation, err := postElcmAction(api, endp.elcmPrepareUpdateEndpoint)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("eLCM update preparation could not be started", err)...)
+		return
+	}
+
+	err = checkElcmTaskStatus(ctx, api.Service, taskLocation, plan.PrepareTimeout.ValueInt64(), isFsas)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("eLCM update preparation did not complete successfully", err)...)
+		return
+	}
+
+	// Step 3: schedule offline update
+	tflog.Info(ctx, "Scheduling eLCM offline update")
+	taskLocation, err = postElcmAction(api, endp.elcmOfflineUpdateEndpoint)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("eLCM offline update could not be scheduled", err)...)
+		return
+	}
+
+	// Step 4: reboot host to let eLCM perform offline update
+	resetType := redfish.ResetType(plan.SystemResetType.ValueString())
+	err = resetOrPowerOnHostWithPostCheck(ctx, api.Service, resetType, plan.UpdateTimeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Host could not be reset to start eLCM offline update", err)...)
+		return
+	}
+
+	// Step 5: wait for completion of offline update
+	err = checkElcmTaskStatus(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), isFsas)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("eLCM offline update did not complete successfully", err)...)
+		return
+	}
+
+	plan.Id = types.StringValue(endp.elcmUpdateConfigEndpoint)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-elcm_update: create ends")
+}
+
+func (r *ElcmUpdateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-elcm_update: read starts")
+	var state models.ElcmUpdateResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "resource-elcm_update: read ends")
+}
+
+// Update modifies the resource state but returns an error if triggered, as updates are not supported.
+func (r *ElcmUpdateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// This function should not be called since updates are not supported; the resource should be recreated instead.
+	resp.Diagnostics.AddError(
+		"Unsupported Update Operation for eLCM Update",
+		"The eLCM Update resource does not support in-place updates. It is intended to be destroyed and recreated if changes are required.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ElcmUpdateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-elcm_update: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-elcm_update: delete ends")
+}
+
+// configureElcmRepository changes repository settings used by eLCM if they are defined in plan.
+func configureElcmRepository(api *gofish.APIClient, plan *models.ElcmUpdateResourceModel, elcmUpdateConfigEndpoint string) error {
+	payload := map[string]interface{}{}
+	if !plan.RepositoryServer.IsNull() && !plan.RepositoryServer.IsUnknown() {
+		payload["RepositoryServer"] = plan.RepositoryServer.ValueString()
+	}
+
+	if !plan.RepositoryPath.IsNull() && !plan.RepositoryPath.IsUnknown() {
+		payload["RepositoryPath"] = plan.RepositoryPath.ValueString()
+	}
+
+	if len(payload) == 0 {
+		return nil
+	}
+
+	return patchEndpointWithEtag(api, elcmUpdateConfigEndpoint, payload)
+}
+
+// postElcmAction triggers eLCM action pointed by actionEndpoint and returns location of created task.
+func postElcmAction(api *gofish.APIClient, actionEndpoint string) (string, error) {
+	res, err := api.Post(actionEndpoint, map[string]interface{}{})
+	if err != nil {
+		return "", fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	defer CloseResource(res.Body)
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusAccepted {
+		return "", fmt.Errorf("POST on %s finished with status code: %d", actionEndpoint, res.StatusCode)
+	}
+
+	taskLocation := res.Header.Get(HTTP_HEADER_LOCATION)
+	if taskLocation == "" {
+		return "", fmt.Errorf("task Location Missing. Location header not found in response")
+	}
+
+	return taskLocation, nil
+}
+
+func checkElcmTaskStatus(ctx context.Context, service *gofish.Service, location string, timeout int64, isFsas bool) error {
+	finishedSuccessfully, err := WaitForRedfishTaskEnd(ctx, service, location, timeout)
+	if err != nil || !finishedSuccessfully {
+		report, _ := GetRedfishTaskReport(service, location, isFsas)
+		return fmt.Errorf("eLCM task failed. Details: %s.\n%s", err, report.String())
+	}
+	return nil
+}
+
+func getElcmUpdateEndpoints(isFsas bool) elcmUpdateEndpoints {
+	if isFsas {
+		return elcmUpdateEndpoints{
+			elcmUpdateConfigEndpoint:  fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/eLCMUpdate", FSAS),
+			elcmPrepareUpdateEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.eLCMPrepareUpdate", FSAS),
+			elcmOfflineUpdateEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.eLCMOfflineUpdate", FSAS),
+		}
+	} else {
+		return elcmUpdateEndpoints{
+			elcmUpdateConfigEndpoint:  fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/eLCMUpdate", TS_FUJITSU),
+			elcmPrepareUpdateEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.eLCMPrepareUpdate", FTS),
+			elcmOfflineUpdateEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.eLCMOfflineUpdate", FTS),
+		}
+	}
+}
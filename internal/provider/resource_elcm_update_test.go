@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const elcm_update_name = "irmc-redfish_elcm_update.elcm"
+
+func TestAccRedfishElcmUpdate_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { testChangePowerHostState(creds, true) },
+				Config:    testAccRedfishResourceElcmUpdateConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(elcm_update_name, "id"),
+					resource.TestCheckResourceAttr(elcm_update_name, "system_reset_type", "GracefulRestart"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceElcmUpdateConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_elcm_update" "elcm" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
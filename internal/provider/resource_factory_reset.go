@@ -0,0 +1,353 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FactoryResetResource{}
+
+func NewFactoryResetResource() resource.Resource {
+	return &FactoryResetResource{}
+}
+
+// FactoryResetResource defines the resource implementation.
+type FactoryResetResource struct {
+	p *IrmcProvider
+}
+
+func (r *FactoryResetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + factoryResetName
+}
+
+func FactoryResetSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of factory reset resource on iRMC.",
+			Description:         "ID of factory reset resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"confirm_reset": schema.BoolAttribute{
+			Required:            true,
+			MarkdownDescription: "Explicit confirmation of factory reset, must be set to true. Reset can not be undone.",
+			Description:         "Explicit confirmation of factory reset, must be set to true. Reset can not be undone.",
+		},
+		"reset_irmc": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			MarkdownDescription: "Reset iRMC configuration to factory defaults.",
+			Description:         "Reset iRMC configuration to factory defaults.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"keep_network": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			MarkdownDescription: "Preserve network settings of iRMC during reset (default true).",
+			Description:         "Preserve network settings of iRMC during reset (default true).",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"keep_users": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			MarkdownDescription: "Preserve local user accounts of iRMC during reset, requires keep_network. Only in such case resource waits until iRMC becomes ready again.",
+			Description:         "Preserve local user accounts of iRMC during reset, requires keep_network. Only in such case resource waits until iRMC becomes ready again.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Bool{
+				validators.RequiresEnabled("keep_network"),
+			},
+		},
+		"reset_bios": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			MarkdownDescription: "Reset BIOS settings to defaults. Defaults are applied during next host boot.",
+			Description:         "Reset BIOS settings to defaults. Defaults are applied during next host boot.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"system_reset_type": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "If defined and host is powered on, host is reset in given way to apply BIOS defaults. Applicable values are: 'ForceRestart', 'GracefulRestart', 'PowerCycle'.",
+			Description:         "If defined and host is powered on, host is reset in given way to apply BIOS defaults. Applicable values are: 'ForceRestart', 'GracefulRestart', 'PowerCycle'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					"ForceRestart",
+					"GracefulRestart",
+					"PowerCycle",
+				}...),
+			},
+		},
+		"job_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(MANAGER_READY_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for host reset and for iRMC to become ready after reset (default 600s).",
+			Description:         "Timeout in seconds for host reset and for iRMC to become ready after reset (default 600s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(60),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Arbitrary map of values, change of which causes factory reset to be performed again.",
+			Description:         "Arbitrary map of values, change of which causes factory reset to be performed again.",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *FactoryResetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to reset iRMC configuration and/or BIOS settings to factory defaults, e.g. during decommissioning.",
+		Description:         "This resource is used to reset iRMC configuration and/or BIOS settings to factory defaults, e.g. during decommissioning.",
+		Attributes:          FactoryResetSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *FactoryResetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *FactoryResetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-factory-reset: create starts")
+
+	var plan models.FactoryResetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resetToDefaults, err := validateFactoryResetPlan(plan)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Factory reset can not be performed", err)...)
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-factory-reset"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	managers, err := api.Service.Managers()
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error when accessing Managers resource", err)...)
+		return
+	}
+
+	if len(managers) == 0 {
+		resp.Diagnostics.AddError("Error when accessing Managers resource", "iRMC does not report any manager")
+		return
+	}
+
+	plan.Id = types.StringValue(managers[0].ID)
+
+	// BIOS is reset first, since iRMC might not be accessible after its reset
+	if plan.ResetBios.ValueBool() {
+		resp.Diagnostics.Append(resetBiosToDefaults(ctx, api.Service, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.ResetIrmc.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("Resetting iRMC to defaults (%s)", resetToDefaults))
+		err = managers[0].ResetToDefaults(resetToDefaults)
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Error resetting iRMC to defaults", err)...)
+			return
+		}
+
+		if irmcResetPreservesCredentials(string(resetToDefaults)) {
+			readyApi, err := waitForManagerRestart(ctx, r.p, &plan.RedfishServer, plan.JobTimeout.ValueInt64())
+			if err != nil {
+				resp.Diagnostics.Append(redfishErrorDiagnostics("iRMC has not become ready after reset to defaults", err)...)
+				return
+			}
+			ReleaseTargetSystem(readyApi)
+		} else {
+			resp.Diagnostics.AddWarning("iRMC has been reset to factory defaults",
+				"Credentials have been reset, so it was not verified that iRMC became ready again")
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-factory-reset: create ends")
+}
+
+func (r *FactoryResetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-factory-reset: read starts")
+	// Reset is one time operation, so there is nothing to be read from iRMC
+	var state models.FactoryResetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-factory-reset: read ends")
+}
+
+// Update modifies the resource state. Changes which require reset cause replacement of the resource,
+// so only attributes which do not trigger reset are updated in place.
+func (r *FactoryResetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.FactoryResetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FactoryResetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-factory-reset: delete starts")
+	// Reset can not be reverted, so resource is only removed from state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-factory-reset: delete ends")
+}
+
+// validateFactoryResetPlan checks that factory reset has been confirmed and describes something
+// to be reset. Returns type of iRMC reset to defaults matching requested options.
+func validateFactoryResetPlan(plan models.FactoryResetResourceModel) (redfish.ResetToDefaultsType, error) {
+	if !plan.ConfirmReset.ValueBool() {
+		return "", fmt.Errorf("factory reset has not been confirmed, confirm_reset must be set to true")
+	}
+
+	if !plan.ResetIrmc.ValueBool() && !plan.ResetBios.ValueBool() {
+		return "", fmt.Errorf("at least one of reset_irmc and reset_bios must be set to true")
+	}
+
+	// keep_users without keep_network is rejected by validator of keep_users
+	if !plan.KeepNetwork.ValueBool() {
+		return redfish.ResetAllResetToDefaultsType, nil
+	}
+
+	if plan.KeepUsers.ValueBool() {
+		return redfish.PreserveNetworkAndUsersResetToDefaultsType, nil
+	}
+
+	return redfish.PreserveNetworkResetToDefaultsType, nil
+}
+
+// resetBiosToDefaults requests reset of BIOS settings to defaults and resets the host
+// to apply them, if requested by plan and host is powered on.
+func resetBiosToDefaults(ctx context.Context, service *gofish.Service, plan models.FactoryResetResourceModel) (diags diag.Diagnostics) {
+	system, err := GetSystemResource(service)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not access system resource", err)...)
+		return diags
+	}
+
+	bios, err := system.Bios()
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not access BIOS resource", err)...)
+		return diags
+	}
+
+	tflog.Info(ctx, "Resetting BIOS settings to defaults")
+	if err = bios.ResetBios(); err != nil {
+		diags.Append(redfishErrorDiagnostics("Error resetting BIOS settings to defaults", err)...)
+		return diags
+	}
+
+	poweredOn, err := isPoweredOn(service)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read host power state", err)...)
+		return diags
+	}
+
+	if !poweredOn || len(plan.SystemResetType.ValueString()) == 0 {
+		diags.AddWarning("BIOS defaults will be applied during next host boot", "Host has not been reset")
+		return diags
+	}
+
+	err = resetHost(ctx, service, redfish.ResetType(plan.SystemResetType.ValueString()), plan.JobTimeout.ValueInt64())
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Host reset to apply BIOS defaults finished with error", err)...)
+	}
+
+	return diags
+}
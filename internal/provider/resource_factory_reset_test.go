@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestAccRedfishFactoryReset_NotConfirmed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceFactoryResetConfig(creds, false),
+				ExpectError: regexp.MustCompile("factory reset has not been confirmed"),
+			},
+		},
+	})
+}
+
+func TestValidateFactoryResetPlan(t *testing.T) {
+	plan := models.FactoryResetResourceModel{
+		ConfirmReset: types.BoolValue(true),
+		ResetIrmc:    types.BoolValue(true),
+		KeepNetwork:  types.BoolValue(true),
+		KeepUsers:    types.BoolValue(true),
+		ResetBios:    types.BoolValue(false),
+	}
+
+	tests := []struct {
+		keepNetwork bool
+		keepUsers   bool
+		expected    redfish.ResetToDefaultsType
+		fails       bool
+	}{
+		{keepNetwork: true, keepUsers: true, expected: redfish.PreserveNetworkAndUsersResetToDefaultsType},
+		{keepNetwork: true, keepUsers: false, expected: redfish.PreserveNetworkResetToDefaultsType},
+		{keepNetwork: false, keepUsers: false, expected: redfish.ResetAllResetToDefaultsType},
+	}
+
+	for _, test := range tests {
+		plan.KeepNetwork = types.BoolValue(test.keepNetwork)
+		plan.KeepUsers = types.BoolValue(test.keepUsers)
+		resetType, err := validateFactoryResetPlan(plan)
+		if test.fails != (err != nil) || resetType != test.expected {
+			t.Errorf("keep_network %t, keep_users %t: unexpected result '%s', %v", test.keepNetwork, test.keepUsers, resetType, err)
+		}
+	}
+
+	plan.ResetIrmc = types.BoolValue(false)
+	if _, err := validateFactoryResetPlan(plan); err == nil {
+		t.Errorf("Expected error when nothing is requested to be reset")
+	}
+}
+
+func testAccRedfishResourceFactoryResetConfig(testingInfo TestingServerCredentials, confirm bool) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_irmc_factory_reset" "reset" {
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		confirm_reset = %t
+		reset_irmc    = true
+		keep_network  = true
+		keep_users    = true
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		confirm,
+	)
+}
@@ -0,0 +1,363 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	// FAN_CONTROL_OEM_OBJECT is name of OEM object of chassis thermal resource holding fan control policy.
+	FAN_CONTROL_OEM_OBJECT = "FanControl"
+	FAN_MODE_CUSTOM        = "Custom"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FanPolicyResource{}
+var _ resource.ResourceWithImportState = &FanPolicyResource{}
+
+func NewFanPolicyResource() resource.Resource {
+	return &FanPolicyResource{}
+}
+
+// FanPolicyResource defines the resource implementation.
+type FanPolicyResource struct {
+	p *IrmcProvider
+}
+
+func (r *FanPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + fanPolicyName
+}
+
+func FanPolicySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of thermal resource of chassis on iRMC.",
+			Description:         "ID of thermal resource of chassis on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"fan_mode": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Fan control policy. Available values are 'Quiet', 'Normal', 'Performance' and 'Custom'.",
+			Description:         "Fan control policy. Available values are 'Quiet', 'Normal', 'Performance' and 'Custom'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("Quiet", "Normal", "Performance", FAN_MODE_CUSTOM),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"minimum_fan_speed_percent": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Minimum speed of fans in percent of maximum speed. Can be set only with `fan_mode` 'Custom'.",
+			Description:         "Minimum speed of fans in percent of maximum speed. Can be set only with fan_mode 'Custom'.",
+			Validators: []validator.Int64{
+				int64validator.Between(0, 100),
+			},
+		},
+		"temperature_threshold_celsius": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Ambient temperature in degrees Celsius above which fans are speeded up. Can be set only with `fan_mode` 'Custom'.",
+			Description:         "Ambient temperature in degrees Celsius above which fans are speeded up. Can be set only with fan_mode 'Custom'.",
+			Validators: []validator.Int64{
+				int64validator.Between(10, 50),
+			},
+		},
+	}
+}
+
+func (r *FanPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) fan control policy of the server, e.g. quiet policy in lab and performance policy in datacenter.",
+		Description:         "The resource is used to control (read, modify or import) fan control policy of the server, e.g. quiet policy in lab and performance policy in datacenter.",
+		Attributes:          FanPolicySchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *FanPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *FanPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-fan_policy: create starts")
+
+	var plan models.FanPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-fan_policy: create ends")
+}
+
+func (r *FanPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-fan_policy: read starts")
+
+	var state models.FanPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-fan_policy: read ends")
+}
+
+func (r *FanPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-fan_policy: update starts")
+
+	var plan, state models.FanPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-fan_policy: update ends")
+}
+
+func (r *FanPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-fan_policy: delete starts")
+	// Fan policy can not be removed, so resource is only removed from state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-fan_policy: delete ends")
+}
+
+func (r *FanPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-fan_policy: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.FanPolicyResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-fan_policy: import ends")
+}
+
+// read reads current fan control policy from iRMC into model.
+func (r *FanPolicyResource) read(ctx context.Context, model *models.FanPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	api, err := ConnectTargetSystem(r.p, &model.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, vendor, err := getThermalEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not find thermal resource", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read fan policy", err)...)
+		return diags
+	}
+
+	fanPolicyToModel(endpoint, vendor, data, model)
+	return diags
+}
+
+// apply sends fan control policy, which is defined in plan and differs from state (if any),
+// to iRMC and reads back the policy into plan.
+func (r *FanPolicyResource) apply(ctx context.Context, plan *models.FanPolicyResourceModel, state *models.FanPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(validateFanPolicy(plan)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-fan_policy"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	thermalEndpoint, vendor, err := getThermalEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not find thermal resource", err)...)
+		return diags
+	}
+
+	var current models.FanPolicyResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	payload := map[string]interface{}{}
+	fanControl := map[string]interface{}{}
+	addStringToPayload(fanControl, "Mode", plan.FanMode, current.FanMode)
+	addInt64ToPayload(fanControl, "MinimumSpeedPercent", plan.MinimumFanSpeedPercent, current.MinimumFanSpeedPercent)
+	addInt64ToPayload(fanControl, "TemperatureThreshold", plan.TemperatureThresholdCelsius, current.TemperatureThresholdCelsius)
+	if len(fanControl) > 0 {
+		payload["Oem"] = map[string]interface{}{
+			vendor: map[string]interface{}{FAN_CONTROL_OEM_OBJECT: fanControl},
+		}
+	}
+
+	tflog.Info(ctx, "Applying fan policy", map[string]interface{}{"payload": payload})
+	data, err := applySettings(api, thermalEndpoint, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply fan policy", err)...)
+		return diags
+	}
+
+	fanPolicyToModel(thermalEndpoint, vendor, data, plan)
+	return diags
+}
+
+// validateFanPolicy checks that custom thresholds are configured only together with custom fan mode.
+func validateFanPolicy(plan *models.FanPolicyResourceModel) (diags diag.Diagnostics) {
+	if plan.FanMode.IsUnknown() || plan.FanMode.ValueString() == FAN_MODE_CUSTOM {
+		return diags
+	}
+
+	for name, value := range map[string]types.Int64{
+		"minimum_fan_speed_percent":     plan.MinimumFanSpeedPercent,
+		"temperature_threshold_celsius": plan.TemperatureThresholdCelsius,
+	} {
+		if !value.IsNull() && !value.IsUnknown() {
+			diags.AddAttributeError(path.Root(name), "Invalid fan policy",
+				fmt.Sprintf("%s can be set only if fan_mode is '%s'", name, FAN_MODE_CUSTOM))
+		}
+	}
+
+	return diags
+}
+
+// getThermalEndpoint returns thermal resource of main chassis and name of OEM vendor object.
+func getThermalEndpoint(ctx context.Context, api *gofish.APIClient) (endpoint string, vendor string, err error) {
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		return "", "", err
+	}
+
+	vendor = TS_FUJITSU
+	if isFsas {
+		vendor = FSAS
+	}
+
+	chassis, err := api.Service.Chassis()
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(chassis) == 0 {
+		return "", "", fmt.Errorf("iRMC does not report any chassis")
+	}
+
+	return chassis[0].ODataID + "/Thermal", vendor, nil
+}
+
+// fanPolicyToModel copies fan control policy from OEM object of thermal resource into model.
+// Custom thresholds are reported only for custom fan mode.
+func fanPolicyToModel(endpoint string, vendor string, data map[string]interface{}, model *models.FanPolicyResourceModel) {
+	fanControl := jsonObjectValue(jsonObjectValue(jsonObjectValue(data, "Oem"), vendor), FAN_CONTROL_OEM_OBJECT)
+
+	model.Id = types.StringValue(endpoint)
+	model.FanMode = jsonStringValue(fanControl, "Mode")
+	model.MinimumFanSpeedPercent = types.Int64Null()
+	model.TemperatureThresholdCelsius = types.Int64Null()
+	if model.FanMode.ValueString() == FAN_MODE_CUSTOM {
+		model.MinimumFanSpeedPercent = jsonInt64Value(fanControl, "MinimumSpeedPercent")
+		model.TemperatureThresholdCelsius = jsonInt64Value(fanControl, "TemperatureThreshold")
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const fan_policy_name = "irmc-redfish_fan_policy.fans"
+
+func TestAccRedfishFanPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceFanPolicyConfig(creds, "Quiet"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fan_policy_name, "id"),
+					resource.TestCheckResourceAttr(fan_policy_name, "fan_mode", "Quiet"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceFanPolicyConfig(creds, "Normal"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fan_policy_name, "fan_mode", "Normal"),
+				),
+			},
+			{
+				ResourceName:            fan_policy_name,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("{\"username\":\"%s\",\"password\":\"%s\",\"endpoint\":\"https://%s\",\"ssl_insecure\":true}", creds.Username, creds.Password, creds.Endpoint),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server"},
+			},
+		},
+	})
+}
+
+func TestFanPolicyToModel(t *testing.T) {
+	data := map[string]interface{}{
+		"Oem": map[string]interface{}{
+			TS_FUJITSU: map[string]interface{}{
+				FAN_CONTROL_OEM_OBJECT: map[string]interface{}{
+					"Mode":                 "Custom",
+					"MinimumSpeedPercent":  float64(30),
+					"TemperatureThreshold": float64(28),
+				},
+			},
+		},
+	}
+
+	var model models.FanPolicyResourceModel
+	fanPolicyToModel("/redfish/v1/Chassis/0/Thermal", TS_FUJITSU, data, &model)
+	if model.FanMode.ValueString() != "Custom" || model.MinimumFanSpeedPercent.ValueInt64() != 30 ||
+		model.TemperatureThresholdCelsius.ValueInt64() != 28 {
+		t.Errorf("Unexpected model %+v", model)
+	}
+
+	data["Oem"].(map[string]interface{})[TS_FUJITSU].(map[string]interface{})[FAN_CONTROL_OEM_OBJECT].(map[string]interface{})["Mode"] = "Normal"
+	fanPolicyToModel("/redfish/v1/Chassis/0/Thermal", TS_FUJITSU, data, &model)
+	if model.FanMode.ValueString() != "Normal" || !model.MinimumFanSpeedPercent.IsNull() || !model.TemperatureThresholdCelsius.IsNull() {
+		t.Errorf("Custom thresholds should not be reported for fan mode Normal, got %+v", model)
+	}
+}
+
+func TestValidateFanPolicy(t *testing.T) {
+	plan := models.FanPolicyResourceModel{
+		FanMode:                     types.StringValue("Custom"),
+		MinimumFanSpeedPercent:      types.Int64Value(30),
+		TemperatureThresholdCelsius: types.Int64Unknown(),
+	}
+	if diags := validateFanPolicy(&plan); diags.HasError() {
+		t.Errorf("Unexpected error %v", diags)
+	}
+
+	plan.FanMode = types.StringValue("Quiet")
+	if diags := validateFanPolicy(&plan); diags.ErrorsCount() != 1 {
+		t.Errorf("Expected one error, got %v", diags)
+	}
+}
+
+func testAccRedfishResourceFanPolicyConfig(testingInfo TestingServerCredentials, mode string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_fan_policy" "fans" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		fan_mode = "%s"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		mode,
+	)
+}
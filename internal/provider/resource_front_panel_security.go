@@ -0,0 +1,308 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	// SECURITY_CONFIGURATION is name of OEM iRMC configuration object holding security settings.
+	SECURITY_CONFIGURATION = "Security"
+	// FRONT_PANEL_SECURITY_OBJECT is name of object of security settings holding front panel settings.
+	FRONT_PANEL_SECURITY_OBJECT = "FrontPanel"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FrontPanelSecurityResource{}
+var _ resource.ResourceWithImportState = &FrontPanelSecurityResource{}
+
+func NewFrontPanelSecurityResource() resource.Resource {
+	return &FrontPanelSecurityResource{}
+}
+
+// FrontPanelSecurityResource defines the resource implementation.
+type FrontPanelSecurityResource struct {
+	p *IrmcProvider
+}
+
+func (r *FrontPanelSecurityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + frontPanelSecurityName
+}
+
+func FrontPanelSecuritySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of security settings resource on iRMC.",
+			Description:         "ID of security settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"front_usb_enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if USB ports on the front panel of the server are enabled.",
+			Description:         "Specifies if USB ports on the front panel of the server are enabled.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"power_button_inhibit": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if power button on the front panel is inhibited, so the server can not be powered on or off locally.",
+			Description:         "Specifies if power button on the front panel is inhibited, so the server can not be powered on or off locally.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"reset_button_inhibit": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if reset button on the front panel is inhibited, so the server can not be reset locally.",
+			Description:         "Specifies if reset button on the front panel is inhibited, so the server can not be reset locally.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *FrontPanelSecurityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) front USB ports and front panel buttons of the server, e.g. for kiosk-like secure deployments.",
+		Description:         "The resource is used to control (read, modify or import) front USB ports and front panel buttons of the server, e.g. for kiosk-like secure deployments.",
+		Attributes:          FrontPanelSecuritySchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *FrontPanelSecurityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *FrontPanelSecurityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-front_panel_security: create starts")
+
+	var plan models.FrontPanelSecurityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-front_panel_security: create ends")
+}
+
+func (r *FrontPanelSecurityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-front_panel_security: read starts")
+
+	var state models.FrontPanelSecurityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-front_panel_security: read ends")
+}
+
+func (r *FrontPanelSecurityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-front_panel_security: update starts")
+
+	var plan, state models.FrontPanelSecurityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-front_panel_security: update ends")
+}
+
+func (r *FrontPanelSecurityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-front_panel_security: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-front_panel_security: delete ends")
+}
+
+func (r *FrontPanelSecurityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-front_panel_security: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.FrontPanelSecurityResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-front_panel_security: import ends")
+}
+
+// read reads current front panel security settings from iRMC into model.
+func (r *FrontPanelSecurityResource) read(ctx context.Context, model *models.FrontPanelSecurityResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	api, err := ConnectTargetSystem(r.p, &model.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, err := getSecuritySettingsEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read front panel security settings", err)...)
+		return diags
+	}
+
+	frontPanelSecurityToModel(endpoint, data, model)
+	return diags
+}
+
+func (r *FrontPanelSecurityResource) apply(ctx context.Context, plan *models.FrontPanelSecurityResourceModel, state *models.FrontPanelSecurityResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-front_panel_security"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	securityEndpoint, err := getSecuritySettingsEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	var current models.FrontPanelSecurityResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	frontPanel := map[string]interface{}{}
+	addBoolToPayload(frontPanel, "UsbPortsEnabled", plan.FrontUsbEnabled, current.FrontUsbEnabled)
+	addBoolToPayload(frontPanel, "PowerButtonInhibit", plan.PowerButtonInhibit, current.PowerButtonInhibit)
+	addBoolToPayload(frontPanel, "ResetButtonInhibit", plan.ResetButtonInhibit, current.ResetButtonInhibit)
+
+	payload := map[string]interface{}{}
+	addObjectToPayload(payload, FRONT_PANEL_SECURITY_OBJECT, frontPanel)
+
+	tflog.Info(ctx, "Applying front panel security settings", map[string]interface{}{"payload": payload})
+	data, err := applySettings(api, securityEndpoint, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply front panel security settings", err)...)
+		return diags
+	}
+
+	frontPanelSecurityToModel(securityEndpoint, data, plan)
+	return diags
+}
+
+func getSecuritySettingsEndpoint(ctx context.Context, api *gofish.APIClient) (string, error) {
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		return "", err
+	}
+
+	return getIrmcConfigurationEndpoint(isFsas, SECURITY_CONFIGURATION), nil
+}
+
+// frontPanelSecurityToModel copies front panel settings from OEM security configuration object into model.
+func frontPanelSecurityToModel(endpoint string, data map[string]interface{}, model *models.FrontPanelSecurityResourceModel) {
+	frontPanel := jsonObjectValue(data, FRONT_PANEL_SECURITY_OBJECT)
+
+	model.Id = types.StringValue(endpoint)
+	model.FrontUsbEnabled = jsonBoolValue(frontPanel, "UsbPortsEnabled")
+	model.PowerButtonInhibit = jsonBoolValue(frontPanel, "PowerButtonInhibit")
+	model.ResetButtonInhibit = jsonBoolValue(frontPanel, "ResetButtonInhibit")
+}
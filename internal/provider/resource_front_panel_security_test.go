@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const front_panel_security_name = "irmc-redfish_front_panel_security.front_panel"
+
+func TestAccRedfishFrontPanelSecurity_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceFrontPanelSecurityConfig(creds, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(front_panel_security_name, "id"),
+					resource.TestCheckResourceAttr(front_panel_security_name, "front_usb_enabled", "false"),
+					resource.TestCheckResourceAttrSet(front_panel_security_name, "power_button_inhibit"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceFrontPanelSecurityConfig(creds, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(front_panel_security_name, "front_usb_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            front_panel_security_name,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("{\"username\":\"%s\",\"password\":\"%s\",\"endpoint\":\"https://%s\",\"ssl_insecure\":true}", creds.Username, creds.Password, creds.Endpoint),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server"},
+			},
+		},
+	})
+}
+
+func TestFrontPanelSecurityApply(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	endpoint := getIrmcConfigurationEndpoint(false, SECURITY_CONFIGURATION)
+	server.Set(endpoint, map[string]interface{}{
+		FRONT_PANEL_SECURITY_OBJECT: map[string]interface{}{
+			"UsbPortsEnabled":    true,
+			"PowerButtonInhibit": false,
+			"ResetButtonInhibit": false,
+		},
+	})
+
+	r := FrontPanelSecurityResource{p: connectMockRedfishServer(t, server)}
+	plan := models.FrontPanelSecurityResourceModel{
+		FrontUsbEnabled:    types.BoolValue(false),
+		PowerButtonInhibit: types.BoolValue(true),
+		ResetButtonInhibit: types.BoolUnknown(),
+	}
+
+	if diags := r.apply(context.Background(), &plan, nil); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if plan.Id.ValueString() != endpoint || plan.FrontUsbEnabled.ValueBool() || !plan.PowerButtonInhibit.ValueBool() ||
+		plan.ResetButtonInhibit.IsUnknown() || plan.ResetButtonInhibit.ValueBool() {
+		t.Errorf("Unexpected front panel settings %+v", plan)
+	}
+
+	frontPanel := server.Resource(endpoint)[FRONT_PANEL_SECURITY_OBJECT].(map[string]interface{})
+	if frontPanel["UsbPortsEnabled"] != false || frontPanel["PowerButtonInhibit"] != true {
+		t.Errorf("Unexpected front panel settings on server %v", frontPanel)
+	}
+}
+
+func testAccRedfishResourceFrontPanelSecurityConfig(testingInfo TestingServerCredentials, usb bool) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_front_panel_security" "front_panel" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		front_usb_enabled = %t
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		usb,
+	)
+}
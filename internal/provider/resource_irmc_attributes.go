@@ -18,13 +18,18 @@ limitations under the License.
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
+	"net"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	"terraform-provider-irmc-redfish/internal/models"
 
@@ -51,6 +56,7 @@ type irmcAttributesEndpoints struct {
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IrmcAttributesResource{}
 var _ resource.ResourceWithImportState = &IrmcAttributesResource{}
+var _ resource.ResourceWithModifyPlan = &IrmcAttributesResource{}
 
 func NewIrmcAttributesResource() resource.Resource {
 	return &IrmcAttributesResource{}
@@ -81,6 +87,20 @@ func IrmcAttributesSchema() map[string]schema.Attribute {
 				mapvalidator.SizeAtLeast(1),
 			},
 		},
+		"ignore_attributes": schema.ListAttribute{
+			Optional:            true,
+			MarkdownDescription: "List of iRMC attributes, whose values are applied, but not refreshed from iRMC, so that their drift does not cause any diff (e.g. attributes rewritten by firmware).",
+			Description:         "List of iRMC attributes, whose values are applied, but not refreshed from iRMC, so that their drift does not cause any diff (e.g. attributes rewritten by firmware).",
+			ElementType:         types.StringType,
+		},
+		"ready_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Description:         "Timeout in seconds for iRMC to become ready again, if change of attributes causes its restart (default 600s).",
+			MarkdownDescription: "Timeout in seconds for iRMC to become ready again, if change of attributes causes its restart (default 600s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(60),
+			},
+		},
 		"job_timeout": schema.Int64Attribute{
 			Computed:            true,
 			Optional:            true,
@@ -130,12 +150,13 @@ func (r *IrmcAttributesResource) Create(ctx context.Context, req resource.Create
 	var plan models.IrmcAttributesResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-irmc-attributes"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -143,15 +164,15 @@ func (r *IrmcAttributesResource) Create(ctx context.Context, req resource.Create
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	isFsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 	endp := getIrmcAttributesEndpoints(isFsas)
@@ -181,6 +202,13 @@ func (r *IrmcAttributesResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Some attributes (e.g. network settings) cause restart of iRMC
+	err = ensureManagerReady(ctx, r.p, &plan.RedfishServer, api, getManagerReadyTimeout(plan.ReadyTimeout))
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("iRMC has not become ready after change of attributes", err)...)
+		return
+	}
+
 	plan.Id = types.StringValue(endp.irmcAttributesSettingsEndpoint)
 
 	diags = resp.State.Set(ctx, &plan)
@@ -204,25 +232,31 @@ func (r *IrmcAttributesResource) Read(ctx context.Context, req resource.ReadRequ
 
 	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	isFsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 	endp := getIrmcAttributesEndpoints(isFsas)
 
+	priorAttributes := state.Attributes
 	diags := readIrmcAttributesSettingsToModel(ctx, api.Service, &state.Attributes, false, endp.irmcAttributesSettingsEndpoint)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(keepConfiguredIrmcAttributes(ctx, priorAttributes, &state.Attributes, state.IgnoreAttributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 
@@ -236,6 +270,7 @@ func (r *IrmcAttributesResource) Update(ctx context.Context, req resource.Update
 	var plan models.IrmcAttributesResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -243,15 +278,15 @@ func (r *IrmcAttributesResource) Update(ctx context.Context, req resource.Update
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	isFsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 	endp := getIrmcAttributesEndpoints(isFsas)
@@ -281,6 +316,13 @@ func (r *IrmcAttributesResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	// Some attributes (e.g. network settings) cause restart of iRMC
+	err = ensureManagerReady(ctx, r.p, &plan.RedfishServer, api, getManagerReadyTimeout(plan.ReadyTimeout))
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("iRMC has not become ready after change of attributes", err)...)
+		return
+	}
+
 	plan.Id = types.StringValue(endp.irmcAttributesSettingsEndpoint)
 
 	diags = resp.State.Set(ctx, &plan)
@@ -292,6 +334,36 @@ func (r *IrmcAttributesResource) Update(ctx context.Context, req resource.Update
 	tflog.Info(ctx, "resource-irmc-attributes: update ends")
 }
 
+// ModifyPlan validates names of planned attributes against attributes supported by iRMC, so that typos
+// are reported already during plan. Validation is skipped if iRMC cannot be reached at plan time.
+func (r *IrmcAttributesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate during destruction or if provider has not been configured yet
+	if req.Plan.Raw.IsNull() || r.p == nil {
+		return
+	}
+
+	var plan models.IrmcAttributesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Attributes.IsNull() || plan.Attributes.IsUnknown() || !isRedfishServerKnown(plan.RedfishServer) {
+		return
+	}
+
+	supported, err := getSupportedIrmcAttributeNames(ctx, r.p, plan.RedfishServer)
+	if err != nil {
+		tflog.Warn(ctx, "resource-irmc-attributes: attribute names could not be validated during plan", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.Diagnostics.Append(validateIrmcAttributeNames(plan.Attributes, supported)...)
+}
+
 func (r *IrmcAttributesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "resource-irmc-attributes: delete starts")
 	resp.State.RemoveResource(ctx)
@@ -302,22 +374,40 @@ func (r *IrmcAttributesResource) ImportState(ctx context.Context, req resource.I
 	tflog.Info(ctx, "resource-irmc-attributes: import starts")
 
 	var config CommonImportConfig
-	err := json.Unmarshal([]byte(req.ID), &config)
+	server, err := parseImportID(req.ID, &config, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
 		return
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
+	creds := []models.RedfishServer{server}
+
+	api, err := ConnectTargetSystem(r.p, &creds)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
 	}
 
-	creds := []models.RedfishServer{server}
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return
+	}
+	endp := getIrmcAttributesEndpoints(isFsas)
+
+	// All current attributes are imported, so that configuration can be captured from already configured server
+	attributes := types.MapNull(types.StringType)
+	resp.Diagnostics.Append(readIrmcAttributesSettingsToModel(ctx, api.Service, &attributes, true, endp.irmcAttributesSettingsEndpoint)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("id"), endp.irmcAttributesSettingsEndpoint)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("attributes"), attributes)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("job_timeout"), types.Int64Value(600))...)
 
 	tflog.Info(ctx, "resource-irmc-attributes: import ends")
 }
@@ -353,7 +443,7 @@ func getIrmcAttributesResource(service *gofish.Service, endpointAttributes strin
 func validateAndAdjustPlannedIrmcAttributes(ctx context.Context, service *gofish.Service, plannedAttributes map[string]string, endpointAttributes string) (adjustedAttributes map[string]interface{}, diags diag.Diagnostics) {
 	resource, err := getIrmcAttributesResource(service, endpointAttributes)
 	if err != nil {
-		diags.AddError("Error while reading /iRMCConfiguration/Attributes", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error while reading /iRMCConfiguration/Attributes", err)...)
 		return adjustedAttributes, diags
 	}
 
@@ -416,7 +506,7 @@ func validateAndAdjustPlannedIrmcAttributes(ctx context.Context, service *gofish
 func readIrmcAttributesSettingsToModel(ctx context.Context, service *gofish.Service, attrMap *types.Map, updateAll bool, endpointAttributes string) (diags diag.Diagnostics) {
 	resource, err := getIrmcAttributesResource(service, endpointAttributes)
 	if err != nil {
-		diags.AddError("Error while reading /iRMCConfiguration/Attributes", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error while reading /iRMCConfiguration/Attributes", err)...)
 		return diags
 	}
 
@@ -444,25 +534,227 @@ func readIrmcAttributesSettingsToModel(ctx context.Context, service *gofish.Serv
 	return diags
 }
 
-func applyIrmcAttributes(service *gofish.Service, attributes map[string]interface{}, endpointAttributes string) (diags diag.Diagnostics, location string) {
-	client := service.GetClient()
-	res, err := client.Get(endpointAttributes)
+// supportedIrmcAttributeNamesEntry keeps outcome of reading attribute names of single iRMC. Its lock is held
+// while iRMC is being read, so that concurrent callers for the same endpoint wait for the outcome.
+type supportedIrmcAttributeNamesEntry struct {
+	lock  sync.Mutex
+	done  bool
+	names []string
+	err   error
+}
+
+// supportedIrmcAttributeNames keeps names of attributes supported by iRMC per endpoint, so that
+// the attributes resource is read only once during plan, even if it is used by many resources.
+// Failures are kept as well, so unreachable iRMC does not delay every plan-time validation.
+var supportedIrmcAttributeNames = struct {
+	lock    sync.Mutex
+	entries map[string]*supportedIrmcAttributeNamesEntry
+}{entries: make(map[string]*supportedIrmcAttributeNamesEntry)}
+
+// isRedfishServerKnown checks if server block does not contain values known only after apply.
+func isRedfishServerKnown(servers []models.RedfishServer) bool {
+	for _, server := range servers {
+		if server.Endpoint.IsUnknown() || server.User.IsUnknown() || server.Password.IsUnknown() ||
+			server.PasswordWO.IsUnknown() || server.SessionToken.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getSupportedIrmcAttributeNames returns sorted names of attributes supported by iRMC described by rserver.
+func getSupportedIrmcAttributeNames(ctx context.Context, pconfig *IrmcProvider, rserver []models.RedfishServer) ([]string, error) {
+	endpoint := normalizeEndpoint(getServerEndpoint(pconfig, rserver))
+
+	supportedIrmcAttributeNames.lock.Lock()
+	entry, ok := supportedIrmcAttributeNames.entries[endpoint]
+	if !ok {
+		entry = &supportedIrmcAttributeNamesEntry{}
+		supportedIrmcAttributeNames.entries[endpoint] = entry
+	}
+	supportedIrmcAttributeNames.lock.Unlock()
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if !entry.done {
+		entry.names, entry.err = readSupportedIrmcAttributeNames(ctx, pconfig, rserver)
+		entry.done = true
+	}
+
+	return entry.names, entry.err
+}
+
+// readSupportedIrmcAttributeNames reads sorted names of attributes supported by iRMC described by rserver.
+func readSupportedIrmcAttributeNames(ctx context.Context, pconfig *IrmcProvider, rserver []models.RedfishServer) ([]string, error) {
+	api, err := ConnectTargetSystem(pconfig, &rserver)
 	if err != nil {
-		diags.AddError("Reading iRMCConfiguration/Attributes failed", err.Error())
-		return diags, ""
+		return nil, err
 	}
 
-	CloseResource(res.Body)
+	defer ReleaseTargetSystem(api)
 
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := getIrmcAttributesResource(api.Service, getIrmcAttributesEndpoints(isFsas).irmcAttributesSettingsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resource.Attributes) == 0 {
+		return nil, fmt.Errorf("iRMC does not report any attributes")
+	}
+
+	return slices.Sorted(maps.Keys(resource.Attributes)), nil
+}
+
+// validateIrmcAttributeNames reports attributes not contained in supported names together with
+// suggestion of the most similar supported name.
+func validateIrmcAttributeNames(attributes types.Map, supported []string) (diags diag.Diagnostics) {
+	for _, name := range slices.Sorted(maps.Keys(attributes.Elements())) {
+		if _, found := slices.BinarySearch(supported, name); found {
+			continue
+		}
+
+		msg := fmt.Sprintf("Attribute '%s' is not supported by the system", name)
+		if suggestion := suggestIrmcAttributeName(name, supported); len(suggestion) > 0 {
+			msg += fmt.Sprintf(", did you mean '%s'?", suggestion)
+		}
+
+		diags.AddAttributeError(tkpath.Root("attributes").AtMapKey(name), "Not supported attribute", msg)
+	}
+
+	return diags
+}
+
+// suggestIrmcAttributeName returns supported name most similar to name (compared case insensitively),
+// if it differs by few characters only. Otherwise empty string is returned.
+func suggestIrmcAttributeName(name string, supported []string) string {
+	var suggestion string
+	best := max(2, len(name)/4) + 1
+	for _, candidate := range supported {
+		if distance := levenshteinDistance(strings.ToLower(name), strings.ToLower(candidate)); distance < best {
+			best = distance
+			suggestion = candidate
+		}
+	}
+
+	return suggestion
+}
+
+// levenshteinDistance returns minimal number of single character edits needed to change a into b.
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// irmcWriteOnlyAttributeSuffixes lists name suffixes of iRMC attributes, which can be written, but whose values
+// are not reported back by iRMC (it returns empty or masked value instead).
+var irmcWriteOnlyAttributeSuffixes = []string{"Password", "Passphrase", "Secret"}
+
+func isWriteOnlyIrmcAttribute(name string) bool {
+	for _, suffix := range irmcWriteOnlyAttributeSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// irmcAttributeValuesEqual checks if configured and reported attribute values are semantically equal, since
+// iRMC normalizes some values, e.g. letter case of booleans, leading zeros of numbers or format of IP and MAC addresses.
+func irmcAttributeValuesEqual(configured, reported string) bool {
+	configured, reported = strings.TrimSpace(configured), strings.TrimSpace(reported)
+	if configured == reported {
+		return true
+	}
+
+	if strings.EqualFold(configured, "true") || strings.EqualFold(configured, "false") {
+		return strings.EqualFold(configured, reported)
+	}
+
+	if c, err := strconv.ParseInt(configured, 10, 64); err == nil {
+		r, err := strconv.ParseInt(reported, 10, 64)
+		return err == nil && c == r
+	}
+
+	if c := net.ParseIP(configured); c != nil {
+		return c.Equal(net.ParseIP(reported))
+	}
+
+	if c, err := net.ParseMAC(configured); err == nil {
+		r, err := net.ParseMAC(reported)
+		return err == nil && bytes.Equal(c, r)
+	}
+
+	return false
+}
+
+// keepConfiguredIrmcAttributes puts back values from prior state into attributes read from iRMC for attributes,
+// which are ignored by user, write-only or semantically equal to the reported value, so that plans converge.
+func keepConfiguredIrmcAttributes(ctx context.Context, prior types.Map, current *types.Map, ignore types.List) (diags diag.Diagnostics) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return diags
+	}
+
+	var priorValues, currentValues map[string]string
+	diags.Append(prior.ElementsAs(ctx, &priorValues, true)...)
+	diags.Append(current.ElementsAs(ctx, &currentValues, true)...)
+
+	var ignored []string
+	if !ignore.IsNull() && !ignore.IsUnknown() {
+		diags.Append(ignore.ElementsAs(ctx, &ignored, true)...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for key, priorVal := range priorValues {
+		currVal, reported := currentValues[key]
+		switch {
+		case slices.Contains(ignored, key), isWriteOnlyIrmcAttribute(key):
+			currentValues[key] = priorVal
+		case reported && irmcAttributeValuesEqual(priorVal, currVal):
+			currentValues[key] = priorVal
+		}
+	}
+
+	*current, diags = types.MapValueFrom(ctx, types.StringType, currentValues)
+	return diags
+}
+
+func applyIrmcAttributes(service *gofish.Service, attributes map[string]interface{}, endpointAttributes string) (diags diag.Diagnostics, location string) {
 	payload := map[string]interface{}{
 		"Attributes": attributes,
 	}
 
-	res, err = client.PatchWithHeaders(endpointAttributes, payload,
-		map[string]string{HTTP_HEADER_IF_MATCH: res.Header.Get(HTTP_HEADER_ETAG)})
-
+	res, err := PatchWithEtag(service.GetClient(), endpointAttributes, payload)
 	if err != nil {
-		diags.AddError("Changing iRMCConfiguration/Attributes failed", err.Error())
+		diags.Append(redfishErrorDiagnostics("Changing iRMCConfiguration/Attributes failed", err)...)
 		return diags, ""
 	}
 
@@ -477,13 +769,7 @@ func applyIrmcAttributes(service *gofish.Service, attributes map[string]interfac
 func waitTillIrmcAttributesSettingsApplied(ctx context.Context, service *gofish.Service, task_location string, timeout int64, isFsas bool) (diags diag.Diagnostics) {
 	_, err := WaitForRedfishTaskEnd(ctx, service, task_location, timeout)
 	if err != nil {
-		diags.AddError("Task for patching attributes reported error", err.Error())
-		logs, internal_diags := FetchRedfishTaskLog(service, task_location, isFsas)
-		if logs == nil {
-			diags = append(diags, internal_diags...)
-		} else {
-			diags.AddError("Task logs for patching attributes", string(logs))
-		}
+		diags.Append(taskFailureDiagnostics(service, task_location, isFsas, "Task for patching attributes reported error", err)...)
 	} else {
 		diags = verifyErrorsInIrmcAttributesTaskLog(service, task_location, isFsas)
 	}
@@ -491,31 +777,19 @@ func waitTillIrmcAttributesSettingsApplied(ctx context.Context, service *gofish.
 	return diags
 }
 
-type taskLog struct {
-	Messages []struct {
-		Time    string `json:"Time"`
-		Message string `json:"Message"`
-	} `json:"Messages"`
-}
-
 func verifyErrorsInIrmcAttributesTaskLog(service *gofish.Service, task_location string, isFsas bool) (diags diag.Diagnostics) {
 	logs_bytes, internal_diags := FetchRedfishTaskLog(service, task_location, isFsas)
 	if logs_bytes == nil {
 		diags = append(diags, internal_diags...)
 	} else {
-		var config taskLog
-		err := json.Unmarshal(logs_bytes, &config)
+		messages, err := ParseRedfishTaskLog(logs_bytes)
 		if err != nil {
-			diags.AddError("Task logs could not be unmarshalled", err.Error())
+			diags.Append(redfishErrorDiagnostics("Task logs could not be unmarshalled", err)...)
 			return diags
 		}
 
-		for _, v := range config.Messages {
-			if strings.Contains(v.Message, "Error") {
-				diags.AddError("Task log contains error message(s)", v.Message)
-			}
-		}
-
+		report := RedfishTaskReport{Messages: messages}
+		diags.Append(report.Diagnostics("Task log contains error message(s)")...)
 	}
 
 	return diags
@@ -23,9 +23,18 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stmcginnis/gofish"
@@ -68,6 +77,21 @@ func TestAccRedfishIrmcAttributes_import(t *testing.T) {
 				ImportStateIdFunc: func(d *terraform.State) (string, error) {
 					return getIrmcAttributesImportConfiguration(creds)
 				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+
+					if count, err := strconv.Atoi(states[0].Attributes["attributes.%"]); err != nil || count == 0 {
+						return fmt.Errorf("expected imported state to contain all current attributes")
+					}
+
+					if _, ok := states[0].Attributes["attributes.BmcCasLoginUri"]; !ok {
+						return fmt.Errorf("expected imported state to contain attribute BmcCasLoginUri")
+					}
+
+					return nil
+				},
 			},
 		},
 	})
@@ -230,3 +254,157 @@ func testChangeCasLoginUri(creds TestingServerCredentials, loginUri string) {
 		}
 	}
 }
+
+func TestKeepConfiguredIrmcAttributes(t *testing.T) {
+	ctx := context.Background()
+
+	prior, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"BmcNetworkIpv6Address": "fd00:0:0:0:0:0:0:10",
+		"BmcSnmpEnabled":        "true",
+		"BmcSmtpAuthPassword":   "secret",
+		"BmcCasLoginUri":        "abc/def",
+		"BmcHostName":           "irmc-golden",
+		"BmcLdapPrimaryServer":  "ldap1",
+	})
+	current, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"BmcNetworkIpv6Address": "fd00::10",
+		"BmcSnmpEnabled":        "True",
+		"BmcSmtpAuthPassword":   "",
+		"BmcCasLoginUri":        "abc/xyz",
+		"BmcHostName":           "irmc-changed",
+		"BmcLdapPrimaryServer":  "ldap2",
+	})
+	ignore, _ := types.ListValueFrom(ctx, types.StringType, []string{"BmcHostName"})
+
+	diags := keepConfiguredIrmcAttributes(ctx, prior, &current, ignore)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics %v", diags)
+	}
+
+	var result map[string]string
+	current.ElementsAs(ctx, &result, false)
+
+	expected := map[string]string{
+		"BmcNetworkIpv6Address": "fd00:0:0:0:0:0:0:10",
+		"BmcSnmpEnabled":        "true",
+		"BmcSmtpAuthPassword":   "secret",
+		"BmcCasLoginUri":        "abc/xyz",
+		"BmcHostName":           "irmc-golden",
+		"BmcLdapPrimaryServer":  "ldap2",
+	}
+	for key, val := range expected {
+		if result[key] != val {
+			t.Errorf("Attribute %s expected '%s', got '%s'", key, val, result[key])
+		}
+	}
+}
+
+func TestIrmcAttributeValuesEqual(t *testing.T) {
+	tests := []struct {
+		configured string
+		reported   string
+		equal      bool
+	}{
+		{"Enabled", "Enabled", true},
+		{"Enabled", "enabled", false},
+		{"false", "FALSE", true},
+		{"60", "060", true},
+		{"60", "61", false},
+		{"192.168.1.10", "192.168.001.010", false},
+		{"2001:db8::1", "2001:0db8:0:0:0:0:0:1", true},
+		{"00:19:99:AA:BB:CC", "00-19-99-aa-bb-cc", true},
+		{" value ", "value", true},
+	}
+
+	for _, test := range tests {
+		if equal := irmcAttributeValuesEqual(test.configured, test.reported); equal != test.equal {
+			t.Errorf("Comparison of '%s' and '%s' expected %t, got %t", test.configured, test.reported, test.equal, equal)
+		}
+	}
+}
+
+func TestValidateIrmcAttributeNames(t *testing.T) {
+	ctx := context.Background()
+	supported := []string{"BmcCasLoginUri", "BmcHostName", "BmcSnmpServiceCommunityName", "BmcSnmpServicePort"}
+
+	attributes, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"BmcHostName":        "irmc",
+		"BmcSnmpServicePrt":  "161",
+		"bmccasloginuri":     "abc/def",
+		"CompletelyUnknown1": "x",
+	})
+
+	diags := validateIrmcAttributeNames(attributes, supported)
+	if diags.ErrorsCount() != 3 {
+		t.Fatalf("Expected 3 errors, got %v", diags)
+	}
+
+	expected := []string{
+		"Attribute 'BmcSnmpServicePrt' is not supported by the system, did you mean 'BmcSnmpServicePort'?",
+		"Attribute 'CompletelyUnknown1' is not supported by the system",
+		"Attribute 'bmccasloginuri' is not supported by the system, did you mean 'BmcCasLoginUri'?",
+	}
+	for i, d := range diags.Errors() {
+		if d.Detail() != expected[i] {
+			t.Errorf("Expected error '%s', got '%s'", expected[i], d.Detail())
+		}
+	}
+}
+
+func TestGetSupportedIrmcAttributeNames(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	server.Set(fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Attributes", TS_FUJITSU), map[string]interface{}{
+		"Attributes": map[string]interface{}{"BmcHostName": "irmc", "BmcSnmpServicePort": 161},
+	})
+
+	pconfig := connectMockRedfishServer(t, server)
+
+	// Concurrent callers for the same endpoint share single read of iRMC
+	var wg sync.WaitGroup
+	results := make([][]string, 4)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			names, err := getSupportedIrmcAttributeNames(context.Background(), pconfig, []models.RedfishServer{})
+			if err != nil {
+				t.Errorf("Unexpected error %s", err.Error())
+			}
+			results[i] = names
+		}(i)
+	}
+	wg.Wait()
+
+	for _, names := range results {
+		if !slices.Equal(names, []string{"BmcHostName", "BmcSnmpServicePort"}) {
+			t.Errorf("Unexpected attribute names %v", names)
+		}
+	}
+}
+
+func TestGetSupportedIrmcAttributeNamesFailureKept(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	pconfig := &IrmcProvider{Endpoint: server.URL, Username: "admin", Password: "admin"}
+	_, err := getSupportedIrmcAttributeNames(context.Background(), pconfig, []models.RedfishServer{})
+	if err == nil {
+		t.Fatalf("Expected error of unreachable iRMC")
+	}
+
+	count := requests.Load()
+	_, err = getSupportedIrmcAttributeNames(context.Background(), pconfig, []models.RedfishServer{})
+	if err == nil {
+		t.Errorf("Expected kept error of unreachable iRMC")
+	}
+
+	if requests.Load() != count {
+		t.Errorf("Unreachable iRMC has been requested again")
+	}
+}
@@ -0,0 +1,338 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	// NETWORK_CONFIGURATION is name of OEM iRMC configuration object holding network settings of iRMC NIC.
+	NETWORK_CONFIGURATION = "Network"
+	// DNS_OBJECT is name of object of network settings holding DNS settings.
+	DNS_OBJECT = "DNS"
+	// Maximum number of DNS servers supported by iRMC.
+	DNS_MAX_SERVERS = 5
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IrmcDnsResource{}
+var _ resource.ResourceWithImportState = &IrmcDnsResource{}
+
+func NewIrmcDnsResource() resource.Resource {
+	return &IrmcDnsResource{}
+}
+
+// IrmcDnsResource defines the resource implementation.
+type IrmcDnsResource struct {
+	p *IrmcProvider
+}
+
+func (r *IrmcDnsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + irmcDnsName
+}
+
+func IrmcDnsSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of network settings resource on iRMC.",
+			Description:         "ID of network settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"dns_from_dhcp": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if DNS servers and domain of iRMC are obtained from DHCP. If enabled, `dns_servers` and `domain_name` are used only if DHCP does not provide them.",
+			Description:         "Specifies if DNS servers and domain of iRMC are obtained from DHCP. If enabled, dns_servers and domain_name are used only if DHCP does not provide them.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"dns_servers": schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: fmt.Sprintf("List of IP addresses of DNS servers used by iRMC (at most %d) in order of preference.", DNS_MAX_SERVERS),
+			Description:         fmt.Sprintf("List of IP addresses of DNS servers used by iRMC (at most %d) in order of preference.", DNS_MAX_SERVERS),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(DNS_MAX_SERVERS),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(validators.IsIPAddress()),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"domain_name": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "DNS domain of iRMC, e.g. `mgmt.example.com`.",
+			Description:         "DNS domain of iRMC, e.g. mgmt.example.com.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtMost(255),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"dynamic_dns_enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if iRMC registers its name in DNS dynamically (DDNS).",
+			Description:         "Specifies if iRMC registers its name in DNS dynamically (DDNS).",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *IrmcDnsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) DNS settings of iRMC network interface (not of host operating system).",
+		Description:         "The resource is used to control (read, modify or import) DNS settings of iRMC network interface (not of host operating system).",
+		Attributes:          IrmcDnsSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *IrmcDnsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *IrmcDnsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-irmc_dns: create starts")
+
+	var plan models.IrmcDnsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-irmc_dns: create ends")
+}
+
+func (r *IrmcDnsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-irmc_dns: read starts")
+
+	var state models.IrmcDnsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-irmc_dns: read ends")
+}
+
+func (r *IrmcDnsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-irmc_dns: update starts")
+
+	var plan, state models.IrmcDnsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-irmc_dns: update ends")
+}
+
+func (r *IrmcDnsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-irmc_dns: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-irmc_dns: delete ends")
+}
+
+func (r *IrmcDnsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-irmc_dns: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.IrmcDnsResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-irmc_dns: import ends")
+}
+
+// read reads current DNS settings from iRMC into model.
+func (r *IrmcDnsResource) read(ctx context.Context, model *models.IrmcDnsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	api, err := ConnectTargetSystem(r.p, &model.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, err := getNetworkSettingsEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read DNS settings", err)...)
+		return diags
+	}
+
+	irmcDnsToModel(endpoint, data, model)
+	return diags
+}
+
+func (r *IrmcDnsResource) apply(ctx context.Context, plan *models.IrmcDnsResourceModel, state *models.IrmcDnsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-irmc_dns"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	networkEndpoint, err := getNetworkSettingsEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	var current models.IrmcDnsResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	dns := map[string]interface{}{}
+	addBoolToPayload(dns, "ObtainFromDhcp", plan.DnsFromDhcp, current.DnsFromDhcp)
+	diags.Append(addStringListToPayload(ctx, dns, "Servers", plan.DnsServers, current.DnsServers)...)
+	addStringToPayload(dns, "DomainName", plan.DomainName, current.DomainName)
+	addBoolToPayload(dns, "DynamicDnsEnabled", plan.DynamicDnsEnabled, current.DynamicDnsEnabled)
+	if diags.HasError() {
+		return diags
+	}
+
+	payload := map[string]interface{}{}
+	addObjectToPayload(payload, DNS_OBJECT, dns)
+
+	tflog.Info(ctx, "Applying DNS settings", map[string]interface{}{"payload": payload})
+	data, err := applySettings(api, networkEndpoint, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply DNS settings", err)...)
+		return diags
+	}
+
+	irmcDnsToModel(networkEndpoint, data, plan)
+	return diags
+}
+
+func getNetworkSettingsEndpoint(ctx context.Context, api *gofish.APIClient) (string, error) {
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		return "", err
+	}
+
+	return getIrmcConfigurationEndpoint(isFsas, NETWORK_CONFIGURATION), nil
+}
+
+// irmcDnsToModel copies DNS settings from OEM network configuration object into model.
+func irmcDnsToModel(endpoint string, data map[string]interface{}, model *models.IrmcDnsResourceModel) {
+	dns := jsonObjectValue(data, DNS_OBJECT)
+
+	model.Id = types.StringValue(endpoint)
+	model.DnsFromDhcp = jsonBoolValue(dns, "ObtainFromDhcp")
+	model.DnsServers = jsonStringListAttrValue(dns, "Servers")
+	model.DomainName = jsonStringValue(dns, "DomainName")
+	model.DynamicDnsEnabled = jsonBoolValue(dns, "DynamicDnsEnabled")
+}
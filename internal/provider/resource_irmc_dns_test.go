@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const irmc_dns_name = "irmc-redfish_irmc_dns.dns"
+
+func TestAccRedfishIrmcDns_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceIrmcDnsConfig(creds, "mgmt.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(irmc_dns_name, "id"),
+					resource.TestCheckResourceAttr(irmc_dns_name, "domain_name", "mgmt.example.com"),
+					resource.TestCheckResourceAttr(irmc_dns_name, "dns_servers.#", "2"),
+					resource.TestCheckResourceAttrSet(irmc_dns_name, "dynamic_dns_enabled"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceIrmcDnsConfig(creds, "lab.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(irmc_dns_name, "domain_name", "lab.example.com"),
+				),
+			},
+			{
+				ResourceName:            irmc_dns_name,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("{\"username\":\"%s\",\"password\":\"%s\",\"endpoint\":\"https://%s\",\"ssl_insecure\":true}", creds.Username, creds.Password, creds.Endpoint),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server"},
+			},
+		},
+	})
+}
+
+func TestIrmcDnsApply(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	endpoint := getIrmcConfigurationEndpoint(false, NETWORK_CONFIGURATION)
+	server.Set(endpoint, map[string]interface{}{
+		DNS_OBJECT: map[string]interface{}{
+			"ObtainFromDhcp":    true,
+			"Servers":           []interface{}{"10.0.0.1"},
+			"DomainName":        "old.example.com",
+			"DynamicDnsEnabled": false,
+		},
+	})
+
+	r := IrmcDnsResource{p: connectMockRedfishServer(t, server)}
+	plan := models.IrmcDnsResourceModel{
+		DnsFromDhcp:       types.BoolValue(false),
+		DnsServers:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.2"), types.StringValue("fd00::53")}),
+		DomainName:        types.StringValue("mgmt.example.com"),
+		DynamicDnsEnabled: types.BoolUnknown(),
+	}
+
+	if diags := r.apply(context.Background(), &plan, nil); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	servers := []string{}
+	plan.DnsServers.ElementsAs(context.Background(), &servers, false)
+	if plan.Id.ValueString() != endpoint || plan.DnsFromDhcp.ValueBool() || plan.DomainName.ValueString() != "mgmt.example.com" ||
+		fmt.Sprint(servers) != "[10.0.0.2 fd00::53]" || plan.DynamicDnsEnabled.IsUnknown() || plan.DynamicDnsEnabled.ValueBool() {
+		t.Errorf("Unexpected DNS settings %+v", plan)
+	}
+
+	dns := server.Resource(endpoint)[DNS_OBJECT].(map[string]interface{})
+	if dns["ObtainFromDhcp"] != false || dns["DomainName"] != "mgmt.example.com" || fmt.Sprint(dns["Servers"]) != "[10.0.0.2 fd00::53]" {
+		t.Errorf("Unexpected DNS settings on server %v", dns)
+	}
+}
+
+func testAccRedfishResourceIrmcDnsConfig(testingInfo TestingServerCredentials, domain string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_irmc_dns" "dns" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		dns_from_dhcp = false
+		dns_servers   = ["10.172.181.1", "10.172.181.2"]
+		domain_name   = "%s"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		domain,
+	)
+}
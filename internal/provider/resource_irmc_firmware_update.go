@@ -17,23 +17,34 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 	"terraform-provider-irmc-redfish/internal/models"
 	"terraform-provider-irmc-redfish/internal/validators"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -47,6 +58,10 @@ const (
 	UPDATE_TYPE_FILE        = "File"
 	UPDATE_TYPE_TFTP        = "TFTP"
 	UPDATE_TYPE_MEMORY_CARD = "MemoryCard"
+	UPDATE_TYPE_HTTPS       = "HTTPS"
+	HTTPS_DOWNLOAD_TIMEOUT  = 30 * time.Minute
+	FIRMWARE_UPLOAD_TIMEOUT = 1800
+	FIRMWARE_HEADER_SIZE    = 4096
 )
 
 type firmwareUpdateEndpoints struct {
@@ -58,6 +73,7 @@ type firmwareUpdateEndpoints struct {
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IrmcFirmwareUpdateResource{}
+var _ resource.ResourceWithModifyPlan = &IrmcFirmwareUpdateResource{}
 
 func NewIrmcFirmwareUpdateResource() resource.Resource {
 	return &IrmcFirmwareUpdateResource{}
@@ -80,17 +96,44 @@ func IrmcFirmwareUpdateSchema() map[string]schema.Attribute {
 			Computed:            true,
 		},
 		"update_type": schema.StringAttribute{
-			MarkdownDescription: "Specifies the type of IRMC firmware update. Available options are: `File`, `TFTP`, and `MemoryCard`.",
-			Description:         "Specifies the type of IRMC firmware update. Available options are: File, TFTP, and MemoryCard.",
+			MarkdownDescription: "Specifies the type of IRMC firmware update. Available options are: `File`, `TFTP`, `MemoryCard` and `HTTPS`.",
+			Description:         "Specifies the type of IRMC firmware update. Available options are: File, TFTP, MemoryCard and HTTPS.",
 			Required:            true,
 			Validators: []validator.String{
 				stringvalidator.OneOf([]string{
 					UPDATE_TYPE_FILE,
 					UPDATE_TYPE_TFTP,
 					UPDATE_TYPE_MEMORY_CARD,
+					UPDATE_TYPE_HTTPS,
 				}...),
 			},
 		},
+		"https_url": schema.StringAttribute{
+			MarkdownDescription: "URL of the firmware file when `update_type` is `HTTPS`. The file is downloaded by the provider and uploaded to iRMC. Accepted format: `https://<host>/<path>.bin`.",
+			Description:         "URL of the firmware file when `update_type` is `HTTPS`. The file is downloaded by the provider and uploaded to iRMC. Accepted format: `https://<host>/<path>.bin`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+			Validators: []validator.String{
+				validators.ChangeToRequired(UPDATE_TYPE, UPDATE_TYPE_HTTPS),
+				stringvalidator.RegexMatches(regexp.MustCompile(`^https://`), "must be a valid HTTPS URL"),
+			},
+		},
+		"https_proxy": schema.StringAttribute{
+			MarkdownDescription: "Proxy used to download firmware file when `update_type` is `HTTPS`. Accepted format: `http://[user:password@]<host>:<port>`. If empty, proxy defined by environment (HTTPS_PROXY) is used.",
+			Description:         "Proxy used to download firmware file when `update_type` is `HTTPS`. Accepted format: `http://[user:password@]<host>:<port>`. If empty, proxy defined by environment (HTTPS_PROXY) is used.",
+			Optional:            true,
+			Computed:            true,
+			Sensitive:           true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"https_ssl_insecure": schema.BoolAttribute{
+			MarkdownDescription: "Skip verification of the HTTPS server certificate when `update_type` is `HTTPS`. Default value: `false`.",
+			Description:         "Skip verification of the HTTPS server certificate when `update_type` is `HTTPS`. Default value: `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
 		"irmc_path_to_binary": schema.StringAttribute{
 			MarkdownDescription: "Path to the binary firmware file to upload when `update_type` is `File`. Accepted format: absolute file path.",
 			Description:         "Path to the binary firmware file to upload when `update_type` is `File`. Accepted format: absolute file path.",
@@ -153,8 +196,8 @@ func IrmcFirmwareUpdateSchema() map[string]schema.Attribute {
 			},
 		},
 		"update_timeout": schema.Int64Attribute{
-			MarkdownDescription: "Maximum duration (in seconds) to wait for the Firmware Update operation to finish before aborting. This does not include the time required for iRMC availability after the update. Default value: `3000` seconds.",
-			Description:         "Maximum duration (in seconds) to wait for the Firmware Update operation to finish before aborting. This does not include the time required for iRMC availability after the update. Default value: `3000` seconds.",
+			MarkdownDescription: "Maximum duration (in seconds) to wait for the Firmware Update operation to finish before aborting. This does not include the time required for iRMC availability after the update. It also defines duration of maintenance window if `apply_schedule` is a timestamp. Default value: `3000` seconds.",
+			Description:         "Maximum duration (in seconds) to wait for the Firmware Update operation to finish before aborting. This does not include the time required for iRMC availability after the update. It also defines duration of maintenance window if `apply_schedule` is a timestamp. Default value: `3000` seconds.",
 			Computed:            true,
 			Optional:            true,
 			Default:             int64default.StaticInt64(FIRMWARE_UPDATE_TIMEOUT),
@@ -162,6 +205,48 @@ func IrmcFirmwareUpdateSchema() map[string]schema.Attribute {
 				int64planmodifier.RequiresReplace(),
 			},
 		},
+		"upload_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Maximum duration (in seconds) of firmware file upload to iRMC when `update_type` is `File` or `HTTPS`. It is independent of `update_timeout`, which starts after the file is uploaded. Value `0` means no limit. Default value: `1800` seconds.",
+			Description:         "Maximum duration (in seconds) of firmware file upload to iRMC when `update_type` is `File` or `HTTPS`. It is independent of `update_timeout`, which starts after the file is uploaded. Value `0` means no limit. Default value: `1800` seconds.",
+			Computed:            true,
+			Optional:            true,
+			Default:             int64default.StaticInt64(FIRMWARE_UPLOAD_TIMEOUT),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"check_only": schema.BoolAttribute{
+			MarkdownDescription: "If set to `true`, firmware is not flashed. Running iRMC firmware version is only compared with `target_version` and the result is reported in `update_required`, e.g. to gate updates in CI. Default value: `false`.",
+			Description:         "If set to `true`, firmware is not flashed. Running iRMC firmware version is only compared with `target_version` and the result is reported in `update_required`, e.g. to gate updates in CI. Default value: `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"target_version": schema.StringAttribute{
+			MarkdownDescription: "Firmware version contained in the update file (e.g. `2.58e`). If not defined, version is derived from the file name of `irmc_path_to_binary`, `tftp_update_file` or `https_url` (e.g. `RX2530M7_02.58e_sdr03.83.bin`).",
+			Description:         "Firmware version contained in the update file (e.g. `2.58e`). If not defined, version is derived from the file name of `irmc_path_to_binary`, `tftp_update_file` or `https_url` (e.g. `RX2530M7_02.58e_sdr03.83.bin`).",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"running_version": schema.StringAttribute{
+			MarkdownDescription: "Firmware version running on iRMC before the update (or check).",
+			Description:         "Firmware version running on iRMC before the update (or check).",
+			Computed:            true,
+		},
+		"update_required": schema.BoolAttribute{
+			MarkdownDescription: "Defines if running firmware version differs from `target_version`, so update would be (or was) performed. Null if target version could not be determined.",
+			Description:         "Defines if running firmware version differs from `target_version`, so update would be (or was) performed. Null if target version could not be determined.",
+			Computed:            true,
+		},
+		"apply_schedule": ApplyScheduleSchema(),
 		"reset_irmc_after_update": schema.BoolAttribute{
 			MarkdownDescription: "Automatically reboot iRMC after flashing if set to `true`. If `false`, the user must reboot iRMC manually to complete the firmware update process. Default value: `true`.",
 			Description:         "Automatically reboot iRMC after flashing if set to `true`. If `false`, the user must reboot iRMC manually to complete the firmware update process. Default value: `true`.",
@@ -188,75 +273,104 @@ func (r *IrmcFirmwareUpdateResource) Create(ctx context.Context, req resource.Cr
 	var plan models.IrmcFirmwareUpdateResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	applyTime, err := getApplySchedulePayload(plan.ApplySchedule.ValueString(), plan.UpdateTimeout.ValueInt64(), time.Now())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("apply_schedule"), "Invalid apply schedule", err.Error())
+		return
+	}
+
+	// Update service handles single update at a time
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	mutexPool.Lock(ctx, endpoint, UPDATE_SERVICE_LOCK_NAME)
+	defer mutexPool.Unlock(ctx, endpoint, UPDATE_SERVICE_LOCK_NAME)
+
 	// Connect to the target system.
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connection Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connection Error", err)...)
 		return
 	}
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	isFsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 
 	firmwareUpdEnpd := getFirmwareEndpoints(isFsas)
 
+	resp.Diagnostics.Append(checkFirmwareVersion(ctx, api, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CheckOnly.ValueBool() {
+		plan.Id = types.StringValue(firmwareUpdEnpd.FirmwareUpdateEndpoint)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		tflog.Info(ctx, "resource-irmc-redfish_irmc_firmware_update: create ends (check only)")
+		return
+	}
+
 	err = setSelectors(api, &plan, firmwareUpdEnpd.FirmwareUpdateEndpoint)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to set iRMC Selectors", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to set iRMC Selectors", err)...)
 		return
 	}
 
 	// Handle firmware update based on the update type.
+	var taskLocation, updateName string
 	switch plan.UpdateType.ValueString() {
 	case UPDATE_TYPE_FILE:
-		taskLocation, err := handleFileUpdate(api, &plan, firmwareUpdEnpd.FileFirmwareUpdateEndpoint)
-		if err != nil {
-			resp.Diagnostics.AddError("File firmware update failed.", err.Error())
-			return
-		}
-		err = checkFirmwareUpdateStatus(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), isFsas)
-		if err != nil {
-			resp.Diagnostics.AddError("File Firmware Update task did not complete successfully", err.Error())
-			return
-		}
+		updateName = "File"
+		taskLocation, err = handleFileUpdate(ctx, api, &plan, firmwareUpdEnpd.FileFirmwareUpdateEndpoint, applyTime)
 	case UPDATE_TYPE_TFTP:
-		taskLocation, err := handleTftpUpdate(api, &plan, firmwareUpdEnpd.FirmwareUpdateEndpoint, firmwareUpdEnpd.TftpFirmwareUpdateEndpoint)
-		if err != nil {
-			resp.Diagnostics.AddError("TFTP firmware update failed.", err.Error())
-			return
-		}
+		updateName = "TFTP"
+		taskLocation, err = handleTftpUpdate(api, &plan, firmwareUpdEnpd.FirmwareUpdateEndpoint, firmwareUpdEnpd.TftpFirmwareUpdateEndpoint, applyTime)
+	case UPDATE_TYPE_HTTPS:
+		updateName = "HTTPS"
+		taskLocation, err = handleHttpsUpdate(ctx, api, &plan, firmwareUpdEnpd.FileFirmwareUpdateEndpoint, applyTime)
+	case UPDATE_TYPE_MEMORY_CARD:
+		updateName = "MemoryCard"
+		taskLocation, err = handleMemoryCardUpdate(api, firmwareUpdEnpd.MemoryCardFirmwareUpdateEndpoint, applyTime)
+	}
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics(fmt.Sprintf("%s firmware update failed.", updateName), err)...)
+		return
+	}
+
+	if scheduled, ok := applyTime[REDFISH_OPERATION_APPLY_TIME]; ok {
+		// Scheduled update is neither awaited nor followed by iRMC reset, it is applied by iRMC later.
+		tflog.Info(ctx, fmt.Sprintf("resource-irmc-redfish_irmc_firmware_update: update scheduled %s, task %s", scheduled, taskLocation))
+	} else {
 		err = checkFirmwareUpdateStatus(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), isFsas)
-		if err != nil {
-			resp.Diagnostics.AddError("TFTP Firmware Update task did not complete successfully", err.Error())
+		if errors.Is(err, errPollCancelled) {
+			// Resource is stored with pending task, so next apply resumes monitoring instead of flashing again
+			plan.Id = types.StringValue(firmwareUpdEnpd.FirmwareUpdateEndpoint)
+			task := newPendingTask(PENDING_TASK_FIRMWARE_UPDATE, taskLocation, time.Now())
+			resp.Diagnostics.Append(savePendingTask(ctx, resp.Private, task)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.Append(pendingTaskDiagnostics(fmt.Sprintf("%s Firmware Update task is still running", updateName), task)...)
 			return
 		}
-	case UPDATE_TYPE_MEMORY_CARD:
-		taskLocation, err := handleMemoryCardUpdate(api, firmwareUpdEnpd.MemoryCardFirmwareUpdateEndpoint)
+
 		if err != nil {
-			resp.Diagnostics.AddError("MemoryCard firmware update failed.", err.Error())
+			resp.Diagnostics.Append(redfishErrorDiagnostics(fmt.Sprintf("%s Firmware Update task did not complete successfully", updateName), err)...)
 			return
 		}
-		err = checkFirmwareUpdateStatus(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), isFsas)
+
+		err = ResetIrmcAfterFirmwareUpd(ctx, api, &plan, r.p)
 		if err != nil {
-			resp.Diagnostics.AddError("Memory Card Firmware Update task did not complete successfully", err.Error())
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to reset iRMC after firmware update", err)...)
 			return
 		}
 	}
 
-	err = ResetIrmcAfterFirmwareUpd(ctx, api, &plan, r.p)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to reset iRMC after firmware update", err.Error())
-		return
-	}
-
 	plan.Id = types.StringValue(firmwareUpdEnpd.FirmwareUpdateEndpoint)
 
 	diags = resp.State.Set(ctx, &plan)
@@ -268,7 +382,9 @@ func (r *IrmcFirmwareUpdateResource) Create(ctx context.Context, req resource.Cr
 	tflog.Info(ctx, "resource-irmc-redfish_irmc_firmware_update: create ends")
 }
 
-// Read handles reading the resource state.
+// Read handles reading the resource state. If firmware update task has been interrupted
+// and has failed or has been cancelled as stale in the meantime, resource is removed,
+// so next apply repeats the update.
 func (r *IrmcFirmwareUpdateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Info(ctx, "resource-irmc-redfish_irmc_firmware_update: read starts")
 	var state models.IrmcFirmwareUpdateResourceModel
@@ -279,19 +395,117 @@ func (r *IrmcFirmwareUpdateResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	task, found, diags := readPendingTask(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if found {
+		api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connection Error", err)...)
+			return
+		}
+		defer ReleaseTargetSystem(api)
+
+		taskState, err := getPendingTaskState(api.Service, task)
+		if err == nil && IsTaskFinished(taskState) && !IsTaskFinishedSuccessfully(taskState) {
+			resp.Diagnostics.AddWarning("Pending firmware update task failed",
+				fmt.Sprintf("Task %s finished with TaskState %s, firmware update will be repeated.", task.Location, taskState))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		if err == nil && cancelStalePendingTask(ctx, r.p, api.Service, task, taskState, time.Now()) {
+			resp.Diagnostics.AddWarning("Stale firmware update task cancelled",
+				fmt.Sprintf("Task %s has not finished within stale_task_age and has been cancelled, firmware update will be repeated.", task.Location))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
 	// Save into State
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	tflog.Info(ctx, "resource-irmc-redfish_irmc_firmware_update: read ends")
 }
 
-// Update modifies the resource state but returns an error if triggered, as updates are not supported.
+// ModifyPlan plans in-place update of the resource if supervision of its firmware update task has been interrupted.
+func (r *IrmcFirmwareUpdateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planPendingTaskResume(ctx, req, resp)
+}
+
+// Update resumes monitoring of firmware update task interrupted during creation of the resource.
+// Otherwise it returns an error, as updates are not supported.
 func (r *IrmcFirmwareUpdateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// This function should not be called since updates are not supported; the resource should be recreated instead.
-	resp.Diagnostics.AddError(
-		"Unsupported Update Operation for IRMC Firmware Update",
-		"The IRMC Firmware Update resource does not support in-place updates. It is intended to be destroyed and recreated if changes are required.",
-	)
+	task, found, diags := readPendingTask(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		// This should not happen since updates are not supported; the resource should be recreated instead.
+		resp.Diagnostics.AddError(
+			"Unsupported Update Operation for IRMC Firmware Update",
+			"The IRMC Firmware Update resource does not support in-place updates. It is intended to be destroyed and recreated if changes are required.",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "resource-irmc-redfish_irmc_firmware_update: update resumes pending task", map[string]interface{}{
+		"task": task.Location,
+	})
+
+	var plan, state models.IrmcFirmwareUpdateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.RunningVersion = state.RunningVersion
+	plan.UpdateRequired = state.UpdateRequired
+
+	// State stays valid if resuming fails, pending task is kept until it finishes
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connection Error", err)...)
+		return
+	}
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return
+	}
+
+	err = checkFirmwareUpdateStatus(ctx, api.Service, task.Location, plan.UpdateTimeout.ValueInt64(), isFsas)
+	if errors.Is(err, errPollCancelled) {
+		resp.Diagnostics.Append(pendingTaskDiagnostics("Firmware Update task is still running", task)...)
+		return
+	}
+
+	// Failed task is kept, so next refresh removes the resource and the update is repeated
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Firmware Update task did not complete successfully", err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(clearPendingTask(ctx, resp.Private)...)
+
+	err = ResetIrmcAfterFirmwareUpd(ctx, api, &plan, r.p)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to reset iRMC after firmware update", err)...)
+		return
+	}
+
+	tflog.Info(ctx, "resource-irmc-redfish_irmc_firmware_update: update ends")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -302,22 +516,13 @@ func (r *IrmcFirmwareUpdateResource) Delete(ctx context.Context, req resource.De
 	tflog.Info(ctx, "resource-irmc-redfish_irmc_firmware_update: delete ends")
 }
 
-func handleTftpUpdate(api *gofish.APIClient, plan *models.IrmcFirmwareUpdateResourceModel, firmwareUpdateEndpoint, tftpFirmwareUpdateEndpoint string) (string, error) {
-
-	res, err := api.Get(firmwareUpdateEndpoint)
-	if err != nil {
-		return "", fmt.Errorf("failed to fetch data from Redfish endpoint: %v", err)
-	}
-
-	CloseResource(res.Body)
-
+func handleTftpUpdate(api *gofish.APIClient, plan *models.IrmcFirmwareUpdateResourceModel, firmwareUpdateEndpoint, tftpFirmwareUpdateEndpoint string, applyTime map[string]interface{}) (string, error) {
 	payload := map[string]interface{}{
 		"ServerName":   plan.TftpServerAddr.ValueString(),
 		"iRMCFileName": plan.TftpUpdateFile.ValueString(),
 	}
 
-	res, err = api.PatchWithHeaders(firmwareUpdateEndpoint, payload,
-		map[string]string{HTTP_HEADER_IF_MATCH: res.Header.Get(HTTP_HEADER_ETAG)})
+	res, err := PatchWithEtag(api, firmwareUpdateEndpoint, payload)
 	if err != nil {
 		return "", fmt.Errorf("failed to send PATCH request: %v", err)
 	}
@@ -328,8 +533,7 @@ func handleTftpUpdate(api *gofish.APIClient, plan *models.IrmcFirmwareUpdateReso
 		return "", fmt.Errorf("PATCH request failed with status code: %d", res.StatusCode)
 	}
 
-	updatePayload := map[string]interface{}{}
-	res, err = api.Post(tftpFirmwareUpdateEndpoint, updatePayload)
+	res, err = api.Post(tftpFirmwareUpdateEndpoint, applyTime)
 	if err != nil {
 		return "", fmt.Errorf("failed to send POST request: %v", err)
 	}
@@ -347,11 +551,8 @@ func handleTftpUpdate(api *gofish.APIClient, plan *models.IrmcFirmwareUpdateReso
 	return taskLocation, nil
 }
 
-func handleMemoryCardUpdate(api *gofish.APIClient, memoryCardFirmwareUpdateEndpoint string) (string, error) {
-
-	payload := map[string]interface{}{}
-
-	res, err := api.Post(memoryCardFirmwareUpdateEndpoint, payload)
+func handleMemoryCardUpdate(api *gofish.APIClient, memoryCardFirmwareUpdateEndpoint string, applyTime map[string]interface{}) (string, error) {
+	res, err := api.Post(memoryCardFirmwareUpdateEndpoint, applyTime)
 	if err != nil {
 		return "", fmt.Errorf("failed to send POST request: %v", err)
 	}
@@ -368,7 +569,7 @@ func handleMemoryCardUpdate(api *gofish.APIClient, memoryCardFirmwareUpdateEndpo
 	return taskLocation, nil
 }
 
-func handleFileUpdate(api *gofish.APIClient, plan *models.IrmcFirmwareUpdateResourceModel, fileFirmwareUpdateEndpoint string) (string, error) {
+func handleFileUpdate(ctx context.Context, api *gofish.APIClient, plan *models.IrmcFirmwareUpdateResourceModel, fileFirmwareUpdateEndpoint string, applyTime map[string]interface{}) (string, error) {
 	if plan.IRMCPathToBinary.IsNull() {
 		return "", fmt.Errorf("missing firmware file name in the configuration")
 	}
@@ -378,7 +579,13 @@ func handleFileUpdate(api *gofish.APIClient, plan *models.IrmcFirmwareUpdateReso
 		return "", fmt.Errorf("error reading firmware file: %w", err)
 	}
 
-	taskLocation, err := sendFileFirmwareUpdate(api, fileData, fileFirmwareUpdateEndpoint)
+	defer CloseResource(fileData)
+
+	if err := checkFirmwareImageHeader(ctx, api, plan, fileData); err != nil {
+		return "", fmt.Errorf("firmware image validation failed: %w", err)
+	}
+
+	taskLocation, err := sendFileFirmwareUpdate(ctx, api, fileData, fileFirmwareUpdateEndpoint, plan.UploadTimeout.ValueInt64(), applyTime)
 	if err != nil {
 		return "", fmt.Errorf("error sending firmware update: %w", err)
 	}
@@ -386,6 +593,98 @@ func handleFileUpdate(api *gofish.APIClient, plan *models.IrmcFirmwareUpdateReso
 	return taskLocation, nil
 }
 
+// handleHttpsUpdate downloads firmware file pointed by plan from HTTPS server
+// and uploads it to iRMC the same way as in case of File update type.
+func handleHttpsUpdate(ctx context.Context, api *gofish.APIClient, plan *models.IrmcFirmwareUpdateResourceModel, fileFirmwareUpdateEndpoint string, applyTime map[string]interface{}) (string, error) {
+	filePath, err := downloadFirmwareFile(ctx, plan.HttpsUrl.ValueString(), plan.HttpsProxy.ValueString(), plan.HttpsSslInsecure.ValueBool())
+	if err != nil {
+		return "", fmt.Errorf("error downloading firmware file: %w", err)
+	}
+
+	defer func() {
+		if err := os.Remove(filePath); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Could not remove temporary firmware file %s: %s", filePath, err.Error()))
+		}
+	}()
+
+	fileData, err := readFirmwareFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("error reading firmware file: %w", err)
+	}
+
+	defer CloseResource(fileData)
+
+	if err := checkFirmwareImageHeader(ctx, api, plan, fileData); err != nil {
+		return "", fmt.Errorf("firmware image validation failed: %w", err)
+	}
+
+	taskLocation, err := sendFileFirmwareUpdate(ctx, api, fileData, fileFirmwareUpdateEndpoint, plan.UploadTimeout.ValueInt64(), applyTime)
+	if err != nil {
+		return "", fmt.Errorf("error sending firmware update: %w", err)
+	}
+
+	return taskLocation, nil
+}
+
+// downloadFirmwareFile downloads file pointed by fileUrl into temporary file using optional proxy.
+// If proxy is empty, proxy configured by environment is used. Path to downloaded file is returned.
+func downloadFirmwareFile(ctx context.Context, fileUrl string, proxy string, insecure bool) (string, error) {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: insecure, //nolint:gosec
+		},
+	}
+
+	if len(proxy) > 0 {
+		proxyUrl, err := url.Parse(proxy)
+		if err != nil {
+			return "", fmt.Errorf("invalid proxy address: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   HTTPS_DOWNLOAD_TIMEOUT,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileUrl, nil)
+	if err != nil {
+		return "", fmt.Errorf("could not prepare request: %w", err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Downloading firmware file from %s", fileUrl))
+	res, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("GET on %s finished with error: %w", fileUrl, err)
+	}
+
+	defer CloseResource(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GET on %s finished with status code: %d", fileUrl, res.StatusCode)
+	}
+
+	tmpFile, err := os.CreateTemp("", "irmc-firmware-*.bin")
+	if err != nil {
+		return "", fmt.Errorf("could not create temporary file: %w", err)
+	}
+
+	defer CloseResource(tmpFile)
+
+	size, err := io.Copy(tmpFile, res.Body)
+	if err != nil {
+		if removeErr := os.Remove(tmpFile.Name()); removeErr != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Could not remove temporary firmware file %s: %s", tmpFile.Name(), removeErr.Error()))
+		}
+		return "", fmt.Errorf("could not save downloaded firmware file: %w", err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Firmware file downloaded (%d bytes)", size))
+	return tmpFile.Name(), nil
+}
+
 func readFirmwareFile(filePath string) (*os.File, error) {
 
 	if strings.ToLower(filepath.Ext(filePath)) != ".bin" {
@@ -404,13 +703,127 @@ func readFirmwareFile(filePath string) (*os.File, error) {
 	return data, nil
 }
 
-func sendFileFirmwareUpdate(api *gofish.APIClient, fileData *os.File, fileFirmwareUpdateEndpoint string) (string, error) {
+// firmwareImageHeader holds identification of the platform, iRMC generation and version
+// found in the header of firmware image. Empty fields mean the information was not found.
+type firmwareImageHeader struct {
+	Models     []string
+	Generation string
+	Version    string
+}
+
+var (
+	firmwareHeaderModelRegex      = regexp.MustCompile(`(?i)([BCRT]X\d{3,4})[ _-]?(M\d+)`)
+	firmwareHeaderGenerationRegex = regexp.MustCompile(`(?i)iRMC[ _-]?(S\d+)`)
+)
+
+// parseFirmwareImageHeader looks for printable strings in the header of firmware image and extracts
+// targeted platform models (e.g. RX2530M7), iRMC generation (e.g. S6) and firmware version (e.g. 02.58e).
+func parseFirmwareImageHeader(data []byte) firmwareImageHeader {
+	var header firmwareImageHeader
+
+	texts := strings.FieldsFunc(string(data), func(r rune) bool {
+		return r < 0x20 || r > 0x7e
+	})
+
+	for _, text := range texts {
+		for _, match := range firmwareHeaderModelRegex.FindAllStringSubmatch(text, -1) {
+			model := strings.ToUpper(match[1] + match[2])
+			if !slices.Contains(header.Models, model) {
+				header.Models = append(header.Models, model)
+			}
+		}
+
+		if match := firmwareHeaderGenerationRegex.FindStringSubmatch(text); match != nil && len(header.Generation) == 0 {
+			header.Generation = strings.ToUpper(match[1])
+		}
+
+		for _, field := range strings.Fields(text) {
+			if version := firmwareVersionFromFileName(field); len(version) > 0 && len(header.Version) == 0 {
+				header.Version = version
+			}
+		}
+	}
+
+	return header
+}
+
+// validateFirmwareImageHeader verifies that firmware image header targets connected system model,
+// iRMC generation and (if defined) target version. Checks of information missing in header are skipped.
+func validateFirmwareImageHeader(header firmwareImageHeader, systemModel, irmcModel, targetVersion string) error {
+	if len(header.Models) > 0 {
+		if match := firmwareHeaderModelRegex.FindStringSubmatch(systemModel); match != nil {
+			model := strings.ToUpper(match[1] + match[2])
+			if !slices.Contains(header.Models, model) {
+				return fmt.Errorf("firmware image is intended for %s, but connected system is %s",
+					strings.Join(header.Models, ", "), systemModel)
+			}
+		}
+	}
+
+	if len(header.Generation) > 0 {
+		if match := firmwareHeaderGenerationRegex.FindStringSubmatch(irmcModel); match != nil {
+			if !strings.EqualFold(match[1], header.Generation) {
+				return fmt.Errorf("firmware image is intended for iRMC %s, but connected iRMC is %s",
+					header.Generation, irmcModel)
+			}
+		}
+	}
+
+	if len(header.Version) > 0 && len(targetVersion) > 0 && !firmwareVersionMatches(header.Version, targetVersion) {
+		return fmt.Errorf("firmware image contains version %s, but target version is %s", header.Version, targetVersion)
+	}
+
+	return nil
+}
+
+// checkFirmwareImageHeader reads header of firmware file and verifies that it fits connected system,
+// so that wrong image is rejected before it is uploaded. File is rewound to the beginning afterwards.
+func checkFirmwareImageHeader(ctx context.Context, api *gofish.APIClient, plan *models.IrmcFirmwareUpdateResourceModel, fileData *os.File) error {
+	data := make([]byte, FIRMWARE_HEADER_SIZE)
+	size, err := io.ReadFull(fileData, data)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return fmt.Errorf("could not read firmware image header: %w", err)
+	}
+
+	if _, err := fileData.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("could not rewind firmware file: %w", err)
+	}
+
+	header := parseFirmwareImageHeader(data[:size])
+	if len(header.Models) == 0 && len(header.Generation) == 0 && len(header.Version) == 0 {
+		tflog.Warn(ctx, "Firmware image header does not contain platform identification, validation skipped")
+		return nil
+	}
+
+	systems, err := api.Service.Systems()
+	if err != nil {
+		return fmt.Errorf("error when accessing Systems resource: %w", err)
+	}
+
+	managers, err := api.Service.Managers()
+	if err != nil {
+		return fmt.Errorf("error when accessing Managers resource: %w", err)
+	}
+
+	if len(systems) == 0 || len(managers) == 0 {
+		return fmt.Errorf("system or manager resource not found")
+	}
 
-	payload := map[string]io.Reader{
-		"data": fileData,
+	tflog.Info(ctx, fmt.Sprintf("Firmware image header: models %v, iRMC generation '%s', version '%s'",
+		header.Models, header.Generation, header.Version))
+
+	return validateFirmwareImageHeader(header, systems[0].Model, managers[0].Model, getFirmwareTargetVersion(plan))
+}
+
+// sendFileFirmwareUpdate streams firmware file to iRMC without buffering it in memory,
+// upload progress is logged periodically. Schedule of the update (if any) is sent as UpdateParameters part.
+func sendFileFirmwareUpdate(ctx context.Context, api *gofish.APIClient, fileData *os.File, fileFirmwareUpdateEndpoint string, uploadTimeout int64, applyTime map[string]interface{}) (string, error) {
+	var parameters map[string]interface{}
+	if len(applyTime) > 0 {
+		parameters = map[string]interface{}{"UpdateParameters": applyTime}
 	}
 
-	resp, err := api.Service.GetClient().PostMultipart(fileFirmwareUpdateEndpoint, payload)
+	resp, err := uploadFileMultipart(ctx, api, fileFirmwareUpdateEndpoint, parameters, "data", fileData, uploadTimeout)
 	if err != nil {
 		return "", fmt.Errorf("error sending firmware update: %w", err)
 	}
@@ -434,22 +847,12 @@ func sendFileFirmwareUpdate(api *gofish.APIClient, fileData *os.File, fileFirmwa
 }
 
 func setSelectors(api *gofish.APIClient, plan *models.IrmcFirmwareUpdateResourceModel, firmwareUpdateEndpoint string) error {
-
-	res, err := api.Get(firmwareUpdateEndpoint)
-	if err != nil {
-		return fmt.Errorf("failed to fetch data from Redfish endpoint: %w", err)
-	}
-
-	CloseResource(res.Body)
-
 	payload := map[string]interface{}{
 		"iRMCBootSelector":  plan.IRMCBootSelector.ValueString(),
 		"iRMCFlashSelector": plan.IRMCFlashSelector.ValueString(),
 	}
 
-	res, err = api.PatchWithHeaders(firmwareUpdateEndpoint, payload, map[string]string{
-		HTTP_HEADER_IF_MATCH: res.Header.Get(HTTP_HEADER_ETAG),
-	})
+	res, err := PatchWithEtag(api, firmwareUpdateEndpoint, payload)
 	if err != nil {
 		return fmt.Errorf("failed to send PATCH request: %w", err)
 	}
@@ -469,12 +872,13 @@ func setSelectors(api *gofish.APIClient, plan *models.IrmcFirmwareUpdateResource
 
 func checkFirmwareUpdateStatus(ctx context.Context, service *gofish.Service, location string, timeout int64, isFsas bool) error {
 	finishedSuccessfully, err := WaitForRedfishTaskEnd(ctx, service, location, timeout)
+	if errors.Is(err, errPollCancelled) {
+		return err
+	}
+
 	if err != nil || !finishedSuccessfully {
-		taskLog, diags := FetchRedfishTaskLog(service, location, isFsas)
-		if diags.HasError() {
-			return fmt.Errorf("firmware Update task did not complete successfully: %s", err)
-		}
-		return fmt.Errorf("firmware Update task failed. Details: %s. Task log: %s", err, string(taskLog))
+		report, _ := GetRedfishTaskReport(service, location, isFsas)
+		return fmt.Errorf("firmware Update task failed. Details: %s.\n%s", err, report.String())
 	}
 	return nil
 }
@@ -496,17 +900,100 @@ func ResetIrmcAfterFirmwareUpd(ctx context.Context, api *gofish.APIClient, plan
 		}
 	}
 
-	api, err = retryConnectWithTimeout(ctx, provider, &plan.RedfishServer)
+	api, err = waitForManagerRestart(ctx, provider, &plan.RedfishServer, MANAGER_READY_TIMEOUT)
 	if err != nil {
-		return fmt.Errorf("service connect target system error: %w", err)
+		return fmt.Errorf("failed to reboot iRMC: %w", err)
 	}
 
-	err = checkIrmcStatus(ctx, api, CHECK_INTERVAL, RESET_TIMEOUT)
+	ReleaseTargetSystem(api)
+	return nil
+}
+
+// checkFirmwareVersion reads firmware version running on iRMC and compares it with target version of the update
+// into plan. In check only mode target version must be known.
+func checkFirmwareVersion(ctx context.Context, api *gofish.APIClient, plan *models.IrmcFirmwareUpdateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	irmc, err := api.Service.Managers()
 	if err != nil {
-		return fmt.Errorf("failed to reboot iRMC: %w", err)
+		diags.Append(redfishErrorDiagnostics("Error when accessing Managers resource", err)...)
+		return diags
 	}
 
-	return nil
+	if len(irmc) == 0 {
+		diags.AddError("Error when accessing Managers resource", "No manager found on the system")
+		return diags
+	}
+
+	plan.RunningVersion = types.StringValue(irmc[0].FirmwareVersion)
+	plan.UpdateRequired = types.BoolNull()
+
+	target := getFirmwareTargetVersion(plan)
+	if len(target) == 0 {
+		if plan.CheckOnly.ValueBool() {
+			diags.AddError("Could not determine firmware version of the update",
+				"Version could not be derived from the update file name, please define target_version")
+		}
+		return diags
+	}
+
+	updateRequired := !firmwareVersionMatches(irmc[0].FirmwareVersion, target)
+	plan.UpdateRequired = types.BoolValue(updateRequired)
+	tflog.Info(ctx, fmt.Sprintf("Running iRMC firmware version '%s', target version '%s', update required: %t",
+		irmc[0].FirmwareVersion, target, updateRequired))
+
+	return diags
+}
+
+// getFirmwareTargetVersion returns target version defined in plan or derived from file name of the update.
+func getFirmwareTargetVersion(plan *models.IrmcFirmwareUpdateResourceModel) string {
+	if len(plan.TargetVersion.ValueString()) > 0 {
+		return plan.TargetVersion.ValueString()
+	}
+
+	switch plan.UpdateType.ValueString() {
+	case UPDATE_TYPE_FILE:
+		return firmwareVersionFromFileName(plan.IRMCPathToBinary.ValueString())
+	case UPDATE_TYPE_TFTP:
+		return firmwareVersionFromFileName(plan.TftpUpdateFile.ValueString())
+	case UPDATE_TYPE_HTTPS:
+		fileUrl, err := url.Parse(plan.HttpsUrl.ValueString())
+		if err != nil {
+			return ""
+		}
+		return firmwareVersionFromFileName(fileUrl.Path)
+	}
+
+	return ""
+}
+
+var firmwareFileVersionRegex = regexp.MustCompile(`(?:^|_)(\d+\.\d+[A-Za-z]?)(?:_|$)`)
+
+// firmwareVersionFromFileName extracts firmware version from name of iRMC update file
+// (e.g. 02.58e from RX2530M7_02.58e_sdr03.83.bin), empty string is returned if no version is found.
+func firmwareVersionFromFileName(name string) string {
+	base := strings.TrimSuffix(filepath.Base(name), filepath.Ext(name))
+	match := firmwareFileVersionRegex.FindStringSubmatch(base)
+	if match == nil {
+		return ""
+	}
+
+	return match[1]
+}
+
+// firmwareVersionMatches checks if running firmware version starts with target one,
+// leading zeros of major version and letter case are ignored.
+func firmwareVersionMatches(running string, target string) bool {
+	normalize := func(version string) string {
+		version = strings.ToLower(strings.TrimSpace(version))
+		trimmed := strings.TrimLeft(version, "0")
+		if strings.HasPrefix(trimmed, ".") || len(trimmed) == 0 {
+			return "0" + trimmed
+		}
+		return trimmed
+	}
+
+	return strings.HasPrefix(normalize(running), normalize(target))
 }
 
 func getFirmwareEndpoints(isFsas bool) firmwareUpdateEndpoints {
@@ -18,10 +18,16 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
+	"terraform-provider-irmc-redfish/internal/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -58,6 +64,29 @@ func TestAccFirmwareUpdateResource_correct_File_update(t *testing.T) {
 	})
 }
 
+func TestAccFirmwareUpdateResource_correct_HTTPS_update(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirmwareUpdateResourceHttpsConfig(creds, "https://10.172.181.125:8006/irmc/RX2530M7/RX2530M7_02.58e_sdr03.83.bin"),
+			},
+		},
+	})
+}
+
+func TestAccFirmwareUpdateResource_wrongHttpsUrl(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirmwareUpdateResourceHttpsConfig(creds, "http://10.172.181.125/irmc/RX2530M7/RX2530M7_02.58e_sdr03.83.bin"),
+				ExpectError: regexp.MustCompile("must be a valid HTTPS URL"),
+			},
+		},
+	})
+}
+
 func TestAccFirmwareUpdateResource_missingAttribute(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -106,6 +135,127 @@ func TestAccFirmwareUpdateResource_wrongUpdateTFTP_wrongtftpUpdateFile(t *testin
 	})
 }
 
+func TestAccFirmwareUpdateResource_checkOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirmwareUpdateResourceCheckOnlyConfig(creds, "irmc/RX2530M7/RX2530M7_02.58e_sdr03.83.bin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("irmc-redfish_irmc_firmware_update.irmcfu", "check_only", "true"),
+					resource.TestCheckResourceAttrSet("irmc-redfish_irmc_firmware_update.irmcfu", "running_version"),
+					resource.TestCheckResourceAttrSet("irmc-redfish_irmc_firmware_update.irmcfu", "update_required"),
+				),
+			},
+			{
+				Config:      testAccFirmwareUpdateResourceCheckOnlyConfig(creds, "irmc/RX2530M7/firmware.bin"),
+				ExpectError: regexp.MustCompile("Could not determine firmware version of the update"),
+			},
+		},
+	})
+}
+
+func TestFirmwareVersionFromFileName(t *testing.T) {
+	cases := map[string]string{
+		"/home/user/RX2530M7_02.58e_sdr03.83.bin":    "02.58e",
+		"irmc/RX2530M7/RX2530M7_02.58c_sdr03.83.bin": "02.58c",
+		"iRMC_3.20P.bin": "3.20P",
+		"firmware.bin":   "",
+		"sdr03.83.bin":   "",
+	}
+
+	for name, expected := range cases {
+		if version := firmwareVersionFromFileName(name); version != expected {
+			t.Errorf("%s: got version '%s', expected '%s'", name, version, expected)
+		}
+	}
+}
+
+func TestFirmwareVersionMatches(t *testing.T) {
+	if !firmwareVersionMatches("2.58e", "02.58e") {
+		t.Errorf("Leading zero of major version should be ignored")
+	}
+	if !firmwareVersionMatches("3.20P", "3.20p") {
+		t.Errorf("Letter case should be ignored")
+	}
+	if firmwareVersionMatches("2.58c", "02.58e") {
+		t.Errorf("Different versions should not match")
+	}
+}
+
+func TestParseFirmwareImageHeader(t *testing.T) {
+	data := append([]byte{0x7f, 0x00, 0x12}, []byte("FUJITSU iRMC S6 firmware\x00RX2530 M7,RX2540M7\x00RX2530M7_02.58e_sdr03.83\x00")...)
+	header := parseFirmwareImageHeader(data)
+
+	if strings.Join(header.Models, ",") != "RX2530M7,RX2540M7" {
+		t.Errorf("Got models %v, expected [RX2530M7 RX2540M7]", header.Models)
+	}
+	if header.Generation != "S6" {
+		t.Errorf("Got generation '%s', expected 'S6'", header.Generation)
+	}
+	if header.Version != "02.58e" {
+		t.Errorf("Got version '%s', expected '02.58e'", header.Version)
+	}
+
+	if header := parseFirmwareImageHeader([]byte{0x00, 0x01, 0xff}); len(header.Models) != 0 || len(header.Generation) != 0 || len(header.Version) != 0 {
+		t.Errorf("Binary data should not contain any identification, got %+v", header)
+	}
+}
+
+func TestValidateFirmwareImageHeader(t *testing.T) {
+	header := firmwareImageHeader{Models: []string{"RX2530M7"}, Generation: "S6", Version: "02.58e"}
+
+	if err := validateFirmwareImageHeader(header, "PRIMERGY RX2530 M7", "iRMC S6", "2.58e"); err != nil {
+		t.Errorf("Unexpected error %s", err.Error())
+	}
+	if err := validateFirmwareImageHeader(header, "PRIMERGY RX2540 M7", "iRMC S6", ""); err == nil {
+		t.Errorf("Image for different model should be rejected")
+	}
+	if err := validateFirmwareImageHeader(header, "PRIMERGY RX2530 M7", "iRMC S5", ""); err == nil {
+		t.Errorf("Image for different iRMC generation should be rejected")
+	}
+	if err := validateFirmwareImageHeader(header, "PRIMERGY RX2530 M7", "iRMC S6", "2.60a"); err == nil {
+		t.Errorf("Image with different version should be rejected")
+	}
+	if err := validateFirmwareImageHeader(firmwareImageHeader{}, "PRIMERGY RX2540 M7", "iRMC S5", "2.60a"); err != nil {
+		t.Errorf("Missing header information should not be validated, got %s", err.Error())
+	}
+}
+
+func TestHandleFileUpdateWrongImage(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	filePath := filepath.Join(t.TempDir(), "RX2540M7_02.58e_sdr03.83.bin")
+	if err := os.WriteFile(filePath, []byte("iRMC S6\x00RX2540M7_02.58e_sdr03.83\x00"), 0o600); err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	plan := models.IrmcFirmwareUpdateResourceModel{
+		UpdateType:       types.StringValue(UPDATE_TYPE_FILE),
+		IRMCPathToBinary: types.StringValue(filePath),
+		UploadTimeout:    types.Int64Value(FIRMWARE_UPLOAD_TIMEOUT),
+	}
+
+	endpoints := getFirmwareEndpoints(false)
+	_, err = handleFileUpdate(context.Background(), api, &plan, endpoints.FileFirmwareUpdateEndpoint, map[string]interface{}{})
+	if err == nil || !strings.Contains(err.Error(), "RX2540M7") {
+		t.Fatalf("Expected error about image for different model, got %v", err)
+	}
+
+	for _, action := range server.Actions {
+		if action.Path == endpoints.FileFirmwareUpdateEndpoint {
+			t.Errorf("Firmware image should not be uploaded")
+		}
+	}
+}
+
 func testAccFirmwareUpdateResourceConfig(testingInfo TestingServerCredentials, updateType, irmcPathToBinary, tftpServerAddrr, tftpUpdateFile string) string {
 	return fmt.Sprintf(`
 	resource "irmc-redfish_irmc_firmware_update" "irmcfu" {
@@ -133,3 +283,48 @@ func testAccFirmwareUpdateResourceConfig(testingInfo TestingServerCredentials, u
 		tftpUpdateFile,
 	)
 }
+
+func testAccFirmwareUpdateResourceHttpsConfig(testingInfo TestingServerCredentials, httpsUrl string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_irmc_firmware_update" "irmcfu" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		update_type        = "HTTPS"
+		https_url          = "%s"
+		https_ssl_insecure = true
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		httpsUrl,
+	)
+}
+
+func testAccFirmwareUpdateResourceCheckOnlyConfig(testingInfo TestingServerCredentials, tftpUpdateFile string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_irmc_firmware_update" "irmcfu" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		update_type      = "TFTP"
+		tftp_server_addr = "10.172.181.125"
+		tftp_update_file = "%s"
+		check_only       = true
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		tftpUpdateFile,
+	)
+}
@@ -20,22 +20,23 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
-	"net/http"
+	"strings"
 	"terraform-provider-irmc-redfish/internal/models"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/redfish"
 )
 
-const RESET_TIMEOUT int = 600
-const CHECK_INTERVAL int = 10
-
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IrmcRestartResource{}
 
@@ -60,6 +61,71 @@ func IrmcRestartSchema() map[string]schema.Attribute {
 			MarkdownDescription: "ID of irmc reset resource on iRMC.",
 			Description:         "ID of irmc reset resource on iRMC.",
 		},
+		"reset_type": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(redfish.GracefulRestartResetType)),
+			MarkdownDescription: "Type of iRMC reset. Applicable values are: 'GracefulRestart' (default), 'ForceRestart'.",
+			Description:         "Type of iRMC reset. Applicable values are: 'GracefulRestart' (default), 'ForceRestart'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(redfish.GracefulRestartResetType), string(redfish.ForceRestartResetType)),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"reset_to_defaults": schema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: "If defined, iRMC settings are reset to factory defaults instead of restart. Applicable values are: " +
+				"'PreserveNetworkAndUsers', 'PreserveNetwork', 'ResetAll'. Since credentials are reset with 'PreserveNetwork' and 'ResetAll', " +
+				"resource does not wait for iRMC to become ready in such case.",
+			Description: "If defined, iRMC settings are reset to factory defaults instead of restart. Applicable values are: " +
+				"'PreserveNetworkAndUsers', 'PreserveNetwork', 'ResetAll'. Since credentials are reset with 'PreserveNetwork' and 'ResetAll', " +
+				"resource does not wait for iRMC to become ready in such case.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(redfish.PreserveNetworkAndUsersResetToDefaultsType),
+					string(redfish.PreserveNetworkResetToDefaultsType),
+					string(redfish.ResetAllResetToDefaultsType),
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"ready_timeout": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Timeout in seconds for iRMC to become ready after reset (default 600s).",
+			Description:         "Timeout in seconds for iRMC to become ready after reset (default 600s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(60),
+			},
+		},
+		"expected_firmware_version": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "If defined, firmware version reported by iRMC after reset must start with this value, e.g. to verify that firmware update has been activated.",
+			Description:         "If defined, firmware version reported by iRMC after reset must start with this value, e.g. to verify that firmware update has been activated.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"firmware_version": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Firmware version reported by iRMC after reset.",
+			Description:         "Firmware version reported by iRMC after reset.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Arbitrary map of values, change of which causes iRMC to be reset again (e.g. between configuration stages).",
+			Description:         "Arbitrary map of values, change of which causes iRMC to be reset again (e.g. between configuration stages).",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
 	}
 }
 
@@ -99,48 +165,75 @@ func (r *IrmcRestartResource) Create(ctx context.Context, req resource.CreateReq
 	var plan models.IrmcResetResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-irmc-reset"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
 
 	config, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connect Target System Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
 		return
 	}
 
-	defer config.Logout()
+	defer ReleaseTargetSystem(config)
 	var irmc []*redfish.Manager
 
 	// Get manager
 	irmc, err = config.Service.Managers()
 	if err != nil {
-		resp.Diagnostics.AddError("Error when accessing Managers resource", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error when accessing Managers resource", err)...)
+		return
+	}
+
+	if !plan.Id.IsNull() && !plan.Id.IsUnknown() && plan.Id.ValueString() != irmc[0].ID {
+		resp.Diagnostics.AddError("Invalid IRMC ID provided", fmt.Sprintf("Manager of the system has ID '%s'", irmc[0].ID))
 		return
 	}
 	plan.Id = types.StringValue(irmc[0].ID)
 
 	// Perform manager reset
-	err = irmc[0].Reset(redfish.GracefulRestartResetType)
+	if len(plan.ResetToDefaults.ValueString()) > 0 {
+		err = irmc[0].ResetToDefaults(redfish.ResetToDefaultsType(plan.ResetToDefaults.ValueString()))
+	} else {
+		err = irmc[0].Reset(redfish.ResetType(plan.ResetType.ValueString()))
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Error resetting manager", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error resetting manager", err)...)
 		return
 	}
 
-	config, err = retryConnectWithTimeout(ctx, r.p, &plan.RedfishServer)
+	if !irmcResetPreservesCredentials(plan.ResetToDefaults.ValueString()) {
+		resp.Diagnostics.AddWarning("iRMC has been reset to factory defaults",
+			"Credentials have been reset, so it was not verified that iRMC became ready again")
+		plan.FirmwareVersion = types.StringValue(irmc[0].FirmwareVersion)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	config, err = waitForManagerRestart(ctx, r.p, &plan.RedfishServer, getManagerReadyTimeout(plan.ReadyTimeout))
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connect Target System Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to reboot IRMC. The operation may take longer than expected to complete.", err)...)
 		return
 	}
 
-	err = checkIrmcStatus(ctx, config, CHECK_INTERVAL, RESET_TIMEOUT)
+	defer ReleaseTargetSystem(config)
+
+	irmc, err = config.Service.Managers()
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to reboot IRMC. The operation may take longer than expected to complete.", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error when accessing Managers resource after reset", err)...)
+		return
+	}
+
+	plan.FirmwareVersion = types.StringValue(irmc[0].FirmwareVersion)
+	if err = verifyIrmcFirmwareVersion(plan.ExpectedFirmwareVersion.ValueString(), irmc[0].FirmwareVersion); err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Unexpected iRMC firmware version after reset", err)...)
 		return
 	}
 
@@ -165,13 +258,19 @@ func (r *IrmcRestartResource) Read(ctx context.Context, req resource.ReadRequest
 	tflog.Info(ctx, "resource-irmc-reset: read ends")
 }
 
-// Update modifies the resource state but returns an error if triggered, as updates are not supported.
-func (*IrmcRestartResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// This function should not be called since updates are not supported; the resource should be recreated instead.
-	resp.Diagnostics.AddError(
-		"Unsupported Update Operation for IRMC Reset",
-		"The IRMC Reset resource does not support in-place updates. It is intended to be destroyed and recreated if changes are required.",
-	)
+// Update modifies the resource state. Changes which require reset cause replacement of the resource,
+// so only attributes which do not trigger reset (e.g. ready_timeout) are updated in place.
+func (*IrmcRestartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.IrmcResetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.FirmwareVersion = state.FirmwareVersion
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -182,35 +281,17 @@ func (*IrmcRestartResource) Delete(ctx context.Context, req resource.DeleteReque
 	tflog.Info(ctx, "resource-irmc-reset: delete ends")
 }
 
-func checkIrmcStatus(ctx context.Context, service *gofish.APIClient, interval int, timeout int) error {
-	path := "/redfish/v1/"
-
-	time.Sleep(45 * time.Second)
-
-	for start := time.Now(); time.Since(start) < (time.Duration(timeout) * time.Second); {
-		tflog.Info(ctx, "Checking IRMC server status via API GET")
-
-		resp, err := service.Get(path)
-		if err != nil {
-			tflog.Warn(ctx, fmt.Sprintf("GET on %s reported error: %s", path, err.Error()))
-			time.Sleep(time.Duration(interval) * time.Second)
-			continue
-		}
-
-		if resp.StatusCode == http.StatusOK {
-			defer CloseResource(resp.Body)
-			_, err := io.ReadAll(resp.Body)
-			if err != nil {
-				tflog.Error(ctx, fmt.Sprintf("Error reading response body: %s", err.Error()))
-				return err
-			}
-			return nil
-		}
-
-		tflog.Warn(ctx, fmt.Sprintf("Received non-200 status code: %d", resp.StatusCode))
+// irmcResetPreservesCredentials checks if credentials used to access iRMC stay valid after the reset
+// (empty resetToDefaults means restart without reset to defaults).
+func irmcResetPreservesCredentials(resetToDefaults string) bool {
+	return len(resetToDefaults) == 0 || resetToDefaults == string(redfish.PreserveNetworkAndUsersResetToDefaultsType)
+}
 
-		time.Sleep(time.Duration(interval) * time.Second)
+// verifyIrmcFirmwareVersion checks that reported firmware version starts with expected one (if defined).
+func verifyIrmcFirmwareVersion(expected string, reported string) error {
+	if len(expected) == 0 || strings.HasPrefix(reported, expected) {
+		return nil
 	}
 
-	return fmt.Errorf("IRMC server status check timed out after %d seconds", timeout)
+	return fmt.Errorf("expected firmware version '%s', but iRMC reports '%s'", expected, reported)
 }
@@ -18,6 +18,7 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -84,6 +85,20 @@ func TestAccRedfishIRMCReset_HostOff(t *testing.T) {
 	})
 }
 
+func TestVerifyIrmcFirmwareVersion(t *testing.T) {
+	if err := verifyIrmcFirmwareVersion("", "3.52P"); err != nil {
+		t.Errorf("Unexpected error %s", err.Error())
+	}
+
+	if err := verifyIrmcFirmwareVersion("3.52", "3.52P SDR: 3.71 ID 0475"); err != nil {
+		t.Errorf("Unexpected error %s", err.Error())
+	}
+
+	if err := verifyIrmcFirmwareVersion("3.60", "3.52P"); err == nil {
+		t.Errorf("Expected error for different firmware version")
+	}
+}
+
 func testAccRedfishResourceIRMCResetConfig(testingInfo TestingServerCredentials,
 	id string,
 ) string {
@@ -125,13 +140,13 @@ func testpreIrmcHostPowerOn(creds TestingServerCredentials, hoston bool) error {
 		return err
 	}
 	if hoston && !isPoweredOn {
-		err = changePowerState(api.Service, true, 300)
+		err = changePowerState(context.Background(), api.Service, true, 300)
 		if err != nil {
 			return err
 		}
 		time.Sleep(2 * time.Minute)
 	} else if !hoston && isPoweredOn {
-		err = changePowerState(api.Service, false, 300)
+		err = changePowerState(context.Background(), api.Service, false, 300)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,235 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const IRMC_SECURE_ERASE_OFFLINE_TIMEOUT = 300
+
+// getIrmcSecureEraseEndpoint returns OEM action erasing configuration, logs and NVRAM of iRMC.
+func getIrmcSecureEraseEndpoint(isFsas bool) string {
+	if isFsas {
+		return fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.SecureErase", FSAS)
+	}
+	return fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.SecureErase", FTS)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IrmcSecureEraseResource{}
+
+func NewIrmcSecureEraseResource() resource.Resource {
+	return &IrmcSecureEraseResource{}
+}
+
+// IrmcSecureEraseResource defines the resource implementation.
+type IrmcSecureEraseResource struct {
+	p *IrmcProvider
+}
+
+func (r *IrmcSecureEraseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + irmcSecureEraseName
+}
+
+func IrmcSecureEraseSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of secure erase resource on iRMC.",
+			Description:         "ID of secure erase resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"confirm": schema.BoolAttribute{
+			Required:            true,
+			MarkdownDescription: "Explicit confirmation of secure erase, must be set to true. Configuration, logs and NVRAM of iRMC can not be recovered and iRMC is not accessible with current credentials afterwards.",
+			Description:         "Explicit confirmation of secure erase, must be set to true. Configuration, logs and NVRAM of iRMC can not be recovered and iRMC is not accessible with current credentials afterwards.",
+		},
+		"wait_for_offline": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			MarkdownDescription: "Wait until iRMC stops responding after secure erase has been started (default true).",
+			Description:         "Wait until iRMC stops responding after secure erase has been started (default true).",
+		},
+		"offline_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(IRMC_SECURE_ERASE_OFFLINE_TIMEOUT),
+			MarkdownDescription: fmt.Sprintf("Timeout in seconds for iRMC to go offline after secure erase has been started (default %ds).", IRMC_SECURE_ERASE_OFFLINE_TIMEOUT),
+			Description:         fmt.Sprintf("Timeout in seconds for iRMC to go offline after secure erase has been started (default %ds).", IRMC_SECURE_ERASE_OFFLINE_TIMEOUT),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(30),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Arbitrary map of values, change of which causes secure erase to be performed again.",
+			Description:         "Arbitrary map of values, change of which causes secure erase to be performed again.",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *IrmcSecureEraseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to securely erase iRMC (configuration, logs and NVRAM) at the end of server lifecycle.",
+		Description:         "This resource is used to securely erase iRMC (configuration, logs and NVRAM) at the end of server lifecycle.",
+		Attributes:          IrmcSecureEraseSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *IrmcSecureEraseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *IrmcSecureEraseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-irmc-secure-erase: create starts")
+
+	var plan models.IrmcSecureEraseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Confirm.ValueBool() {
+		resp.Diagnostics.AddError("Secure erase of iRMC can not be performed", "secure erase of iRMC has not been confirmed, confirm must be set to true")
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-irmc-secure-erase"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		ReleaseTargetSystem(api)
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return
+	}
+
+	actionEndpoint := getIrmcSecureEraseEndpoint(isFsas)
+	tflog.Info(ctx, fmt.Sprintf("Starting secure erase of iRMC using %s", actionEndpoint))
+	res, err := api.Post(actionEndpoint, map[string]interface{}{})
+	ReleaseTargetSystem(api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error starting secure erase of iRMC", fmt.Errorf("POST on %s finished with error '%w'", actionEndpoint, err))...)
+		return
+	}
+	CloseResource(res.Body)
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusAccepted && res.StatusCode != http.StatusNoContent {
+		resp.Diagnostics.AddError("Error starting secure erase of iRMC", fmt.Sprintf("POST on %s finished with status code %d", actionEndpoint, res.StatusCode))
+		return
+	}
+
+	plan.Id = types.StringValue(actionEndpoint)
+
+	if plan.WaitForOffline.ValueBool() {
+		err = waitForManagerOffline(ctx, r.p, &plan.RedfishServer, plan.OfflineTimeout.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Secure erase of iRMC has been started, but iRMC has not gone offline", err)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-irmc-secure-erase: create ends")
+}
+
+func (r *IrmcSecureEraseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-irmc-secure-erase: read starts")
+	// Secure erase is one time operation and iRMC is not accessible afterwards,
+	// so there is nothing to be read from iRMC
+	var state models.IrmcSecureEraseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-irmc-secure-erase: read ends")
+}
+
+// Update modifies the resource state. Change of triggers causes replacement of the resource,
+// so only attributes which do not trigger secure erase are updated in place.
+func (r *IrmcSecureEraseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.IrmcSecureEraseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *IrmcSecureEraseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-irmc-secure-erase: delete starts")
+	// Secure erase can not be reverted, so resource is only removed from state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-irmc-secure-erase: delete ends")
+}
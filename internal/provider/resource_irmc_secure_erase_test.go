@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRedfishIrmcSecureErase_NotConfirmed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceIrmcSecureEraseConfig(creds, false),
+				ExpectError: regexp.MustCompile("secure erase of iRMC has not been confirmed"),
+			},
+		},
+	})
+}
+
+func TestWaitForManagerOffline(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	rserver := []models.RedfishServer{{Endpoint: types.StringValue(server.URL)}}
+	if err := waitForManagerOffline(context.Background(), &IrmcProvider{}, &rserver, 30); err != nil {
+		t.Errorf("Unexpected error %s", err.Error())
+	}
+
+	if requests != 2 {
+		t.Errorf("Got %d requests, expected 2", requests)
+	}
+}
+
+func testAccRedfishResourceIrmcSecureEraseConfig(testingInfo TestingServerCredentials, confirm bool) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_irmc_secure_erase" "erase" {
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		confirm = %t
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		confirm,
+	)
+}
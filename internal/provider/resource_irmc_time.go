@@ -0,0 +1,432 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	MANAGER_NETWORK_PROTOCOL_ENDPOINT = MANAGER_ENDPOINT + "/NetworkProtocol"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IrmcTimeResource{}
+var _ resource.ResourceWithImportState = &IrmcTimeResource{}
+
+func NewIrmcTimeResource() resource.Resource {
+	return &IrmcTimeResource{}
+}
+
+// IrmcTimeResource defines the resource implementation.
+type IrmcTimeResource struct {
+	p *IrmcProvider
+}
+
+func (r *IrmcTimeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + irmcTimeName
+}
+
+func IrmcTimeSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of manager resource on iRMC.",
+			Description:         "ID of manager resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"time_zone_offset": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Offset of iRMC local time from UTC in format '+HH:MM' or '-HH:MM'.",
+			Description:         "Offset of iRMC local time from UTC in format '+HH:MM' or '-HH:MM'.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[+-]\d{2}:\d{2}$`), "must be in format '+HH:MM' or '-HH:MM'"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"time_zone_name": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Name of time zone of iRMC (e.g. 'Europe/Berlin'), if supported by iRMC firmware.",
+			Description:         "Name of time zone of iRMC (e.g. 'Europe/Berlin'), if supported by iRMC firmware.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ntp_enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Defines whether iRMC synchronizes its time with NTP servers.",
+			Description:         "Defines whether iRMC synchronizes its time with NTP servers.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ntp_servers": schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "List of NTP servers used by iRMC.",
+			Description:         "List of NTP servers used by iRMC.",
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"date_time": schema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: "Date and time in RFC 3339 format (e.g. '2025-01-01T12:00:00+01:00') set manually on iRMC. " +
+				"Can be used only with NTP disabled. Date and time is set when the value changes, afterwards iRMC clock runs on its own.",
+			Description: "Date and time in RFC 3339 format (e.g. '2025-01-01T12:00:00+01:00') set manually on iRMC. " +
+				"Can be used only with NTP disabled. Date and time is set when the value changes, afterwards iRMC clock runs on its own.",
+			Validators: []validator.String{
+				validators.IsRFC3339(),
+			},
+		},
+		"current_date_time": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Current date and time reported by iRMC.",
+			Description:         "Current date and time reported by iRMC.",
+		},
+		"time_skew_seconds": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Difference in seconds between iRMC clock and clock of machine running Terraform, positive value means that iRMC clock is ahead.",
+			Description:         "Difference in seconds between iRMC clock and clock of machine running Terraform, positive value means that iRMC clock is ahead.",
+		},
+	}
+}
+
+func (r *IrmcTimeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) time zone, NTP and date/time settings of iRMC.",
+		Description:         "The resource is used to control (read, modify or import) time zone, NTP and date/time settings of iRMC.",
+		Attributes:          IrmcTimeSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *IrmcTimeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *IrmcTimeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-irmc_time: create starts")
+
+	var plan models.IrmcTimeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-irmc_time: create ends")
+}
+
+func (r *IrmcTimeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-irmc_time: read starts")
+
+	var state models.IrmcTimeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	resp.Diagnostics.Append(readIrmcTime(ctx, api, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-irmc_time: read ends")
+}
+
+func (r *IrmcTimeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-irmc_time: update starts")
+
+	var plan models.IrmcTimeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.IrmcTimeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-irmc_time: update ends")
+}
+
+func (r *IrmcTimeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-irmc_time: delete starts")
+	// Time settings can not be removed, so resource is only removed from state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-irmc_time: delete ends")
+}
+
+func (r *IrmcTimeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-irmc_time: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.IrmcTimeResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+		DateTime:      types.StringNull(),
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	resp.Diagnostics.Append(readIrmcTime(ctx, api, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-irmc_time: import ends")
+}
+
+// apply sends NTP settings, time zone and manual date/time, which are defined in plan and differ
+// from state (if any), to iRMC and reads back all time settings into plan.
+func (r *IrmcTimeResource) apply(ctx context.Context, plan *models.IrmcTimeResourceModel, state *models.IrmcTimeResourceModel) (diags diag.Diagnostics) {
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-irmc_time"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	var current models.IrmcTimeResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	ntpPayload := map[string]interface{}{}
+	addBoolToPayload(ntpPayload, "ProtocolEnabled", plan.NtpEnabled, current.NtpEnabled)
+	if !plan.NtpServers.IsNull() && !plan.NtpServers.IsUnknown() && !plan.NtpServers.Equal(current.NtpServers) {
+		var servers []string
+		diags.Append(plan.NtpServers.ElementsAs(ctx, &servers, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		ntpPayload["NTPServers"] = servers
+	}
+
+	if len(ntpPayload) > 0 {
+		tflog.Info(ctx, "Changing NTP settings", map[string]interface{}{"payload": ntpPayload})
+		if err := patchEndpointWithEtag(api, MANAGER_NETWORK_PROTOCOL_ENDPOINT, map[string]interface{}{"NTP": ntpPayload}); err != nil {
+			diags.Append(redfishErrorDiagnostics("Could not apply NTP settings", err)...)
+			return diags
+		}
+	}
+
+	managerPayload := map[string]interface{}{}
+	addStringToPayload(managerPayload, "DateTimeLocalOffset", plan.TimeZoneOffset, current.TimeZoneOffset)
+	addStringToPayload(managerPayload, "TimeZoneName", plan.TimeZoneName, current.TimeZoneName)
+
+	if len(managerPayload) > 0 {
+		tflog.Info(ctx, "Changing time zone settings", map[string]interface{}{"payload": managerPayload})
+	}
+
+	if _, err := applySettings(api, MANAGER_ENDPOINT, managerPayload); err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply time zone settings", err)...)
+		return diags
+	}
+
+	requested := *plan
+	diags.Append(readIrmcTime(ctx, api, plan)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := verifyIrmcNtpServers(ctx, requested.NtpServers, plan.NtpServers); err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply NTP settings", err)...)
+		return diags
+	}
+
+	if requested.DateTime.IsNull() || requested.DateTime.Equal(current.DateTime) {
+		return diags
+	}
+
+	if plan.NtpEnabled.ValueBool() {
+		diags.AddAttributeError(path.Root("date_time"), "Date and time can not be set",
+			"iRMC synchronizes its time with NTP servers, so date_time can be used only with ntp_enabled set to false")
+		return diags
+	}
+
+	// Value of DateTime changes continuously, so it's not verified by read back
+	tflog.Info(ctx, "Setting date and time", map[string]interface{}{"date_time": requested.DateTime.ValueString()})
+	if err := patchEndpointWithEtag(api, MANAGER_ENDPOINT, map[string]interface{}{"DateTime": requested.DateTime.ValueString()}); err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not set date and time", err)...)
+		return diags
+	}
+
+	diags.Append(readIrmcTime(ctx, api, plan)...)
+	return diags
+}
+
+// readIrmcTime reads time settings of manager and its network protocol into model. Manually
+// configured date_time is kept as it is, since iRMC clock runs on its own after it has been set.
+func readIrmcTime(ctx context.Context, api *gofish.APIClient, model *models.IrmcTimeResourceModel) (diags diag.Diagnostics) {
+	manager, err := getJsonObject(api, MANAGER_ENDPOINT)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read manager time settings", err)...)
+		return diags
+	}
+
+	protocol, err := getJsonObject(api, MANAGER_NETWORK_PROTOCOL_ENDPOINT)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read NTP settings", err)...)
+		return diags
+	}
+
+	model.Id = types.StringValue(MANAGER_ENDPOINT)
+	model.TimeZoneOffset = jsonStringValue(manager, "DateTimeLocalOffset")
+	model.TimeZoneName = jsonStringValue(manager, "TimeZoneName")
+	model.CurrentDateTime = jsonStringValue(manager, "DateTime")
+	model.TimeSkew = getIrmcTimeSkew(model.CurrentDateTime.ValueString(), time.Now())
+
+	ntp := jsonObjectValue(protocol, "NTP")
+	model.NtpEnabled = jsonBoolValue(ntp, "ProtocolEnabled")
+
+	servers := []string{}
+	if list, ok := ntp["NTPServers"].([]interface{}); ok {
+		for _, server := range list {
+			// iRMC reports unused server slots as empty strings
+			if val, ok := server.(string); ok && len(val) > 0 {
+				servers = append(servers, val)
+			}
+		}
+	}
+
+	var d diag.Diagnostics
+	model.NtpServers, d = types.ListValueFrom(ctx, types.StringType, servers)
+	diags.Append(d...)
+	return diags
+}
+
+// getIrmcTimeSkew returns difference in seconds between date and time reported by iRMC and now,
+// or null if iRMC does not report date and time in expected format.
+func getIrmcTimeSkew(dateTime string, now time.Time) types.Int64 {
+	irmcTime, err := time.Parse(time.RFC3339, dateTime)
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(irmcTime.Sub(now).Round(time.Second).Seconds()))
+}
+
+// verifyIrmcNtpServers checks that requested NTP servers (if defined) are reported by iRMC.
+func verifyIrmcNtpServers(ctx context.Context, requested types.List, reported types.List) error {
+	if requested.IsNull() || requested.IsUnknown() {
+		return nil
+	}
+
+	var requestedServers, reportedServers []string
+	requested.ElementsAs(ctx, &requestedServers, false)
+	reported.ElementsAs(ctx, &reportedServers, false)
+	if !slices.Equal(requestedServers, reportedServers) {
+		return fmt.Errorf("requested NTP servers are %v, but iRMC reports %v", requestedServers, reportedServers)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const irmc_time_name = "irmc-redfish_irmc_time.time"
+
+func TestAccRedfishIrmcTime_InvalidOffset(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceIrmcTimeConfig(creds, "UTC"),
+				ExpectError: regexp.MustCompile("must be in format"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishIrmcTime_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceIrmcTimeConfig(creds, "+01:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(irmc_time_name, "id", MANAGER_ENDPOINT),
+					resource.TestCheckResourceAttr(irmc_time_name, "time_zone_offset", "+01:00"),
+					resource.TestCheckResourceAttrSet(irmc_time_name, "ntp_enabled"),
+					resource.TestCheckResourceAttrSet(irmc_time_name, "current_date_time"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceIrmcTimeConfig(creds, "+00:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(irmc_time_name, "time_zone_offset", "+00:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestIrmcTimeApply(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	r := IrmcTimeResource{p: connectMockRedfishServer(t, server)}
+	ctx := context.Background()
+
+	servers, _ := types.ListValueFrom(ctx, types.StringType, []string{"ntp1.example.com", "ntp2.example.com"})
+	plan := models.IrmcTimeResourceModel{
+		TimeZoneOffset: types.StringValue("+02:00"),
+		TimeZoneName:   types.StringNull(),
+		NtpEnabled:     types.BoolValue(true),
+		NtpServers:     servers,
+		DateTime:       types.StringNull(),
+	}
+
+	if diags := r.apply(ctx, &plan, nil); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if plan.TimeZoneOffset.ValueString() != "+02:00" || !plan.NtpServers.Equal(servers) || plan.Id.ValueString() != MANAGER_ENDPOINT {
+		t.Errorf("Unexpected time settings %v", plan)
+	}
+
+	ntp := server.Resource(MANAGER_NETWORK_PROTOCOL_ENDPOINT)["NTP"].(map[string]interface{})
+	if ntp["ProtocolEnabled"] != true || len(ntp["NTPServers"].([]interface{})) != 2 {
+		t.Errorf("Unexpected NTP settings on server %v", ntp)
+	}
+
+	// Date and time can not be set manually while NTP is enabled
+	state := plan
+	plan.DateTime = types.StringValue("2025-01-01T12:00:00+02:00")
+	if diags := r.apply(ctx, &plan, &state); !diags.HasError() {
+		t.Fatalf("Expected error for date_time with NTP enabled")
+	}
+
+	plan.NtpEnabled = types.BoolValue(false)
+	if diags := r.apply(ctx, &plan, &state); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if server.Resource(MANAGER_ENDPOINT)["DateTime"] != "2025-01-01T12:00:00+02:00" || plan.NtpEnabled.ValueBool() {
+		t.Errorf("Unexpected manager state %v", server.Resource(MANAGER_ENDPOINT))
+	}
+}
+
+func TestGetIrmcTimeSkew(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if skew := getIrmcTimeSkew("2025-01-01T13:00:30+01:00", now); skew.ValueInt64() != 30 {
+		t.Errorf("Unexpected time skew %v", skew)
+	}
+
+	if skew := getIrmcTimeSkew("", now); !skew.IsNull() {
+		t.Errorf("Expected null time skew, got %v", skew)
+	}
+}
+
+func testAccRedfishResourceIrmcTimeConfig(testingInfo TestingServerCredentials, offset string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_irmc_time" "time" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		time_zone_offset = "%s"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		offset,
+	)
+}
@@ -0,0 +1,325 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	IRMC_WAIT_TIMEOUT       = 600
+	IRMC_WAIT_POLL_INTERVAL = 5
+)
+
+var (
+	redfishPathRegex = regexp.MustCompile(`^/redfish/v1(/\S*)?$`)
+	jsonPointerRegex = regexp.MustCompile(`^/`)
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IrmcWaitResource{}
+
+func NewIrmcWaitResource() resource.Resource {
+	return &IrmcWaitResource{}
+}
+
+// IrmcWaitResource defines the resource implementation.
+type IrmcWaitResource struct {
+	p *IrmcProvider
+}
+
+func (r *IrmcWaitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + irmcWaitName
+}
+
+func IrmcWaitSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of irmc wait resource.",
+			Description:         "ID of irmc wait resource.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"path": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Redfish path of the polled resource, e.g. '/redfish/v1/Systems/0'.",
+			Description:         "Redfish path of the polled resource, e.g. '/redfish/v1/Systems/0'.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(redfishPathRegex, "must be Redfish path starting with /redfish/v1"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"json_pointer": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "JSON pointer (RFC 6901) to the polled property within the resource, e.g. '/PowerState' or '/Status/Health'.",
+			Description:         "JSON pointer (RFC 6901) to the polled property within the resource, e.g. '/PowerState' or '/Status/Health'.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(jsonPointerRegex, "must be JSON pointer starting with /"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"expected_value": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "Value which the polled property is expected to reach. Strings are compared directly, " +
+				"other values using their JSON representation, e.g. 'true', '100' or 'null'.",
+			Description: "Value which the polled property is expected to reach. Strings are compared directly, " +
+				"other values using their JSON representation, e.g. 'true', '100' or 'null'.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(IRMC_WAIT_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for the property to reach expected value (default 600s).",
+			Description:         "Timeout in seconds for the property to reach expected value (default 600s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"poll_interval": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(IRMC_WAIT_POLL_INTERVAL),
+			MarkdownDescription: "Initial interval in seconds between checks, which is extended with backoff (default 5s).",
+			Description:         "Initial interval in seconds between checks, which is extended with backoff (default 5s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"current_value": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Value of the polled property observed when the wait finished.",
+			Description:         "Value of the polled property observed when the wait finished.",
+		},
+		"triggers": schema.MapAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Arbitrary map of values, change of which causes the wait to be executed again (e.g. between configuration stages).",
+			Description:         "Arbitrary map of values, change of which causes the wait to be executed again (e.g. between configuration stages).",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *IrmcWaitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource waits until property of Redfish resource reaches expected value, e.g. PowerState of the system becomes 'Off'.",
+		Description:         "This resource waits until property of Redfish resource reaches expected value, e.g. PowerState of the system becomes 'Off'.",
+		Attributes:          IrmcWaitSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *IrmcWaitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *IrmcWaitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-irmc_wait: create starts")
+
+	var plan models.IrmcWaitResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint := plan.Path.ValueString()
+	pointer := plan.JsonPointer.ValueString()
+	expected := plan.ExpectedValue.ValueString()
+
+	var current string
+	var lastErr error
+	err = pollService(ctx, api.Service, plan.Timeout.ValueInt64(), time.Duration(plan.PollInterval.ValueInt64())*time.Second,
+		func(ctx context.Context) (bool, error) {
+			// Resource might be temporarily unavailable (e.g. during reset), so errors do not stop waiting
+			data, err := getJsonObject(api, endpoint)
+			if err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Could not read %s: %s", endpoint, err.Error()))
+				lastErr = err
+				return false, nil
+			}
+
+			value, err := jsonPointerValue(data, pointer)
+			if err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Could not resolve %s in %s: %s", pointer, endpoint, err.Error()))
+				lastErr = err
+				return false, nil
+			}
+
+			lastErr = nil
+			current = formatJsonPointerValue(value)
+			tflog.Info(ctx, fmt.Sprintf("%s%s is '%s', expected '%s'", endpoint, pointer, current, expected))
+			return current == expected, nil
+		})
+
+	if err != nil {
+		detail := fmt.Sprintf("last observed value '%s'", current)
+		if lastErr != nil {
+			detail = fmt.Sprintf("last error '%s'", lastErr.Error())
+		}
+
+		resp.Diagnostics.AddError("Wait for expected value failed",
+			fmt.Sprintf("%s%s has not reached value '%s': %s (%s)", endpoint, pointer, expected, err.Error(), detail))
+		return
+	}
+
+	plan.Id = types.StringValue(endpoint + "#" + pointer)
+	plan.CurrentValue = types.StringValue(current)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Info(ctx, "resource-irmc_wait: create ends")
+}
+
+func (r *IrmcWaitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-irmc_wait: read starts")
+
+	var state models.IrmcWaitResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-irmc_wait: read ends")
+}
+
+// Update modifies the resource state. Changes of awaited condition cause replacement of the resource,
+// so only attributes which do not influence it (e.g. timeout) are updated in place.
+func (r *IrmcWaitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.IrmcWaitResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.CurrentValue = state.CurrentValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IrmcWaitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-irmc_wait: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-irmc_wait: delete ends")
+}
+
+// jsonPointerValue returns value referenced by RFC 6901 JSON pointer within data.
+func jsonPointerValue(data interface{}, pointer string) (interface{}, error) {
+	if len(pointer) == 0 {
+		return data, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("JSON pointer '%s' must start with /", pointer)
+	}
+
+	value := data
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch node := value.(type) {
+		case map[string]interface{}:
+			child, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("property '%s' not found", token)
+			}
+			value = child
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("index '%s' out of range of array with %d elements", token, len(node))
+			}
+			value = node[index]
+		default:
+			return nil, fmt.Errorf("'%s' cannot be resolved in scalar value", token)
+		}
+	}
+
+	return value, nil
+}
+
+// formatJsonPointerValue returns string as is and JSON representation of any other value.
+func formatJsonPointerValue(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(bytes)
+}
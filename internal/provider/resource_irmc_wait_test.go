@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const irmc_wait_name = "irmc-redfish_irmc_wait.wait"
+
+func TestAccRedfishIrmcWait_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceIrmcWaitConfig(creds, "/redfish/v1/Managers/iRMC", "/Status/State", "Enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(irmc_wait_name, "id", "/redfish/v1/Managers/iRMC#/Status/State"),
+					resource.TestCheckResourceAttr(irmc_wait_name, "current_value", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestJsonPointerValue(t *testing.T) {
+	var data map[string]interface{}
+	body := `{"PowerState": "On", "Status": {"Health": "OK"}, "a/b": {"c~d": 5}, "Members": [{"Id": "0"}, {"Id": "1"}], "Enabled": true}`
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	tests := map[string]string{
+		"/PowerState":    "On",
+		"/Status/Health": "OK",
+		"/a~1b/c~0d":     "5",
+		"/Members/1/Id":  "1",
+		"/Enabled":       "true",
+		"/Status":        `{"Health":"OK"}`,
+	}
+
+	for pointer, expected := range tests {
+		value, err := jsonPointerValue(data, pointer)
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", pointer, err.Error())
+			continue
+		}
+
+		if formatted := formatJsonPointerValue(value); formatted != expected {
+			t.Errorf("%s: expected '%s', got '%s'", pointer, expected, formatted)
+		}
+	}
+
+	for _, pointer := range []string{"/Unknown", "/Members/2/Id", "/Members/x", "/PowerState/Value", "PowerState"} {
+		if _, err := jsonPointerValue(data, pointer); err == nil {
+			t.Errorf("%s: error expected", pointer)
+		}
+	}
+}
+
+func testAccRedfishResourceIrmcWaitConfig(testingInfo TestingServerCredentials, path string, pointer string, expected string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_irmc_wait" "wait" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		path           = "%s"
+		json_pointer   = "%s"
+		expected_value = "%s"
+		timeout        = 60
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		path,
+		pointer,
+		expected,
+	)
+}
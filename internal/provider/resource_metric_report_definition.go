@@ -0,0 +1,330 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MetricReportDefinitionResource{}
+var _ resource.ResourceWithImportState = &MetricReportDefinitionResource{}
+
+func NewMetricReportDefinitionResource() resource.Resource {
+	return &MetricReportDefinitionResource{}
+}
+
+// MetricReportDefinitionResource defines the resource implementation.
+type MetricReportDefinitionResource struct {
+	p *IrmcProvider
+}
+
+type metricReportDefinitionImportConfig struct {
+	ServerConfig
+	ID string `json:"metric_report_definition_id"`
+}
+
+// ISO 8601 duration as used by Redfish, e.g. PT60S or P1DT12H.
+var iso8601DurationRegex = regexp.MustCompile(`^P(\d+D(T(\d+H)?(\d+M)?(\d+(\.\d+)?S)?)?|T(\d+H(\d+M)?(\d+(\.\d+)?S)?|\d+M(\d+(\.\d+)?S)?|\d+(\.\d+)?S))$`)
+
+func (r *MetricReportDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + metricReportDefName
+}
+
+func MetricReportDefinitionSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ODataId of metric report definition.",
+			Description:         "ODataId of metric report definition.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"metric_report_definition_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Id of metric report definition exposed by telemetry service (e.g. PowerMetrics).",
+			Description:         "Id of metric report definition exposed by telemetry service (e.g. PowerMetrics).",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Defines if metric report definition is enabled.",
+			Description:         "Defines if metric report definition is enabled.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"metric_report_definition_type": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "When metric report is generated. Applicable values are: 'Periodic', 'OnChange', 'OnRequest'.",
+			Description:         "When metric report is generated. Applicable values are: 'Periodic', 'OnChange', 'OnRequest'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("Periodic", "OnChange", "OnRequest"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"recurrence_interval": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Interval of periodic metric report as ISO 8601 duration (e.g. PT60S).",
+			Description:         "Interval of periodic metric report as ISO 8601 duration (e.g. PT60S).",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(iso8601DurationRegex, "must be ISO 8601 duration, e.g. 'PT60S'"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"report_updates": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "How subsequent metric reports are handled. Applicable values are: 'Overwrite', 'AppendWrapsWhenFull', 'AppendStopsWhenFull', 'NewReport'.",
+			Description:         "How subsequent metric reports are handled. Applicable values are: 'Overwrite', 'AppendWrapsWhenFull', 'AppendStopsWhenFull', 'NewReport'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("Overwrite", "AppendWrapsWhenFull", "AppendStopsWhenFull", "NewReport"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *MetricReportDefinitionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) metric report definition of telemetry service.",
+		Description:         "The resource is used to control (read, modify or import) metric report definition of telemetry service.",
+		Attributes:          MetricReportDefinitionSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *MetricReportDefinitionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *MetricReportDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-metric_report_definition: create starts")
+
+	var plan models.MetricReportDefinitionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-metric_report_definition: create ends")
+}
+
+func (r *MetricReportDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-metric_report_definition: read starts")
+
+	var state models.MetricReportDefinitionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	definition, err := findMetricReportDefinition(api, state.MetricReportDefinitionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not read metric report definition", err)...)
+		return
+	}
+
+	metricReportDefinitionToModel(definition, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-metric_report_definition: read ends")
+}
+
+func (r *MetricReportDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-metric_report_definition: update starts")
+
+	var plan models.MetricReportDefinitionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.MetricReportDefinitionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-metric_report_definition: update ends")
+}
+
+func (r *MetricReportDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-metric_report_definition: delete starts")
+	// Predefined metric report definitions can not be removed, so resource is only removed from state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-metric_report_definition: delete ends")
+}
+
+func (r *MetricReportDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-metric_report_definition: import starts")
+
+	var config metricReportDefinitionImportConfig
+	server, err := parseImportID(req.ID, &config, &config.ID)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.MetricReportDefinitionResourceModel{
+		RedfishServer:            []models.RedfishServer{server},
+		MetricReportDefinitionId: types.StringValue(config.ID),
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	definition, err := findMetricReportDefinition(api, config.ID)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not read metric report definition", err)...)
+		return
+	}
+
+	metricReportDefinitionToModel(definition, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-metric_report_definition: import ends")
+}
+
+// apply sends metric report definition values, which are defined in plan and differ from state (if any),
+// to iRMC and reads back all values into plan.
+func (r *MetricReportDefinitionResource) apply(ctx context.Context, plan *models.MetricReportDefinitionResourceModel,
+	state *models.MetricReportDefinitionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-metric_report_definition"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	definition, err := findMetricReportDefinition(api, plan.MetricReportDefinitionId.ValueString())
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read metric report definition", err)...)
+		return diags
+	}
+
+	var current models.MetricReportDefinitionResourceModel
+	if state != nil {
+		current = *state
+	} else {
+		metricReportDefinitionToModel(definition, &current)
+	}
+
+	payload := map[string]interface{}{}
+	addBoolToPayload(payload, "MetricReportDefinitionEnabled", plan.Enabled, current.Enabled)
+	addStringToPayload(payload, "MetricReportDefinitionType", plan.MetricReportDefinitionType, current.MetricReportDefinitionType)
+	addStringToPayload(payload, "ReportUpdates", plan.ReportUpdates, current.ReportUpdates)
+
+	schedule := map[string]interface{}{}
+	addStringToPayload(schedule, "RecurrenceInterval", plan.RecurrenceInterval, current.RecurrenceInterval)
+	addObjectToPayload(payload, "Schedule", schedule)
+
+	odataId, _ := definition["@odata.id"].(string)
+	if len(payload) > 0 {
+		tflog.Info(ctx, "Changing metric report definition", map[string]interface{}{
+			"endpoint": odataId,
+			"payload":  payload,
+		})
+	}
+
+	data, err := applySettings(api, odataId, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply metric report definition settings", err)...)
+		return diags
+	}
+
+	metricReportDefinitionToModel(data, plan)
+	return diags
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const metric_report_definition_name = "irmc-redfish_metric_report_definition.mrd"
+
+func TestAccRedfishMetricReportDefinition_basic(t *testing.T) {
+	definition := os.Getenv("TF_TESTING_METRIC_REPORT_DEFINITION")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceMetricReportDefinitionConfig(creds, definition, true, "PT60S"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(metric_report_definition_name, "enabled", "true"),
+					resource.TestCheckResourceAttr(metric_report_definition_name, "recurrence_interval", "PT60S"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceMetricReportDefinitionConfig(creds, definition, false, "PT120S"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(metric_report_definition_name, "enabled", "false"),
+					resource.TestCheckResourceAttr(metric_report_definition_name, "recurrence_interval", "PT120S"),
+				),
+			},
+		},
+	})
+}
+
+func TestIso8601DurationRegex(t *testing.T) {
+	for _, valid := range []string{"PT60S", "PT1M", "PT1H30M", "P1D", "P1DT12H", "PT0.5S"} {
+		if !iso8601DurationRegex.MatchString(valid) {
+			t.Errorf("expected '%s' to be valid duration", valid)
+		}
+	}
+
+	for _, invalid := range []string{"60", "P", "PT", "T60S", "PT60"} {
+		if iso8601DurationRegex.MatchString(invalid) {
+			t.Errorf("expected '%s' to be invalid duration", invalid)
+		}
+	}
+}
+
+func testAccRedfishResourceMetricReportDefinitionConfig(testingInfo TestingServerCredentials, definition string,
+	enabled bool, interval string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_metric_report_definition" "mrd" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		metric_report_definition_id = "%s"
+		enabled                     = %t
+		recurrence_interval         = "%s"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		definition,
+		enabled,
+		interval,
+	)
+}
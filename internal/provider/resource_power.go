@@ -143,12 +143,13 @@ func (r *PowerResource) Create(ctx context.Context, req resource.CreateRequest,
 	var powerPlan models.PowerResourceModel
 	diags := req.Plan.Get(ctx, &powerPlan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, powerPlan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = powerPlan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, powerPlan.RedfishServer)
 	var resource_name = "resource-power"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -156,21 +157,21 @@ func (r *PowerResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Initialize the Redfish server connection
 	config, err := ConnectTargetSystem(r.p, &powerPlan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connect Target System Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
 		return
 	}
 	system, err := GetSystemResource(config.Service)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Get System Resource Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Get System Resource Error", err)...)
 		return
 	}
 	powerPlan.Id = types.StringValue(system.ID)
 
-	defer config.Logout()
+	defer ReleaseTargetSystem(config)
 
 	isFsas, err := IsFsasCheck(ctx, config)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 
@@ -182,10 +183,10 @@ func (r *PowerResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	switch powerAction {
 	case "On", "ForceOn":
-		powerErr = changePowerState(config.Service, true, powerPlan.MaxWaitTime.ValueInt64())
+		powerErr = changePowerState(ctx, config.Service, true, powerPlan.MaxWaitTime.ValueInt64())
 
 	case "ForceOff":
-		powerErr = changePowerState(config.Service, false, powerPlan.MaxWaitTime.ValueInt64())
+		powerErr = changePowerState(ctx, config.Service, false, powerPlan.MaxWaitTime.ValueInt64())
 
 	case "PowerCycle":
 		var payload map[string]string
@@ -201,7 +202,7 @@ func (r *PowerResource) Create(ctx context.Context, req resource.CreateRequest,
 
 		respPost, err := config.Post(powerEndpoint.hostPowerActionEndpoint, payload)
 		if err != nil {
-			resp.Diagnostics.AddError("PowerCycle POST request failed", err.Error())
+			resp.Diagnostics.Append(redfishErrorDiagnostics("PowerCycle POST request failed", err)...)
 			return
 		}
 
@@ -212,22 +213,25 @@ func (r *PowerResource) Create(ctx context.Context, req resource.CreateRequest,
 			return
 		}
 
-		powerErr = waitUntilHostStateChanged(config.Service, false, powerPlan.MaxWaitTime.ValueInt64())
+		powerErr = waitUntilHostStateChanged(ctx, config.Service, false, powerPlan.MaxWaitTime.ValueInt64())
 		if powerErr != nil {
 			resp.Diagnostics.AddError("Host state has not been changed within given timeout", powerErr.Error())
 			return
 		}
-		time.Sleep(30 * time.Second)
+		powerErr = sleepWithContext(ctx, 30*time.Second)
 	default:
-		powerErr = resetHost(config.Service, redfish.ResetType(powerAction),
+		powerErr = resetHost(ctx, config.Service, redfish.ResetType(powerAction),
 			powerPlan.MaxWaitTime.ValueInt64())
 	}
 
+	if powerErr == nil {
+		powerErr = sleepWithContext(ctx, 10*time.Second)
+	}
+
 	if powerErr != nil {
-		resp.Diagnostics.AddError("Power Operation Error", powerErr.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Power Operation Error", powerErr)...)
 		return
 	}
-	time.Sleep(10 * time.Second)
 	powerStateStatus, errpowerstate := isPoweredOn(config.Service)
 	if errpowerstate != nil {
 		resp.Diagnostics.AddError("Service Connect Target System Error", errpowerstate.Error())
@@ -259,15 +263,15 @@ func (r *PowerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Initialize the Redfish server connection
 	config, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connect Target System Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
 		return
 	}
 
-	defer config.Logout()
+	defer ReleaseTargetSystem(config)
 
 	system, err := GetSystemResource(config.Service)
 	if err != nil {
-		resp.Diagnostics.AddError("system error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("system error", err)...)
 		return
 	}
 	if state.PowerState != types.StringValue(string(system.PowerState)) {
@@ -18,10 +18,14 @@ limitations under the License.
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"terraform-provider-irmc-redfish/internal/models"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stmcginnis/gofish"
 )
@@ -54,7 +58,7 @@ func TestAccRedfishIrmcPower(t *testing.T) {
 			}
 
 			if isPoweredOn {
-				if err = changePowerState(api.Service, false, 120); err != nil {
+				if err = changePowerState(context.Background(), api.Service, false, 120); err != nil {
 					t.Fatalf("Failed to change power state within given timeout: %s", err.Error())
 				}
 			}
@@ -145,3 +149,27 @@ func testAccRedfishResourcePowerConfig(testingInfo TestingServerCredentials,
 		HostPowerAction,
 	)
 }
+
+func TestWaitUntilHostStateChangedCancelled(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	// mock host stays powered on, so only cancellation ends waiting
+	start := time.Now()
+	err = waitUntilHostStateChanged(ctx, api.Service, false, 600)
+	if !errors.Is(err, errPollCancelled) {
+		t.Fatalf("Expected cancellation error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Waiting should return promptly after cancellation, took %s", elapsed)
+	}
+}
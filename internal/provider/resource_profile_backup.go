@@ -0,0 +1,272 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProfileBackupResource{}
+
+func NewProfileBackupResource() resource.Resource {
+	return &ProfileBackupResource{}
+}
+
+// ProfileBackupResource defines the resource implementation.
+type ProfileBackupResource struct {
+	p *IrmcProvider
+}
+
+func (r *ProfileBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + profileBackupName
+}
+
+func ProfileBackupSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of profile backup resource, path to the backup file.",
+			Description:         "ID of profile backup resource, path to the backup file.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"file_path": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Path to local JSON file to which configuration profile will be written.",
+			Description:         "Path to local JSON file to which configuration profile will be written.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"sections": schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue(PROFILE_SECTION_BIOS), types.StringValue(PROFILE_SECTION_IRMC)})),
+			MarkdownDescription: "Sections of configuration to be exported. Applicable values are: 'BiosConfig', 'IrmcConfig', 'RAIDAdapter' (default BiosConfig and IrmcConfig).",
+			Description:         "Sections of configuration to be exported. Applicable values are: 'BiosConfig', 'IrmcConfig', 'RAIDAdapter' (default BiosConfig and IrmcConfig).",
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(profileSectionNames()...)),
+			},
+		},
+		"job_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(PROFILE_JOB_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for export of every section to finish (default 600s).",
+			Description:         "Timeout in seconds for export of every section to finish (default 600s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(60),
+			},
+		},
+		"checksum": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "SHA256 checksum of written backup file.",
+			Description:         "SHA256 checksum of written backup file.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Arbitrary map of values, change of which causes backup to be taken again.",
+			Description:         "Arbitrary map of values, change of which causes backup to be taken again.",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *ProfileBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to export iRMC and BIOS configuration profile of a server to local JSON file.",
+		Description:         "This resource is used to export iRMC and BIOS configuration profile of a server to local JSON file.",
+		Attributes:          ProfileBackupSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *ProfileBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *ProfileBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-profile-backup: create starts")
+
+	var plan models.ProfileBackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sections []string
+	resp.Diagnostics.Append(plan.Sections.ElementsAs(ctx, &sections, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-profile-backup"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return
+	}
+
+	backup := map[string]interface{}{}
+	for _, section := range sections {
+		profile, err := exportProfileSection(ctx, api, section, plan.JobTimeout.ValueInt64(), isFsas)
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics(fmt.Sprintf("Export of profile section %s failed", section), err)...)
+			return
+		}
+
+		if err = mergeProfileSection(backup, profile, section); err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics(fmt.Sprintf("Exported profile section %s is not valid", section), err)...)
+			return
+		}
+	}
+
+	content, err := json.MarshalIndent(backup, "", "  ")
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not encode configuration profile", err)...)
+		return
+	}
+
+	// Profile might contain sensitive settings, so file is readable only by its owner
+	if err = os.WriteFile(plan.FilePath.ValueString(), content, 0o600); err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not write configuration profile file", err)...)
+		return
+	}
+
+	plan.Id = plan.FilePath
+	plan.Checksum = types.StringValue(profileChecksum(content))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-profile-backup: create ends")
+}
+
+func (r *ProfileBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-profile-backup: read starts")
+
+	var state models.ProfileBackupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Backup is taken again if file has been removed or modified outside of Terraform
+	content, err := os.ReadFile(state.FilePath.ValueString())
+	if errors.Is(err, os.ErrNotExist) || (err == nil && profileChecksum(content) != state.Checksum.ValueString()) {
+		tflog.Info(ctx, fmt.Sprintf("Backup file %s is missing or has been modified", state.FilePath.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not read configuration profile file", err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-profile-backup: read ends")
+}
+
+// Update modifies the resource state. Changes which require new backup cause replacement of the resource,
+// so only attributes which do not influence backup are updated in place.
+func (r *ProfileBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.ProfileBackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.Checksum = state.Checksum
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProfileBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-profile-backup: delete starts")
+	// Backup file is kept, since it might be still needed to restore the configuration
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-profile-backup: delete ends")
+}
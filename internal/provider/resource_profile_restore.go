@@ -0,0 +1,290 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProfileRestoreResource{}
+
+func NewProfileRestoreResource() resource.Resource {
+	return &ProfileRestoreResource{}
+}
+
+// ProfileRestoreResource defines the resource implementation.
+type ProfileRestoreResource struct {
+	p *IrmcProvider
+}
+
+func (r *ProfileRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + profileRestoreName
+}
+
+func ProfileRestoreSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of profile restore resource, path to the restored file.",
+			Description:         "ID of profile restore resource, path to the restored file.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"file_path": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Path to local JSON file with configuration profile, e.g. created by irmc_profile_backup resource.",
+			Description:         "Path to local JSON file with configuration profile, e.g. created by irmc_profile_backup resource.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"sections": schema.ListAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Sections of configuration profile to be applied. If not defined, all supported sections found in the file are applied. Applicable values are: 'BiosConfig', 'IrmcConfig', 'RAIDAdapter'.",
+			Description:         "Sections of configuration profile to be applied. If not defined, all supported sections found in the file are applied. Applicable values are: 'BiosConfig', 'IrmcConfig', 'RAIDAdapter'.",
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(profileSectionNames()...)),
+			},
+		},
+		"job_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(PROFILE_JOB_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for profile to be applied (default 600s).",
+			Description:         "Timeout in seconds for profile to be applied (default 600s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(60),
+			},
+		},
+		"ready_timeout": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Timeout in seconds for iRMC to become ready again, if applying of IrmcConfig section causes its restart (default 600s).",
+			Description:         "Timeout in seconds for iRMC to become ready again, if applying of IrmcConfig section causes its restart (default 600s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(60),
+			},
+		},
+		"checksum": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "SHA256 checksum of the file which has been applied.",
+			Description:         "SHA256 checksum of the file which has been applied.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Arbitrary map of values, change of which causes profile to be applied again, e.g. checksum of the file.",
+			Description:         "Arbitrary map of values, change of which causes profile to be applied again, e.g. checksum of the file.",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *ProfileRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to apply iRMC and BIOS configuration profile stored in local JSON file to a server.",
+		Description:         "This resource is used to apply iRMC and BIOS configuration profile stored in local JSON file to a server.",
+		Attributes:          ProfileRestoreSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *ProfileRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *ProfileRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-profile-restore: create starts")
+
+	var plan models.ProfileRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sections []string
+	if !plan.Sections.IsNull() {
+		resp.Diagnostics.Append(plan.Sections.ElementsAs(ctx, &sections, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	content, err := os.ReadFile(plan.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not read configuration profile file", err)...)
+		return
+	}
+
+	profile, sections, err := prepareProfileToRestore(content, sections)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Configuration profile file is not valid", err)...)
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-profile-restore"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Applying profile sections %v", sections))
+	if err = importProfile(ctx, api, profile, plan.JobTimeout.ValueInt64(), isFsas); err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Applying of configuration profile failed", err)...)
+		return
+	}
+
+	// Applying of iRMC configuration might cause its restart
+	if slices.Contains(sections, PROFILE_SECTION_IRMC) {
+		err = ensureManagerReady(ctx, r.p, &plan.RedfishServer, api, getManagerReadyTimeout(plan.ReadyTimeout))
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("iRMC has not become ready after applying configuration profile", err)...)
+			return
+		}
+	}
+
+	plan.Id = plan.FilePath
+	plan.Checksum = types.StringValue(profileChecksum(content))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-profile-restore: create ends")
+}
+
+func (r *ProfileRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-profile-restore: read starts")
+	// Restore is one time operation, so there is nothing to be read from iRMC
+	var state models.ProfileRestoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-profile-restore: read ends")
+}
+
+// Update modifies the resource state. Changes which require profile to be applied again cause replacement
+// of the resource, so only timeouts are updated in place.
+func (r *ProfileRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.ProfileRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.Checksum = state.Checksum
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProfileRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-profile-restore: delete starts")
+	// Applied configuration can not be reverted, so resource is only removed from state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-profile-restore: delete ends")
+}
+
+// prepareProfileToRestore decodes profile file content and leaves only requested sections in it.
+// If no section is requested, all supported sections found in the profile are used.
+// Returns profile to be applied and list of its sections.
+func prepareProfileToRestore(content []byte, sections []string) (map[string]interface{}, []string, error) {
+	var profile map[string]interface{}
+	if err := json.Unmarshal(content, &profile); err != nil {
+		return nil, nil, fmt.Errorf("could not decode profile: %w", err)
+	}
+
+	if len(sections) == 0 {
+		sections = profileSectionsPresent(profile)
+		if len(sections) == 0 {
+			return nil, nil, fmt.Errorf("profile does not contain any of supported sections %v", profileSectionNames())
+		}
+	}
+
+	filtered, err := filterProfileSections(profile, sections)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return filtered, sections, nil
+}
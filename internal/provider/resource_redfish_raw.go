@@ -0,0 +1,325 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const REDFISH_RAW_TASK_TIMEOUT = 600
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RedfishRawResource{}
+
+func NewRedfishRawResource() resource.Resource {
+	return &RedfishRawResource{}
+}
+
+// RedfishRawResource defines the resource implementation.
+type RedfishRawResource struct {
+	p *IrmcProvider
+}
+
+func (r *RedfishRawResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + redfishRawName
+}
+
+func RedfishRawSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of redfish raw resource.",
+			Description:         "ID of redfish raw resource.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"path": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Redfish path to which payload is sent, e.g. '/redfish/v1/Managers/iRMC' or path of an action.",
+			Description:         "Redfish path to which payload is sent, e.g. '/redfish/v1/Managers/iRMC' or path of an action.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(redfishPathRegex, "must be Redfish path starting with /redfish/v1"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"method": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(http.MethodPatch),
+			MarkdownDescription: "HTTP method used to send payload. Applicable values are: 'PATCH' (default), 'POST'.",
+			Description:         "HTTP method used to send payload. Applicable values are: 'PATCH' (default), 'POST'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(http.MethodPatch, http.MethodPost),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"payload": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "JSON object sent as request body, e.g. created using jsonencode function. Change of payload causes the request to be sent again. Wrap it in sensitive function, if it contains secrets (e.g. passwords).",
+			Description:         "JSON object sent as request body, e.g. created using jsonencode function. Change of payload causes the request to be sent again. Wrap it in sensitive function, if it contains secrets (e.g. passwords).",
+		},
+		"wait_for_task": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			MarkdownDescription: "If the request is accepted as Redfish task, wait until the task finishes (default true).",
+			Description:         "If the request is accepted as Redfish task, wait until the task finishes (default true).",
+		},
+		"task_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(REDFISH_RAW_TASK_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for Redfish task to finish (default 600s).",
+			Description:         "Timeout in seconds for Redfish task to finish (default 600s).",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"status_code": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "HTTP status code of the last sent request.",
+			Description:         "HTTP status code of the last sent request.",
+		},
+		"response": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Body of response to the last sent request.",
+			Description:         "Body of response to the last sent request.",
+		},
+		"triggers": schema.MapAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Arbitrary map of values, change of which causes the request to be sent again.",
+			Description:         "Arbitrary map of values, change of which causes the request to be sent again.",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *RedfishRawResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource sends user defined JSON payload to arbitrary Redfish path using PATCH or POST, what allows to use features not covered by the provider yet.",
+		Description:         "This resource sends user defined JSON payload to arbitrary Redfish path using PATCH or POST, what allows to use features not covered by the provider yet.",
+		Attributes:          RedfishRawSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *RedfishRawResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *RedfishRawResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-redfish_raw: create starts")
+
+	var plan models.RedfishRawResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.send(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(plan.Path.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Info(ctx, "resource-redfish_raw: create ends")
+}
+
+func (r *RedfishRawResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-redfish_raw: read starts")
+
+	var state models.RedfishRawResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-redfish_raw: read ends")
+}
+
+// Update sends the request again if payload has been changed, change of remaining
+// attributes (e.g. task_timeout) is only stored in state.
+func (r *RedfishRawResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-redfish_raw: update starts")
+
+	var plan, state models.RedfishRawResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Payload.Equal(state.Payload) {
+		plan.StatusCode = state.StatusCode
+		plan.Response = state.Response
+	} else {
+		resp.Diagnostics.Append(r.send(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Info(ctx, "resource-redfish_raw: update ends")
+}
+
+// Delete only removes the resource from state, changes done on iRMC are kept.
+func (r *RedfishRawResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-redfish_raw: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-redfish_raw: delete ends")
+}
+
+// send sends payload defined in plan to iRMC, waits for task if requested and stores result into plan.
+func (r *RedfishRawResource) send(ctx context.Context, plan *models.RedfishRawResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(plan.Payload.ValueString()), &payload); err != nil {
+		diags.AddError("Payload is not valid JSON object", err.Error())
+		return diags
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-redfish_raw"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	method := plan.Method.ValueString()
+	path := plan.Path.ValueString()
+	// Payload might contain secrets (e.g. passwords), so only its keys are logged
+	tflog.Info(ctx, fmt.Sprintf("Sending %s on %s", method, path))
+	tflog.Debug(ctx, "Payload of raw request", map[string]interface{}{"keys": slices.Sorted(maps.Keys(payload))})
+
+	res, err := sendRedfishRawRequest(api, method, path, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics(fmt.Sprintf("%s on %s failed", method, path), err)...)
+		return diags
+	}
+
+	defer CloseResource(res.Body)
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Could not read %s response body", method), err.Error())
+		return diags
+	}
+
+	plan.StatusCode = types.Int64Value(int64(res.StatusCode))
+	plan.Response = types.StringValue(string(body))
+
+	switch res.StatusCode {
+	case http.StatusAccepted:
+		location := res.Header.Get(HTTP_HEADER_LOCATION)
+		if !plan.WaitForTask.ValueBool() || len(location) == 0 {
+			tflog.Info(ctx, fmt.Sprintf("Request has been accepted, task '%s' is not awaited", location))
+			return diags
+		}
+
+		isFsas, err := IsFsasCheck(ctx, api)
+		if err != nil {
+			diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+			return diags
+		}
+
+		if _, err := WaitForRedfishTaskEnd(ctx, api.Service, location, plan.TaskTimeout.ValueInt64()); err != nil {
+			diags.Append(taskFailureDiagnostics(api.Service, location, isFsas, fmt.Sprintf("Task created by %s on %s reported error", method, path), err)...)
+			return diags
+		}
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+	default:
+		diags.AddError(fmt.Sprintf("%s on %s failed", method, path),
+			fmt.Sprintf("Status code %d, response: %s", res.StatusCode, string(body)))
+	}
+
+	return diags
+}
+
+// sendRedfishRawRequest sends payload to path using method. PATCH requests are sent with ETag of the patched
+// resource. Body of returned response must be closed by the caller.
+func sendRedfishRawRequest(api *gofish.APIClient, method string, path string, payload map[string]interface{}) (*http.Response, error) {
+	if method == http.MethodPost {
+		return api.Post(path, payload)
+	}
+
+	return PatchWithEtag(api, path, payload)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish"
+)
+
+const redfish_raw_name = "irmc-redfish_redfish_raw.raw"
+
+func TestAccRedfishRaw_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceRedfishRawConfig(creds, "terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(redfish_raw_name, "id", "/redfish/v1/Systems/0"),
+					resource.TestCheckResourceAttr(redfish_raw_name, "method", "PATCH"),
+					resource.TestCheckResourceAttrSet(redfish_raw_name, "status_code"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceRedfishRawConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(redfish_raw_name, "payload", "{\"AssetTag\":\"\"}"),
+				),
+			},
+		},
+	})
+}
+
+func TestSendRedfishRawRequest(t *testing.T) {
+	var ifMatch, method string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redfish/v1/":
+			fmt.Fprint(w, `{"@odata.id": "/redfish/v1/"}`)
+		case "/redfish/v1/Systems/0":
+			if r.Method == http.MethodGet {
+				w.Header().Set(HTTP_HEADER_ETAG, `W/"1234"`)
+				fmt.Fprint(w, `{"@odata.id": "/redfish/v1/Systems/0"}`)
+				return
+			}
+			ifMatch = r.Header.Get(HTTP_HEADER_IF_MATCH)
+			method = r.Method
+			w.WriteHeader(http.StatusNoContent)
+		case "/redfish/v1/Systems/0/Actions/ComputerSystem.Reset":
+			ifMatch = r.Header.Get(HTTP_HEADER_IF_MATCH)
+			method = r.Method
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	api, err := gofish.Connect(gofish.ClientConfig{Endpoint: server.URL, BasicAuth: true})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	res, err := sendRedfishRawRequest(api, http.MethodPatch, "/redfish/v1/Systems/0", map[string]interface{}{"AssetTag": "terraform"})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	CloseResource(res.Body)
+
+	if method != http.MethodPatch || ifMatch != `W/"1234"` {
+		t.Errorf("Expected PATCH with ETag, got %s with If-Match '%s'", method, ifMatch)
+	}
+
+	res, err = sendRedfishRawRequest(api, http.MethodPost, "/redfish/v1/Systems/0/Actions/ComputerSystem.Reset", map[string]interface{}{"ResetType": "On"})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	CloseResource(res.Body)
+
+	if method != http.MethodPost || len(ifMatch) > 0 {
+		t.Errorf("Expected POST without ETag, got %s with If-Match '%s'", method, ifMatch)
+	}
+}
+
+func testAccRedfishResourceRedfishRawConfig(testingInfo TestingServerCredentials, assetTag string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_redfish_raw" "raw" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		path    = "/redfish/v1/Systems/0"
+		payload = jsonencode({ AssetTag = "%s" })
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		assetTag,
+	)
+}
@@ -21,11 +21,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
+	"os"
+	"slices"
+	"strings"
 	"terraform-provider-irmc-redfish/internal/models"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -62,6 +69,10 @@ const (
 	OPERATION_TIME_ON_RESET  = "OnReset"
 )
 
+// UPDATE_SERVICE_LOCK_NAME is used by all resources starting update through UpdateService,
+// since it handles single update at a time.
+const UPDATE_SERVICE_LOCK_NAME = "update-service"
+
 func (r *SimpleUpdateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + simpleUpdate
 }
@@ -77,27 +88,55 @@ func (r *SimpleUpdateResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed:            true,
 			},
 			"transfer_protocol": schema.StringAttribute{
-				MarkdownDescription: "Protocol for the update. Supported values: http, https, ftp.",
-				Description:         "Protocol for the update. Supported values: http, https, ftp.",
-				Required:            true,
+				MarkdownDescription: "Protocol for the update. Supported values: http, https, ftp. Required if `update_image` is defined.",
+				Description:         "Protocol for the update. Supported values: http, https, ftp. Required if `update_image` is defined.",
+				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf(
 						PROTOCOL_HTTP,
 						PROTOCOL_HTTPS,
 						PROTOCOL_FTP),
+					stringvalidator.AlsoRequires(path.MatchRoot("update_image")),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"update_image": schema.StringAttribute{
-				MarkdownDescription: "URI of the firmware image for update. Example: \"10.172.200.100/binaries/binary.zip\"",
-				Description:         "URI of the firmware image for update. Example: \"10.172.200.100/binaries/binary.zip\"",
-				Required:            true,
+				MarkdownDescription: "URI of the firmware image for update, downloaded by iRMC. Example: \"10.172.200.100/binaries/binary.zip\"",
+				Description:         "URI of the firmware image for update, downloaded by iRMC. Example: \"10.172.200.100/binaries/binary.zip\"",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("transfer_protocol")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"update_file": schema.StringAttribute{
+				MarkdownDescription: "Path to local firmware image, which is uploaded to iRMC using multipart HTTP push update, so no FTP or HTTP server is needed. Exactly one of `update_image` and `update_file` must be defined.",
+				Description:         "Path to local firmware image, which is uploaded to iRMC using multipart HTTP push update, so no FTP or HTTP server is needed. Exactly one of `update_image` and `update_file` must be defined.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("update_image")),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"upload_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum duration in seconds of `update_file` upload to iRMC. Value 0 means no limit.",
+				Description:         "Maximum duration in seconds of `update_file` upload to iRMC. Value 0 means no limit.",
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(FIRMWARE_UPLOAD_TIMEOUT),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
 			"operation_apply_time": schema.StringAttribute{
 				MarkdownDescription: "Time to apply the update. Supported values: Immediate, OnReset..",
 				Description:         "Time to apply the update. Supported values: Immediate, OnReset.",
@@ -114,9 +153,10 @@ func (r *SimpleUpdateResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"apply_schedule": ApplyScheduleSchema(stringvalidator.ConflictsWith(path.MatchRoot("operation_apply_time"))),
 			"update_timeout": schema.Int64Attribute{
-				MarkdownDescription: "Maximum duration in seconds to wait for the Simple Update operation to finish before aborting.",
-				Description:         "Maximum duration in seconds to wait for the Simple Update operation to finish before aborting.",
+				MarkdownDescription: "Maximum duration in seconds to wait for the Simple Update operation to finish before aborting. It also defines duration of maintenance window if `apply_schedule` is a timestamp.",
+				Description:         "Maximum duration in seconds to wait for the Simple Update operation to finish before aborting. It also defines duration of maintenance window if apply_schedule is a timestamp.",
 				Computed:            true,
 				Optional:            true,
 				Default:             int64default.StaticInt64(SIMPLE_UPDATE_TIMEOUT),
@@ -134,6 +174,24 @@ func (r *SimpleUpdateResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"updated_components": schema.ListAttribute{
+				MarkdownDescription: "Components successfully updated by the Simple Update task. Null if the update has been scheduled on reset.",
+				Description:         "Components successfully updated by the Simple Update task. Null if the update has been scheduled on reset.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"skipped_components": schema.ListAttribute{
+				MarkdownDescription: "Components skipped by the Simple Update task, e.g. because the same version is already installed. Null if the update has been scheduled on reset.",
+				Description:         "Components skipped by the Simple Update task, e.g. because the same version is already installed. Null if the update has been scheduled on reset.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"failed_components": schema.ListAttribute{
+				MarkdownDescription: "Components which failed to be updated by the Simple Update task. Null if the update has been scheduled on reset.",
+				Description:         "Components which failed to be updated by the Simple Update task. Null if the update has been scheduled on reset.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 		Blocks: RedfishServerResourceBlockMap(),
 	}
@@ -165,25 +223,34 @@ func (r *SimpleUpdateResource) Create(ctx context.Context, req resource.CreateRe
 	var plan models.SimpleUpdateResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
-	const resource_name = "resource-simple-update"
-	mutexPool.Lock(ctx, endpoint, resource_name)
-	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+	applyTime, err := getApplySchedulePayload(plan.ApplySchedule.ValueString(), plan.UpdateTimeout.ValueInt64(), time.Now())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("apply_schedule"), "Invalid apply schedule", err.Error())
+		return
+	}
+	if len(applyTime) == 0 {
+		applyTime[REDFISH_OPERATION_APPLY_TIME] = plan.OperationTime.ValueString()
+	}
+
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	mutexPool.Lock(ctx, endpoint, UPDATE_SERVICE_LOCK_NAME)
+	defer mutexPool.Unlock(ctx, endpoint, UPDATE_SERVICE_LOCK_NAME)
 
 	config, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connect Target System Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
 		return
 	}
-	defer config.Logout()
+	defer ReleaseTargetSystem(config)
 
 	isFsas, err := IsFsasCheck(ctx, config)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 
@@ -191,28 +258,42 @@ func (r *SimpleUpdateResource) Create(ctx context.Context, req resource.CreateRe
 
 	poweredOn, err := isPoweredOn(config.Service)
 	if err != nil {
-		resp.Diagnostics.AddError("Power state check failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Power state check failed", err)...)
 		return
 	}
 	err = UpdateUmeToolsDirName(config, plan.UmeToolDirName.ValueString(), isFsas)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to update SimpleUpdateOfflineToolsDirName", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to update SimpleUpdateOfflineToolsDirName", err)...)
 		return
 	}
-	taskLocation, diags := ConfigSimpleUpd(
-		ctx,
-		config,
-		plan.UpdateImage.ValueString(),
-		plan.Protocol.ValueString(),
-		plan.OperationTime.ValueString(),
-	)
+
+	var taskLocation string
+	if len(plan.UpdateFile.ValueString()) > 0 {
+		var pushUri string
+		pushUri, taskLocation, diags = pushSimpleUpdateFile(ctx, config, plan.UpdateFile.ValueString(),
+			applyTime, plan.UploadTimeout.ValueInt64())
+		plan.Id = types.StringValue(pushUri)
+	} else {
+		taskLocation, diags = ConfigSimpleUpd(
+			ctx,
+			config,
+			plan.UpdateImage.ValueString(),
+			plan.Protocol.ValueString(),
+			applyTime,
+		)
+	}
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if plan.OperationTime.ValueString() == "OnReset" && poweredOn {
-		tflog.Info(ctx, "resource-simple-update: update will apply on next reset, ending create without waiting")
+	plan.Updated = types.ListNull(types.StringType)
+	plan.Skipped = types.ListNull(types.StringType)
+	plan.Failed = types.ListNull(types.StringType)
+
+	scheduled := applyTime[REDFISH_OPERATION_APPLY_TIME]
+	if scheduled == OPERATION_TIME_AT_MAINTENANCE_WINDOW || (scheduled == OPERATION_TIME_ON_RESET && poweredOn) {
+		tflog.Info(ctx, fmt.Sprintf("resource-simple-update: update will apply %s, ending create without waiting", scheduled))
 		diags = resp.State.Set(ctx, &plan)
 		resp.Diagnostics.Append(diags...)
 		return
@@ -220,7 +301,22 @@ func (r *SimpleUpdateResource) Create(ctx context.Context, req resource.CreateRe
 
 	err = CheckSimpleUpdateStatus(ctx, config.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), isFsas)
 	if err != nil {
-		resp.Diagnostics.AddError("Simple Update task did not complete successfully", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Simple Update task did not complete successfully", err)...)
+		return
+	}
+
+	report, diags := GetRedfishTaskReport(config.Service, taskLocation, isFsas)
+	resp.Diagnostics.Append(diags...)
+
+	results := getSimpleUpdateComponentResults(report.Messages)
+	tflog.Info(ctx, fmt.Sprintf("resource-simple-update: updated %v, skipped %v, failed %v", results.updated, results.skipped, results.failed))
+	plan.Updated, diags = types.ListValueFrom(ctx, types.StringType, results.updated)
+	resp.Diagnostics.Append(diags...)
+	plan.Skipped, diags = types.ListValueFrom(ctx, types.StringType, results.skipped)
+	resp.Diagnostics.Append(diags...)
+	plan.Failed, diags = types.ListValueFrom(ctx, types.StringType, results.failed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -264,26 +360,77 @@ func (r *SimpleUpdateResource) Delete(ctx context.Context, req resource.DeleteRe
 func CheckSimpleUpdateStatus(ctx context.Context, service *gofish.Service, location string, timeout int64, isFsas bool) error {
 	finishedSuccessfully, err := WaitForRedfishTaskEnd(ctx, service, location, timeout)
 	if err != nil || !finishedSuccessfully {
-		taskLog, diags := FetchRedfishTaskLog(service, location, isFsas)
-		if diags.HasError() {
-			return fmt.Errorf("simple Update task did not complete successfully: %s", err)
-		}
-		return fmt.Errorf("simple Update task failed. Details: %s. Task log: %s", err, string(taskLog))
+		report, _ := GetRedfishTaskReport(service, location, isFsas)
+		return fmt.Errorf("simple Update task failed. Details: %s.\n%s", err, report.String())
 	}
 	return nil
 }
 
-func ConfigSimpleUpd(ctx context.Context, config *gofish.APIClient, updateImage string, protocol string, applyTime string) (string, diag.Diagnostics) {
+// simpleUpdateComponentResults groups components by result of Simple Update.
+type simpleUpdateComponentResults struct {
+	updated []string
+	skipped []string
+	failed  []string
+}
+
+// getSimpleUpdateComponentResults classifies components mentioned in messages from Update message registry
+// reported for Simple Update task. Other messages are ignored.
+func getSimpleUpdateComponentResults(messages []TaskLogMessage) simpleUpdateComponentResults {
+	results := simpleUpdateComponentResults{
+		updated: []string{},
+		skipped: []string{},
+		failed:  []string{},
+	}
+
+	for _, msg := range messages {
+		idParts := strings.Split(msg.MessageId, ".")
+		if len(idParts) < 2 || idParts[0] != "Update" {
+			continue
+		}
+
+		// Index of message argument naming the component, failure messages describe
+		// image in first argument and target in second one
+		var list *[]string
+		argIdx := 0
+		switch idParts[len(idParts)-1] {
+		case "UpdateSuccessful":
+			list = &results.updated
+		case "UpdateSkipped", "UpdateSkippedSameVersion", "UpdateNotApplicable":
+			list = &results.skipped
+		case "TransferFailed", "VerificationFailed", "ApplyFailed", "ActivateFailed":
+			list, argIdx = &results.failed, 1
+		default:
+			continue
+		}
+
+		component := msg.Message
+		if len(msg.MessageArgs) > argIdx {
+			component = msg.MessageArgs[argIdx]
+		} else if len(msg.MessageArgs) > 0 {
+			component = msg.MessageArgs[0]
+		}
+
+		if !slices.Contains(*list, component) {
+			*list = append(*list, component)
+		}
+	}
+
+	return results
+}
+
+// ConfigSimpleUpd requests Simple Update of image downloaded by iRMC. Parameters (e.g. apply time
+// or update targets) are added into request payload. Location of created task is returned.
+func ConfigSimpleUpd(ctx context.Context, config *gofish.APIClient, updateImage string, protocol string, parameters map[string]interface{}) (string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	fullImageURI := fmt.Sprintf("%s://%s", protocol, updateImage)
 	payload := map[string]interface{}{
-		"ImageURI":                    fullImageURI,
-		"@Redfish.OperationApplyTime": applyTime,
+		"ImageURI": fullImageURI,
 	}
+	maps.Copy(payload, parameters)
 
 	resp, err := config.Post(SIMPLE_UPDATE_ENDPOINT, payload)
 	if err != nil {
-		diags.AddError("Simple Update POST request failed", err.Error())
+		diags.Append(redfishErrorDiagnostics("Simple Update POST request failed", err)...)
 		return "", diags
 	}
 
@@ -303,6 +450,64 @@ func ConfigSimpleUpd(ctx context.Context, config *gofish.APIClient, updateImage
 	return taskLocation, diags
 }
 
+// pushSimpleUpdateFile uploads local firmware image to MultipartHttpPushUri of UpdateService.
+// Options (e.g. apply time or update targets) are added into update parameters.
+// Push URI and location of created task are returned.
+func pushSimpleUpdateFile(ctx context.Context, config *gofish.APIClient, updateFile string, options map[string]interface{}, uploadTimeout int64) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	updateService, err := config.Service.UpdateService()
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read UpdateService", err)...)
+		return "", "", diags
+	}
+
+	pushUri := updateService.MultipartHTTPPushURI
+	if len(pushUri) == 0 {
+		diags.AddError("Multipart HTTP push update not supported",
+			"UpdateService does not expose MultipartHttpPushUri, use update_image instead")
+		return "", "", diags
+	}
+
+	file, err := os.Open(updateFile)
+	if err != nil {
+		diags.AddError("Could not open update file", err.Error())
+		return pushUri, "", diags
+	}
+
+	defer CloseResource(file)
+
+	updateParameters := map[string]interface{}{
+		"Targets": []string{},
+	}
+	maps.Copy(updateParameters, options)
+
+	parameters := map[string]interface{}{
+		"UpdateParameters": updateParameters,
+	}
+
+	resp, err := uploadFileMultipart(ctx, config, pushUri, parameters, "UpdateFile", file, uploadTimeout)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Multipart HTTP push update request failed", err)...)
+		return pushUri, "", diags
+	}
+
+	defer CloseResource(resp.Body)
+
+	if resp.StatusCode != http.StatusAccepted {
+		diags.AddError("Multipart HTTP push update request not accepted", fmt.Sprintf("unexpected status code: %d", resp.StatusCode))
+		return pushUri, "", diags
+	}
+
+	taskLocation := resp.Header.Get(HTTP_HEADER_LOCATION)
+	if taskLocation == "" {
+		diags.AddError("Task Location Missing", "Location header not found in response")
+		return pushUri, "", diags
+	}
+
+	return pushUri, taskLocation, diags
+}
+
 func UpdateUmeToolsDirName(apiClient *gofish.APIClient, umeFileDirectory string, isFsas bool) error {
 	res, err := apiClient.Get(UPDATE_SERVICE_ENDPOINT)
 	if err != nil {
@@ -345,8 +550,7 @@ func UpdateUmeToolsDirName(apiClient *gofish.APIClient, umeFileDirectory string,
 		},
 	}
 
-	res, err = apiClient.PatchWithHeaders(UPDATE_SERVICE_ENDPOINT, patchData,
-		map[string]string{HTTP_HEADER_IF_MATCH: res.Header.Get(HTTP_HEADER_ETAG)})
+	res, err = PatchWithEtag(apiClient, UPDATE_SERVICE_ENDPOINT, patchData)
 	if err != nil {
 		return fmt.Errorf("failed to send PATCH request: %v", err)
 	}
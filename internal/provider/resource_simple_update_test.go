@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -48,6 +49,21 @@ func TestAccSimpleUpdateResource_correct(t *testing.T) {
 	})
 }
 
+func TestAccSimpleUpdateResource_updateFile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimpleUpdateResourceFileConfig(creds, os.Getenv("TF_TESTING_SIMPLE_UPDATE_FILE")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(simpleUpdateResourceName, "update_file", os.Getenv("TF_TESTING_SIMPLE_UPDATE_FILE")),
+					resource.TestCheckResourceAttrSet(simpleUpdateResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSimpleUpdateResource_invalidTransferProtocol(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -107,3 +123,45 @@ func testAccSimpleUpdateResourceConfig(testingInfo TestingServerCredentials, tra
 		applyTime,
 	)
 }
+
+func testAccSimpleUpdateResourceFileConfig(testingInfo TestingServerCredentials, updateFile string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_simple_update" "simple_update" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		update_file = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		updateFile,
+	)
+}
+
+func TestGetSimpleUpdateComponentResults(t *testing.T) {
+	results := getSimpleUpdateComponentResults([]TaskLogMessage{
+		{MessageId: "Update.1.0.TargetDetermined", MessageArgs: []string{"BIOS", "image.bin"}},
+		{MessageId: "Update.1.0.UpdateSuccessful", MessageArgs: []string{"BIOS", "image.bin"}},
+		{MessageId: "Update.1.1.UpdateSuccessful", MessageArgs: []string{"BIOS", "image.bin"}},
+		{MessageId: "Update.1.1.UpdateSkippedSameVersion", MessageArgs: []string{"iRMC"}},
+		{MessageId: "Update.1.0.ApplyFailed", MessageArgs: []string{"image.bin", "LAN Adapter"}},
+		{MessageId: "Base.1.8.Success", MessageArgs: []string{"PSU"}},
+		{Message: "Update finished"},
+	})
+
+	check := func(name string, got []string, expected []string) {
+		if !slices.Equal(got, expected) {
+			t.Errorf("%s: got %v, expected %v", name, got, expected)
+		}
+	}
+
+	check("updated", results.updated, []string{"BIOS"})
+	check("skipped", results.skipped, []string{"iRMC"})
+	check("failed", results.failed, []string{"LAN Adapter"})
+}
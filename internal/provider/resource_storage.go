@@ -19,13 +19,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"terraform-provider-irmc-redfish/internal/models"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -40,6 +40,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &StorageResource{}
 var _ resource.ResourceWithImportState = &StorageResource{}
+var _ resource.ResourceWithIdentity = &StorageResource{}
 
 func NewStorageResource() resource.Resource {
 	return &StorageResource{}
@@ -249,9 +250,19 @@ func StorageControllerSchema() map[string]schema.Attribute {
 			MarkdownDescription: "Auto rebuild enabled.",
 			Description:         "Auto rebuild enabled.",
 		},
+		"boot_volume": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "ODataId of volume used as boot device of the controller. Volume must exist on the controller.",
+			Description:         "ODataId of volume used as boot device of the controller. Volume must exist on the controller.",
+		},
 	}
 }
 
+func (r *StorageResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = ResourceIdentitySchema("Serial number of the storage controller.")
+}
+
 func (r *StorageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The resource is used to control (read, modify or import) storage controller settings on Fujitsu server equipped with iRMC controller.",
@@ -287,22 +298,23 @@ func (r *StorageResource) Create(ctx context.Context, req resource.CreateRequest
 	var plan models.StorageResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-storage"
-	mutexPool.Lock(ctx, endpoint, resource_name)
-	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+	mutexPool.LockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+	defer mutexPool.UnlockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
 
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	diags = applyStorageControllerProperties(ctx, api, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -318,6 +330,7 @@ func (r *StorageResource) Create(ctx context.Context, req resource.CreateRequest
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, plan.RedfishServer), plan.StorageControllerSN.ValueString())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -336,11 +349,11 @@ func (r *StorageResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	odataid, diags := readStorageControllerSettingsToState(api.Service, &state.StorageSettings)
 	resp.Diagnostics.Append(diags...)
@@ -352,6 +365,7 @@ func (r *StorageResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, state.RedfishServer), state.StorageControllerSN.ValueString())...)
 
 	tflog.Info(ctx, "resource-storage: read ends")
 }
@@ -362,22 +376,23 @@ func (r *StorageResource) Update(ctx context.Context, req resource.UpdateRequest
 	var plan models.StorageResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-storage"
-	mutexPool.Lock(ctx, endpoint, resource_name)
-	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+	mutexPool.LockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
+	defer mutexPool.UnlockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), resource_name)
 
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	diags = applyStorageControllerProperties(ctx, api, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -393,6 +408,7 @@ func (r *StorageResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, plan.RedfishServer), plan.StorageControllerSN.ValueString())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -415,23 +431,30 @@ func (r *StorageResource) ImportState(ctx context.Context, req resource.ImportSt
 	tflog.Info(ctx, "resource-storage: import starts")
 
 	var config StorageImportConfig
-	err := json.Unmarshal([]byte(req.ID), &config)
-	if err != nil {
-		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
-		return
-	}
-
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
+	var server models.RedfishServer
+
+	if len(req.ID) == 0 {
+		// import block with identity
+		var diags diag.Diagnostics
+		server, config.SN, diags = readImportIdentity(ctx, req.Identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		var err error
+		server, err = parseImportID(req.ID, &config, &config.SN)
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+			return
+		}
 	}
 
 	creds := []models.RedfishServer{server}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("storage_controller_serial_number"), config.SN)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, server.Endpoint.ValueString(), config.SN)...)
 
 	tflog.Info(ctx, "resource-storage: import ends")
 }
@@ -0,0 +1,555 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const STORAGE_LAYOUT_RESOURCE_NAME = "resource-storage_layout"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StorageLayoutResource{}
+
+func NewStorageLayoutResource() resource.Resource {
+	return &StorageLayoutResource{}
+}
+
+// StorageLayoutResource defines the resource implementation.
+type StorageLayoutResource struct {
+	p *IrmcProvider
+}
+
+func (r *StorageLayoutResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + storageLayoutName
+}
+
+func StorageLayoutSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Endpoint of storage controller which volumes are managed.",
+			Description:         "Endpoint of storage controller which volumes are managed.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_serial_number": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Serial number of storage controller.",
+			Description:         "Serial number of storage controller.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"job_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(STORAGE_VOLUME_JOB_DEFAULT_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for creation or deletion of a single volume.",
+			Description:         "Timeout in seconds for creation or deletion of a single volume.",
+		},
+		"delete_extra_volumes": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			MarkdownDescription: "Defines whether volumes existing on the controller, which are not declared in volumes, are deleted. If false, they are only reported in unmanaged_volumes.",
+			Description:         "Defines whether volumes existing on the controller, which are not declared in volumes, are deleted. If false, they are only reported in unmanaged_volumes.",
+		},
+		"remove_volumes_on_destroy": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			MarkdownDescription: "Defines whether declared volumes are deleted from the controller when the resource is destroyed. If false, the resource is only removed from state.",
+			Description:         "Defines whether declared volumes are deleted from the controller when the resource is destroyed. If false, the resource is only removed from state.",
+		},
+		"volumes": schema.ListNestedAttribute{
+			Required:            true,
+			MarkdownDescription: "Complete desired set of volumes of the controller. Volumes are matched with existing ones by name, matching volumes are never modified.",
+			Description:         "Complete desired set of volumes of the controller. Volumes are matched with existing ones by name, matching volumes are never modified.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Volume name, which identifies the volume within the layout.",
+						Description:         "Volume name, which identifies the volume within the layout.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.LengthAtMost(15),
+						},
+					},
+					"raid_type": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "RAID volume type depending on controller itself.",
+						Description:         "RAID volume type depending on controller itself.",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{
+								"RAID0",
+								"RAID1",
+								"RAID1E",
+								"RAID10",
+								"RAID5",
+								"RAID50",
+								"RAID6",
+								"RAID60",
+							}...),
+						},
+					},
+					"physical_drives": schema.ListAttribute{
+						Required:            true,
+						MarkdownDescription: "List of slot locations of disks used for volume creation (the same format as in storage_volume resource).",
+						Description:         "List of slot locations of disks used for volume creation (the same format as in storage_volume resource).",
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"optimum_io_size_bytes": schema.Int64Attribute{
+						Required:            true,
+						MarkdownDescription: "Optimum IO size bytes.",
+						Description:         "Optimum IO size bytes.",
+					},
+					"capacity_bytes": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Volume capacity in bytes. If not specified, volume will have maximum size calculated from chosen disks.",
+						Description:         "Volume capacity in bytes. If not specified, volume will have maximum size calculated from chosen disks.",
+					},
+					"init_mode": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Initialize mode for new volume.",
+						Description:         "Initialize mode for new volume.",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{
+								"None",
+								"Fast",
+								"Normal",
+							}...),
+						},
+					},
+				},
+			},
+		},
+		"volume_ids": schema.MapAttribute{
+			Computed:            true,
+			MarkdownDescription: "Map of declared volume names to endpoints of the volumes.",
+			Description:         "Map of declared volume names to endpoints of the volumes.",
+			ElementType:         types.StringType,
+		},
+		"unmanaged_volumes": schema.ListAttribute{
+			Computed:            true,
+			MarkdownDescription: "Names of volumes existing on the controller, which are not declared in volumes and have been left untouched.",
+			Description:         "Names of volumes existing on the controller, which are not declared in volumes and have been left untouched.",
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+func (r *StorageLayoutResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to declare complete volume layout of storage controller of iRMC system. Missing volumes are created, extra volumes are optionally deleted, while matching volumes are never touched.",
+		Description:         "This resource is used to declare complete volume layout of storage controller of iRMC system. Missing volumes are created, extra volumes are optionally deleted, while matching volumes are never touched.",
+		Attributes:          StorageLayoutSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *StorageLayoutResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *StorageLayoutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-storage_layout: create starts")
+
+	// Read Terraform plan data into the model
+	var plan models.StorageLayoutResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-storage_layout: create ends")
+}
+
+func (r *StorageLayoutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-storage_layout: read starts")
+
+	// Read Terraform prior state data into the model
+	var state models.StorageLayoutResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Connection to service failed: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	resp.Diagnostics.Append(readStorageLayoutToState(api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-storage_layout: read ends")
+}
+
+func (r *StorageLayoutResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-storage_layout: update starts")
+
+	// Read Terraform plan data into the model
+	var plan models.StorageLayoutResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-storage_layout: update ends")
+}
+
+func (r *StorageLayoutResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-storage_layout: delete starts")
+
+	// Read Terraform prior state data into the model
+	var state models.StorageLayoutResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.RemoveVolumesOnDestroy.ValueBool() {
+		tflog.Info(ctx, "resource-storage_layout: volumes are kept on controller")
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, state.RedfishServer)
+	mutexPool.LockScope(ctx, endpoint, state.StorageControllerSN.ValueString(), STORAGE_LAYOUT_RESOURCE_NAME)
+	defer mutexPool.UnlockScope(ctx, endpoint, state.StorageControllerSN.ValueString(), STORAGE_LAYOUT_RESOURCE_NAME)
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Connection to service failed: ", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor detection failed: ", err)...)
+		return
+	}
+
+	existing, err := getStorageLayoutVolumes(api.Service, state.StorageControllerSN.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not read volumes of storage controller", err)...)
+		return
+	}
+
+	declared := make(map[string]struct{}, len(state.Volumes))
+	for _, volume := range state.Volumes {
+		declared[volume.Name.ValueString()] = struct{}{}
+	}
+
+	for _, volume := range existing {
+		if _, ok := declared[volume.Name]; !ok {
+			continue
+		}
+
+		resp.Diagnostics.Append(deleteStorageVolume(ctx, api.Service, volume.ODataID, isFsas, state.JobTimeout.ValueInt64())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "resource-storage_layout: delete ends")
+}
+
+// apply computes difference between planned layout and volumes existing on controller,
+// deletes extra volumes (if requested), creates missing ones and returns resulting state.
+func (r *StorageLayoutResource) apply(ctx context.Context, plan models.StorageLayoutResourceModel) (state models.StorageLayoutResourceModel, diags diag.Diagnostics) {
+	serial := plan.StorageControllerSN.ValueString()
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	mutexPool.LockScope(ctx, endpoint, serial, STORAGE_LAYOUT_RESOURCE_NAME)
+	defer mutexPool.UnlockScope(ctx, endpoint, serial, STORAGE_LAYOUT_RESOURCE_NAME)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Connection to service failed: ", err)...)
+		return state, diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor detection failed: ", err)...)
+		return state, diags
+	}
+
+	existing, err := getStorageLayoutVolumes(api.Service, serial)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read volumes of storage controller", err)...)
+		return state, diags
+	}
+
+	toCreate, toDelete, err := planStorageLayoutChanges(plan.Volumes, existing, plan.DeleteExtraVolumes.ValueBool())
+	if err != nil {
+		diags.AddError("Storage layout could not be applied", err.Error())
+		return state, diags
+	}
+
+	tflog.Info(ctx, "Storage layout changes", map[string]interface{}{
+		"create": len(toCreate),
+		"delete": len(toDelete),
+	})
+
+	// Extra volumes are deleted first, so their drives can be used by new volumes
+	for _, volume := range toDelete {
+		diags.Append(deleteStorageVolume(ctx, api.Service, volume.ODataID, isFsas, plan.JobTimeout.ValueInt64())...)
+		if diags.HasError() {
+			return state, diags
+		}
+	}
+
+	for _, volume := range toCreate {
+		_, _, createDiags := requestAndSuperviseVolumeCreationProcess(ctx, api, storageLayoutVolumePlan(plan, volume))
+		diags.Append(createDiags...)
+		if diags.HasError() {
+			return state, diags
+		}
+	}
+
+	state = plan
+	diags.Append(readStorageLayoutToState(api.Service, &state)...)
+	return state, diags
+}
+
+// getStorageLayoutVolumes returns volumes of storage controller identified by serial.
+func getStorageLayoutVolumes(service *gofish.Service, serial string) ([]*redfish.Volume, error) {
+	storage, err := getSystemStorageFromSerialNumber(service, serial)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.Volumes()
+}
+
+// planStorageLayoutChanges returns declared volumes which do not exist yet and existing volumes
+// which are not declared and should be deleted. Volumes are matched by name, matching volume
+// of different RAID type is reported as error since existing volumes are never modified.
+func planStorageLayoutChanges(declared []models.StorageLayoutVolume, existing []*redfish.Volume,
+	deleteExtra bool) (toCreate []models.StorageLayoutVolume, toDelete []*redfish.Volume, err error) {
+	existingByName := make(map[string]*redfish.Volume, len(existing))
+	for _, volume := range existing {
+		existingByName[volume.Name] = volume
+	}
+
+	declaredNames := make(map[string]struct{}, len(declared))
+	for _, volume := range declared {
+		name := volume.Name.ValueString()
+		if _, ok := declaredNames[name]; ok {
+			return nil, nil, fmt.Errorf("volume name '%s' is declared more than once", name)
+		}
+		declaredNames[name] = struct{}{}
+
+		current, ok := existingByName[name]
+		if !ok {
+			toCreate = append(toCreate, volume)
+			continue
+		}
+
+		if string(current.RAIDType) != volume.RaidType.ValueString() {
+			return nil, nil, fmt.Errorf("volume '%s' already exists as %s while %s is declared, existing volumes are never modified, "+
+				"please delete or rename the volume", name, current.RAIDType, volume.RaidType.ValueString())
+		}
+	}
+
+	if deleteExtra {
+		for _, volume := range existing {
+			if _, ok := declaredNames[volume.Name]; !ok {
+				toDelete = append(toDelete, volume)
+			}
+		}
+	}
+
+	return toCreate, toDelete, nil
+}
+
+// storageLayoutVolumePlan converts declared volume into plan used by volume creation process.
+func storageLayoutVolumePlan(plan models.StorageLayoutResourceModel, volume models.StorageLayoutVolume) models.StorageVolumeResourceModel {
+	capacity := models.CapacityByteValue{Int64Value: types.Int64Unknown()}
+	if !volume.CapacityBytes.IsNull() && !volume.CapacityBytes.IsUnknown() {
+		capacity = models.CapacityByteValue{Int64Value: volume.CapacityBytes}
+	}
+
+	return models.StorageVolumeResourceModel{
+		StorageControllerSN: plan.StorageControllerSN,
+		JobTimeout:          plan.JobTimeout,
+		RaidType:            volume.RaidType,
+		VolumeName:          volume.Name,
+		PhysicalDrives:      volume.PhysicalDrives,
+		OptimumIOSizeBytes:  volume.OptimumIOSizeBytes,
+		CapacityBytes:       capacity,
+		InitMode:            volume.InitMode,
+		DriveCacheMode:      types.StringUnknown(),
+	}
+}
+
+// readStorageLayoutToState reads volumes of storage controller into state. Declared volumes
+// which do not exist are removed from state, so they are planned to be created again. Extra
+// volumes are added into state if they should be deleted, otherwise they are reported
+// as unmanaged.
+func readStorageLayoutToState(service *gofish.Service, state *models.StorageLayoutResourceModel) (diags diag.Diagnostics) {
+	storage, err := getSystemStorageFromSerialNumber(service, state.StorageControllerSN.ValueString())
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not obtain storage controller with requested serial number", err)...)
+		return diags
+	}
+
+	existing, err := storage.Volumes()
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read volumes of storage controller", err)...)
+		return diags
+	}
+
+	existingByName := make(map[string]*redfish.Volume, len(existing))
+	for _, volume := range existing {
+		existingByName[volume.Name] = volume
+	}
+
+	volumes := []models.StorageLayoutVolume{}
+	ids := map[string]attr.Value{}
+	for _, volume := range state.Volumes {
+		current, ok := existingByName[volume.Name.ValueString()]
+		if !ok {
+			continue
+		}
+
+		volume.RaidType = types.StringValue(string(current.RAIDType))
+		volumes = append(volumes, volume)
+		ids[current.Name] = types.StringValue(current.ODataID)
+		delete(existingByName, current.Name)
+	}
+
+	unmanaged := []attr.Value{}
+	for _, volume := range existing {
+		if _, ok := existingByName[volume.Name]; !ok {
+			continue
+		}
+
+		if !state.DeleteExtraVolumes.ValueBool() {
+			unmanaged = append(unmanaged, types.StringValue(volume.Name))
+			continue
+		}
+
+		volumes = append(volumes, models.StorageLayoutVolume{
+			Name:               types.StringValue(volume.Name),
+			RaidType:           types.StringValue(string(volume.RAIDType)),
+			PhysicalDrives:     getStorageLayoutVolumeDrives(volume),
+			CapacityBytes:      types.Int64Value(int64(volume.CapacityBytes)),
+			OptimumIOSizeBytes: types.Int64Value(int64(volume.OptimumIOSizeBytes)),
+			InitMode:           types.StringNull(),
+		})
+		ids[volume.Name] = types.StringValue(volume.ODataID)
+	}
+
+	state.Id = types.StringValue(storage.ODataID)
+	state.Volumes = volumes
+	state.VolumeIds, diags = types.MapValue(types.StringType, ids)
+	if diags.HasError() {
+		return diags
+	}
+
+	state.UnmanagedVolumes, diags = types.ListValue(types.StringType, unmanaged)
+	return diags
+}
+
+// getStorageLayoutVolumeDrives returns drives of existing volume in format of physical_drives.
+func getStorageLayoutVolumeDrives(volume *redfish.Volume) types.List {
+	slots := []string{}
+	if drives, err := volume.Drives(); err == nil {
+		for _, drive := range drives {
+			if slot, err := getDriveSlot(drive); err == nil {
+				slots = append(slots, slot)
+			}
+		}
+	}
+
+	group, _ := json.Marshal(slots)
+	return types.ListValueMust(types.StringType, []attr.Value{types.StringValue(string(group))})
+}
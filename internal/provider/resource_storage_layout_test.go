@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const storage_layout_resource_name = "irmc-redfish_storage_layout.layout"
+
+func TestAccRedfishStorageLayout_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPrepareStorageVolume(creds) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageLayoutConfig(creds, os.Getenv("TF_TESTING_STORAGE_SERIAL_NUMBER")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(storage_layout_resource_name, "volumes.#", "1"),
+					resource.TestCheckResourceAttr(storage_layout_resource_name, "volumes.0.raid_type", "RAID0"),
+					resource.TestCheckResourceAttrSet(storage_layout_resource_name, "volume_ids.layout-os"),
+				),
+			},
+		},
+	})
+}
+
+func storageLayoutTestVolume(name string, raidType string) models.StorageLayoutVolume {
+	return models.StorageLayoutVolume{
+		Name:               types.StringValue(name),
+		RaidType:           types.StringValue(raidType),
+		PhysicalDrives:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue(`["0", "1"]`)}),
+		CapacityBytes:      types.Int64Null(),
+		OptimumIOSizeBytes: types.Int64Value(65536),
+		InitMode:           types.StringNull(),
+	}
+}
+
+func TestPlanStorageLayoutChanges(t *testing.T) {
+	existing := []*redfish.Volume{
+		{Entity: common.Entity{ODataID: "/Volumes/0", Name: "os"}, RAIDType: redfish.RAID1RAIDType},
+		{Entity: common.Entity{ODataID: "/Volumes/1", Name: "scratch"}, RAIDType: redfish.RAID0RAIDType},
+	}
+	declared := []models.StorageLayoutVolume{
+		storageLayoutTestVolume("os", "RAID1"),
+		storageLayoutTestVolume("data", "RAID0"),
+	}
+
+	toCreate, toDelete, err := planStorageLayoutChanges(declared, existing, false)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if len(toCreate) != 1 || toCreate[0].Name.ValueString() != "data" || len(toDelete) != 0 {
+		t.Errorf("unexpected changes: create %v, delete %v", toCreate, toDelete)
+	}
+
+	_, toDelete, _ = planStorageLayoutChanges(declared, existing, true)
+	if len(toDelete) != 1 || toDelete[0].Name != "scratch" {
+		t.Errorf("expected extra volume 'scratch' to be deleted, got %v", toDelete)
+	}
+
+	if _, _, err = planStorageLayoutChanges([]models.StorageLayoutVolume{storageLayoutTestVolume("os", "RAID0")}, existing, false); err == nil {
+		t.Errorf("expected error for existing volume of different RAID type")
+	}
+
+	if _, _, err = planStorageLayoutChanges([]models.StorageLayoutVolume{declared[1], declared[1]}, existing, false); err == nil {
+		t.Errorf("expected error for duplicated volume name")
+	}
+}
+
+func TestStorageLayoutApply(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	volumes := "/redfish/v1/Systems/0/Storage/0/Volumes"
+	server.Set(volumes+"/0", map[string]interface{}{"Name": "os", "RAIDType": "RAID1"})
+	server.Set(volumes+"/1", map[string]interface{}{"Name": "scratch", "RAIDType": "RAID0"})
+	server.Set(volumes, map[string]interface{}{
+		"Members": []interface{}{mockRedfishLink(volumes + "/0"), mockRedfishLink(volumes + "/1")},
+	})
+
+	r := &StorageLayoutResource{p: connectMockRedfishServer(t, server)}
+	plan := models.StorageLayoutResourceModel{
+		StorageControllerSN: types.StringValue(MOCK_REDFISH_STORAGE_SERIAL),
+		JobTimeout:          types.Int64Value(30),
+		DeleteExtraVolumes:  types.BoolValue(false),
+		Volumes: []models.StorageLayoutVolume{
+			storageLayoutTestVolume("os", "RAID1"),
+			storageLayoutTestVolume("data", "RAID0"),
+		},
+	}
+
+	state, diags := r.apply(context.Background(), plan)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics %v", diags)
+	}
+
+	if len(state.Volumes) != 2 || len(state.VolumeIds.Elements()) != 2 {
+		t.Errorf("expected 2 managed volumes, got %v", state.Volumes)
+	}
+
+	if unmanaged := state.UnmanagedVolumes.Elements(); len(unmanaged) != 1 || unmanaged[0].(types.String).ValueString() != "scratch" {
+		t.Errorf("expected 'scratch' to be unmanaged, got %v", unmanaged)
+	}
+
+	if server.Resource(volumes+"/0") == nil || server.Resource(volumes+"/1") == nil {
+		t.Errorf("existing volumes must not be touched")
+	}
+
+	// extra volume is deleted when requested
+	plan.DeleteExtraVolumes = types.BoolValue(true)
+	state, diags = r.apply(context.Background(), plan)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics %v", diags)
+	}
+
+	if server.Resource(volumes+"/1") != nil || len(state.UnmanagedVolumes.Elements()) != 0 {
+		t.Errorf("extra volume 'scratch' has not been deleted")
+	}
+}
+
+func testAccRedfishResourceStorageLayoutConfig(testingInfo TestingServerCredentials, serial string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_storage_layout" "layout" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		storage_controller_serial_number = "%s"
+		remove_volumes_on_destroy        = true
+
+		volumes = [
+			{
+				name                  = "layout-os"
+				raid_type             = "RAID0"
+				optimum_io_size_bytes = 65536
+				physical_drives       = ["[\"0\"]"]
+			}
+		]
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		serial,
+	)
+}
@@ -18,13 +18,19 @@ limitations under the License.
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
 	"testing"
 
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 const (
@@ -141,6 +147,84 @@ func TestAccStorageResource_negative_emptyPayload(t *testing.T) {
 	})
 }
 
+func TestValidateStorageBootVolume(t *testing.T) {
+	volumes := []*redfish.Volume{}
+	volumes = append(volumes, &redfish.Volume{})
+	volumes[0].ODataID = "/redfish/v1/Systems/0/Storage/0/Volumes/0"
+
+	if err := validateStorageBootVolume(volumes, "/redfish/v1/Systems/0/Storage/0/Volumes/0/"); err != nil {
+		t.Errorf("unexpected error %s", err.Error())
+	}
+
+	if err := validateStorageBootVolume(volumes, "/redfish/v1/Systems/0/Storage/0/Volumes/1"); err == nil {
+		t.Errorf("expected error for not existing volume")
+	}
+}
+
+func TestGetStorageControllerOem(t *testing.T) {
+	var storage Storage_Fujitsu
+	if err := json.Unmarshal([]byte(`{"StorageControllers": [
+		{"SerialNumber": "SPC0001", "Oem": {}},
+		{"SerialNumber": "SPC0002", "Oem": {"Fsas": {"BGIRate": 30}}}
+	]}`), &storage); err != nil {
+		t.Fatal(err)
+	}
+
+	oem, index, err := getStorageControllerOem(storage, "SPC0001")
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	if index != 1 || oem.BGIRate == nil || *oem.BGIRate != 30 {
+		t.Errorf("unexpected OEM settings taken from entry %d", index)
+	}
+
+	storage.StorageControllers = storage.StorageControllers[:1]
+	if _, _, err = getStorageControllerOem(storage, "SPC0001"); err == nil {
+		t.Errorf("expected error for not supported OEM layout")
+	}
+}
+
+func TestCheckAppliedSettingsFromPlan_absentProperty(t *testing.T) {
+	var storage Storage_Fujitsu
+	if err := json.Unmarshal([]byte(`{"StorageControllers": [{"Oem": {"ts_fujitsu": {"BGIRate": 30}}}]}`), &storage); err != nil {
+		t.Fatal(err)
+	}
+
+	var plan models.StorageResourceModel
+	plan.BGIRate = types.Int64Value(30)
+	plan.MDCRate = types.Int64Value(30)
+	if checkAppliedSettingsFromPlan(context.Background(), plan, storage) {
+		t.Errorf("property not reported by controller must not be treated as applied")
+	}
+
+	oem, _, _ := getStorageControllerOem(storage, "")
+	unsupported := getUnsupportedStorageProperties(plan, oem)
+	if len(unsupported) != 1 || unsupported[0] != "mdc_rate" {
+		t.Errorf("unexpected unsupported properties %v", unsupported)
+	}
+}
+
+func TestConvertPlanToPayload_index(t *testing.T) {
+	var plan models.StorageResourceModel
+	plan.BGIRate = types.Int64Value(30)
+
+	payload, anyValue := convertPlanToPayload(true, plan, 1)
+	if !anyValue {
+		t.Fatalf("expected non-empty payload")
+	}
+
+	out, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"StorageControllers":[{},{"Oem":{"Fsas":{"BGIRate":30}}}]}`
+	if string(out) != expected {
+		t.Errorf("expected payload %s, got %s", expected, string(out))
+	}
+}
+
 func testAccStorageResourceSimpleConfig(testingInfo TestingServerCredentials, serial string, bios_continue_on_error string, bgi_rate int64) string {
 	return fmt.Sprintf(`
 	resource "irmc-redfish_storage" "sto" {
@@ -19,16 +19,17 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
 
 	"terraform-provider-irmc-redfish/internal/models"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -37,10 +38,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
 )
 
 var _ resource.Resource = &StorageVolumeResource{}
 var _ resource.ResourceWithImportState = &StorageVolumeResource{}
+var _ resource.ResourceWithIdentity = &StorageVolumeResource{}
+var _ resource.ResourceWithModifyPlan = &StorageVolumeResource{}
 
 func NewStorageVolumeResource() resource.Resource {
 	return &StorageVolumeResource{}
@@ -57,10 +61,13 @@ const (
 	STORAGE_RAIDCAPABILITIES_FSAS_SUFFIX = "/Oem/Fsas/RAIDCapabilities"
 	STORAGE_VOLUME_RESOURCE_NAME         = "resource-storage_volume"
 	STORAGE_VOLUME_JOB_DEFAULT_TIMEOUT   = 300
+	STORAGE_VOLUME_INIT_DEFAULT_TIMEOUT  = 3600
 )
 
 func (r *StorageVolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + storageVolumeName
+	// Id of volume is known only once interrupted creation task finishes
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func StorageVolumeSchema() map[string]schema.Attribute {
@@ -229,9 +236,37 @@ func StorageVolumeSchema() map[string]schema.Attribute {
 			},
 			Computed: true,
 		},
+		"wait_for_init": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "Defines whether creation of volume waits until initialization of volume running in background is finished.",
+			MarkdownDescription: "Defines whether creation of volume waits until initialization of volume running in background is finished, so resources depending on the volume get fully initialized volume.",
+			Default:             booldefault.StaticBool(false),
+		},
+		"init_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "Timeout in seconds for volume initialization, used if wait_for_init is true.",
+			MarkdownDescription: "Timeout in seconds for volume initialization, used if `wait_for_init` is true.",
+			Default:             int64default.StaticInt64(STORAGE_VOLUME_INIT_DEFAULT_TIMEOUT),
+		},
+		"init_state": schema.StringAttribute{
+			Computed:            true,
+			Description:         "State of volume initialization (InProgress, Completed).",
+			MarkdownDescription: "State of volume initialization (`InProgress`, `Completed`).",
+		},
+		"init_percent": schema.Int64Attribute{
+			Computed:            true,
+			Description:         "Percentage of volume initialization completed.",
+			MarkdownDescription: "Percentage of volume initialization completed.",
+		},
 	}
 }
 
+func (r *StorageVolumeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = ResourceIdentitySchema("Odata ID of the volume.")
+}
+
 func (r *StorageVolumeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "This resource is used to manipulate (Create, Read, Delete, Update and Import) logical volumes of iRMC system",
@@ -268,37 +303,50 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 	var plan models.StorageVolumeResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
-	mutexPool.Lock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
-	defer mutexPool.Unlock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	mutexPool.LockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), STORAGE_VOLUME_RESOURCE_NAME)
+	defer mutexPool.UnlockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), STORAGE_VOLUME_RESOURCE_NAME)
 
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Connection to service failed: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Connection to service failed: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	var state models.StorageVolumeResourceModel
-	beRemoved, diags := createStorageVolume(ctx, api, plan, &state)
+	beRemoved, pending, diags := createStorageVolume(ctx, api, plan, &state)
 	if beRemoved {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
+	if pending != nil {
+		// Resource is stored with pending task, so next apply resumes monitoring instead of creating another volume
+		state = pendingStorageVolumeState(plan)
+		resp.Diagnostics.Append(savePendingTask(ctx, resp.Private, *pending)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, state.RedfishServer), state.Id.ValueString())...)
+		resp.Diagnostics.Append(pendingTaskDiagnostics("Volume creation task is still running", *pending)...)
+		return
+	}
+
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
+	state.CapacityBytes.Tolerance = r.capacityTolerance()
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, state.RedfishServer), state.Id.ValueString())...)
 
 	tflog.Info(ctx, "resource-storage-volume: create ends")
 }
@@ -317,15 +365,32 @@ func (r *StorageVolumeResource) Read(ctx context.Context, req resource.ReadReque
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Connection to service failed: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Connection to service failed: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
+
+	task, found, diags := readPendingTask(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if found {
+		// Volume is not known until creation task finishes, failed or cancelled task means volume has to be created again
+		taskState, err := getPendingTaskState(api.Service, task)
+		if err == nil && IsTaskFinished(taskState) && !IsTaskFinishedSuccessfully(taskState) {
+			resp.Diagnostics.AddWarning("Pending volume creation task failed",
+				fmt.Sprintf("Task %s finished with TaskState %s, volume will be created again.", task.Location, taskState))
+			resp.State.RemoveResource(ctx)
+		} else if err == nil && cancelStalePendingTask(ctx, r.p, api.Service, task, taskState, time.Now()) {
+			resp.Diagnostics.AddWarning("Stale volume creation task cancelled",
+				fmt.Sprintf("Task %s has not finished within stale_task_age and has been cancelled, volume will be created again.", task.Location))
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
 
 	validStorageEndpoint, err := getValidStorageEndpointFromSerial(api.Service, state.StorageControllerSN.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to get valid storage id", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to get valid storage id", err)...)
 		return
 	}
 
@@ -355,12 +420,28 @@ func (r *StorageVolumeResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	// Values are not known after import
+	if state.WaitForInit.IsNull() {
+		state.WaitForInit = types.BoolValue(false)
+	}
+
+	if state.InitTimeout.IsNull() {
+		state.InitTimeout = types.Int64Value(STORAGE_VOLUME_INIT_DEFAULT_TIMEOUT)
+	}
+
+	state.CapacityBytes.Tolerance = r.capacityTolerance()
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, state.RedfishServer), state.Id.ValueString())...)
 
 	tflog.Info(ctx, "resource-storage-volume: read ends")
 }
 
+// ModifyPlan plans in-place update of the resource if supervision of its creation task has been interrupted.
+func (r *StorageVolumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planPendingTaskResume(ctx, req, resp)
+}
+
 func (r *StorageVolumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Info(ctx, "resource-storage-volume: update starts")
 
@@ -375,23 +456,31 @@ func (r *StorageVolumeResource) Update(ctx context.Context, req resource.UpdateR
 	var plan models.StorageVolumeResourceModel
 	diags = req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
-	mutexPool.Lock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
-	defer mutexPool.Unlock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	mutexPool.LockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), STORAGE_VOLUME_RESOURCE_NAME)
+	defer mutexPool.UnlockScope(ctx, endpoint, plan.StorageControllerSN.ValueString(), STORAGE_VOLUME_RESOURCE_NAME)
 
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Connection to service failed: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Connection to service failed: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
+
+	task, found, diags := readPendingTask(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if found {
+		r.resumeCreation(ctx, api, plan, state, task, resp)
+		return
+	}
 
 	beRemoved, diags := updateStorageVolume(ctx, api, plan, &state)
 	if beRemoved {
@@ -404,12 +493,58 @@ func (r *StorageVolumeResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	state.CapacityBytes.Tolerance = r.capacityTolerance()
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, state.RedfishServer), state.Id.ValueString())...)
+
+	tflog.Info(ctx, "resource-storage-volume: update ends")
+}
+
+// resumeCreation waits for volume creation task interrupted during creation of the resource
+// and stores details of created volume.
+func (r *StorageVolumeResource) resumeCreation(ctx context.Context, api *gofish.APIClient, plan models.StorageVolumeResourceModel,
+	state models.StorageVolumeResourceModel, task pendingTask, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-storage-volume: update resumes pending task", map[string]interface{}{
+		"task": task.Location,
+	})
+
+	// State stays valid if resuming fails, pending task is kept until it finishes
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created models.StorageVolumeResourceModel
+	_, interrupted, diags := resumeStorageVolumeCreation(ctx, api, plan, &created, task)
+	if interrupted {
+		resp.Diagnostics.Append(pendingTaskDiagnostics("Volume creation task is still running", task)...)
+		return
+	}
+
+	// Failed task is kept, so next refresh removes the resource and volume is created again
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	created.CapacityBytes.Tolerance = r.capacityTolerance()
+	resp.Diagnostics.Append(clearPendingTask(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &created)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, created.RedfishServer), created.Id.ValueString())...)
 
 	tflog.Info(ctx, "resource-storage-volume: update ends")
 }
 
+// capacityTolerance returns capacity tolerance configured on provider. It is attached to capacity
+// stored into state, since semantic equality of capacity has no access to provider configuration.
+func (r *StorageVolumeResource) capacityTolerance() *models.CapacityTolerance {
+	if r.p == nil {
+		return nil
+	}
+	return &r.p.CapacityTolerance
+}
+
 func (r *StorageVolumeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "resource-storage-volume: delete starts")
 
@@ -422,22 +557,31 @@ func (r *StorageVolumeResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	// Provide synchronization
-	var endpoint = state.RedfishServer[0].Endpoint.ValueString()
-	mutexPool.Lock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
-	defer mutexPool.Unlock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
+	var endpoint = getServerEndpoint(r.p, state.RedfishServer)
+	mutexPool.LockScope(ctx, endpoint, state.StorageControllerSN.ValueString(), STORAGE_VOLUME_RESOURCE_NAME)
+	defer mutexPool.UnlockScope(ctx, endpoint, state.StorageControllerSN.ValueString(), STORAGE_VOLUME_RESOURCE_NAME)
 
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Connection to service failed: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Connection to service failed: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
+
+	// Volume of resource with pending creation task is not known yet
+	task, found, diags := readPendingTask(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if found {
+		resp.Diagnostics.Append(deletePendingStorageVolume(ctx, api, state, task)...)
+		tflog.Info(ctx, "resource-storage-volume: delete ends")
+		return
+	}
 
 	is_fsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor detection failed: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor detection failed: ", err)...)
 		return
 	}
 
@@ -461,24 +605,46 @@ func (r *StorageVolumeResource) ImportState(ctx context.Context, req resource.Im
 	tflog.Info(ctx, "resource-storage-volume: import starts")
 
 	var config StorageVolumeImportConfig
-	err := json.Unmarshal([]byte(req.ID), &config)
+	var server models.RedfishServer
+
+	if len(req.ID) == 0 {
+		// import block with identity
+		var diags diag.Diagnostics
+		server, config.ID, diags = readImportIdentity(ctx, req.Identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		var err error
+		server, err = parseImportID(req.ID, &config, &config.ID)
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Could not import configuration", err)...)
+			return
+		}
+	}
+
+	state := models.StorageVolumeResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Could not import configuration", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Connection to service failed: ", err)...)
 		return
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
-	}
+	defer ReleaseTargetSystem(api)
 
-	// no need to read current configuration since terraform will call Read() once
-	// import procedure will be successfully finished
+	// Attributes requiring replacement are read from volume, so that configuration
+	// describing imported volume does not lead to its recreation
+	resp.Diagnostics.Append(readStorageVolumeForImport(api.Service, config.ID, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), config.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server"), []models.RedfishServer{server})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, server.Endpoint.ValueString(), config.ID)...)
 
 	tflog.Info(ctx, "resource-storage-volume: import ends")
 }
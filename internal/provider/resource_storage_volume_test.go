@@ -18,18 +18,212 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
+	"terraform-provider-irmc-redfish/internal/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 const (
 	storage_volume_resource_name = "irmc-redfish_storage_volume.volume"
 )
 
+func TestCapacityByteValueSemanticEquals(t *testing.T) {
+	capacity := func(v int64, tolerance *models.CapacityTolerance) models.CapacityByteValue {
+		return models.CapacityByteValue{Int64Value: types.Int64Value(v), Tolerance: tolerance}
+	}
+
+	cases := []struct {
+		name      string
+		bytes     int64
+		percent   float64
+		requested int64
+		actual    int64
+		equal     bool
+	}{
+		{"default tolerance", models.CAPACITY_TOLERANCE_BYTES, 0, 100000000000, 99800000000, true},
+		{"default tolerance exceeded", models.CAPACITY_TOLERANCE_BYTES, 0, 100000000000, 99000000000, false},
+		{"bytes tolerance", 2000000000, 0, 100000000000, 99000000000, true},
+		{"percent tolerance", 0, 1, 100000000000, 99000000000, true},
+		{"percent tolerance exceeded", 0, 1, 100000000000, 98900000000, false},
+		{"bigger tolerance used", 500000000, 2, 100000000000, 98500000000, true},
+		{"exact match without tolerance", 0, 0, 100000000000, 100000000000, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// Value returned by provider carries tolerance, prior value decoded by framework does not
+			tolerance := &models.CapacityTolerance{Bytes: c.bytes, Percent: c.percent}
+			equal, diags := capacity(c.actual, tolerance).Int64SemanticEquals(context.Background(), capacity(c.requested, nil))
+			if equal != c.equal {
+				t.Fatalf("expected equal=%v, got %v", c.equal, equal)
+			}
+			if diags.HasError() == c.equal {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+
+	t.Run("default tolerance without provider", func(t *testing.T) {
+		equal, _ := capacity(99800000000, nil).Int64SemanticEquals(context.Background(), capacity(100000000000, nil))
+		if !equal {
+			t.Fatalf("expected default tolerance to be used")
+		}
+	})
+
+	t.Run("tolerances of provider instances", func(t *testing.T) {
+		strict := &models.CapacityTolerance{Bytes: 0}
+		relaxed := &models.CapacityTolerance{Bytes: 2000000000}
+		if equal, _ := capacity(99000000000, strict).Int64SemanticEquals(context.Background(), capacity(100000000000, nil)); equal {
+			t.Errorf("expected strict tolerance to report difference")
+		}
+		if equal, _ := capacity(99000000000, relaxed).Int64SemanticEquals(context.Background(), capacity(100000000000, nil)); !equal {
+			t.Errorf("expected relaxed tolerance to accept difference")
+		}
+	})
+}
+
+func TestRequestVolumeCreationReturnsCreatedVolume(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	collection, err := getVolumesCollectionUrl(api.Service, MOCK_REDFISH_STORAGE_SERIAL)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	// Volumes created one after another must be identified without comparing
+	// content of volume collection, which can be changed in parallel
+	for _, name := range []string{"first", "second"} {
+		endpoint, _, diags := requestVolumeCreationAndSuperviseTheProcess(context.Background(), api.Service,
+			collection, map[string]interface{}{"Name": name, "RAIDType": "RAID1"}, false, 30)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error %v", diags)
+		}
+
+		if server.Resource(endpoint)["Name"] != name {
+			t.Errorf("Endpoint %s does not point to volume %s", endpoint, name)
+		}
+	}
+}
+
+func TestReadStorageVolumeForImport(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	drives := []interface{}{}
+	for i := 0; i < 4; i++ {
+		drive := fmt.Sprintf("/redfish/v1/Chassis/0/Drives/%d", i)
+		server.Set(drive, map[string]interface{}{
+			"Name":      fmt.Sprintf("Drive %d", i),
+			"MediaType": "SSD",
+			"Location": []interface{}{map[string]interface{}{
+				"Info":       fmt.Sprintf("[ 0 : 0 : 0 : %d ]", i+4),
+				"InfoFormat": "[ System_Id : Controller_Id : Enclosure_Id : Slot_Id ]",
+			}},
+		})
+		drives = append(drives, mockRedfishLink(drive))
+	}
+
+	volume := "/redfish/v1/Systems/0/Storage/0/Volumes/0"
+	server.Set(volume, map[string]interface{}{
+		"Name":               "data",
+		"RAIDType":           "RAID10",
+		"CapacityBytes":      1919850381312,
+		"OptimumIOSizeBytes": 65536,
+		"MediaSpanCount":     2,
+		"Links":              map[string]interface{}{"Drives": drives},
+		"Oem": map[string]interface{}{TS_FUJITSU: map[string]interface{}{
+			"ReadMode":       "ReadAhead",
+			"WriteMode":      "WriteBack",
+			"DriveCacheMode": "Enabled",
+		}},
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	var state models.StorageVolumeResourceModel
+	if diags := readStorageVolumeForImport(api.Service, volume, &state); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if state.StorageControllerSN.ValueString() != MOCK_REDFISH_STORAGE_SERIAL || state.RaidType.ValueString() != "RAID10" ||
+		state.OptimumIOSizeBytes.ValueInt64() != 65536 || state.CapacityBytes.ValueInt64() != 1919850381312 {
+		t.Errorf("Unexpected imported volume %v", state)
+	}
+
+	if state.ReadMode.Requested.ValueString() != "ReadAhead" || state.WriteMode.Requested.ValueString() != "WriteBack" {
+		t.Errorf("Unexpected imported volume modes %v %v", state.ReadMode, state.WriteMode)
+	}
+
+	var groups []string
+	state.PhysicalDrives.ElementsAs(context.Background(), &groups, false)
+	if len(groups) != 2 || groups[0] != `["0-4","0-5"]` || groups[1] != `["0-6","0-7"]` {
+		t.Errorf("Unexpected physical drives %v", groups)
+	}
+
+	if diags := readStorageVolumeForImport(api.Service, "/redfish/v1/Systems/0/Storage/0/Volumes/9", &state); !diags.HasError() {
+		t.Errorf("Expected error for not existing volume")
+	}
+}
+
+func TestWaitForVolumeInitialization(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	volume := "/redfish/v1/Systems/0/Storage/0/Volumes/10"
+	server.Set(volume, map[string]interface{}{
+		"Name":     "init",
+		"RAIDType": "RAID1",
+		"Operations": []interface{}{
+			map[string]interface{}{"OperationName": "Background Initialization", "PercentageComplete": 40},
+		},
+	})
+
+	v, err := redfish.GetVolume(api.Service.GetClient(), volume)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	state, percent := getVolumeInitProgress(v)
+	if state != VOLUME_INIT_STATE_IN_PROGRESS || percent != 40 {
+		t.Errorf("Got initialization %s %d%%, expected %s 40%%", state, percent, VOLUME_INIT_STATE_IN_PROGRESS)
+	}
+
+	err = waitForVolumeInitialization(context.Background(), api.Service, volume, 1)
+	if err == nil {
+		t.Errorf("Expected timeout while volume is being initialized")
+	}
+
+	server.Set(volume, map[string]interface{}{"Name": "init", "RAIDType": "RAID1"})
+	err = waitForVolumeInitialization(context.Background(), api.Service, volume, 10)
+	if err != nil {
+		t.Errorf("Unexpected error %s", err.Error())
+	}
+}
+
 // These tests are very hardware dependent (controller existence, id, disks etc.) so be.
 func TestAccRedfishStorageVolume_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -0,0 +1,356 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	TEST_ALERT_TYPE_SNMP_TRAP     = "SnmpTrap"
+	TEST_ALERT_TYPE_EMAIL         = "Email"
+	TEST_ALERT_TYPE_REDFISH_EVENT = "RedfishEvent"
+
+	TEST_ALERT_DEFAULT_TIMEOUT = 120
+	TEST_ALERT_DEFAULT_MESSAGE = "Test alert triggered by Terraform"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TestAlertResource{}
+
+func NewTestAlertResource() resource.Resource {
+	return &TestAlertResource{}
+}
+
+// TestAlertResource defines the resource implementation.
+type TestAlertResource struct {
+	p *IrmcProvider
+}
+
+// testAlertEndpoint describes resource, which offers action sending test alert of given type.
+// Name of the action differs between firmware versions, so it's looked up among actions
+// of the resource by keyword.
+type testAlertEndpoint struct {
+	endpoint string
+	keyword  string
+}
+
+func getTestAlertEndpoint(alertType string, isFsas bool) testAlertEndpoint {
+	oem := TS_FUJITSU
+	if isFsas {
+		oem = FSAS
+	}
+
+	switch alertType {
+	case TEST_ALERT_TYPE_SNMP_TRAP:
+		return testAlertEndpoint{endpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Snmp", oem), keyword: "trap"}
+	case TEST_ALERT_TYPE_EMAIL:
+		return testAlertEndpoint{endpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Email", oem), keyword: "mail"}
+	default:
+		return testAlertEndpoint{endpoint: "/redfish/v1/EventService", keyword: "event"}
+	}
+}
+
+func (r *TestAlertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + testAlertName
+}
+
+func TestAlertSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Target of the action used to send test alert.",
+			Description:         "Target of the action used to send test alert.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"alert_type": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "Type of test alert. Applicable values are: 'SnmpTrap' (test trap sent to configured SNMP trap destinations), " +
+				"'Email' (test mail sent to configured mail recipients), 'RedfishEvent' (test event sent to Redfish event subscribers).",
+			Description: "Type of test alert. Applicable values are: 'SnmpTrap' (test trap sent to configured SNMP trap destinations), " +
+				"'Email' (test mail sent to configured mail recipients), 'RedfishEvent' (test event sent to Redfish event subscribers).",
+			Validators: []validator.String{
+				stringvalidator.OneOf(TEST_ALERT_TYPE_SNMP_TRAP, TEST_ALERT_TYPE_EMAIL, TEST_ALERT_TYPE_REDFISH_EVENT),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"message": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Message of test event, used only with 'RedfishEvent' alert type.",
+			Description:         "Message of test event, used only with 'RedfishEvent' alert type.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(TEST_ALERT_DEFAULT_TIMEOUT),
+			MarkdownDescription: fmt.Sprintf("Timeout in seconds for iRMC to report test alert as sent, if sending is done asynchronously (default %ds).", TEST_ALERT_DEFAULT_TIMEOUT),
+			Description:         fmt.Sprintf("Timeout in seconds for iRMC to report test alert as sent, if sending is done asynchronously (default %ds).", TEST_ALERT_DEFAULT_TIMEOUT),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Arbitrary map of values, change of which causes test alert to be sent again (e.g. after alerting configuration is changed).",
+			Description:         "Arbitrary map of values, change of which causes test alert to be sent again (e.g. after alerting configuration is changed).",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *TestAlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to send test SNMP trap, test email or test Redfish event from iRMC, so that alerting configuration can be verified as part of apply.",
+		Description:         "This resource is used to send test SNMP trap, test email or test Redfish event from iRMC, so that alerting configuration can be verified as part of apply.",
+		Attributes:          TestAlertSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *TestAlertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+// Create sends test alert and sets the initial Terraform state.
+func (r *TestAlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-test-alert: create starts")
+	var plan models.TestAlertResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-test-alert"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
+		return
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor detection failed", err)...)
+		return
+	}
+
+	alertEndpoint := getTestAlertEndpoint(plan.AlertType.ValueString(), isFsas)
+	target, err := getTestAlertActionTarget(api.Service, alertEndpoint)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics(fmt.Sprintf("Action sending test alert of type %s is not available", plan.AlertType.ValueString()), err)...)
+		return
+	}
+
+	err = sendTestAlert(ctx, api.Service, target, getTestAlertPayload(plan), isFsas, plan.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Test alert could not be sent", err)...)
+		return
+	}
+
+	plan.Id = types.StringValue(target)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-test-alert: create ends")
+}
+
+func (r *TestAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-test-alert: read starts")
+	var state models.TestAlertResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-test-alert: read ends")
+}
+
+// Update modifies the resource state. Changes which require sending test alert again cause
+// replacement of the resource, so only timeout is updated in place.
+func (*TestAlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.TestAlertResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the Terraform state, test alert can not be taken back.
+func (*TestAlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-test-alert: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-test-alert: delete ends")
+}
+
+// getTestAlertActionTarget looks up target of action sending test alert among actions
+// (including OEM ones) offered by resource described by alertEndpoint.
+func getTestAlertActionTarget(service *gofish.Service, alertEndpoint testAlertEndpoint) (string, error) {
+	res, err := service.GetClient().Get(alertEndpoint.endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	defer CloseResource(res.Body)
+
+	var body struct {
+		Actions map[string]json.RawMessage
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("could not decode %s: %w", alertEndpoint.endpoint, err)
+	}
+
+	actions := map[string]string{}
+	collectRedfishActionTargets(body.Actions, actions)
+
+	names := make([]string, 0, len(actions))
+	for name := range actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		lower := strings.ToLower(name)
+		if strings.Contains(lower, "test") && strings.Contains(lower, alertEndpoint.keyword) {
+			return actions[name], nil
+		}
+	}
+
+	return "", fmt.Errorf("resource %s does not offer test action (available actions: %v)", alertEndpoint.endpoint, names)
+}
+
+// collectRedfishActionTargets fills targets with action names and their targets, OEM actions
+// nested in Oem object are included.
+func collectRedfishActionTargets(actions map[string]json.RawMessage, targets map[string]string) {
+	for name, raw := range actions {
+		if name == "Oem" {
+			var oem map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &oem); err == nil {
+				collectRedfishActionTargets(oem, targets)
+			}
+			continue
+		}
+
+		var action struct {
+			Target string `json:"target"`
+		}
+		if err := json.Unmarshal(raw, &action); err == nil && len(action.Target) > 0 {
+			targets[name] = action.Target
+		}
+	}
+}
+
+// getTestAlertPayload prepares body of action sending test alert.
+func getTestAlertPayload(plan models.TestAlertResourceModel) map[string]interface{} {
+	if plan.AlertType.ValueString() != TEST_ALERT_TYPE_REDFISH_EVENT {
+		return map[string]interface{}{}
+	}
+
+	message := TEST_ALERT_DEFAULT_MESSAGE
+	if len(plan.Message.ValueString()) > 0 {
+		message = plan.Message.ValueString()
+	}
+
+	return map[string]interface{}{
+		"EventType": "Alert",
+		"MessageId": "Base.1.0.Success",
+		"Message":   message,
+		"Severity":  "OK",
+	}
+}
+
+// sendTestAlert invokes action pointed by target and waits for task, if sending is done asynchronously.
+func sendTestAlert(ctx context.Context, service *gofish.Service, target string, payload map[string]interface{}, isFsas bool, timeout int64) error {
+	res, err := service.GetClient().Post(target, payload)
+	if err != nil {
+		return err
+	}
+
+	defer CloseResource(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusAccepted:
+		location := res.Header.Get(HTTP_HEADER_LOCATION)
+		if len(location) == 0 {
+			return nil
+		}
+
+		if _, err := WaitForRedfishTaskEnd(ctx, service, location, timeout); err != nil {
+			report, _ := GetRedfishTaskReport(service, location, isFsas)
+			return fmt.Errorf("%w\n%s", err, report.String())
+		}
+		return nil
+	default:
+		return fmt.Errorf("action %s finished with status %d", target, res.StatusCode)
+	}
+}
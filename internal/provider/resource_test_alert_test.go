@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRedfishTestAlert_InvalidType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceTestAlertConfig(creds, "Pager"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishTestAlert_RedfishEvent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceTestAlertConfig(creds, TEST_ALERT_TYPE_REDFISH_EVENT),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("irmc-redfish_test_alert.alert", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestSendTestAlert(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	snmp := fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Snmp", TS_FUJITSU)
+	server.Set(snmp, map[string]interface{}{
+		"Actions": map[string]interface{}{
+			"Oem": map[string]interface{}{
+				"#FTSSnmp.SendTestTrap": map[string]interface{}{"target": snmp + "/Actions/FTSSnmp.SendTestTrap"},
+			},
+		},
+	})
+	server.Set("/redfish/v1/EventService", map[string]interface{}{
+		"Actions": map[string]interface{}{
+			"#EventService.SubmitTestEvent": map[string]interface{}{"target": "/redfish/v1/EventService/Actions/EventService.SubmitTestEvent"},
+		},
+	})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	target, err := getTestAlertActionTarget(api.Service, getTestAlertEndpoint(TEST_ALERT_TYPE_SNMP_TRAP, false))
+	if err != nil || target != snmp+"/Actions/FTSSnmp.SendTestTrap" {
+		t.Fatalf("Unexpected SNMP test trap target '%s': %v", target, err)
+	}
+
+	if err := sendTestAlert(context.Background(), api.Service, target, map[string]interface{}{}, false, 10); err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	plan := models.TestAlertResourceModel{AlertType: types.StringValue(TEST_ALERT_TYPE_REDFISH_EVENT), Message: types.StringValue("hello")}
+	target, err = getTestAlertActionTarget(api.Service, getTestAlertEndpoint(TEST_ALERT_TYPE_REDFISH_EVENT, false))
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if err := sendTestAlert(context.Background(), api.Service, target, getTestAlertPayload(plan), false, 10); err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if len(server.Actions) != 2 || server.Actions[1].Payload["Message"] != "hello" {
+		t.Errorf("Unexpected actions invoked %v", server.Actions)
+	}
+
+	// Email is not configured in mock, so test action can not be found
+	if _, err := getTestAlertActionTarget(api.Service, getTestAlertEndpoint(TEST_ALERT_TYPE_EMAIL, false)); err == nil {
+		t.Errorf("Expected error for missing test email action")
+	}
+}
+
+func testAccRedfishResourceTestAlertConfig(testingInfo TestingServerCredentials, alertType string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_test_alert" "alert" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		alert_type = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		alertType,
+	)
+}
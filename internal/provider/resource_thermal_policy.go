@@ -0,0 +1,458 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ThermalPolicyResource{}
+var _ resource.ResourceWithImportState = &ThermalPolicyResource{}
+
+func NewThermalPolicyResource() resource.Resource {
+	return &ThermalPolicyResource{}
+}
+
+// ThermalPolicyResource defines the resource implementation.
+type ThermalPolicyResource struct {
+	p *IrmcProvider
+}
+
+func (r *ThermalPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + thermalPolicyName
+}
+
+func ThermalPolicySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of thermal resource of chassis on iRMC.",
+			Description:         "ID of thermal resource of chassis on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"temperature_sensors": schema.MapNestedAttribute{
+			Required:            true,
+			MarkdownDescription: "Policy of temperature sensors keyed by sensor name (e.g. 'Ambient', 'CPU1'). Only listed sensors are managed.",
+			Description:         "Policy of temperature sensors keyed by sensor name (e.g. 'Ambient', 'CPU1'). Only listed sensors are managed.",
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"upper_threshold_critical": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Temperature in degrees Celsius above which sensor reports critical state.",
+						Description:         "Temperature in degrees Celsius above which sensor reports critical state.",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 150),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"upper_threshold_fatal": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Temperature in degrees Celsius above which `shutdown_action` is triggered.",
+						Description:         "Temperature in degrees Celsius above which shutdown_action is triggered.",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 150),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"shutdown_action": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Action taken when fatal threshold is exceeded. Available values are 'None', 'GracefulShutdown' and 'PowerOff'.",
+						Description:         "Action taken when fatal threshold is exceeded. Available values are 'None', 'GracefulShutdown' and 'PowerOff'.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("None", "GracefulShutdown", "PowerOff"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ThermalPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) thresholds and shutdown actions of temperature sensors of the server.",
+		Description:         "The resource is used to control (read, modify or import) thresholds and shutdown actions of temperature sensors of the server.",
+		Attributes:          ThermalPolicySchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *ThermalPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *ThermalPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-thermal_policy: create starts")
+
+	var plan models.ThermalPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-thermal_policy: create ends")
+}
+
+func (r *ThermalPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-thermal_policy: read starts")
+
+	var state models.ThermalPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-thermal_policy: read ends")
+}
+
+func (r *ThermalPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-thermal_policy: update starts")
+
+	var plan, state models.ThermalPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-thermal_policy: update ends")
+}
+
+func (r *ThermalPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-thermal_policy: delete starts")
+	// Thermal policy can not be removed, so resource is only removed from state
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-thermal_policy: delete ends")
+}
+
+// ImportState imports policy of all temperature sensors reported by iRMC.
+func (r *ThermalPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-thermal_policy: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.ThermalPolicyResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-thermal_policy: import ends")
+}
+
+// read reads current policy of temperature sensors from iRMC into model. If model
+// does not contain any sensor, all sensors reported by iRMC are read.
+func (r *ThermalPolicyResource) read(ctx context.Context, model *models.ThermalPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	api, err := ConnectTargetSystem(r.p, &model.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, vendor, err := getThermalEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not find thermal resource", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read thermal policy", err)...)
+		return diags
+	}
+
+	if err = thermalPolicyToModel(endpoint, vendor, data, model); err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read thermal policy", err)...)
+	}
+
+	return diags
+}
+
+// apply sends policy of temperature sensors, which is defined in plan and differs from state (if any),
+// to iRMC and reads back the policy into plan.
+func (r *ThermalPolicyResource) apply(ctx context.Context, plan *models.ThermalPolicyResourceModel, state *models.ThermalPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-thermal_policy"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	thermalEndpoint, vendor, err := getThermalEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not find thermal resource", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, thermalEndpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read thermal policy", err)...)
+		return diags
+	}
+
+	var current map[string]models.TemperatureSensorPolicy
+	if state != nil {
+		current = state.TemperatureSensors
+	}
+
+	payload, err := getThermalPolicyPayload(vendor, data, plan.TemperatureSensors, current)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Invalid thermal policy", err)...)
+		return diags
+	}
+
+	tflog.Info(ctx, "Applying thermal policy", map[string]interface{}{"payload": payload})
+	if len(payload) > 0 {
+		if err = patchEndpointWithEtag(api, thermalEndpoint, payload); err != nil {
+			diags.Append(redfishErrorDiagnostics("Could not apply thermal policy", err)...)
+			return diags
+		}
+
+		if data, err = getJsonObject(api, thermalEndpoint); err != nil {
+			diags.Append(redfishErrorDiagnostics("Could not read thermal policy", err)...)
+			return diags
+		}
+	}
+
+	// iRMC might ignore or adjust requested value, what would be reported as inconsistent result by Terraform
+	requested := plan.TemperatureSensors
+	if err = thermalPolicyToModel(thermalEndpoint, vendor, data, plan); err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read thermal policy", err)...)
+		return diags
+	}
+
+	if err = verifyThermalPolicy(requested, plan.TemperatureSensors); err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply thermal policy", err)...)
+	}
+
+	return diags
+}
+
+// temperatureSensors returns temperature sensors reported by thermal resource keyed by sensor name
+// together with their order, which has to be kept in PATCH request.
+func temperatureSensors(data map[string]interface{}) (sensors map[string]map[string]interface{}, order []string) {
+	sensors = map[string]map[string]interface{}{}
+	list, _ := data["Temperatures"].([]interface{})
+	for _, item := range list {
+		sensor, _ := item.(map[string]interface{})
+		name, _ := sensor["Name"].(string)
+		order = append(order, name)
+		if len(name) > 0 {
+			sensors[name] = sensor
+		}
+	}
+
+	return sensors, order
+}
+
+// getThermalPolicyPayload returns PATCH payload of thermal resource changing sensors defined in plan,
+// which differ from current policy. Redfish requires array to be patched as whole, so sensors which
+// are not changed are represented by empty objects.
+func getThermalPolicyPayload(vendor string, data map[string]interface{}, plan map[string]models.TemperatureSensorPolicy,
+	current map[string]models.TemperatureSensorPolicy) (map[string]interface{}, error) {
+	sensors, order := temperatureSensors(data)
+
+	names := []string{}
+	for name := range plan {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := sensors[name]; !ok {
+			return nil, fmt.Errorf("temperature sensor '%s' is not reported by iRMC", name)
+		}
+	}
+
+	changed := false
+	temperatures := []interface{}{}
+	for _, name := range order {
+		entry := map[string]interface{}{}
+		if policy, ok := plan[name]; ok {
+			previous := current[name]
+			addInt64ToPayload(entry, "UpperThresholdCritical", policy.UpperThresholdCritical, previous.UpperThresholdCritical)
+			addInt64ToPayload(entry, "UpperThresholdFatal", policy.UpperThresholdFatal, previous.UpperThresholdFatal)
+
+			oem := map[string]interface{}{}
+			addStringToPayload(oem, "ShutdownAction", policy.ShutdownAction, previous.ShutdownAction)
+			if len(oem) > 0 {
+				entry["Oem"] = map[string]interface{}{vendor: oem}
+			}
+		}
+
+		changed = changed || len(entry) > 0
+		temperatures = append(temperatures, entry)
+	}
+
+	if !changed {
+		return map[string]interface{}{}, nil
+	}
+
+	return map[string]interface{}{"Temperatures": temperatures}, nil
+}
+
+// thermalPolicyToModel copies policy of temperature sensors from thermal resource into model.
+// Only sensors already present in model are copied, unless model does not contain any sensor.
+func thermalPolicyToModel(endpoint string, vendor string, data map[string]interface{}, model *models.ThermalPolicyResourceModel) error {
+	sensors, _ := temperatureSensors(data)
+
+	names := []string{}
+	for name := range model.TemperatureSensors {
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		for name := range sensors {
+			names = append(names, name)
+		}
+	}
+
+	policies := map[string]models.TemperatureSensorPolicy{}
+	for _, name := range names {
+		sensor, ok := sensors[name]
+		if !ok {
+			return fmt.Errorf("temperature sensor '%s' is not reported by iRMC", name)
+		}
+
+		policies[name] = models.TemperatureSensorPolicy{
+			UpperThresholdCritical: jsonInt64Value(sensor, "UpperThresholdCritical"),
+			UpperThresholdFatal:    jsonInt64Value(sensor, "UpperThresholdFatal"),
+			ShutdownAction:         jsonStringValue(jsonObjectValue(jsonObjectValue(sensor, "Oem"), vendor), "ShutdownAction"),
+		}
+	}
+
+	model.Id = types.StringValue(endpoint)
+	model.TemperatureSensors = policies
+	return nil
+}
+
+// verifyThermalPolicy checks that all known values of requested policy are reported by iRMC.
+func verifyThermalPolicy(requested map[string]models.TemperatureSensorPolicy, applied map[string]models.TemperatureSensorPolicy) error {
+	for name, policy := range requested {
+		reported := applied[name]
+		for _, pair := range []struct {
+			field     string
+			requested attr.Value
+			reported  attr.Value
+		}{
+			{"upper_threshold_critical", policy.UpperThresholdCritical, reported.UpperThresholdCritical},
+			{"upper_threshold_fatal", policy.UpperThresholdFatal, reported.UpperThresholdFatal},
+			{"shutdown_action", policy.ShutdownAction, reported.ShutdownAction},
+		} {
+			if pair.requested.IsNull() || pair.requested.IsUnknown() || pair.requested.Equal(pair.reported) {
+				continue
+			}
+
+			return fmt.Errorf("requested value of %s of sensor '%s' is %s, but iRMC reports %s",
+				pair.field, name, pair.requested.String(), pair.reported.String())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const thermal_policy_name = "irmc-redfish_thermal_policy.thermal"
+
+func TestAccRedfishThermalPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceThermalPolicyConfig(creds, "GracefulShutdown"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(thermal_policy_name, "id"),
+					resource.TestCheckResourceAttr(thermal_policy_name, "temperature_sensors.Ambient.shutdown_action", "GracefulShutdown"),
+					resource.TestCheckResourceAttrSet(thermal_policy_name, "temperature_sensors.Ambient.upper_threshold_fatal"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceThermalPolicyConfig(creds, "None"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(thermal_policy_name, "temperature_sensors.Ambient.shutdown_action", "None"),
+				),
+			},
+		},
+	})
+}
+
+func TestThermalPolicyApply(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	r := ThermalPolicyResource{p: connectMockRedfishServer(t, server)}
+	ctx := context.Background()
+
+	plan := models.ThermalPolicyResourceModel{
+		TemperatureSensors: map[string]models.TemperatureSensorPolicy{
+			"CPU1": {
+				UpperThresholdCritical: types.Int64Unknown(),
+				UpperThresholdFatal:    types.Int64Value(95),
+				ShutdownAction:         types.StringValue("GracefulShutdown"),
+			},
+		},
+	}
+
+	if diags := r.apply(ctx, &plan, nil); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	cpu := plan.TemperatureSensors["CPU1"]
+	if cpu.UpperThresholdCritical.ValueInt64() != 90 || cpu.UpperThresholdFatal.ValueInt64() != 95 ||
+		cpu.ShutdownAction.ValueString() != "GracefulShutdown" || len(plan.TemperatureSensors) != 1 {
+		t.Errorf("Unexpected thermal policy %+v", plan)
+	}
+
+	// Sensors which are not managed must stay untouched
+	temperatures := server.Resource("/redfish/v1/Chassis/0/Thermal")["Temperatures"].([]interface{})
+	ambient := temperatures[0].(map[string]interface{})
+	if ambient["Name"] != "Ambient" || ambient["UpperThresholdFatal"] != float64(42) {
+		t.Errorf("Unexpected ambient sensor on server %v", ambient)
+	}
+
+	plan.TemperatureSensors["Inlet"] = models.TemperatureSensorPolicy{
+		UpperThresholdCritical: types.Int64Value(40),
+		UpperThresholdFatal:    types.Int64Null(),
+		ShutdownAction:         types.StringNull(),
+	}
+	if diags := r.apply(ctx, &plan, nil); !diags.HasError() {
+		t.Errorf("Expected error for not reported sensor")
+	}
+}
+
+func TestGetThermalPolicyPayload(t *testing.T) {
+	data := map[string]interface{}{
+		"Temperatures": []interface{}{
+			map[string]interface{}{"Name": "Ambient", "UpperThresholdFatal": float64(42)},
+			map[string]interface{}{"Name": "CPU1", "UpperThresholdFatal": float64(98)},
+		},
+	}
+	policy := models.TemperatureSensorPolicy{
+		UpperThresholdCritical: types.Int64Null(),
+		UpperThresholdFatal:    types.Int64Value(45),
+		ShutdownAction:         types.StringValue("PowerOff"),
+	}
+
+	payload, err := getThermalPolicyPayload(TS_FUJITSU, data, map[string]models.TemperatureSensorPolicy{"Ambient": policy}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	expected := `map[Temperatures:[map[Oem:map[ts_fujitsu:map[ShutdownAction:PowerOff]] UpperThresholdFatal:45] map[]]]`
+	if fmt.Sprint(payload) != expected {
+		t.Errorf("Got payload %v, expected %s", payload, expected)
+	}
+
+	// Nothing is sent if policy does not differ from state
+	payload, err = getThermalPolicyPayload(TS_FUJITSU, data, map[string]models.TemperatureSensorPolicy{"Ambient": policy},
+		map[string]models.TemperatureSensorPolicy{"Ambient": policy})
+	if err != nil || len(payload) != 0 {
+		t.Errorf("Expected empty payload, got %v, %v", payload, err)
+	}
+}
+
+func testAccRedfishResourceThermalPolicyConfig(testingInfo TestingServerCredentials, action string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_thermal_policy" "thermal" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		temperature_sensors = {
+		  "Ambient" = {
+		    shutdown_action = "%s"
+		  }
+		}
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		action,
+	)
+}
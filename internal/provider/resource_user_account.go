@@ -18,16 +18,22 @@ limitations under the License.
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"unicode"
 
 	"terraform-provider-irmc-redfish/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -60,16 +66,38 @@ const (
 )
 
 type userAccountImportConfig struct {
-	Username    string `json:"username"`
-	Password    string `json:"password"`
-	Endpoint    string `json:"endpoint"`
-	SslInsecure bool   `json:"ssl_insecure"`
-	UserID      string `json:"user_id"`
+	ServerConfig
+	UserID string `json:"user_id"`
 }
 
 const USER_ACCOUNT_ENDPOINT = "/redfish/v1/AccountService/Accounts"
 const MIN_PASSW_CONDITIONS = 3
 
+// USER_SSH_PUBLIC_KEYS is OEM property of the account holding SSHv2 public keys of the user.
+const USER_SSH_PUBLIC_KEYS = "SSHv2PublicKeys"
+
+// OEM properties of the account describing alerting of the user via email.
+const (
+	USER_EMAIL_ENABLED     = "Enabled"
+	USER_EMAIL_ADDRESS     = "Address"
+	USER_EMAIL_MAIL_FORMAT = "MailFormat"
+	USER_EMAIL_ALERTS      = "Alerts"
+)
+
+var userMailFormats = []string{"Standard", "Fixed Subject", "ITS-Format", "SMS"}
+
+var userAlertGroups = []string{
+	"CriticalHardwareErrors", "DiskDriversAndControllers", "FanSensors", "Memory", "NetworkInterface", "Others",
+	"POSTErrors", "RemoteManagement", "Security", "SystemHang", "SystemPower", "SystemStatus", "TemperatureSensors",
+}
+
+var userAlertLevels = []string{"None", "Critical", "Warning", "All"}
+
+// Extended permissions of the account managed by dedicated attributes of the resource.
+var userDedicatedExtendedPermissions = []string{"ConfigureUsers", "ConfigureIrmc", "UseVideoRedirection", "UseRemoteStorage"}
+
+var sshPublicKeyRegex = regexp.MustCompile(`^(ssh-(rsa|dss|ed25519)|ecdsa-sha2-nistp(256|384|521)) [A-Za-z0-9+/]+={0,3}( .*)?$`)
+
 type RedfishMethod string
 
 const (
@@ -80,6 +108,7 @@ const (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IrmcUserAccountResource{}
 var _ resource.ResourceWithImportState = &IrmcUserAccountResource{}
+var _ resource.ResourceWithIdentity = &IrmcUserAccountResource{}
 
 func NewUserAccountResource() resource.Resource {
 	return &IrmcUserAccountResource{}
@@ -93,6 +122,10 @@ type IrmcUserAccountResource struct {
 func (r *IrmcUserAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + userAccount
 }
+func (r *IrmcUserAccountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = ResourceIdentitySchema("ID of the user account (user_id).")
+}
+
 func (r *IrmcUserAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "This resource is used to manage user accounts.",
@@ -123,6 +156,24 @@ func (r *IrmcUserAccountResource) Schema(ctx context.Context, req resource.Schem
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("user_password_wo")),
+				},
+			},
+			"user_password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password of the user, which is not persisted in Terraform state (requires Terraform 1.11 or later). To change the password, user_password_wo_version must be changed as well.",
+				Description:         "Password of the user, which is not persisted in Terraform state (requires Terraform 1.11 or later). To change the password, user_password_wo_version must be changed as well.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("user_password_wo_version")),
+				},
+			},
+			"user_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of user_password_wo. Since write-only value is not stored in state, change of the version triggers update of the password.",
+				Description:         "Version of user_password_wo. Since write-only value is not stored in state, change of the version triggers update of the password.",
+				Optional:            true,
 			},
 			"user_role": schema.StringAttribute{
 				MarkdownDescription: "Role of the user. Available values are 'Administrator', 'Operator', and 'ReadOnly'.",
@@ -229,6 +280,83 @@ func (r *IrmcUserAccountResource) Schema(ctx context.Context, req resource.Schem
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"user_alert_email_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Specifies if alerts are sent to the user via email. If not defined, setting is not managed.",
+				Description:         "Specifies if alerts are sent to the user via email. If not defined, setting is not managed.",
+				Optional:            true,
+			},
+			"user_alert_email_address": schema.StringAttribute{
+				MarkdownDescription: "Email address to which alerts for the user are sent. If not defined, setting is not managed.",
+				Description:         "Email address to which alerts for the user are sent. If not defined, setting is not managed.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^@\s]+@[^@\s]+$`), "must be valid email address"),
+				},
+			},
+			"user_alert_mail_format": schema.StringAttribute{
+				MarkdownDescription: "Preferred format of alert emails sent to the user. Available values are 'Standard', 'Fixed Subject', 'ITS-Format' and 'SMS'. If not defined, setting is not managed.",
+				Description:         "Preferred format of alert emails sent to the user. Available values are 'Standard', 'Fixed Subject', 'ITS-Format' and 'SMS'. If not defined, setting is not managed.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(userMailFormats...),
+				},
+			},
+			"user_alert_levels": schema.MapAttribute{
+				MarkdownDescription: "Map of alert groups to alert level, which must be reached so that email is sent to the user. " +
+					"Available groups are 'CriticalHardwareErrors', 'DiskDriversAndControllers', 'FanSensors', 'Memory', 'NetworkInterface', " +
+					"'Others', 'POSTErrors', 'RemoteManagement', 'Security', 'SystemHang', 'SystemPower', 'SystemStatus' and 'TemperatureSensors'. " +
+					"Available levels are 'None', 'Critical', 'Warning' and 'All'. Only groups defined in the map are managed.",
+				Description: "Map of alert groups to alert level, which must be reached so that email is sent to the user. " +
+					"Available groups are 'CriticalHardwareErrors', 'DiskDriversAndControllers', 'FanSensors', 'Memory', 'NetworkInterface', " +
+					"'Others', 'POSTErrors', 'RemoteManagement', 'Security', 'SystemHang', 'SystemPower', 'SystemStatus' and 'TemperatureSensors'. " +
+					"Available levels are 'None', 'Critical', 'Warning' and 'All'. Only groups defined in the map are managed.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(userAlertGroups...)),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(userAlertLevels...)),
+				},
+			},
+			"user_ssh_public_keys": schema.ListAttribute{
+				MarkdownDescription: "List of SSHv2 public keys in OpenSSH format used for key based login of the user to iRMC CLI. If not defined, keys of the user are not managed.",
+				Description:         "List of SSHv2 public keys in OpenSSH format used for key based login of the user to iRMC CLI. If not defined, keys of the user are not managed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(sshPublicKeyRegex, "must be SSHv2 public key in OpenSSH format, e.g. 'ssh-ed25519 AAAA... comment'"),
+					),
+				},
+			},
+			"user_extended_permissions": schema.MapAttribute{
+				MarkdownDescription: "Map of extended permissions of the user (OEM Permissions/Extended properties of the account, e.g. privileges introduced by newer iRMC firmware) " +
+					"not covered by dedicated attributes of the resource. Only permissions defined in the map are managed. " +
+					"Permissions supported by iRMC are reported by user_reported_extended_permissions.",
+				Description: "Map of extended permissions of the user (OEM Permissions/Extended properties of the account, e.g. privileges introduced by newer iRMC firmware) " +
+					"not covered by dedicated attributes of the resource. Only permissions defined in the map are managed. " +
+					"Permissions supported by iRMC are reported by user_reported_extended_permissions.",
+				Optional:    true,
+				ElementType: types.BoolType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOf(userDedicatedExtendedPermissions...)),
+				},
+			},
+			"user_reported_extended_permissions": schema.MapAttribute{
+				MarkdownDescription: "Map of all extended permissions of the user as reported by iRMC, including those managed by dedicated attributes.",
+				Description:         "Map of all extended permissions of the user as reported by iRMC, including those managed by dedicated attributes.",
+				Computed:            true,
+				ElementType:         types.BoolType,
+			},
+			"verify_password_on_read": schema.BoolAttribute{
+				MarkdownDescription: "If true, during refresh provider tries to log in as the user with user_password to detect password changed outside of Terraform. " +
+					"If login fails, the password is planned to be updated. Every failed login counts towards account lockout policy of iRMC. Default is false.",
+				Description: "If true, during refresh provider tries to log in as the user with user_password to detect password changed outside of Terraform. " +
+					"If login fails, the password is planned to be updated. Every failed login counts towards account lockout policy of iRMC. Default is false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 		Blocks: RedfishServerResourceBlockMap(),
 	}
@@ -260,31 +388,33 @@ func (r *IrmcUserAccountResource) Create(ctx context.Context, req resource.Creat
 	var plan models.IrmcUserAccountResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_password_wo"), &plan.UserPasswordWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-user-account"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
 
-	userPassword := plan.UserPassword.ValueString()
+	userPassword := userAccountPassword(plan)
 	userName := plan.UserUsername.ValueString()
 	userId := plan.UserID.ValueString()
 
 	config, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("error. Service Connect Target System Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error. Service Connect Target System Error", err)...)
 		return
 	}
 
-	defer config.Logout()
+	defer ReleaseTargetSystem(config)
 
 	isFsas, err := IsFsasCheck(ctx, config)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 	plan.Id = types.StringValue(USER_ACCOUNT_ENDPOINT)
@@ -292,39 +422,39 @@ func (r *IrmcUserAccountResource) Create(ctx context.Context, req resource.Creat
 	// Chec Password validation
 	err = CheckPasswordValidation(userPassword)
 	if err != nil {
-		resp.Diagnostics.AddError("error.", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error.", err)...)
 		return
 	}
 
 	accountList, err := GetListOfUserAccounts(config.Service)
 	if err != nil {
-		resp.Diagnostics.AddError("error.", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error.", err)...)
 		return
 	}
 
 	// check if username is free to use
 	err = CheckIsUsernameTaken(accountList, userName)
 	if err != nil {
-		resp.Diagnostics.AddError("error.", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error.", err)...)
 		return
 	}
 
 	// check if user id already exists
 	err = CheckUserIDExistence(accountList, userId)
 	if err != nil {
-		resp.Diagnostics.AddError("error.", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error.", err)...)
 		return
 	}
 	createPayload, err := InitializeUserAccountRedfishRequest(plan, Create, isFsas)
 	if err != nil {
-		resp.Diagnostics.AddError("error.", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error.", err)...)
 		return
 	}
 
 	url := USER_ACCOUNT_ENDPOINT
 	respPost, err := config.Post(url, createPayload)
 	if err != nil {
-		resp.Diagnostics.AddError("error. creating HTTP request: %v", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error. creating HTTP request: %v", err)...)
 		return
 	}
 
@@ -337,20 +467,51 @@ func (r *IrmcUserAccountResource) Create(ctx context.Context, req resource.Creat
 
 	accountList, err = GetListOfUserAccounts(config.Service)
 	if err != nil {
-		resp.Diagnostics.AddError("error.", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error.", err)...)
 		return
 	}
 
 	userId, err = FindUserIDByName(accountList, userName)
 	if err != nil {
-		resp.Diagnostics.AddError("error.", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error.", err)...)
 		return
 	}
 	plan.UserID = types.StringValue(userId)
 	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", USER_ACCOUNT_ENDPOINT, userId))
+
+	plan.UserReportedPermissions = types.MapNull(types.BoolType)
+	account, err := getJsonObject(config, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error. Not able to read created Redfish user account", err)...)
+		return
+	}
+
+	var oemKey string
+	if isFsas {
+		oemKey = FSAS
+	} else {
+		oemKey = TS_FUJITSU
+	}
+
+	if oem, ok := account["Oem"].(map[string]interface{}); ok {
+		if oemData, ok := oem[oemKey].(map[string]interface{}); ok {
+			if permissions, ok := oemData["Permissions"].(map[string]interface{}); ok {
+				if extended, ok := permissions["Extended"].(map[string]interface{}); ok {
+					readUserExtendedPermissions(extended, &plan)
+				}
+			}
+		}
+	}
+
+	// Password defined only by user_password_wo leaves computed user_password unknown
+	if plan.UserPassword.IsNull() || plan.UserPassword.IsUnknown() || plan.UserPassword.ValueString() == "" {
+		plan.UserPassword = types.StringNull()
+	}
+
 	// Save into State
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, plan.RedfishServer), plan.UserID.ValueString())...)
 	tflog.Info(ctx, "resource-user-account: create ends")
 
 }
@@ -366,14 +527,14 @@ func (r *IrmcUserAccountResource) Read(ctx context.Context, req resource.ReadReq
 
 	config, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connect Target System Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
 		return
 	}
-	defer config.Logout()
+	defer ReleaseTargetSystem(config)
 
 	isFsas, err := IsFsasCheck(ctx, config)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 
@@ -387,7 +548,7 @@ func (r *IrmcUserAccountResource) Read(ctx context.Context, req resource.ReadReq
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading Redfish user account", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error reading Redfish user account", err)...)
 		return
 	}
 
@@ -396,7 +557,7 @@ func (r *IrmcUserAccountResource) Read(ctx context.Context, req resource.ReadReq
 	var data map[string]interface{}
 	err = json.NewDecoder(respGet.Body).Decode(&data)
 	if err != nil {
-		resp.Diagnostics.AddError("Error decoding JSON from Redfish user account response", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error decoding JSON from Redfish user account response", err)...)
 		return
 	}
 
@@ -452,18 +613,42 @@ func (r *IrmcUserAccountResource) Read(ctx context.Context, req resource.ReadReq
 					if val, ok := extended["UseRemoteStorage"].(bool); ok {
 						state.UserEnabledRemoteStorage = types.BoolValue(val)
 					}
+					readUserExtendedPermissions(extended, &state)
 				}
 			}
 			if email, ok := oemData["Email"].(map[string]interface{}); ok {
 				if val, ok := email["AlertChassisEventsUser"].(bool); ok {
 					state.UserEnabledAlertChassisEvents = types.BoolValue(val)
 				}
+				readUserAlertEmail(email, &state)
 			}
+			state.UserSSHPublicKeys = readUserSSHPublicKeys(oemData, state.UserSSHPublicKeys)
+		}
+	}
+
+	if state.UserReportedPermissions.IsUnknown() {
+		state.UserReportedPermissions = types.MapNull(types.BoolType)
+	}
+
+	if state.VerifyPasswordOnRead.IsNull() || state.VerifyPasswordOnRead.IsUnknown() {
+		state.VerifyPasswordOnRead = types.BoolValue(false)
+	}
+
+	// Password is not readable, so its drift is detected by login attempt of the user
+	if state.VerifyPasswordOnRead.ValueBool() && state.UserPassword.ValueString() != "" &&
+		state.UserEnabled.ValueBool() && state.UserRedfishEnabled.ValueBool() {
+		valid, err := verifyUserAccountLogin(r.p, state.RedfishServer, state.UserUsername.ValueString(), state.UserPassword.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning("Password of user account could not be verified", err.Error())
+		} else if !valid {
+			tflog.Warn(ctx, fmt.Sprintf("Login of user '%s' failed, password will be updated.", state.UserUsername.ValueString()))
+			state.UserPassword = types.StringNull()
 		}
 	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, state.RedfishServer), state.UserID.ValueString())...)
 
 	tflog.Info(ctx, "resource-user-account: read ends")
 
@@ -475,6 +660,7 @@ func (r *IrmcUserAccountResource) Update(ctx context.Context, req resource.Updat
 	var state models.IrmcUserAccountResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, state.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -482,20 +668,26 @@ func (r *IrmcUserAccountResource) Update(ctx context.Context, req resource.Updat
 	var plan models.IrmcUserAccountResourceModel
 	diags = req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_password_wo"), &plan.UserPasswordWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Write-only password is sent only if its version has been changed
+	if plan.UserPasswordWOVersion.Equal(state.UserPasswordWOVersion) {
+		plan.UserPasswordWO = types.StringNull()
+	}
+
 	config, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connect Target System Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
 		return
 	}
-	defer config.Logout()
+	defer ReleaseTargetSystem(config)
 
 	isFsas, err := IsFsasCheck(ctx, config)
 	if err != nil {
-		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
 		return
 	}
 
@@ -505,43 +697,27 @@ func (r *IrmcUserAccountResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	userPassword := plan.UserPassword.ValueString()
+	userPassword := userAccountPassword(plan)
 	if userPassword != "" {
 		err = CheckPasswordValidation(userPassword)
 		if err != nil {
-			resp.Diagnostics.AddError("Password validation failed", err.Error())
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Password validation failed", err)...)
 			return
 		}
 	}
 
 	updatePayload, err := InitializeUserAccountRedfishRequest(plan, Update, isFsas)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to initialize update payload", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Failed to initialize update payload", err)...)
 		return
 	}
 
 	url := fmt.Sprintf("%s/%s", USER_ACCOUNT_ENDPOINT, userID)
 	tflog.Debug(ctx, fmt.Sprintf("Update URL: %s", url))
 
-	respGet, err := config.Get(url)
+	respPatch, err := PatchWithEtag(config, url, updatePayload)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Redfish user account", err.Error())
-		return
-	}
-
-	defer CloseResource(respGet.Body)
-
-	etag := respGet.Header.Get(HTTP_HEADER_ETAG)
-	if etag == "" {
-		resp.Diagnostics.AddError("Missing ETag", "ETag header is missing in the GET response")
-		return
-	}
-
-	respPatch, err := config.PatchWithHeaders(url, updatePayload, map[string]string{
-		HTTP_HEADER_IF_MATCH: etag,
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Error sending PATCH request", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error sending PATCH request", err)...)
 		return
 	}
 
@@ -551,9 +727,10 @@ func (r *IrmcUserAccountResource) Update(ctx context.Context, req resource.Updat
 		resp.Diagnostics.AddError("User Account Update PATCH request failed", fmt.Sprintf("Received status code: %d", respPatch.StatusCode))
 		return
 	}
-	respGet, err = config.Get(url)
+
+	respGet, err := config.Get(url)
 	if err != nil {
-		resp.Diagnostics.AddError("error. Not able to read updated Redfish user account", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error. Not able to read updated Redfish user account", err)...)
 		return
 	}
 
@@ -570,7 +747,7 @@ func (r *IrmcUserAccountResource) Update(ctx context.Context, req resource.Updat
 	var data map[string]interface{}
 	err = json.NewDecoder(respGet.Body).Decode(&data)
 	if err != nil {
-		resp.Diagnostics.AddError("error. Decoding JSON from Redfish user account response failed", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("error. Decoding JSON from Redfish user account response failed", err)...)
 		return
 	}
 
@@ -626,20 +803,27 @@ func (r *IrmcUserAccountResource) Update(ctx context.Context, req resource.Updat
 					if val, ok := extended["UseRemoteStorage"].(bool); ok {
 						plan.UserEnabledRemoteStorage = types.BoolValue(val)
 					}
+					readUserExtendedPermissions(extended, &plan)
 				}
 			}
 			if email, ok := oemData["Email"].(map[string]interface{}); ok {
 				if val, ok := email["AlertChassisEventsUser"].(bool); ok {
 					plan.UserEnabledAlertChassisEvents = types.BoolValue(val)
 				}
+				readUserAlertEmail(email, &plan)
 			}
+			plan.UserSSHPublicKeys = readUserSSHPublicKeys(oemData, plan.UserSSHPublicKeys)
 		}
 	}
+	if plan.UserReportedPermissions.IsUnknown() {
+		plan.UserReportedPermissions = types.MapNull(types.BoolType)
+	}
 	plan.UserID = state.UserID
 	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", USER_ACCOUNT_ENDPOINT, userID))
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, plan.RedfishServer), plan.UserID.ValueString())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -659,10 +843,10 @@ func (r *IrmcUserAccountResource) Delete(ctx context.Context, req resource.Delet
 
 	config, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Connect Target System Error", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Service Connect Target System Error", err)...)
 		return
 	}
-	defer config.Logout()
+	defer ReleaseTargetSystem(config)
 
 	userID := state.UserID.ValueString()
 	if userID == "" {
@@ -674,7 +858,7 @@ func (r *IrmcUserAccountResource) Delete(ctx context.Context, req resource.Delet
 
 	respDelete, err := config.Delete(url)
 	if err != nil {
-		resp.Diagnostics.AddError("Error sending DELETE request", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error sending DELETE request", err)...)
 		return
 	}
 
@@ -694,21 +878,28 @@ func (r *IrmcUserAccountResource) ImportState(ctx context.Context, req resource.
 	tflog.Info(ctx, "resource-user_account: import starts")
 
 	var config userAccountImportConfig
-
-	err := json.Unmarshal([]byte(req.ID), &config)
-	if err != nil {
-		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
-	}
-
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
+	var server models.RedfishServer
+
+	if len(req.ID) == 0 {
+		// import block with identity
+		var diags diag.Diagnostics
+		server, config.UserID, diags = readImportIdentity(ctx, req.Identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		var err error
+		server, err = parseImportID(req.ID, &config, &config.UserID)
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling id", err)...)
+			return
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), config.UserID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server"), []models.RedfishServer{server})...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, server.Endpoint.ValueString(), config.UserID)...)
 
 	tflog.Info(ctx, "resource-user_account: import ends")
 }
@@ -823,11 +1014,19 @@ func InitializeUserAccountRedfishRequest(plan models.IrmcUserAccountResourceMode
 		},
 	}
 
+	addUserAlertEmailToPayload(plan, oemPayload["Email"].(map[string]interface{}))
+	addUserExtendedPermissionsToPayload(plan, oemPayload["Permissions"].(map[string]interface{})["Extended"].(map[string]interface{}))
+
+	// keys are sent only if managed by the resource
+	if !plan.UserSSHPublicKeys.IsNull() && !plan.UserSSHPublicKeys.IsUnknown() {
+		oemPayload[USER_SSH_PUBLIC_KEYS] = userSSHPublicKeys(plan.UserSSHPublicKeys)
+	}
+
 	switch redfishMethod {
 	case Create:
 		redfishRequest := map[string]interface{}{
 			"UserName": plan.UserUsername.ValueString(),
-			"Password": plan.UserPassword.ValueString(),
+			"Password": userAccountPassword(plan),
 			"RoleId":   plan.UserRole.ValueString(),
 			"Enabled":  plan.UserEnabled.ValueBool(),
 			"Oem":      map[string]interface{}{oemKey: oemPayload},
@@ -841,8 +1040,8 @@ func InitializeUserAccountRedfishRequest(plan models.IrmcUserAccountResourceMode
 			"RoleId":   plan.UserRole.ValueString(),
 			"Oem":      map[string]interface{}{oemKey: oemPayload},
 		}
-		if !plan.UserPassword.IsNull() && !plan.UserPassword.IsUnknown() && plan.UserPassword.ValueString() != "" {
-			redfishRequest["Password"] = plan.UserPassword.ValueString()
+		if password := userAccountPassword(plan); password != "" {
+			redfishRequest["Password"] = password
 		}
 		return redfishRequest, nil
 	}
@@ -851,6 +1050,210 @@ func InitializeUserAccountRedfishRequest(plan models.IrmcUserAccountResourceMode
 
 }
 
+// userAccountPassword returns password of the user defined either by user_password or user_password_wo.
+func userAccountPassword(plan models.IrmcUserAccountResourceModel) string {
+	if !plan.UserPassword.IsNull() && !plan.UserPassword.IsUnknown() && plan.UserPassword.ValueString() != "" {
+		return plan.UserPassword.ValueString()
+	}
+
+	return plan.UserPasswordWO.ValueString()
+}
+
+// verifyUserAccountLogin tries to create Redfish session as the user with given password.
+// It returns false if iRMC rejects the credentials, session is logged out immediately otherwise.
+func verifyUserAccountLogin(pconfig *IrmcProvider, rserver []models.RedfishServer, username string, password string) (bool, error) {
+	server := resolveRedfishServer(pconfig, rserver)
+	client := newRedfishHttpClient(pconfig, server.SslInsecure.ValueBool())
+	sessionsURL := strings.TrimSuffix(server.Endpoint.ValueString(), "/") + SESSIONS_ENDPOINT
+
+	payload, err := json.Marshal(map[string]string{
+		"UserName": username,
+		"Password": password,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, sessionsURL, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("login of user '%s' failed: %w", username, err)
+	}
+	CloseResource(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return false, nil
+	default:
+		return false, fmt.Errorf("login of user '%s' finished with status code %d", username, res.StatusCode)
+	}
+
+	location := res.Header.Get(HTTP_HEADER_LOCATION)
+	if len(location) == 0 {
+		return true, nil
+	}
+
+	if !strings.HasPrefix(location, "http") {
+		location = strings.TrimSuffix(server.Endpoint.ValueString(), "/") + location
+	}
+	req, err = http.NewRequest(http.MethodDelete, location, nil)
+	if err != nil {
+		return true, nil
+	}
+	req.Header.Set(HTTP_HEADER_AUTH_TOKEN, res.Header.Get(HTTP_HEADER_AUTH_TOKEN))
+	if res, err := client.Do(req); err == nil {
+		CloseResource(res.Body)
+	}
+
+	return true, nil
+}
+
+// addUserAlertEmailToPayload adds alert email settings managed by the resource to Email part of OEM payload.
+func addUserAlertEmailToPayload(plan models.IrmcUserAccountResourceModel, email map[string]interface{}) {
+	if !plan.UserAlertEmailEnabled.IsNull() && !plan.UserAlertEmailEnabled.IsUnknown() {
+		email[USER_EMAIL_ENABLED] = plan.UserAlertEmailEnabled.ValueBool()
+	}
+	if !plan.UserAlertEmailAddress.IsNull() && !plan.UserAlertEmailAddress.IsUnknown() {
+		email[USER_EMAIL_ADDRESS] = plan.UserAlertEmailAddress.ValueString()
+	}
+	if !plan.UserAlertMailFormat.IsNull() && !plan.UserAlertMailFormat.IsUnknown() {
+		email[USER_EMAIL_MAIL_FORMAT] = plan.UserAlertMailFormat.ValueString()
+	}
+	if !plan.UserAlertLevels.IsNull() && !plan.UserAlertLevels.IsUnknown() {
+		alerts := map[string]interface{}{}
+		for group, level := range plan.UserAlertLevels.Elements() {
+			if val, ok := level.(types.String); ok {
+				alerts[group] = val.ValueString()
+			}
+		}
+		email[USER_EMAIL_ALERTS] = alerts
+	}
+}
+
+// readUserAlertEmail updates alert email settings managed by the resource (not null in model)
+// using Email part of OEM account data.
+func readUserAlertEmail(email map[string]interface{}, model *models.IrmcUserAccountResourceModel) {
+	if val, ok := email[USER_EMAIL_ENABLED].(bool); ok && !model.UserAlertEmailEnabled.IsNull() {
+		model.UserAlertEmailEnabled = types.BoolValue(val)
+	}
+	if val, ok := email[USER_EMAIL_ADDRESS].(string); ok && !model.UserAlertEmailAddress.IsNull() {
+		model.UserAlertEmailAddress = types.StringValue(val)
+	}
+	if val, ok := email[USER_EMAIL_MAIL_FORMAT].(string); ok && !model.UserAlertMailFormat.IsNull() {
+		model.UserAlertMailFormat = types.StringValue(val)
+	}
+
+	alerts, ok := email[USER_EMAIL_ALERTS].(map[string]interface{})
+	if !ok || model.UserAlertLevels.IsNull() || model.UserAlertLevels.IsUnknown() {
+		return
+	}
+
+	// only groups defined in configuration are reported
+	levels := map[string]attr.Value{}
+	for group, level := range model.UserAlertLevels.Elements() {
+		if val, ok := alerts[group].(string); ok {
+			levels[group] = types.StringValue(val)
+		} else {
+			levels[group] = level
+		}
+	}
+	model.UserAlertLevels = types.MapValueMust(types.StringType, levels)
+}
+
+// addUserExtendedPermissionsToPayload adds extended permissions defined by user_extended_permissions to Extended part of OEM payload.
+func addUserExtendedPermissionsToPayload(plan models.IrmcUserAccountResourceModel, extended map[string]interface{}) {
+	if plan.UserExtendedPermissions.IsNull() || plan.UserExtendedPermissions.IsUnknown() {
+		return
+	}
+
+	for name, value := range plan.UserExtendedPermissions.Elements() {
+		if enabled, ok := value.(types.Bool); ok && !enabled.IsUnknown() && !enabled.IsNull() {
+			extended[name] = enabled.ValueBool()
+		}
+	}
+}
+
+// readUserExtendedPermissions reads extended permissions reported by iRMC in OEM part of the account.
+// All boolean permissions are reported by user_reported_extended_permissions, while only permissions
+// defined in configuration are read into user_extended_permissions.
+func readUserExtendedPermissions(extended map[string]interface{}, model *models.IrmcUserAccountResourceModel) {
+	reported := map[string]attr.Value{}
+	for name, value := range extended {
+		if enabled, ok := value.(bool); ok {
+			reported[name] = types.BoolValue(enabled)
+		}
+	}
+	model.UserReportedPermissions = types.MapValueMust(types.BoolType, reported)
+
+	if model.UserExtendedPermissions.IsNull() || model.UserExtendedPermissions.IsUnknown() {
+		return
+	}
+
+	managed := map[string]attr.Value{}
+	for name, value := range model.UserExtendedPermissions.Elements() {
+		if enabled, ok := reported[name]; ok {
+			managed[name] = enabled
+		} else {
+			managed[name] = value
+		}
+	}
+	model.UserExtendedPermissions = types.MapValueMust(types.BoolType, managed)
+}
+
+// userSSHPublicKeys returns SSH public keys from list attribute with surrounding whitespaces removed.
+func userSSHPublicKeys(list types.List) []string {
+	keys := []string{}
+	for _, elem := range list.Elements() {
+		if key, ok := elem.(types.String); ok {
+			keys = append(keys, strings.TrimSpace(key.ValueString()))
+		}
+	}
+
+	return keys
+}
+
+// readUserSSHPublicKeys returns SSH public keys reported by iRMC in OEM part of the account.
+// If keys are not managed by the resource (current is null), current value is kept.
+func readUserSSHPublicKeys(oemData map[string]interface{}, current types.List) types.List {
+	if current.IsNull() {
+		return current
+	}
+
+	values, ok := oemData[USER_SSH_PUBLIC_KEYS].([]interface{})
+	if !ok {
+		return current
+	}
+
+	// keep keys as defined in configuration if they differ only in surrounding whitespaces
+	configured := map[string]attr.Value{}
+	if !current.IsUnknown() {
+		for _, elem := range current.Elements() {
+			if key, ok := elem.(types.String); ok {
+				configured[strings.TrimSpace(key.ValueString())] = key
+			}
+		}
+	}
+
+	keys := []attr.Value{}
+	for _, value := range values {
+		if key, ok := value.(string); ok {
+			if configuredKey, found := configured[strings.TrimSpace(key)]; found {
+				keys = append(keys, configuredKey)
+			} else {
+				keys = append(keys, types.StringValue(key))
+			}
+		}
+	}
+
+	return types.ListValueMust(types.StringType, keys)
+}
+
 func FindUserIDByName(accounts []*redfish.ManagerAccount, targetUserName string) (string, error) {
 	for _, acc := range accounts {
 		if acc.UserName == targetUserName {
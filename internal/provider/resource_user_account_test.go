@@ -19,13 +19,23 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strconv"
+	"sync/atomic"
 	"testing"
 
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stmcginnis/gofish"
 )
 
@@ -233,6 +243,226 @@ func TestAccRedfishUserAccount_ImportUser_fail(t *testing.T) {
 	})
 }
 
+func TestAccRedfishUserAccount_writeOnlyPassword(t *testing.T) {
+	userID := getHighestUserID(creds)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceUserAccountWriteOnlyConfig(creds, userID, "Test_password123!", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userResourceName, "user_username", "test_user_wo"),
+					resource.TestCheckResourceAttr(userResourceName, "user_id", userID),
+					resource.TestCheckNoResourceAttr(userResourceName, "user_password"),
+					resource.TestCheckNoResourceAttr(userResourceName, "user_password_wo"),
+					testAccCheckUserAccountLogin(creds, "test_user_wo", "Test_password123!"),
+					resource.TestCheckNoResourceAttr(userResourceName, "server.0.password_wo"),
+					resource.TestCheckResourceAttr(userResourceName, "user_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceUserAccountWriteOnlyConfig(creds, userID, "Test_password456!", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(userResourceName, "user_password_wo"),
+					resource.TestCheckResourceAttr(userResourceName, "user_password_wo_version", "2"),
+					testAccCheckUserAccountLogin(creds, "test_user_wo", "Test_password456!"),
+				),
+			},
+		},
+	})
+}
+
+func TestReadUserSSHPublicKeys(t *testing.T) {
+	key := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMRtE3iRnGbTuvFh1nO0oDxdC1sJJ1Lgt0mDN+d3HT6q user@host"
+	oemData := map[string]interface{}{
+		USER_SSH_PUBLIC_KEYS: []interface{}{key},
+	}
+
+	unmanaged := readUserSSHPublicKeys(oemData, types.ListNull(types.StringType))
+	if !unmanaged.IsNull() {
+		t.Errorf("expected keys not to be managed, got %s", unmanaged.String())
+	}
+
+	configured := types.ListValueMust(types.StringType, []attr.Value{types.StringValue(key + " ")})
+	keys := readUserSSHPublicKeys(oemData, configured)
+	if !keys.Equal(configured) {
+		t.Errorf("expected %s, got %s", configured.String(), keys.String())
+	}
+
+	keys = readUserSSHPublicKeys(map[string]interface{}{USER_SSH_PUBLIC_KEYS: []interface{}{}}, configured)
+	if len(keys.Elements()) != 0 {
+		t.Errorf("expected no keys, got %s", keys.String())
+	}
+
+	if !sshPublicKeyRegex.MatchString(key) || sshPublicKeyRegex.MatchString("AAAAC3NzaC1lZDI1NTE5") {
+		t.Errorf("unexpected result of SSH public key validation")
+	}
+}
+
+func TestUserAlertEmail(t *testing.T) {
+	plan := models.IrmcUserAccountResourceModel{
+		UserAlertEmailEnabled: types.BoolValue(true),
+		UserAlertEmailAddress: types.StringNull(),
+		UserAlertMailFormat:   types.StringValue("SMS"),
+		UserAlertLevels: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"FanSensors": types.StringValue("Critical"),
+		}),
+	}
+
+	email := map[string]interface{}{}
+	addUserAlertEmailToPayload(plan, email)
+	if _, ok := email[USER_EMAIL_ADDRESS]; ok {
+		t.Errorf("unmanaged email address should not be sent")
+	}
+	if email[USER_EMAIL_ENABLED] != true || email[USER_EMAIL_MAIL_FORMAT] != "SMS" {
+		t.Errorf("unexpected payload %v", email)
+	}
+
+	readUserAlertEmail(map[string]interface{}{
+		USER_EMAIL_ENABLED:     false,
+		USER_EMAIL_ADDRESS:     "admin@example.com",
+		USER_EMAIL_MAIL_FORMAT: "Standard",
+		USER_EMAIL_ALERTS: map[string]interface{}{
+			"FanSensors":         "All",
+			"TemperatureSensors": "Warning",
+		},
+	}, &plan)
+
+	if plan.UserAlertEmailEnabled.ValueBool() || plan.UserAlertMailFormat.ValueString() != "Standard" {
+		t.Errorf("managed settings were not read back")
+	}
+	if !plan.UserAlertEmailAddress.IsNull() {
+		t.Errorf("unmanaged email address should stay null")
+	}
+
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"FanSensors": types.StringValue("All"),
+	})
+	if !plan.UserAlertLevels.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected.String(), plan.UserAlertLevels.String())
+	}
+}
+
+func TestUserExtendedPermissions(t *testing.T) {
+	plan := models.IrmcUserAccountResourceModel{
+		UserExtendedPermissions: types.MapValueMust(types.BoolType, map[string]attr.Value{
+			"UseRemoteKvm": types.BoolValue(false),
+		}),
+	}
+
+	extended := map[string]interface{}{"ConfigureUsers": true}
+	addUserExtendedPermissionsToPayload(plan, extended)
+	if extended["UseRemoteKvm"] != false || extended["ConfigureUsers"] != true {
+		t.Errorf("unexpected payload %v", extended)
+	}
+
+	readUserExtendedPermissions(map[string]interface{}{
+		"ConfigureUsers": true,
+		"UseRemoteKvm":   true,
+		"UsePowerCtrl":   false,
+	}, &plan)
+
+	expected := types.MapValueMust(types.BoolType, map[string]attr.Value{
+		"UseRemoteKvm": types.BoolValue(true),
+	})
+	if !plan.UserExtendedPermissions.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected.String(), plan.UserExtendedPermissions.String())
+	}
+	if len(plan.UserReportedPermissions.Elements()) != 3 {
+		t.Errorf("expected 3 reported permissions, got %s", plan.UserReportedPermissions.String())
+	}
+}
+
+func TestVerifyUserAccountLogin(t *testing.T) {
+	var logouts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			atomic.AddInt32(&logouts, 1)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["UserName"] != "operator" || body["Password"] != "Secret_password1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set(HTTP_HEADER_AUTH_TOKEN, "token")
+		w.Header().Set(HTTP_HEADER_LOCATION, SESSIONS_ENDPOINT+"/5")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	pconfig := &IrmcProvider{Endpoint: server.URL}
+
+	valid, err := verifyUserAccountLogin(pconfig, nil, "operator", "Secret_password1")
+	if err != nil || !valid {
+		t.Errorf("Got valid=%t, error '%v', expected successful login", valid, err)
+	}
+	if logouts != 1 {
+		t.Errorf("Got %d logouts, expected 1", logouts)
+	}
+
+	valid, err = verifyUserAccountLogin(pconfig, nil, "operator", "Changed_password1")
+	if err != nil || valid {
+		t.Errorf("Got valid=%t, error '%v', expected rejected login", valid, err)
+	}
+}
+
+// testAccCheckUserAccountLogin checks that user can log in to iRMC with password, which is not stored in state.
+func testAccCheckUserAccountLogin(testingInfo TestingServerCredentials, username string, password string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		server := []models.RedfishServer{{
+			Endpoint:    types.StringValue("https://" + testingInfo.Endpoint),
+			SslInsecure: types.BoolValue(true),
+		}}
+
+		valid, err := verifyUserAccountLogin(nil, server, username, password)
+		if err != nil {
+			return err
+		}
+
+		if !valid {
+			return fmt.Errorf("user '%s' can not log in with password defined by user_password_wo", username)
+		}
+
+		return nil
+	}
+}
+
+func testAccRedfishResourceUserAccountWriteOnlyConfig(testingInfo TestingServerCredentials, userID string,
+	password string, passwordVersion int) string {
+	return fmt.Sprintf(`
+	provider "irmc-redfish" {
+		username = "%s"
+		password = "%s"
+	}
+
+	resource "irmc-redfish_user_account" "ua" {
+		server {
+			password_wo  = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+		user_id                  = "%s"
+		user_username            = "test_user_wo"
+		user_password_wo         = "%s"
+		user_password_wo_version = %d
+	}`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		userID,
+		password,
+		passwordVersion,
+	)
+}
+
 func testAccRedfishResourceUserAccountConfig(
 	testingInfo TestingServerCredentials,
 	userID string,
@@ -26,10 +26,16 @@ import (
 
 	"terraform-provider-irmc-redfish/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -48,9 +54,22 @@ const (
 
 const VMEDIA_ENDPOINT = "/redfish/v1/Managers/iRMC/VirtualMedia/"
 
+// Types of virtual media devices, CD devices are used for .iso images and HD devices for .img images.
+const (
+	VMEDIA_TYPE_CD = "CD"
+	VMEDIA_TYPE_HD = "HD"
+)
+
+const (
+	VMEDIA_MOUNT_TIMEOUT          = 60
+	VMEDIA_MOUNT_INITIAL_INTERVAL = 1 * time.Second
+	VMEDIA_MOUNT_MAX_INTERVAL     = 10 * time.Second
+)
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &VirtualMediaResource{}
 var _ resource.ResourceWithImportState = &VirtualMediaResource{}
+var _ resource.ResourceWithIdentity = &VirtualMediaResource{}
 
 func NewVirtualMediaResource() resource.Resource {
 	return &VirtualMediaResource{}
@@ -87,12 +106,89 @@ func VirtualMediaSchema() map[string]schema.Attribute {
 			MarkdownDescription: "Indicates protocol on which the transfer will be done.",
 			Description:         "Indicates protocol on which the transfer will be done.",
 			Validators: []validator.String{
-				stringvalidator.OneOf([]string{"CIFS", "HTTPS", "NFS"}...),
+				stringvalidator.OneOf([]string{"CIFS", "HTTP", "HTTPS", "NFS"}...),
+			},
+		},
+		"user_name": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "User name used to access the remote media share (e.g. authenticated CIFS share).",
+			Description:         "User name used to access the remote media share (e.g. authenticated CIFS share).",
+		},
+		"password": schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			MarkdownDescription: "Password used to access the remote media share.",
+			Description:         "Password used to access the remote media share.",
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("user_name")),
+				stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+			},
+		},
+		"password_wo": schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			MarkdownDescription: "Password used to access the remote media share, which is not persisted in Terraform state (requires Terraform 1.11 or later). It is used only when media is mounted.",
+			Description:         "Password used to access the remote media share, which is not persisted in Terraform state (requires Terraform 1.11 or later). It is used only when media is mounted.",
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("user_name")),
+			},
+		},
+		"mount_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(VMEDIA_MOUNT_TIMEOUT),
+			MarkdownDescription: "Timeout in seconds for media to be mounted (default 60s). Large images accessed over slow links might require longer time.",
+			Description:         "Timeout in seconds for media to be mounted (default 60s). Large images accessed over slow links might require longer time.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(5),
+			},
+		},
+		"slot": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "ID of virtual media device (e.g. '0', '2') into which media is mounted. If not defined, first free device of requested media_type is used.",
+			Description:         "ID of virtual media device (e.g. '0', '2') into which media is mounted. If not defined, first free device of requested media_type is used.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"media_type": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Type of virtual media device used for mounting ('CD' or 'HD'). If not defined, it is derived from image extension (.iso - CD, .img - HD).",
+			Description:         "Type of virtual media device used for mounting ('CD' or 'HD'). If not defined, it is derived from image extension (.iso - CD, .img - HD).",
+			Validators: []validator.String{
+				stringvalidator.OneOf(VMEDIA_TYPE_CD, VMEDIA_TYPE_HD),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"write_protected": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			MarkdownDescription: "Indicates whether media is mounted as write protected (default true). Change of the value causes media to be mounted again.",
+			Description:         "Indicates whether media is mounted as write protected (default true). Change of the value causes media to be mounted again.",
+		},
+		"share_domain": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Domain of the user used to access CIFS share. It is sent together with user name as '<share_domain>\\<user_name>'.",
+			Description:         "Domain of the user used to access CIFS share. It is sent together with user name as '<share_domain>\\<user_name>'.",
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("user_name")),
 			},
 		},
 	}
 }
 
+func (r *VirtualMediaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = ResourceIdentitySchema("Odata ID of the virtual media.")
+}
+
 func (r *VirtualMediaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The resource is used to control (read, mount, unmount or modify) virtual media on Fujitsu server equipped with iRMC controller.",
@@ -129,32 +225,22 @@ func (r *VirtualMediaResource) Create(ctx context.Context, req resource.CreateRe
 	var plan models.VirtualMediaResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-virtual_media"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
 
-	// Validate required image and define under which index it could be tried to be mounted
-	image := plan.Image.ValueString()
-	var imageType = IMAGE_TYPE_UNKNOWN
-	redfish_index := "0"
-	if strings.HasSuffix(image, ".iso") {
-		imageType = IMAGE_TYPE_ISO
-		redfish_index = "0"
-	} else {
-		if strings.HasSuffix(image, ".img") {
-			imageType = IMAGE_TYPE_IMG
-			redfish_index = "1"
-		}
-	}
-
-	if imageType == IMAGE_TYPE_UNKNOWN {
-		resp.Diagnostics.AddError("Image type format is not supported", "Only .iso and .img formats are supported")
+	// Validate required image and define type of device into which it could be tried to be mounted
+	mediaType := getVirtualMediaType(plan.MediaType, plan.Image.ValueString())
+	if len(mediaType) == 0 {
+		resp.Diagnostics.AddError("Image type format is not supported", "Only .iso and .img formats are supported, otherwise media_type must be defined")
 		return
 	}
 
@@ -167,38 +253,36 @@ func (r *VirtualMediaResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	defer env.client.Logout()
+	defer ReleaseTargetSystem(env.client)
 
 	// Construct request to insert media
-	virtualMediaConfig := redfish.VirtualMediaConfig{
-		Image:                image,
-		Inserted:             plan.Inserted.ValueBool(),
-		TransferProtocolType: redfish.TransferProtocolType(plan.TransferProtocolType.ValueString()),
-	}
+	virtualMediaConfig := getVirtualMediaConfig(plan)
 
-	// Look for slot corresponding to requested image type
+	// Look for slot corresponding to requested media type
 	service, vmediaCollection := env.client.Service, env.collection
-	for index := range vmediaCollection {
-		if vmediaCollection[index].ID == redfish_index {
+	slot, err := selectVirtualMediaSlot(vmediaCollection, plan.Slot.ValueString(), mediaType)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error: there are no virtual media to mount", err)...)
+		return
+	}
 
-			vmedia, err := InsertMedia(ctx, vmediaCollection[index].ID, vmediaCollection, virtualMediaConfig, service)
-			if err != nil {
-				resp.Diagnostics.AddError("Error while inserting vmedia ", err.Error())
-				return
-			}
+	vmedia, err := InsertMedia(ctx, slot.ID, vmediaCollection, virtualMediaConfig, service, plan.MountTimeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while inserting vmedia ", err)...)
+		return
+	}
 
-			if vmedia != nil {
-				result := r.updateVirtualMediaState(vmedia, plan)
-				diags = resp.State.Set(ctx, &result)
-				resp.Diagnostics.Append(diags...)
-				tflog.Info(ctx, "resource-virtual_media: create ends")
-				return
-			}
-		}
+	if vmedia == nil {
+		resp.Diagnostics.AddError("Error: there are no virtual media to mount", "Please detach media and try again")
+		return
 	}
 
-	resp.Diagnostics.AddError("Error: there are no virtual media to mount", "Please detach media and try again")
+	plan.MediaType = types.StringValue(mediaType)
+	result := r.updateVirtualMediaState(vmedia, plan)
+	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, result.RedfishServer), result.Id.ValueString())...)
+	tflog.Info(ctx, "resource-virtual_media: create ends")
 }
 
 func (r *VirtualMediaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -214,16 +298,16 @@ func (r *VirtualMediaResource) Read(ctx context.Context, req resource.ReadReques
 	// Connect to service
 	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("service error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("service error: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	// Get information about virtual media slot into which the plan has been applied
 	virtualMedia, err := redfish.GetVirtualMedia(api.Service.GetClient(), state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Virtual media does not exist: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Virtual media does not exist: ", err)...)
 		return
 	}
 
@@ -234,6 +318,7 @@ func (r *VirtualMediaResource) Read(ctx context.Context, req resource.ReadReques
 	// Save updated data into Terraform state
 	new_state := r.updateVirtualMediaState(virtualMedia, state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &new_state)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, new_state.RedfishServer), new_state.Id.ValueString())...)
 	tflog.Info(ctx, "resource-virtual_media: read ends")
 }
 
@@ -243,6 +328,7 @@ func (r *VirtualMediaResource) Update(ctx context.Context, req resource.UpdateRe
 	// Read Terraform plan
 	var plan models.VirtualMediaResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -251,74 +337,76 @@ func (r *VirtualMediaResource) Update(ctx context.Context, req resource.UpdateRe
 	var state models.VirtualMediaResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, state.RedfishServer)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Validate required image and define under which index it could be tried to be mounted
-	image := plan.Image.ValueString()
-	var imageType = IMAGE_TYPE_UNKNOWN
-	if strings.HasSuffix(image, ".iso") {
-		imageType = IMAGE_TYPE_ISO
-	} else {
-		if strings.HasSuffix(image, ".img") {
-			imageType = IMAGE_TYPE_IMG
-		}
-	}
-
-	if imageType == IMAGE_TYPE_UNKNOWN {
-		resp.Diagnostics.AddError("Image type format is not supported", "Only .iso and .img formats are supported")
+	// Validate required image, media type of the device can not be changed without replacement
+	if len(getVirtualMediaType(plan.MediaType, plan.Image.ValueString())) == 0 {
+		resp.Diagnostics.AddError("Image type format is not supported", "Only .iso and .img formats are supported, otherwise media_type must be defined")
 		return
 	}
 
 	// Get information about current virtual media setup
 	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Connection to service failed: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Connection to service failed: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	vmedia, err := redfish.GetVirtualMedia(api.Service.GetClient(), state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Virtual media resource does not exist: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Virtual media resource does not exist: ", err)...)
+		return
+	}
+
+	// Change of e.g. mount_timeout does not require media to be mounted again
+	if vmedia.Inserted && !virtualMediaRemountRequired(plan, state) {
+		plan.RedfishServer = state.RedfishServer
+		result := r.updateVirtualMediaState(vmedia, plan)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+		resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, result.RedfishServer), result.Id.ValueString())...)
+		tflog.Info(ctx, "resource-virtual_media: update ends")
 		return
 	}
 
 	if vmedia.Inserted {
 		err = vmedia.EjectMedia()
 		if err != nil {
-			resp.Diagnostics.AddError("Error while ejecting media: ", err.Error())
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Error while ejecting media: ", err)...)
 			return
 		}
 
-		time.Sleep(2 * time.Second)
+		if err = sleepWithContext(ctx, 2*time.Second); err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Virtual media update interrupted after ejecting media", err)...)
+			return
+		}
 	}
 
 	// Construct request to insert media
-	virtualMediaConfig := redfish.VirtualMediaConfig{
-		Image:                image,
-		Inserted:             plan.Inserted.ValueBool(),
-		TransferProtocolType: redfish.TransferProtocolType(plan.TransferProtocolType.ValueString()),
-	}
+	virtualMediaConfig := getVirtualMediaConfig(plan)
 
-	err = vmedia.InsertMediaConfig(virtualMediaConfig)
+	err = insertVirtualMediaConfig(vmedia, virtualMediaConfig)
 	if err != nil {
-		resp.Diagnostics.AddError("Could not mount virtual media ", err.Error())
+		resp.Diagnostics.AddError("Could not mount virtual media ", mountError(err, virtualMediaConfig).Error())
 		return
 	}
 
-	vmedia, err = redfish.GetVirtualMedia(api.Service.GetClient(), state.Id.ValueString())
+	vmedia, err = WaitForMediaSuccessfullyMounted(ctx, api.Service, state.Id.ValueString(), plan.MountTimeout.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Virtual media does not exist ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Could not mount virtual media ", err)...)
 		return
 	}
 
 	// Save updated data into Terraform state
-	result := r.updateVirtualMediaState(vmedia, state)
+	plan.RedfishServer = state.RedfishServer
+	result := r.updateVirtualMediaState(vmedia, plan)
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, getServerEndpoint(r.p, result.RedfishServer), result.Id.ValueString())...)
 	tflog.Info(ctx, "resource-virtual_media: update ends")
 }
 
@@ -336,21 +424,21 @@ func (r *VirtualMediaResource) Delete(ctx context.Context, req resource.DeleteRe
 	// Get information about current virtual media setup
 	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
 	if err != nil {
-		resp.Diagnostics.AddError("Connection to service failed: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Connection to service failed: ", err)...)
 		return
 	}
 
-	defer api.Logout()
+	defer ReleaseTargetSystem(api)
 
 	vmedia, err := redfish.GetVirtualMedia(api.Service.GetClient(), state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Virtual media resource does not exist: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Virtual media resource does not exist: ", err)...)
 		return
 	}
 
 	err = vmedia.EjectMedia()
 	if err != nil {
-		resp.Diagnostics.AddError("Virtual media eject finished with error: ", err.Error())
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Virtual media eject finished with error: ", err)...)
 		return
 	}
 
@@ -365,17 +453,23 @@ func (r *VirtualMediaResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Info(ctx, "resource-virtual_media: import starts")
 
 	var config CommonImportConfig
-	err := json.Unmarshal([]byte(req.ID), &config)
-	if err != nil {
-		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
-		return
-	}
-
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
+	var server models.RedfishServer
+
+	if len(req.ID) == 0 {
+		// import block with identity
+		var diags diag.Diagnostics
+		server, config.ID, diags = readImportIdentity(ctx, req.Identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		var err error
+		server, err = parseImportID(req.ID, &config, &config.ID)
+		if err != nil {
+			resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+			return
+		}
 	}
 
 	creds := []models.RedfishServer{server}
@@ -389,7 +483,7 @@ func (r *VirtualMediaResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
-	defer env.client.Logout()
+	defer ReleaseTargetSystem(env.client)
 
 	// In collection of vmedia from SUT, look for the one which is intended to be imported
 	var vmedia *redfish.VirtualMedia
@@ -405,10 +499,13 @@ func (r *VirtualMediaResource) ImportState(ctx context.Context, req resource.Imp
 	}
 
 	result := r.updateVirtualMediaState(vmedia, models.VirtualMediaResourceModel{
-		RedfishServer: creds,
+		RedfishServer:  creds,
+		MountTimeout:   types.Int64Value(VMEDIA_MOUNT_TIMEOUT),
+		WriteProtected: types.BoolNull(),
 	})
 	diags := resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, server.Endpoint.ValueString(), config.ID)...)
 
 	tflog.Info(ctx, "resource-virtual_media: import ends")
 }
@@ -429,9 +526,195 @@ func (r *VirtualMediaResource) updateVirtualMediaState(response *redfish.Virtual
 		Inserted:             types.BoolValue(response.Inserted),
 		TransferProtocolType: types.StringValue(string(response.TransferProtocolType)),
 		RedfishServer:        plan.RedfishServer,
+		// share credentials are not reported back by iRMC
+		UserName:     plan.UserName,
+		Password:     plan.Password,
+		ShareDomain:  plan.ShareDomain,
+		MountTimeout: plan.MountTimeout,
+		Slot:         types.StringValue(response.ID),
+		MediaType:    virtualMediaStateType(response, plan),
+		// older firmware does not report write protection, so configured value is kept
+		WriteProtected: virtualMediaStateWriteProtected(response, plan),
 	}
 }
 
+// virtualMediaStateWriteProtected returns write protection reported in state of the resource.
+func virtualMediaStateWriteProtected(vmedia *redfish.VirtualMedia, plan models.VirtualMediaResourceModel) types.Bool {
+	if plan.WriteProtected.IsNull() || plan.WriteProtected.IsUnknown() {
+		return types.BoolValue(vmedia.WriteProtected)
+	}
+
+	return plan.WriteProtected
+}
+
+// getImageType returns type of image based on its extension.
+func getImageType(image string) VmediaImageType {
+	if strings.HasSuffix(image, ".iso") {
+		return IMAGE_TYPE_ISO
+	}
+
+	if strings.HasSuffix(image, ".img") {
+		return IMAGE_TYPE_IMG
+	}
+
+	return IMAGE_TYPE_UNKNOWN
+}
+
+// getVirtualMediaType returns requested type of device. If mediaType is not defined,
+// it is derived from image extension. Empty string is returned, if type can not be determined.
+func getVirtualMediaType(mediaType types.String, image string) string {
+	if !mediaType.IsNull() && !mediaType.IsUnknown() {
+		return mediaType.ValueString()
+	}
+
+	switch getImageType(image) {
+	case IMAGE_TYPE_ISO:
+		return VMEDIA_TYPE_CD
+	case IMAGE_TYPE_IMG:
+		return VMEDIA_TYPE_HD
+	}
+
+	return ""
+}
+
+// getVirtualMediaDeviceType returns type of virtual media device based on media types it supports.
+// Empty string is returned, if device does not report supported media types.
+func getVirtualMediaDeviceType(vmedia *redfish.VirtualMedia) string {
+	for _, mediaType := range vmedia.MediaTypes {
+		switch mediaType {
+		case redfish.CDMediaType, redfish.DVDMediaType:
+			return VMEDIA_TYPE_CD
+		case redfish.USBStickMediaType, redfish.FloppyMediaType:
+			return VMEDIA_TYPE_HD
+		}
+	}
+
+	return ""
+}
+
+// virtualMediaStateType returns media type reported in state of the resource.
+func virtualMediaStateType(vmedia *redfish.VirtualMedia, plan models.VirtualMediaResourceModel) types.String {
+	if deviceType := getVirtualMediaDeviceType(vmedia); len(deviceType) > 0 {
+		return types.StringValue(deviceType)
+	}
+
+	if mediaType := getVirtualMediaType(plan.MediaType, vmedia.Image); len(mediaType) > 0 {
+		return types.StringValue(mediaType)
+	}
+
+	return types.StringNull()
+}
+
+// selectVirtualMediaSlot returns virtual media device into which media of mediaType should be mounted.
+// If slot is defined, exactly this device is used, otherwise first free device of mediaType is selected.
+// Devices, which do not report supported media types, are matched as on older firmware: slot 0 is used
+// for CD and slot 1 for HD.
+func selectVirtualMediaSlot(collection []*redfish.VirtualMedia, slot string, mediaType string) (*redfish.VirtualMedia, error) {
+	matchesType := func(vmedia *redfish.VirtualMedia) bool {
+		deviceType := getVirtualMediaDeviceType(vmedia)
+		if len(deviceType) == 0 {
+			return (mediaType == VMEDIA_TYPE_CD && vmedia.ID == "0") || (mediaType == VMEDIA_TYPE_HD && vmedia.ID == "1")
+		}
+
+		return deviceType == mediaType
+	}
+
+	if len(slot) > 0 {
+		vmedia, err := GetVirtualMedia(slot, collection)
+		if err != nil {
+			return nil, err
+		}
+
+		if vmedia.Inserted {
+			return nil, fmt.Errorf("virtual media %s has already mounted media %s, please detach media and try again", slot, vmedia.Image)
+		}
+
+		if deviceType := getVirtualMediaDeviceType(vmedia); len(deviceType) > 0 && deviceType != mediaType {
+			return nil, fmt.Errorf("virtual media %s is of type %s, but media of type %s has been requested", slot, deviceType, mediaType)
+		}
+
+		return vmedia, nil
+	}
+
+	for _, vmedia := range collection {
+		if matchesType(vmedia) && !vmedia.Inserted {
+			return vmedia, nil
+		}
+	}
+
+	return nil, fmt.Errorf("there is no free virtual media device of type %s, please detach media or increase number of devices (see vmedia_settings resource) and try again", mediaType)
+}
+
+// virtualMediaRemountRequired checks if plan changes attributes, which require media to be mounted again.
+func virtualMediaRemountRequired(plan models.VirtualMediaResourceModel, state models.VirtualMediaResourceModel) bool {
+	return !plan.Image.Equal(state.Image) ||
+		!plan.TransferProtocolType.Equal(state.TransferProtocolType) ||
+		!plan.UserName.Equal(state.UserName) ||
+		!plan.Password.Equal(state.Password) ||
+		!plan.ShareDomain.Equal(state.ShareDomain) ||
+		!plan.WriteProtected.Equal(state.WriteProtected)
+}
+
+// virtualMediaInsertConfig is request to insert media. In contrast to redfish.VirtualMediaConfig,
+// WriteProtected is always sent, since service defaults to write protected media when omitted.
+type virtualMediaInsertConfig struct {
+	redfish.VirtualMediaConfig
+	WriteProtected bool
+}
+
+// insertVirtualMediaConfig sends request to insert media described by config into virtual media device.
+func insertVirtualMediaConfig(vmedia *redfish.VirtualMedia, config virtualMediaInsertConfig) error {
+	if !vmedia.SupportsMediaInsert {
+		return fmt.Errorf("virtual media %s does not support media insert", vmedia.ID)
+	}
+
+	return vmedia.Post(vmedia.ODataID+"/Actions/VirtualMedia.InsertMedia", config)
+}
+
+// getVirtualMediaConfig returns request to insert media described by plan.
+func getVirtualMediaConfig(plan models.VirtualMediaResourceModel) virtualMediaInsertConfig {
+	config := redfish.VirtualMediaConfig{
+		Image:                plan.Image.ValueString(),
+		Inserted:             plan.Inserted.ValueBool(),
+		TransferProtocolType: redfish.TransferProtocolType(plan.TransferProtocolType.ValueString()),
+		UserName:             plan.UserName.ValueString(),
+		Password:             plan.Password.ValueString(),
+	}
+
+	if len(config.Password) == 0 {
+		config.Password = plan.PasswordWO.ValueString()
+	}
+
+	if len(plan.ShareDomain.ValueString()) > 0 {
+		config.UserName = plan.ShareDomain.ValueString() + "\\" + config.UserName
+	}
+
+	writeProtected := true
+	if !plan.WriteProtected.IsNull() && !plan.WriteProtected.IsUnknown() {
+		writeProtected = plan.WriteProtected.ValueBool()
+	}
+
+	return virtualMediaInsertConfig{VirtualMediaConfig: config, WriteProtected: writeProtected}
+}
+
+// mountError extends error reported during media insert with hint, when it was most likely
+// caused by rejected access to the remote share.
+func mountError(err error, config virtualMediaInsertConfig) error {
+	msg := strings.ToLower(err.Error())
+	for _, reason := range []string{"401", "403", "unauthorized", "authentication", "access denied", "permission denied", "logon failure"} {
+		if strings.Contains(msg, reason) {
+			if len(config.UserName) == 0 {
+				return fmt.Errorf("access to remote share of image %s has been denied, share probably requires user_name and password: %w", config.Image, err)
+			}
+
+			return fmt.Errorf("access to remote share of image %s has been denied for user %s, please check user_name, password and share_domain: %w",
+				config.Image, config.UserName, err)
+		}
+	}
+
+	return err
+}
+
 func (r *VirtualMediaResource) GetVirtualMediaEnvironment(rserver *[]models.RedfishServer) (virtualMediaEnvironment, diag.Diagnostics) {
 	var env virtualMediaEnvironment
 	var d diag.Diagnostics
@@ -439,7 +722,7 @@ func (r *VirtualMediaResource) GetVirtualMediaEnvironment(rserver *[]models.Redf
 
 	api, err := ConnectTargetSystem(r.p, rserver)
 	if err != nil {
-		d.AddError("Error while connecting to SUT", err.Error())
+		d.Append(redfishErrorDiagnostics("Error while connecting to SUT", err)...)
 		return env, d
 	}
 
@@ -447,13 +730,13 @@ func (r *VirtualMediaResource) GetVirtualMediaEnvironment(rserver *[]models.Redf
 
 	manager, err = api.Service.Managers()
 	if err != nil {
-		d.AddError("Error when accessing Managers resource", err.Error())
+		d.Append(redfishErrorDiagnostics("Error when accessing Managers resource", err)...)
 		return env, d
 	}
 
 	vmediaCollection, err := manager[0].VirtualMedia()
 	if err != nil {
-		d.AddError("Could not retrieve vmedia collection from redfish API", err.Error())
+		d.Append(redfishErrorDiagnostics("Could not retrieve vmedia collection from redfish API", err)...)
 		return env, d
 	}
 
@@ -474,29 +757,83 @@ func GetVirtualMedia(vmediaID string, vms []*redfish.VirtualMedia) (*redfish.Vir
 	return nil, fmt.Errorf("virtual media with ID %s does not exist", vmediaID)
 }
 
-// WaitForMediaSuccessfullyMounted checks requested endpoint of given service
-// until the endpoint will returned Inserted as true or counter will reach limit.
-func WaitForMediaSuccessfullyMounted(service *gofish.Service, endpoint string) (*redfish.VirtualMedia, error) {
-	cnt := 20 // number of tries every second
-	virtualMedia, err := redfish.GetVirtualMedia(service.GetClient(), endpoint)
-	for cnt > 0 {
+// WaitForMediaSuccessfullyMounted checks requested endpoint of given service with increasing interval
+// until the endpoint reports Inserted as true or timeout (in seconds) is reached. Failures of single
+// reads are tolerated, since iRMC might be busy while media is being connected.
+func WaitForMediaSuccessfullyMounted(ctx context.Context, service *gofish.Service, endpoint string, timeout int64) (*redfish.VirtualMedia, error) {
+	var virtualMedia *redfish.VirtualMedia
+	var lastErr error
+
+	err := taskSupervisor.Poll(ctx, PollOptions{
+		Key:             pollKeyForService(service),
+		Timeout:         time.Duration(timeout) * time.Second,
+		InitialInterval: VMEDIA_MOUNT_INITIAL_INTERVAL,
+		MaxInterval:     VMEDIA_MOUNT_MAX_INTERVAL,
+	}, func(ctx context.Context) (bool, error) {
+		vmedia, err := redfish.GetVirtualMedia(service.GetClient(), endpoint)
 		if err != nil {
-			return nil, fmt.Errorf("%d Could not read media state %s due to %w", cnt, endpoint, err)
+			tflog.Warn(ctx, fmt.Sprintf("Could not read media state %s due to %s", endpoint, err.Error()))
+			lastErr = err
+			return false, nil
 		}
 
-		if virtualMedia.Inserted {
-			break
+		virtualMedia, lastErr = vmedia, nil
+		return vmedia.Inserted, nil
+	})
+
+	if err == nil {
+		return virtualMedia, nil
+	}
+
+	if lastErr != nil {
+		return nil, fmt.Errorf("could not read media state %s: %w (%s)", endpoint, lastErr, err.Error())
+	}
+
+	if reason := getVirtualMediaMountFailureReason(service, endpoint); len(reason) > 0 {
+		return nil, fmt.Errorf("media has not been mounted: %s (%s)", reason, err.Error())
+	}
+
+	return nil, fmt.Errorf("media has not been mounted: %w", err)
+}
+
+// getVirtualMediaMountFailureReason returns reason of failed mount reported by iRMC in status
+// of virtual media resource. Empty string is returned if no reason is available.
+func getVirtualMediaMountFailureReason(service *gofish.Service, endpoint string) string {
+	resp, err := service.GetClient().Get(endpoint)
+	if err != nil {
+		return ""
+	}
+
+	defer CloseResource(resp.Body)
+
+	var data struct {
+		Status struct {
+			Health string
+			State  string
+		}
+		Oem map[string]struct {
+			ErrorMessage string
 		}
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return ""
+	}
 
-		time.Sleep(1 * time.Second)
-		cnt--
+	for _, oem := range data.Oem {
+		if len(oem.ErrorMessage) > 0 {
+			return oem.ErrorMessage
+		}
+	}
 
-		virtualMedia, err = redfish.GetVirtualMedia(service.GetClient(), endpoint)
+	if len(data.Status.Health) > 0 && data.Status.Health != "OK" {
+		return fmt.Sprintf("iRMC reports health '%s' and state '%s'", data.Status.Health, data.Status.State)
 	}
-	return virtualMedia, nil
+
+	return ""
 }
 
-func InsertMedia(ctx context.Context, id string, collection []*redfish.VirtualMedia, config redfish.VirtualMediaConfig, service *gofish.Service) (*redfish.VirtualMedia, error) {
+func InsertMedia(ctx context.Context, id string, collection []*redfish.VirtualMedia, config virtualMediaInsertConfig, service *gofish.Service, timeout int64) (*redfish.VirtualMedia, error) {
 	virtualMedia, err := GetVirtualMedia(id, collection)
 	if err != nil {
 		return nil, fmt.Errorf("virtual media with ID %s does not exist", id)
@@ -507,14 +844,17 @@ func InsertMedia(ctx context.Context, id string, collection []*redfish.VirtualMe
 		return nil, err
 	}
 
-	err = virtualMedia.InsertMediaConfig(config)
+	err = insertVirtualMediaConfig(virtualMedia, config)
 	if err != nil {
-		return nil, fmt.Errorf("could not mount vmedia %s: %w", id, err)
+		return nil, fmt.Errorf("could not mount vmedia %s: %w", id, mountError(err, config))
 	}
 
-	virtualMedia, err = WaitForMediaSuccessfullyMounted(service, virtualMedia.ODataID)
+	virtualMedia, err = WaitForMediaSuccessfullyMounted(ctx, service, virtualMedia.ODataID, timeout)
 	if err != nil {
-		return nil, fmt.Errorf("reading status of selected virtual media finished with error: %w", err)
+		if len(config.UserName) > 0 {
+			return nil, fmt.Errorf("image %s: %w, please check image URI and share credentials", config.Image, err)
+		}
+		return nil, fmt.Errorf("image %s: %w, please check image URI and whether share requires credentials", config.Image, err)
 	}
 
 	return virtualMedia, nil
@@ -18,13 +18,21 @@ limitations under the License.
 package provider
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 const (
@@ -166,6 +174,130 @@ func TestAccRedfishVirtualMedia_NotAllowedExtension(t *testing.T) {
 	})
 }
 
+func TestGetVirtualMediaConfig(t *testing.T) {
+	plan := models.VirtualMediaResourceModel{
+		Image:                types.StringValue("//10.172.181.125/share/image.iso"),
+		TransferProtocolType: types.StringValue("CIFS"),
+		UserName:             types.StringValue("deploy"),
+		Password:             types.StringNull(),
+		PasswordWO:           types.StringValue("secret"),
+		ShareDomain:          types.StringValue("LAB"),
+	}
+
+	config := getVirtualMediaConfig(plan)
+	if config.UserName != "LAB\\deploy" || config.Password != "secret" {
+		t.Errorf("unexpected share credentials '%s' and '%s'", config.UserName, config.Password)
+	}
+
+	err := mountError(errors.New("401: Unauthorized"), config)
+	if !strings.Contains(err.Error(), "share_domain") {
+		t.Errorf("expected hint about share credentials, got '%s'", err.Error())
+	}
+
+	err = mountError(errors.New("500: Internal Server Error"), config)
+	if err.Error() != "500: Internal Server Error" {
+		t.Errorf("unexpected error '%s'", err.Error())
+	}
+}
+
+func TestVirtualMediaRemountRequired(t *testing.T) {
+	state := models.VirtualMediaResourceModel{
+		Image:                types.StringValue("http://10.172.181.125/image.iso"),
+		TransferProtocolType: types.StringValue("HTTPS"),
+		UserName:             types.StringNull(),
+		Password:             types.StringNull(),
+		ShareDomain:          types.StringNull(),
+		MountTimeout:         types.Int64Value(VMEDIA_MOUNT_TIMEOUT),
+	}
+
+	plan := state
+	plan.MountTimeout = types.Int64Value(600)
+	if virtualMediaRemountRequired(plan, state) {
+		t.Errorf("change of mount_timeout must not require media to be mounted again")
+	}
+
+	plan.Image = types.StringValue("http://10.172.181.125/other.iso")
+	if !virtualMediaRemountRequired(plan, state) {
+		t.Errorf("change of image must require media to be mounted again")
+	}
+
+	plan = state
+	plan.WriteProtected = types.BoolValue(false)
+	if !virtualMediaRemountRequired(plan, state) {
+		t.Errorf("change of write_protected must require media to be mounted again")
+	}
+}
+
+func TestGetVirtualMediaConfigWriteProtected(t *testing.T) {
+	plan := models.VirtualMediaResourceModel{
+		Image:                types.StringValue("http://10.172.181.125/image.img"),
+		TransferProtocolType: types.StringValue("HTTP"),
+		WriteProtected:       types.BoolValue(false),
+	}
+
+	// false must be sent explicitly, otherwise iRMC mounts media as write protected
+	payload, err := json.Marshal(getVirtualMediaConfig(plan))
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	if !strings.Contains(string(payload), `"WriteProtected":false`) || !strings.Contains(string(payload), `"TransferProtocolType":"HTTP"`) {
+		t.Errorf("unexpected insert media request %s", string(payload))
+	}
+
+	plan.WriteProtected = types.BoolNull()
+	if !getVirtualMediaConfig(plan).WriteProtected {
+		t.Errorf("media must be write protected by default")
+	}
+}
+
+func TestSelectVirtualMediaSlot(t *testing.T) {
+	cd := []redfish.VirtualMediaType{redfish.CDMediaType, redfish.DVDMediaType}
+	hd := []redfish.VirtualMediaType{redfish.USBStickMediaType}
+	collection := []*redfish.VirtualMedia{
+		{Entity: common.Entity{ID: "0"}, MediaTypes: cd, Inserted: true, Image: "config.iso"},
+		{Entity: common.Entity{ID: "1"}, MediaTypes: hd},
+		{Entity: common.Entity{ID: "2"}, MediaTypes: cd},
+		{Entity: common.Entity{ID: "3"}, MediaTypes: hd},
+	}
+
+	tests := []struct {
+		slot      string
+		mediaType string
+		expected  string
+		fails     bool
+	}{
+		{slot: "", mediaType: VMEDIA_TYPE_CD, expected: "2"},
+		{slot: "", mediaType: VMEDIA_TYPE_HD, expected: "1"},
+		{slot: "3", mediaType: VMEDIA_TYPE_HD, expected: "3"},
+		{slot: "0", mediaType: VMEDIA_TYPE_CD, fails: true},
+		{slot: "3", mediaType: VMEDIA_TYPE_CD, fails: true},
+		{slot: "7", mediaType: VMEDIA_TYPE_CD, fails: true},
+	}
+
+	for _, test := range tests {
+		vmedia, err := selectVirtualMediaSlot(collection, test.slot, test.mediaType)
+		if test.fails {
+			if err == nil {
+				t.Errorf("slot '%s' of type %s: expected error, got slot %s", test.slot, test.mediaType, vmedia.ID)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("slot '%s' of type %s: unexpected error %s", test.slot, test.mediaType, err.Error())
+		} else if vmedia.ID != test.expected {
+			t.Errorf("slot '%s' of type %s: expected slot %s, got %s", test.slot, test.mediaType, test.expected, vmedia.ID)
+		}
+	}
+
+	// devices without reported media types are selected as on older firmware
+	legacy := []*redfish.VirtualMedia{{Entity: common.Entity{ID: "0"}}, {Entity: common.Entity{ID: "1"}}}
+	if vmedia, err := selectVirtualMediaSlot(legacy, "", VMEDIA_TYPE_HD); err != nil || vmedia.ID != "1" {
+		t.Errorf("expected legacy slot 1 for HD image, got %v, %v", vmedia, err)
+	}
+}
+
 func testAccRedfishResourceVirtualMediaConfig(testingInfo TestingServerCredentials,
 	image string,
 	transfer_protocol_type string,
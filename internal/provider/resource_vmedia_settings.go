@@ -0,0 +1,331 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+// VMEDIA_SETTINGS_ENDPOINT is OEM endpoint of system holding virtual media service settings.
+const VMEDIA_SETTINGS_ENDPOINT = "/redfish/v1/Systems/0/Oem/%s/VirtualMedia"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VmediaSettingsResource{}
+var _ resource.ResourceWithImportState = &VmediaSettingsResource{}
+
+func NewVmediaSettingsResource() resource.Resource {
+	return &VmediaSettingsResource{}
+}
+
+// VmediaSettingsResource defines the resource implementation.
+type VmediaSettingsResource struct {
+	p *IrmcProvider
+}
+
+func (r *VmediaSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + vmediaSettingsName
+}
+
+func VmediaSettingsSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of virtual media settings resource on iRMC.",
+			Description:         "ID of virtual media settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Specifies if virtual media service is enabled.",
+			Description:         "Specifies if virtual media service is enabled.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"cd_devices": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Number of virtual CD/DVD devices exposed to the host.",
+			Description:         "Number of virtual CD/DVD devices exposed to the host.",
+			Validators: []validator.Int64{
+				int64validator.Between(0, 4),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"hd_devices": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Number of virtual hard disk devices exposed to the host.",
+			Description:         "Number of virtual hard disk devices exposed to the host.",
+			Validators: []validator.Int64{
+				int64validator.Between(0, 4),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"usb_attach_mode": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Defines when virtual media devices are attached to USB of the host. Available values are 'AutoAttach' (attached only while media is connected), 'AlwaysAttach' and 'AlwaysDetach'.",
+			Description:         "Defines when virtual media devices are attached to USB of the host. Available values are 'AutoAttach' (attached only while media is connected), 'AlwaysAttach' and 'AlwaysDetach'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("AutoAttach", "AlwaysAttach", "AlwaysDetach"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *VmediaSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) settings of virtual media service of iRMC. Media are mounted using virtual_media resource.",
+		Description:         "The resource is used to control (read, modify or import) settings of virtual media service of iRMC. Media are mounted using virtual_media resource.",
+		Attributes:          VmediaSettingsSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *VmediaSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *VmediaSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-vmedia_settings: create starts")
+
+	var plan models.VmediaSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-vmedia_settings: create ends")
+}
+
+func (r *VmediaSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-vmedia_settings: read starts")
+
+	var state models.VmediaSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-vmedia_settings: read ends")
+}
+
+func (r *VmediaSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-vmedia_settings: update starts")
+
+	var plan, state models.VmediaSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(readServerPasswordWO(ctx, req.Config, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "resource-vmedia_settings: update ends")
+}
+
+func (r *VmediaSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-vmedia_settings: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-vmedia_settings: delete ends")
+}
+
+func (r *VmediaSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-vmedia_settings: import starts")
+
+	var config CommonImportConfig
+	server, err := parseImportID(req.ID, &config, nil)
+	if err != nil {
+		resp.Diagnostics.Append(redfishErrorDiagnostics("Error while unmarshalling import config", err)...)
+		return
+	}
+
+	state := models.VmediaSettingsResourceModel{
+		RedfishServer: []models.RedfishServer{server},
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "resource-vmedia_settings: import ends")
+}
+
+// read reads current virtual media settings from iRMC into model.
+func (r *VmediaSettingsResource) read(ctx context.Context, model *models.VmediaSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	api, err := ConnectTargetSystem(r.p, &model.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	endpoint, err := getVmediaSettingsEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	data, err := getJsonObject(api, endpoint)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read virtual media settings", err)...)
+		return diags
+	}
+
+	vmediaSettingsToModel(endpoint, data, model)
+	return diags
+}
+
+func (r *VmediaSettingsResource) apply(ctx context.Context, plan *models.VmediaSettingsResourceModel, state *models.VmediaSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Provide synchronization
+	var endpoint = getServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-vmedia_settings"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("service error: ", err)...)
+		return diags
+	}
+
+	defer ReleaseTargetSystem(api)
+
+	vmediaEndpoint, err := getVmediaSettingsEndpoint(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor Detection Failed", err)...)
+		return diags
+	}
+
+	var current models.VmediaSettingsResourceModel
+	if state != nil {
+		current = *state
+	}
+
+	payload := map[string]interface{}{}
+	addBoolToPayload(payload, "RemoteMountEnabled", plan.Enabled, current.Enabled)
+	addStringToPayload(payload, "USBAttachMode", plan.UsbAttachMode, current.UsbAttachMode)
+
+	cdImage, hdImage := map[string]interface{}{}, map[string]interface{}{}
+	addInt64ToPayload(cdImage, "MaximumNumberOfDevices", plan.CdDevices, current.CdDevices)
+	addInt64ToPayload(hdImage, "MaximumNumberOfDevices", plan.HdDevices, current.HdDevices)
+	addObjectToPayload(payload, "CDImage", cdImage)
+	addObjectToPayload(payload, "HDImage", hdImage)
+
+	tflog.Info(ctx, "Applying virtual media settings", map[string]interface{}{"payload": payload})
+	data, err := applySettings(api, vmediaEndpoint, payload)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not apply virtual media settings", err)...)
+		return diags
+	}
+
+	vmediaSettingsToModel(vmediaEndpoint, data, plan)
+	return diags
+}
+
+func getVmediaSettingsEndpoint(ctx context.Context, api *gofish.APIClient) (string, error) {
+	isFsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		return "", err
+	}
+
+	if isFsas {
+		return fmt.Sprintf(VMEDIA_SETTINGS_ENDPOINT, FSAS), nil
+	}
+
+	return fmt.Sprintf(VMEDIA_SETTINGS_ENDPOINT, TS_FUJITSU), nil
+}
+
+// vmediaSettingsToModel copies virtual media settings from OEM configuration object into model.
+func vmediaSettingsToModel(endpoint string, data map[string]interface{}, model *models.VmediaSettingsResourceModel) {
+	model.Id = types.StringValue(endpoint)
+	model.Enabled = jsonBoolValue(data, "RemoteMountEnabled")
+	model.CdDevices = jsonInt64Value(jsonObjectValue(data, "CDImage"), "MaximumNumberOfDevices")
+	model.HdDevices = jsonInt64Value(jsonObjectValue(data, "HDImage"), "MaximumNumberOfDevices")
+	model.UsbAttachMode = jsonStringValue(data, "USBAttachMode")
+}
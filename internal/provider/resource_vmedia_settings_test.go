@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const vmedia_settings_name = "irmc-redfish_vmedia_settings.vms"
+
+func TestAccRedfishVmediaSettings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceVmediaSettingsConfig(creds, "AlwaysAttach"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(vmedia_settings_name, "id"),
+					resource.TestCheckResourceAttr(vmedia_settings_name, "enabled", "true"),
+					resource.TestCheckResourceAttr(vmedia_settings_name, "usb_attach_mode", "AlwaysAttach"),
+					resource.TestCheckResourceAttrSet(vmedia_settings_name, "cd_devices"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceVmediaSettingsConfig(creds, "AutoAttach"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(vmedia_settings_name, "usb_attach_mode", "AutoAttach"),
+				),
+			},
+			{
+				ResourceName:            vmedia_settings_name,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("{\"username\":\"%s\",\"password\":\"%s\",\"endpoint\":\"https://%s\",\"ssl_insecure\":true}", creds.Username, creds.Password, creds.Endpoint),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server"},
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceVmediaSettingsConfig(testingInfo TestingServerCredentials, mode string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_vmedia_settings" "vms" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		enabled         = true
+		usb_attach_mode = "%s"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		mode,
+	)
+}
@@ -0,0 +1,356 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	HTTP_HEADER_AUTH_TOKEN    = "X-Auth-Token"
+	SESSIONS_ENDPOINT         = "/redfish/v1/SessionService/Sessions"
+	SESSION_POOL_IDLE_TIMEOUT = 30 * time.Second
+)
+
+// SessionPool keeps Redfish sessions shared by all resources and data sources
+// talking to the same system with the same credentials, so applying many resources
+// does not exhaust session slots of iRMC. Sessions are reference counted and logged
+// out after being idle for idleTimeout or when the pool is closed.
+type SessionPool struct {
+	lock        sync.Mutex
+	sessions    map[string]*pooledSession
+	clients     map[*gofish.APIClient]*pooledSession
+	idleTimeout time.Duration
+	closed      bool
+}
+
+type pooledSession struct {
+	key       string
+	config    gofish.ClientConfig
+	api       *gofish.APIClient
+	refs      int
+	stale     bool
+	idleTimer *time.Timer
+
+	// token and sessionURI describe session created by relogin, which replaces
+	// session established by gofish client.
+	authLock   sync.Mutex
+	token      string
+	sessionURI string
+}
+
+func InitSessionPoolInstance(idleTimeout time.Duration) *SessionPool {
+	return &SessionPool{
+		sessions:    make(map[string]*pooledSession),
+		clients:     make(map[*gofish.APIClient]*pooledSession),
+		idleTimeout: idleTimeout,
+	}
+}
+
+func sessionPoolKey(config gofish.ClientConfig) string {
+	secret := config.Password
+	if config.Session != nil {
+		secret = "token:" + config.Session.Token
+	}
+	hash := sha256.Sum256([]byte(secret))
+	return fmt.Sprintf("%s|%s|%s|%t", config.Endpoint, config.Username, hex.EncodeToString(hash[:]), config.Insecure)
+}
+
+// Acquire returns client connected to system described by config. If there is already
+// session established for the same system and credentials it is reused, otherwise new one
+// is created. With forceNew set, existing session is not reused (e.g. after iRMC reset).
+// Every acquired client must be returned to the pool using Release.
+func (sp *SessionPool) Acquire(config gofish.ClientConfig, forceNew bool) (*gofish.APIClient, error) {
+	key := sessionPoolKey(config)
+
+	sp.lock.Lock()
+	if session, ok := sp.sessions[key]; ok && !forceNew {
+		if session.idleTimer != nil {
+			session.idleTimer.Stop()
+			session.idleTimer = nil
+		}
+		session.refs++
+		sp.lock.Unlock()
+		return session.api, nil
+	}
+	sp.lock.Unlock()
+
+	session := &pooledSession{key: key, config: config}
+	if config.HTTPClient != nil {
+		client := *config.HTTPClient
+		client.Transport = &reauthTransport{next: client.Transport, session: session}
+		session.config.HTTPClient = &client
+	}
+
+	api, err := gofish.Connect(session.config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Session of account with initial password is usable only to change the password,
+	// so it is not kept in the pool
+	if config.Session == nil {
+		if accountURI, required := passwordChangeRequired(api); required {
+			api.Logout()
+			return nil, &PasswordChangeRequiredError{
+				Endpoint:   config.Endpoint,
+				Username:   config.Username,
+				AccountURI: accountURI,
+			}
+		}
+	}
+
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+
+	session.api = api
+	session.refs = 1
+	if previous, ok := sp.sessions[key]; ok {
+		previous.stale = true
+		if previous.refs == 0 {
+			sp.closeSession(previous)
+		}
+	}
+	sp.sessions[key] = session
+	sp.clients[api] = session
+	return api, nil
+}
+
+// Release returns client obtained from Acquire to the pool.
+func (sp *SessionPool) Release(api *gofish.APIClient) {
+	if api == nil {
+		return
+	}
+
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+
+	session, ok := sp.clients[api]
+	if !ok {
+		api.Logout()
+		return
+	}
+
+	session.refs--
+	if session.refs > 0 {
+		return
+	}
+
+	if session.stale || sp.closed || sp.idleTimeout <= 0 {
+		sp.closeSession(session)
+		return
+	}
+
+	session.idleTimer = time.AfterFunc(sp.idleTimeout, func() {
+		sp.lock.Lock()
+		defer sp.lock.Unlock()
+		if session.refs == 0 {
+			sp.closeSession(session)
+		}
+	})
+}
+
+// closeSession must be called with sp.lock held.
+func (sp *SessionPool) closeSession(session *pooledSession) {
+	if sp.detachSession(session) {
+		go session.logout()
+	}
+}
+
+// detachSession removes session from the pool and reports if it has been still
+// kept there, so it has to be logged out. Must be called with sp.lock held.
+func (sp *SessionPool) detachSession(session *pooledSession) bool {
+	if current, ok := sp.sessions[session.key]; ok && current == session {
+		delete(sp.sessions, session.key)
+	}
+
+	if _, ok := sp.clients[session.api]; !ok {
+		return false
+	}
+
+	delete(sp.clients, session.api)
+	return true
+}
+
+// Close synchronously logs out all idle sessions kept in the pool. Sessions still in use
+// are logged out as soon as they are released. The plugin process is terminated shortly
+// after the provider server stops, so idle timers would never fire then.
+func (sp *SessionPool) Close() {
+	sp.lock.Lock()
+	sp.closed = true
+	idle := []*pooledSession{}
+	for _, session := range sp.clients {
+		if session.refs > 0 {
+			continue
+		}
+
+		if session.idleTimer != nil {
+			session.idleTimer.Stop()
+			session.idleTimer = nil
+		}
+
+		if sp.detachSession(session) {
+			idle = append(idle, session)
+		}
+	}
+	sp.lock.Unlock()
+
+	var wg sync.WaitGroup
+	for _, session := range idle {
+		wg.Add(1)
+		go func(session *pooledSession) {
+			defer wg.Done()
+			session.logout()
+		}(session)
+	}
+	wg.Wait()
+}
+
+// logout deletes session created by relogin (if any) and session established by gofish client.
+func (s *pooledSession) logout() {
+	if uri := s.replacedSession(); len(uri) > 0 {
+		if res, err := s.api.Delete(uri); err == nil {
+			CloseResource(res.Body)
+		}
+	}
+	s.api.Logout()
+}
+
+// replacedToken returns token of session created by relogin or empty string,
+// if token established by gofish client is still in use.
+func (s *pooledSession) replacedToken() string {
+	s.authLock.Lock()
+	defer s.authLock.Unlock()
+	return s.token
+}
+
+// replacedSession returns URI of session created by relogin or empty string.
+func (s *pooledSession) replacedSession() string {
+	s.authLock.Lock()
+	defer s.authLock.Unlock()
+	return s.sessionURI
+}
+
+// relogin creates new session on the system and replaces authentication token used
+// by pooled client. If token has been already replaced by other request, nothing is done.
+// Since gofish client does not allow to change its token, the new one is injected
+// into requests by reauthTransport.
+func (s *pooledSession) relogin(transport http.RoundTripper, failedToken string) (string, error) {
+	s.authLock.Lock()
+	defer s.authLock.Unlock()
+
+	if len(s.token) > 0 && s.token != failedToken {
+		return s.token, nil
+	}
+
+	if len(s.config.Username) == 0 {
+		return "", fmt.Errorf("session token is not valid anymore and no credentials are available to log in again")
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"UserName": s.config.Username,
+		"Password": s.config.Password,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(s.config.Endpoint, "/")+SESSIONS_ENDPOINT, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return "", fmt.Errorf("re-login failed: %w", err)
+	}
+	defer CloseResource(res.Body)
+
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("re-login failed with status code %d", res.StatusCode)
+	}
+
+	token := res.Header.Get(HTTP_HEADER_AUTH_TOKEN)
+	if len(token) == 0 {
+		return "", fmt.Errorf("re-login response does not contain %s header", HTTP_HEADER_AUTH_TOKEN)
+	}
+
+	s.token = token
+	s.sessionURI = res.Header.Get(HTTP_HEADER_LOCATION)
+
+	return token, nil
+}
+
+// reauthTransport replays request with new session token, if iRMC reports
+// that session used by pooled client is not valid anymore (401). Once session
+// has been replaced, token of all following requests is replaced as well.
+type reauthTransport struct {
+	next    http.RoundTripper
+	session *pooledSession
+}
+
+func (t *reauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	if token := t.session.replacedToken(); len(token) > 0 && len(req.Header.Get(HTTP_HEADER_AUTH_TOKEN)) > 0 {
+		req = req.Clone(req.Context())
+		req.Header.Set(HTTP_HEADER_AUTH_TOKEN, token)
+	}
+
+	res, err := next.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+
+	token := req.Header.Get(HTTP_HEADER_AUTH_TOKEN)
+	if len(token) == 0 || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return res, err
+	}
+
+	newToken, reloginErr := t.session.relogin(next, token)
+	if reloginErr != nil {
+		return res, err
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, bodyErr := req.GetBody()
+		if bodyErr != nil {
+			return res, err
+		}
+		retry.Body = body
+	}
+	retry.Header.Set(HTTP_HEADER_AUTH_TOKEN, newToken)
+
+	CloseResource(res.Body)
+	return next.RoundTrip(retry)
+}
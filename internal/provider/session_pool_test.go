@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+)
+
+func TestReauthTransport(t *testing.T) {
+	var logins int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == SESSIONS_ENDPOINT {
+			atomic.AddInt32(&logins, 1)
+			w.Header().Set(HTTP_HEADER_AUTH_TOKEN, "new-token")
+			w.Header().Set(HTTP_HEADER_LOCATION, SESSIONS_ENDPOINT+"/2")
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+
+		if r.Header.Get(HTTP_HEADER_AUTH_TOKEN) != "new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	session := &pooledSession{}
+	session.config.Endpoint = server.URL
+	session.config.Username = "admin"
+	session.config.Password = "admin"
+
+	client := &http.Client{Transport: &reauthTransport{session: session}}
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/redfish/v1/Systems/0", nil)
+		req.Header.Set(HTTP_HEADER_AUTH_TOKEN, "expired-token")
+		res, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Unexpected error %s", err.Error())
+		}
+		CloseResource(res.Body)
+
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("Got status %d, expected 200", res.StatusCode)
+		}
+	}
+
+	if logins != 1 {
+		t.Errorf("Got %d logins, expected 1", logins)
+	}
+
+	if session.replacedSession() != SESSIONS_ENDPOINT+"/2" {
+		t.Errorf("Got session '%s', expected '%s'", session.replacedSession(), SESSIONS_ENDPOINT+"/2")
+	}
+}
+
+func TestSessionPoolClose(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	config := gofish.ClientConfig{
+		Endpoint: server.URL,
+		Username: MOCK_REDFISH_USERNAME,
+		Password: MOCK_REDFISH_PASSWORD,
+		Insecure: true,
+	}
+
+	pool := InitSessionPoolInstance(time.Hour)
+	api, err := pool.Acquire(config, false)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	pool.Release(api)
+
+	if count := server.sessionCount(); count != 1 {
+		t.Errorf("Got %d sessions before close, expected idle session to be kept", count)
+	}
+
+	pool.Close()
+	if count := server.sessionCount(); count != 0 {
+		t.Errorf("Got %d sessions after close, expected 0", count)
+	}
+
+	// Session used while pool is being closed is logged out once released
+	api, err = pool.Acquire(config, false)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	pool.Release(api)
+	for i := 0; i < 100 && server.sessionCount() > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if count := server.sessionCount(); count != 0 {
+		t.Errorf("Got %d sessions after release to closed pool, expected 0", count)
+	}
+}
@@ -20,13 +20,16 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"terraform-provider-irmc-redfish/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,6 +38,10 @@ import (
 	"github.com/stmcginnis/gofish/redfish"
 )
 
+// getSystemStorageFromSerialNumber returns storage subsystem identified by serial. Serial numbers of
+// embedded StorageControllers entries are checked first, then those of controllers linked via Controllers
+// collection (e.g. direct-attached NVMe subsystems) and finally Id of storage resource, which is used as
+// identifier of subsystems whose controllers do not report any serial number.
 func getSystemStorageFromSerialNumber(service *gofish.Service, serial string) (*redfish.Storage, error) {
 	system, err := GetSystemResource(service)
 	if err != nil {
@@ -47,7 +54,32 @@ func getSystemStorageFromSerialNumber(service *gofish.Service, serial string) (*
 	}
 
 	for _, storage := range list_of_storage_controllers {
-		if storage.StorageControllers[0].SerialNumber == serial {
+		for _, controller := range storage.StorageControllers {
+			if controller.SerialNumber == serial {
+				return storage, nil
+			}
+		}
+	}
+
+	for _, storage := range list_of_storage_controllers {
+		if len(storage.StorageControllers) > 0 {
+			continue
+		}
+
+		controllers, err := storage.Controllers()
+		if err != nil {
+			continue
+		}
+
+		for _, controller := range controllers {
+			if controller.SerialNumber == serial {
+				return storage, nil
+			}
+		}
+	}
+
+	for _, storage := range list_of_storage_controllers {
+		if storage.ID == serial && len(getStorageControllerIdentity(storage).SerialNumber) == 0 {
 			return storage, nil
 		}
 	}
@@ -55,6 +87,64 @@ func getSystemStorageFromSerialNumber(service *gofish.Service, serial string) (*
 	return nil, fmt.Errorf("storage controller represented by serial has not been found on list of controllers for the target system")
 }
 
+type storageControllerIdentity struct {
+	SerialNumber    string
+	Model           string
+	FirmwareVersion string
+}
+
+// getStorageControllerIdentity returns serial number, model and firmware version of controller of storage
+// subsystem, taken from embedded StorageControllers entries or (if there are none) from Controllers collection.
+func getStorageControllerIdentity(storage *redfish.Storage) storageControllerIdentity {
+	for _, controller := range storage.StorageControllers {
+		if len(controller.SerialNumber) > 0 {
+			return storageControllerIdentity{controller.SerialNumber, controller.Model, controller.FirmwareVersion}
+		}
+	}
+
+	if len(storage.StorageControllers) > 0 {
+		controller := storage.StorageControllers[0]
+		return storageControllerIdentity{"", controller.Model, controller.FirmwareVersion}
+	}
+
+	controllers, err := storage.Controllers()
+	if err != nil || len(controllers) == 0 {
+		return storageControllerIdentity{}
+	}
+
+	for _, controller := range controllers {
+		if len(controller.SerialNumber) > 0 {
+			return storageControllerIdentity{controller.SerialNumber, controller.Model, controller.FirmwareVersion}
+		}
+	}
+
+	return storageControllerIdentity{"", controllers[0].Model, controllers[0].FirmwareVersion}
+}
+
+// findStorageDrive returns drive placed in slot or identified by durableName.
+func findStorageDrive(drives []*redfish.Drive, slot string, durableName string) (*redfish.Drive, error) {
+	for _, drive := range drives {
+		if len(slot) > 0 {
+			if driveSlot, err := getDriveSlot(drive); err == nil && driveSlot == slot {
+				return drive, nil
+			}
+			continue
+		}
+
+		for _, identifier := range drive.Identifiers {
+			if identifier.DurableName == durableName {
+				return drive, nil
+			}
+		}
+	}
+
+	if len(slot) > 0 {
+		return nil, fmt.Errorf("drive in slot '%s' has not been found", slot)
+	}
+
+	return nil, fmt.Errorf("drive with durable name '%s' has not been found", durableName)
+}
+
 type storageControllerOem struct {
 	BiosContinueOnError       string `json:"BIOSContinueOnError,omitempty"`
 	BiosStatusEnabled         *bool  `json:"BIOSStatus,omitempty"`
@@ -66,14 +156,15 @@ type storageControllerOem struct {
 	RebuildRate               *int64 `json:"RebuildRate,omitempty"`
 	MigrationRate             *int64 `json:"MigrationRate,omitempty"`
 
-	SpinupDelay               *int64 `json:"SpinupDelaySec,omitempty"`
-	SpindownDelay             *int64 `json:"SpindownDelayMin,omitempty"`
-	SpindownUnconfiguredDrive *bool  `json:"SpindownUnconfiguredDrive,omitempty"`
-	SpindownHotspare          *bool  `json:"SpindownHotspare,omitempty"`
-	MDCScheduleMode           string `json:"MDCScheduleMode,omitempty"`
-	MDCAbortOnError           *bool  `json:"MDCAbortOnError,omitempty"`
-	CoercionMode              string `json:"CoercionMode,omitempty"`
-	AutoRebuild               *bool  `json:"AutoRebuildSupport,omitempty"`
+	SpinupDelay               *int64             `json:"SpinupDelaySec,omitempty"`
+	SpindownDelay             *int64             `json:"SpindownDelayMin,omitempty"`
+	SpindownUnconfiguredDrive *bool              `json:"SpindownUnconfiguredDrive,omitempty"`
+	SpindownHotspare          *bool              `json:"SpindownHotspare,omitempty"`
+	MDCScheduleMode           string             `json:"MDCScheduleMode,omitempty"`
+	MDCAbortOnError           *bool              `json:"MDCAbortOnError,omitempty"`
+	CoercionMode              string             `json:"CoercionMode,omitempty"`
+	AutoRebuild               *bool              `json:"AutoRebuildSupport,omitempty"`
+	BootVolume                *storageBootVolume `json:"BootVolume,omitempty"`
 	/*
 		CopybackSupport                bool   `json:"CopybackSupport,omitempty"`
 		CopybackOnSmartErrorSupport    bool   `json:"CopybackOnSMARTErrSupport,omitempty"`
@@ -81,28 +172,121 @@ type storageControllerOem struct {
 	*/
 }
 
+type storageBootVolume struct {
+	ODataId string `json:"@odata.id"`
+}
+
 type StorageControllerFujitsuOem struct {
 	OemFujitsu *storageControllerOem `json:"ts_fujitsu,omitempty"`
 	OemFsas    *storageControllerOem `json:"Fsas,omitempty"`
 }
 
 type StorageController_Fujitsu struct {
-	Oem StorageControllerFujitsuOem
+	SerialNumber string `json:"SerialNumber,omitempty"`
+	Oem          StorageControllerFujitsuOem
 }
 
 type Storage_Fujitsu struct {
 	StorageControllers []StorageController_Fujitsu
 }
 
-func getOemStorage(oem StorageControllerFujitsuOem) storageControllerOem {
+func getOemStorage(oem StorageControllerFujitsuOem) (storageControllerOem, bool) {
 	if oem.OemFujitsu != nil {
-		return *oem.OemFujitsu
+		return *oem.OemFujitsu, true
+	}
+
+	if oem.OemFsas != nil {
+		return *oem.OemFsas, true
+	}
+
+	return storageControllerOem{}, false
+}
+
+// getStorageControllerOem returns OEM settings of storage controller together with index of
+// StorageControllers entry which reports them. Storage resources might expose several entries
+// (e.g. PDUAL CP100), so entry matching serial is preferred over first entry with OEM settings.
+func getStorageControllerOem(storage Storage_Fujitsu, serial string) (storageControllerOem, int, error) {
+	if len(storage.StorageControllers) == 0 {
+		return storageControllerOem{}, -1, fmt.Errorf("storage resource does not report any StorageControllers entry")
+	}
+
+	index := -1
+	for i, controller := range storage.StorageControllers {
+		if _, ok := getOemStorage(controller.Oem); !ok {
+			continue
+		}
+
+		if controller.SerialNumber == serial {
+			index = i
+			break
+		}
+
+		if index < 0 {
+			index = i
+		}
+	}
+
+	if index < 0 {
+		return storageControllerOem{}, -1, fmt.Errorf("none of %d StorageControllers entries reports supported OEM settings (Oem.ts_fujitsu or Oem.Fsas)",
+			len(storage.StorageControllers))
 	}
 
-	return *oem.OemFsas
+	oem, _ := getOemStorage(storage.StorageControllers[index].Oem)
+	return oem, index, nil
 }
 
-func convertPlanToPayload(isFsas bool, plan models.StorageResourceModel) (any, bool) {
+// isStoragePropertyApplied compares planned value with value reported by controller, absent property is never treated as applied.
+func isStoragePropertyApplied[T comparable](planned T, reported *T) bool {
+	return reported != nil && *reported == planned
+}
+
+func reportedStorageProperty[T any](reported *T) any {
+	if reported == nil {
+		return nil
+	}
+
+	return *reported
+}
+
+// getUnsupportedStorageProperties returns names of planned properties which are not reported by controller.
+func getUnsupportedStorageProperties(plan models.StorageResourceModel, oem storageControllerOem) []string {
+	properties := []struct {
+		name      string
+		planned   attr.Value
+		supported bool
+	}{
+		{"auto_rebuild_enabled", plan.AutoRebuild, oem.AutoRebuild != nil},
+		{"bgi_rate", plan.BGIRate, oem.BGIRate != nil},
+		{"bios_continue_on_error", plan.BiosContinueOnError, len(oem.BiosContinueOnError) > 0},
+		{"bios_status", plan.BiosStatusEnabled, oem.BiosStatusEnabled != nil},
+		{"coercion_mode", plan.CoercionMode, len(oem.CoercionMode) > 0},
+		{"mdc_abort_on_error_enabled", plan.MDCAbortOnError, oem.MDCAbortOnError != nil},
+		{"mdc_rate", plan.MDCRate, oem.MDCRate != nil},
+		{"mdc_schedule_mode", plan.MDCScheduleMode, len(oem.MDCScheduleMode) > 0},
+		{"migration_rate", plan.MigrationRate, oem.MigrationRate != nil},
+		{"patrol_read", plan.PatrolRead, len(oem.PatrolRead) > 0},
+		{"patrol_read_rate", plan.PatrolReadRate, oem.PatrolReadRatePercent != nil},
+		{"patrol_read_recovery_support", plan.PatrolReadRecoverySupport, oem.PatrolReadRecoverySupport != nil},
+		{"rebuild_rate", plan.RebuildRate, oem.RebuildRate != nil},
+		{"spindown_delay", plan.SpindownDelay, oem.SpindownDelay != nil},
+		{"spindown_hotspare_enabled", plan.SpindownHotspare, oem.SpindownHotspare != nil},
+		{"spindown_unconfigured_drive_enabled", plan.SpindownUnconfDrive, oem.SpindownUnconfiguredDrive != nil},
+		{"spinup_delay", plan.SpinupDelay, oem.SpinupDelay != nil},
+	}
+
+	unsupported := []string{}
+	for _, property := range properties {
+		if !property.planned.IsNull() && !property.planned.IsUnknown() && !property.supported {
+			unsupported = append(unsupported, property.name)
+		}
+	}
+
+	return unsupported
+}
+
+// convertPlanToPayload creates PATCH payload for storage resource, where index points to StorageControllers
+// entry which holds OEM settings. Preceding entries are sent as empty objects, so they stay untouched.
+func convertPlanToPayload(isFsas bool, plan models.StorageResourceModel, index int) (any, bool) {
 	var storageController StorageController_Fujitsu
 	anyValueIntoPlan := false
 
@@ -263,225 +447,224 @@ func convertPlanToPayload(isFsas bool, plan models.StorageResourceModel) (any, b
 		(*oem).AutoRebuild = nil
 	}
 
-	var payload Storage_Fujitsu
-	payload.StorageControllers = append(payload.StorageControllers, storageController)
-	return payload, anyValueIntoPlan
-}
+	if !plan.BootVolume.IsNull() && !plan.BootVolume.IsUnknown() {
+		(*oem).BootVolume = &storageBootVolume{ODataId: plan.BootVolume.ValueString()}
+		anyValueIntoPlan = true
+	}
 
-type ExtendedInfoMsg struct {
-	MessageId string `json:"MessageId"`
-	Message   string `json:"Message"`
-}
+	controllers := []any{}
+	for i := 0; i < index; i++ {
+		controllers = append(controllers, map[string]any{})
+	}
 
-type StoragePatchResponse struct {
-	ExtendedInfo []ExtendedInfoMsg `json:"@Message.ExtendedInfo"`
+	payload := map[string]any{
+		"StorageControllers": append(controllers, storageController),
+	}
+	return payload, anyValueIntoPlan
 }
 
-func patchStorageEndpoint(ctx context.Context, service *gofish.Service, endpoint string, payload any) (taskLocation string, err error) {
+// patchStorageEndpoint sends payload to storage endpoint. Messages reported by service for request
+// which has been accepted only partially are returned as warnings.
+func patchStorageEndpoint(ctx context.Context, service *gofish.Service, endpoint string, payload any) (taskLocation string, warnings diag.Diagnostics, err error) {
 	tflog.Info(ctx, "Payload will be PATCHed to controller", map[string]interface{}{
 		"storage endpoint": endpoint,
 		"payload":          payload,
 	})
 
-	resp, err := service.GetClient().Patch(endpoint, payload)
+	resp, err := PatchWithEtag(service.GetClient(), endpoint, payload)
 	if err != nil {
-		return "", err
+		return "", warnings, err
 	}
 
 	defer CloseResource(resp.Body)
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return "", fmt.Errorf("PATCH request on '%s' finished with not expected status '%d'", endpoint, resp.StatusCode)
+		return "", warnings, fmt.Errorf("PATCH request on '%s' finished with not expected status '%d'", endpoint, resp.StatusCode)
 	}
 
 	if resp.StatusCode == http.StatusAccepted {
 		taskLocation := resp.Header.Get(HTTP_HEADER_LOCATION)
 		if taskLocation == "" {
-			return "", fmt.Errorf("location header not found in response")
+			return "", warnings, fmt.Errorf("location header not found in response")
 		}
-		return taskLocation, nil
+		return taskLocation, warnings, nil
 	}
 
 	// Request might be accepted but some properties will not be successfully validated and it should be reported to terraform
 	out, err := io.ReadAll(resp.Body)
-	var respStruct StoragePatchResponse
-
-	err = json.Unmarshal(out, &respStruct)
 	if err != nil {
-		return "", err
-	}
-
-	if len(respStruct.ExtendedInfo) > 0 {
-		for _, v := range respStruct.ExtendedInfo {
-			tflog.Warn(ctx, "Request responded with non-empty ExtendedMessageInfo", map[string]interface{}{
-				"MessageId": v.MessageId,
-				"Message":   v.Message,
-			})
-		}
+		return "", warnings, err
 	}
 
-	return "", err
+	warnings = redfishResponseWarnings("Storage controller reported message for requested change", out)
+	return "", warnings, nil
 }
 
 func checkAppliedSettingsFromPlan(ctx context.Context, plan models.StorageResourceModel, current Storage_Fujitsu) bool {
+	oem, _, err := getStorageControllerOem(current, plan.StorageControllerSN.ValueString())
+	if err != nil {
+		tflog.Error(ctx, err.Error())
+		return false
+	}
+
 	status := true
 
 	if !plan.BiosContinueOnError.IsNull() && !plan.BiosContinueOnError.IsUnknown() {
-		if plan.BiosContinueOnError.ValueString() != getOemStorage(current.StorageControllers[0].Oem).BiosContinueOnError {
+		if plan.BiosContinueOnError.ValueString() != oem.BiosContinueOnError {
 			status = false
 			tflog.Info(ctx, "Value for property BIOSContinueOnError has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.BiosContinueOnError.ValueString(),
-				"reported": getOemStorage(current.StorageControllers[0].Oem).BiosContinueOnError,
+				"reported": oem.BiosContinueOnError,
 			})
 		}
 	}
 
 	if !plan.BiosStatusEnabled.IsNull() && !plan.BiosStatusEnabled.IsUnknown() {
-		if plan.BiosStatusEnabled.ValueBool() != *(getOemStorage(current.StorageControllers[0].Oem).BiosStatusEnabled) {
+		if !isStoragePropertyApplied(plan.BiosStatusEnabled.ValueBool(), oem.BiosStatusEnabled) {
 			status = false
 			tflog.Info(ctx, "Value for property BIOSStatus has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.BiosStatusEnabled.ValueBool(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).BiosStatusEnabled),
+				"reported": reportedStorageProperty(oem.BiosStatusEnabled),
 			})
 		}
 	}
 
 	if !plan.PatrolRead.IsNull() && !plan.PatrolRead.IsUnknown() {
-		if plan.PatrolRead.ValueString() != getOemStorage(current.StorageControllers[0].Oem).PatrolRead {
+		if plan.PatrolRead.ValueString() != oem.PatrolRead {
 			status = false
 			tflog.Info(ctx, "Value for property PatrolRead has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.PatrolRead.ValueString(),
-				"reported": getOemStorage(current.StorageControllers[0].Oem).PatrolRead,
+				"reported": oem.PatrolRead,
 			})
 		}
 	}
 
 	if !plan.PatrolReadRate.IsNull() && !plan.PatrolReadRate.IsUnknown() {
-		if plan.PatrolReadRate.ValueInt64() != *(getOemStorage(current.StorageControllers[0].Oem).PatrolReadRatePercent) {
+		if !isStoragePropertyApplied(plan.PatrolReadRate.ValueInt64(), oem.PatrolReadRatePercent) {
 			status = false
 			tflog.Info(ctx, "Value for property PatrolReadRate has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.PatrolReadRate.ValueInt64(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).PatrolReadRatePercent),
+				"reported": reportedStorageProperty(oem.PatrolReadRatePercent),
 			})
 		}
 	}
 
 	if !plan.PatrolReadRecoverySupport.IsNull() && !plan.PatrolReadRecoverySupport.IsUnknown() {
-		if plan.PatrolReadRecoverySupport.ValueBool() != *(getOemStorage(current.StorageControllers[0].Oem).PatrolReadRecoverySupport) {
+		if !isStoragePropertyApplied(plan.PatrolReadRecoverySupport.ValueBool(), oem.PatrolReadRecoverySupport) {
 			status = false
 			tflog.Info(ctx, "Value for property PatrolReadRecoverySupport has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.PatrolReadRecoverySupport.ValueBool(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).PatrolReadRecoverySupport),
+				"reported": reportedStorageProperty(oem.PatrolReadRecoverySupport),
 			})
 		}
 	}
 
 	if !plan.BGIRate.IsNull() && !plan.BGIRate.IsUnknown() {
-		if plan.BGIRate.ValueInt64() != *(getOemStorage(current.StorageControllers[0].Oem).BGIRate) {
+		if !isStoragePropertyApplied(plan.BGIRate.ValueInt64(), oem.BGIRate) {
 			status = false
 			tflog.Info(ctx, "Value for property BGIRate has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.BGIRate.ValueInt64(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).BGIRate),
+				"reported": reportedStorageProperty(oem.BGIRate),
 			})
 		}
 	}
 
 	if !plan.MDCRate.IsNull() && !plan.MDCRate.IsUnknown() {
-		if plan.MDCRate.ValueInt64() != *(getOemStorage(current.StorageControllers[0].Oem).MDCRate) {
+		if !isStoragePropertyApplied(plan.MDCRate.ValueInt64(), oem.MDCRate) {
 			status = false
 			tflog.Info(ctx, "Value for property MDCRate has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.MDCRate.ValueInt64(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).MDCRate),
+				"reported": reportedStorageProperty(oem.MDCRate),
 			})
 		}
 	}
 
 	if !plan.RebuildRate.IsNull() && !plan.RebuildRate.IsUnknown() {
-		if plan.RebuildRate.ValueInt64() != *(getOemStorage(current.StorageControllers[0].Oem).RebuildRate) {
+		if !isStoragePropertyApplied(plan.RebuildRate.ValueInt64(), oem.RebuildRate) {
 			status = false
 			tflog.Info(ctx, "Value for property RebuildRate has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.RebuildRate.ValueInt64(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).RebuildRate),
+				"reported": reportedStorageProperty(oem.RebuildRate),
 			})
 		}
 	}
 
 	if !plan.MigrationRate.IsNull() && !plan.MigrationRate.IsUnknown() {
-		if plan.MigrationRate.ValueInt64() != *(getOemStorage(current.StorageControllers[0].Oem).MigrationRate) {
+		if !isStoragePropertyApplied(plan.MigrationRate.ValueInt64(), oem.MigrationRate) {
 			status = false
 			tflog.Info(ctx, "Value for property MigrationRate has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.MigrationRate.ValueInt64(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).MigrationRate),
+				"reported": reportedStorageProperty(oem.MigrationRate),
 			})
 		}
 	}
 
 	if !plan.SpindownDelay.IsNull() && !plan.SpindownDelay.IsUnknown() {
-		if plan.SpindownDelay.ValueInt64() != *(getOemStorage(current.StorageControllers[0].Oem).SpindownDelay) {
+		if !isStoragePropertyApplied(plan.SpindownDelay.ValueInt64(), oem.SpindownDelay) {
 			status = false
 			tflog.Info(ctx, "Value for property SpindownDelay has not yet reached planned value", map[string]interface{}{
-				"plan":     plan.MigrationRate.ValueInt64(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).SpindownDelay),
+				"plan":     plan.SpindownDelay.ValueInt64(),
+				"reported": reportedStorageProperty(oem.SpindownDelay),
 			})
 		}
 	}
 
 	if !plan.SpinupDelay.IsNull() && !plan.SpinupDelay.IsUnknown() {
-		if plan.SpinupDelay.ValueInt64() != *(getOemStorage(current.StorageControllers[0].Oem).SpinupDelay) {
+		if !isStoragePropertyApplied(plan.SpinupDelay.ValueInt64(), oem.SpinupDelay) {
 			status = false
 			tflog.Info(ctx, "Value for property SpinupDelay has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.SpinupDelay.ValueInt64(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).SpinupDelay),
+				"reported": reportedStorageProperty(oem.SpinupDelay),
 			})
 		}
 	}
 
 	if !plan.SpindownUnconfDrive.IsNull() && !plan.SpindownUnconfDrive.IsUnknown() {
-		if plan.SpindownUnconfDrive.ValueBool() != *(getOemStorage(current.StorageControllers[0].Oem).SpindownUnconfiguredDrive) {
+		if !isStoragePropertyApplied(plan.SpindownUnconfDrive.ValueBool(), oem.SpindownUnconfiguredDrive) {
 			status = false
 			tflog.Info(ctx, "Value for property SpindownUnconfiguredDrive has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.SpindownUnconfDrive.ValueBool(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).SpindownUnconfiguredDrive),
+				"reported": reportedStorageProperty(oem.SpindownUnconfiguredDrive),
 			})
 		}
 	}
 
 	if !plan.SpindownHotspare.IsNull() && !plan.SpindownHotspare.IsUnknown() {
-		if plan.SpindownHotspare.ValueBool() != *(getOemStorage(current.StorageControllers[0].Oem).SpindownHotspare) {
+		if !isStoragePropertyApplied(plan.SpindownHotspare.ValueBool(), oem.SpindownHotspare) {
 			status = false
 			tflog.Info(ctx, "Value for property SpindownHotspare has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.SpindownHotspare.ValueBool(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).SpindownHotspare),
+				"reported": reportedStorageProperty(oem.SpindownHotspare),
 			})
 		}
 	}
 
 	if !plan.MDCScheduleMode.IsNull() && !plan.MDCScheduleMode.IsUnknown() {
-		if plan.MDCScheduleMode.ValueString() != getOemStorage(current.StorageControllers[0].Oem).MDCScheduleMode {
+		if plan.MDCScheduleMode.ValueString() != oem.MDCScheduleMode {
 			status = false
 			tflog.Info(ctx, "Value for property MDCScheduleMode has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.MDCScheduleMode.ValueString(),
-				"reported": getOemStorage(current.StorageControllers[0].Oem).MDCScheduleMode,
+				"reported": oem.MDCScheduleMode,
 			})
 		}
 	}
 
 	if !plan.MDCAbortOnError.IsNull() && !plan.MDCAbortOnError.IsUnknown() {
-		if plan.MDCAbortOnError.ValueBool() != *(getOemStorage(current.StorageControllers[0].Oem).MDCAbortOnError) {
+		if !isStoragePropertyApplied(plan.MDCAbortOnError.ValueBool(), oem.MDCAbortOnError) {
 			status = false
 			tflog.Info(ctx, "Value for property MDCAbortOnError has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.MDCAbortOnError.ValueBool(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).MDCAbortOnError),
+				"reported": reportedStorageProperty(oem.MDCAbortOnError),
 			})
 		}
 	}
 
 	if !plan.CoercionMode.IsNull() && !plan.CoercionMode.IsUnknown() {
-		if plan.CoercionMode.ValueString() != getOemStorage(current.StorageControllers[0].Oem).CoercionMode {
+		if plan.CoercionMode.ValueString() != oem.CoercionMode {
 			status = false
 			tflog.Info(ctx, "Value for property CoercionMode has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.CoercionMode.ValueString(),
-				"reported": getOemStorage(current.StorageControllers[0].Oem).CoercionMode,
+				"reported": oem.CoercionMode,
 			})
 		}
 	}
@@ -518,11 +701,21 @@ func checkAppliedSettingsFromPlan(ctx context.Context, plan models.StorageResour
 		}
 	*/
 	if !plan.AutoRebuild.IsNull() && !plan.AutoRebuild.IsUnknown() {
-		if plan.AutoRebuild.ValueBool() != *(getOemStorage(current.StorageControllers[0].Oem).AutoRebuild) {
+		if !isStoragePropertyApplied(plan.AutoRebuild.ValueBool(), oem.AutoRebuild) {
 			status = false
 			tflog.Info(ctx, "Value for property AutoRebuild has not yet reached planned value", map[string]interface{}{
 				"plan":     plan.AutoRebuild.ValueBool(),
-				"reported": *(getOemStorage(current.StorageControllers[0].Oem).AutoRebuild),
+				"reported": reportedStorageProperty(oem.AutoRebuild),
+			})
+		}
+	}
+
+	if !plan.BootVolume.IsNull() && !plan.BootVolume.IsUnknown() {
+		if plan.BootVolume.ValueString() != getStorageBootVolume(oem) {
+			status = false
+			tflog.Info(ctx, "Value for property BootVolume has not yet reached planned value", map[string]interface{}{
+				"plan":     plan.BootVolume.ValueString(),
+				"reported": getStorageBootVolume(oem),
 			})
 		}
 	}
@@ -553,36 +746,30 @@ func waitUntilStorageChangesApplied(ctx context.Context, service *gofish.Service
 	if len(task_location) != 0 {
 		_, err := WaitForRedfishTaskEnd(ctx, service, task_location, timeout)
 		if err != nil {
-			diags.AddError("Task for storage controller modification reported error", err.Error())
-			logs, internal_diags := FetchRedfishTaskLog(service, task_location, is_fsas)
-			if logs == nil {
-				diags = append(diags, internal_diags...)
-			} else {
-				diags.AddError("Task logs for volume creation", string(logs))
-			}
+			diags.Append(taskFailureDiagnostics(service, task_location, is_fsas, "Task for storage controller modification reported error", err)...)
 
 			return diags
 		}
 	}
 
-	for {
-		if checkIfPlannedStorageChangesSuccessfullyApplied(ctx, service, plan) {
-			return diags
-		}
-
-		if time.Now().Unix()-startTime > timeout {
-			diags.AddError("Timeout for storage controller change expired", fmt.Sprintf("Timeout of %d s has been reached", timeout))
-			return diags
-		}
+	remaining := timeout - (time.Now().Unix() - startTime)
+	err := pollService(ctx, service, remaining, 5*time.Second, func(ctx context.Context) (bool, error) {
+		return checkIfPlannedStorageChangesSuccessfullyApplied(ctx, service, plan), nil
+	})
 
-		time.Sleep(5 * time.Second)
+	if errors.Is(err, errPollTimeout) {
+		diags.AddError("Timeout for storage controller change expired", fmt.Sprintf("Timeout of %d s has been reached", timeout))
+	} else if err != nil {
+		diags.Append(redfishErrorDiagnostics("Waiting for storage controller change has been interrupted", err)...)
 	}
+
+	return diags
 }
 
 func applyStorageControllerProperties(ctx context.Context, api *gofish.APIClient, plan *models.StorageResourceModel) (diags diag.Diagnostics) {
 	storage, err := getSystemStorageFromSerialNumber(api.Service, plan.StorageControllerSN.ValueString())
 	if err != nil {
-		diags.AddError("Requested storage serial does not match to any installed controller serial.", err.Error())
+		diags.Append(redfishErrorDiagnostics("Requested storage serial does not match to any installed controller serial.", err)...)
 		return diags
 	}
 
@@ -592,11 +779,43 @@ func applyStorageControllerProperties(ctx context.Context, api *gofish.APIClient
 
 	isFsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		diags.AddError("Server vendor verification failed", err.Error())
+		diags.Append(redfishErrorDiagnostics("Server vendor verification failed", err)...)
+		return diags
+	}
+
+	if !plan.BootVolume.IsNull() && !plan.BootVolume.IsUnknown() {
+		volumes, err := storage.Volumes()
+		if err != nil {
+			diags.Append(redfishErrorDiagnostics("Could not obtain volumes of storage controller", err)...)
+			return diags
+		}
+
+		if err = validateStorageBootVolume(volumes, plan.BootVolume.ValueString()); err != nil {
+			diags.Append(redfishErrorDiagnostics("Requested boot volume does not exist on storage controller", err)...)
+			return diags
+		}
+	}
+
+	var current Storage_Fujitsu
+	err = getParsedStorageResource(api.Service, storage.ODataID, &current)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not obtain storage resource settings", err)...)
+		return diags
+	}
+
+	oem, index, err := getStorageControllerOem(current, plan.StorageControllerSN.ValueString())
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Storage controller reports not supported OEM layout", err)...)
 		return diags
 	}
 
-	payload, anyValue := convertPlanToPayload(isFsas, *plan)
+	if unsupported := getUnsupportedStorageProperties(*plan, oem); len(unsupported) > 0 {
+		diags.AddError("Storage controller does not support requested properties",
+			fmt.Sprintf("Properties not reported by controller '%s': %v", plan.StorageControllerSN.ValueString(), unsupported))
+		return diags
+	}
+
+	payload, anyValue := convertPlanToPayload(isFsas, *plan, index)
 
 	if !anyValue {
 		diags.AddError("Payload created out of defined plan will be empty.",
@@ -606,9 +825,10 @@ func applyStorageControllerProperties(ctx context.Context, api *gofish.APIClient
 
 	startTime := time.Now().Unix()
 	timeout := plan.JobTimeout.ValueInt64()
-	taskLocation, err := patchStorageEndpoint(ctx, api.Service, storage.ODataID, payload)
+	taskLocation, warnings, err := patchStorageEndpoint(ctx, api.Service, storage.ODataID, payload)
+	diags.Append(warnings...)
 	if err != nil {
-		diags.AddError("Error during PATCH to storage controller.", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error during PATCH to storage controller.", err)...)
 		return diags
 	}
 
@@ -637,6 +857,28 @@ func getStorageResource(service *gofish.Service, endpoint string) (out []byte, e
 	return out, err
 }
 
+// getStorageBootVolume returns ODataId of volume configured as boot device of the controller.
+func getStorageBootVolume(oem storageControllerOem) string {
+	if oem.BootVolume == nil {
+		return ""
+	}
+
+	return oem.BootVolume.ODataId
+}
+
+// validateStorageBootVolume checks that volume pointed by volumeODataId exists on the controller.
+func validateStorageBootVolume(volumes []*redfish.Volume, volumeODataId string) error {
+	ids := []string{}
+	for _, volume := range volumes {
+		if strings.TrimSuffix(volume.ODataID, "/") == strings.TrimSuffix(volumeODataId, "/") {
+			return nil
+		}
+		ids = append(ids, volume.ODataID)
+	}
+
+	return fmt.Errorf("volume '%s' does not exist on storage controller, existing volumes: %v", volumeODataId, ids)
+}
+
 func getParsedStorageResource(service *gofish.Service, endpoint string, config *Storage_Fujitsu) error {
 	body, err := getStorageResource(service, endpoint)
 	if err != nil {
@@ -646,67 +888,100 @@ func getParsedStorageResource(service *gofish.Service, endpoint string, config *
 	return json.Unmarshal(body, config)
 }
 
-func copyStorageConfigIntoModel(storageConfig Storage_Fujitsu, state *models.StorageSettings) {
-	state.BiosContinueOnError = types.StringValue(getOemStorage(storageConfig.StorageControllers[0].Oem).BiosContinueOnError)
-	state.PatrolRead = types.StringValue(getOemStorage(storageConfig.StorageControllers[0].Oem).PatrolRead)
-	state.MDCScheduleMode = types.StringValue(getOemStorage(storageConfig.StorageControllers[0].Oem).MDCScheduleMode)
-	state.CoercionMode = types.StringValue(getOemStorage(storageConfig.StorageControllers[0].Oem).CoercionMode)
+func copyStorageConfigIntoModel(storageConfig Storage_Fujitsu, state *models.StorageSettings) error {
+	oem, _, err := getStorageControllerOem(storageConfig, state.StorageControllerSN.ValueString())
+	if err != nil {
+		return err
+	}
+
+	state.BiosContinueOnError = types.StringValue(oem.BiosContinueOnError)
+	state.PatrolRead = types.StringValue(oem.PatrolRead)
+	state.MDCScheduleMode = types.StringValue(oem.MDCScheduleMode)
+	state.CoercionMode = types.StringValue(oem.CoercionMode)
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).BiosStatusEnabled != nil {
-		state.BiosStatusEnabled = types.BoolValue(*(getOemStorage(storageConfig.StorageControllers[0].Oem).BiosStatusEnabled))
+	if oem.BiosStatusEnabled != nil {
+		state.BiosStatusEnabled = types.BoolValue(*(oem.BiosStatusEnabled))
+	} else {
+		state.BiosStatusEnabled = types.BoolNull()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).PatrolReadRatePercent != nil {
-		state.PatrolReadRate = types.Int64Value(*(getOemStorage(storageConfig.StorageControllers[0].Oem).PatrolReadRatePercent))
+	if oem.PatrolReadRatePercent != nil {
+		state.PatrolReadRate = types.Int64Value(*(oem.PatrolReadRatePercent))
+	} else {
+		state.PatrolReadRate = types.Int64Null()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).PatrolReadRecoverySupport != nil {
-		state.PatrolReadRecoverySupport = types.BoolValue(*(getOemStorage(storageConfig.StorageControllers[0].Oem).PatrolReadRecoverySupport))
+	if oem.PatrolReadRecoverySupport != nil {
+		state.PatrolReadRecoverySupport = types.BoolValue(*(oem.PatrolReadRecoverySupport))
 	} else {
 		state.PatrolReadRecoverySupport = types.BoolValue(false)
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).BGIRate != nil {
-		state.BGIRate = types.Int64Value(*(getOemStorage(storageConfig.StorageControllers[0].Oem).BGIRate))
+	if oem.BGIRate != nil {
+		state.BGIRate = types.Int64Value(*(oem.BGIRate))
+	} else {
+		state.BGIRate = types.Int64Null()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).MDCRate != nil {
-		state.MDCRate = types.Int64Value(*(getOemStorage(storageConfig.StorageControllers[0].Oem).MDCRate))
+	if oem.MDCRate != nil {
+		state.MDCRate = types.Int64Value(*(oem.MDCRate))
+	} else {
+		state.MDCRate = types.Int64Null()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).RebuildRate != nil {
-		state.RebuildRate = types.Int64Value(*(getOemStorage(storageConfig.StorageControllers[0].Oem).RebuildRate))
+	if oem.RebuildRate != nil {
+		state.RebuildRate = types.Int64Value(*(oem.RebuildRate))
+	} else {
+		state.RebuildRate = types.Int64Null()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).MigrationRate != nil {
-		state.MigrationRate = types.Int64Value(*(getOemStorage(storageConfig.StorageControllers[0].Oem).MigrationRate))
+	if oem.MigrationRate != nil {
+		state.MigrationRate = types.Int64Value(*(oem.MigrationRate))
+	} else {
+		state.MigrationRate = types.Int64Null()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).SpindownDelay != nil {
-		state.SpindownDelay = types.Int64Value(*(getOemStorage(storageConfig.StorageControllers[0].Oem).SpindownDelay))
+	if oem.SpindownDelay != nil {
+		state.SpindownDelay = types.Int64Value(*(oem.SpindownDelay))
+	} else {
+		state.SpindownDelay = types.Int64Null()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).SpindownDelay != nil {
-		state.SpinupDelay = types.Int64Value(*(getOemStorage(storageConfig.StorageControllers[0].Oem).SpindownDelay))
+	if oem.SpinupDelay != nil {
+		state.SpinupDelay = types.Int64Value(*(oem.SpinupDelay))
+	} else {
+		state.SpinupDelay = types.Int64Null()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).SpindownUnconfiguredDrive != nil {
-		state.SpindownUnconfDrive = types.BoolValue(*(getOemStorage(storageConfig.StorageControllers[0].Oem).SpindownUnconfiguredDrive))
+	if oem.SpindownUnconfiguredDrive != nil {
+		state.SpindownUnconfDrive = types.BoolValue(*(oem.SpindownUnconfiguredDrive))
+	} else {
+		state.SpindownUnconfDrive = types.BoolNull()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).SpindownHotspare != nil {
-		state.SpindownHotspare = types.BoolValue(*(getOemStorage(storageConfig.StorageControllers[0].Oem).SpindownHotspare))
+	if oem.SpindownHotspare != nil {
+		state.SpindownHotspare = types.BoolValue(*(oem.SpindownHotspare))
+	} else {
+		state.SpindownHotspare = types.BoolNull()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).AutoRebuild != nil {
-		state.AutoRebuild = types.BoolValue(*(getOemStorage(storageConfig.StorageControllers[0].Oem).AutoRebuild))
+	if oem.AutoRebuild != nil {
+		state.AutoRebuild = types.BoolValue(*(oem.AutoRebuild))
+	} else {
+		state.AutoRebuild = types.BoolNull()
 	}
 
-	if getOemStorage(storageConfig.StorageControllers[0].Oem).MDCAbortOnError != nil {
-		state.MDCAbortOnError = types.BoolValue(*(getOemStorage(storageConfig.StorageControllers[0].Oem).MDCAbortOnError))
+	if oem.MDCAbortOnError != nil {
+		state.MDCAbortOnError = types.BoolValue(*(oem.MDCAbortOnError))
 	} else {
 		state.MDCAbortOnError = types.BoolValue(false)
 	}
+
+	if bootVolume := getStorageBootVolume(oem); len(bootVolume) > 0 {
+		state.BootVolume = types.StringValue(bootVolume)
+	} else {
+		state.BootVolume = types.StringNull()
+	}
 	/*
 				if storageConfig.StorageControllers[0].Oem.OemFujitsu.CopybackSupport != nil {
 		    		state.CopybackSupport = types.BoolValue(storageConfig.StorageControllers[0].Oem.OemFujitsu.CopybackSupport)
@@ -720,6 +995,7 @@ func copyStorageConfigIntoModel(storageConfig Storage_Fujitsu, state *models.Sto
 		    		state.CopybackOnSSDSmartErrorSupport = types.BoolValue(storageConfig.StorageControllers[0].Oem.OemFujitsu.CopybackOnSSDSmartErrorSupport)
 		        }
 	*/
+	return nil
 }
 
 func readStorageControllerSettings(service *gofish.Service, serialNumber string, storageResource *Storage_Fujitsu) (odataid string, err error) {
@@ -740,10 +1016,14 @@ func readStorageControllerSettingsToState(service *gofish.Service, state *models
 	var storageResource Storage_Fujitsu
 	odataid, err := readStorageControllerSettings(service, state.StorageControllerSN.ValueString(), &storageResource)
 	if err != nil {
-		diags.AddError("Could not obtain storage resource settings", err.Error())
+		diags.Append(redfishErrorDiagnostics("Could not obtain storage resource settings", err)...)
 		return odataid, diags
 	}
 
-	copyStorageConfigIntoModel(storageResource, state)
+	err = copyStorageConfigIntoModel(storageResource, state)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not read storage controller settings", err)...)
+	}
+
 	return odataid, diags
 }
@@ -56,6 +56,11 @@ type raidCapabilitiesConfig struct {
 	} `json:"RAIDLevels"`
 }
 
+const (
+	VOLUME_INIT_STATE_IN_PROGRESS = "InProgress"
+	VOLUME_INIT_STATE_COMPLETED   = "Completed"
+)
+
 type volumeOem struct {
 	Name           string `json:"Name,omitempty"`
 	InitMode       string `json:"InitMode,omitempty"`
@@ -105,6 +110,15 @@ func getSystemStorageOemRaidCapabilitiesResource(service *gofish.Service, endpoi
 	return config, nil
 }
 
+// getRaidCapabilitiesEndpoint returns endpoint of OEM RAIDCapabilities resource of storage controller.
+func getRaidCapabilitiesEndpoint(storageODataId string, isFsas bool) string {
+	if isFsas {
+		return storageODataId + STORAGE_RAIDCAPABILITIES_FSAS_SUFFIX
+	}
+
+	return storageODataId + STORAGE_RAIDCAPABILITIES_SUFFIX
+}
+
 func getVolumesCollectionUrl(service *gofish.Service, serial string) (url string, err error) {
 	storage, err := getSystemStorageFromSerialNumber(service, serial)
 	if err != nil {
@@ -132,15 +146,8 @@ func validateRequestAgainstStorageControllerCapabilities(ctx context.Context, se
 	}
 
 	// Obtain RAIDCapabilities for particular storage controller
-	raidc_endpoint := storage.ODataID
-	if is_fsas {
-		raidc_endpoint = raidc_endpoint + STORAGE_RAIDCAPABILITIES_FSAS_SUFFIX
-	} else {
-		raidc_endpoint = raidc_endpoint + STORAGE_RAIDCAPABILITIES_SUFFIX
-	}
-
 	var capabilities raidCapabilitiesConfig
-	capabilities, err = getSystemStorageOemRaidCapabilitiesResource(service, raidc_endpoint)
+	capabilities, err = getSystemStorageOemRaidCapabilitiesResource(service, getRaidCapabilitiesEndpoint(storage.ODataID, is_fsas))
 	if err != nil {
 		return physical_disk_groups, fmt.Errorf("storage controller capabilities could not be obtained %s", err.Error())
 	}
@@ -263,43 +270,18 @@ func verifyRequestedDisks(ctx context.Context, plan models.StorageVolumeResource
 					"Drive location": drive.Location[0].Info,
 				})
 
-				drive_s := strings.NewReader(drive.Location[0].Info)
-				var (
-					system     int
-					controller int
-					enclosure  int
-					slot       int
-				)
-
-				// Differentiate between drives in enclosure and directly attached
-				var err error
-				enclosure_attached := false
-				if drive.Location[0].InfoFormat == "[ System_Id : Controller_Id : Enclosure_Id : Slot_Id ]" {
-					_, err = fmt.Fscanf(drive_s, "[ %d : %d : %d : %d ]",
-						&system, &controller, &enclosure, &slot)
-					enclosure_attached = true
-				} else {
-					_, err = fmt.Fscanf(drive_s, "[ %d : %d : %d ]", &system, &controller, &slot)
-				}
-
+				slot, err := getDriveSlot(drive)
 				if err != nil {
 					tflog.Warn(ctx, "Scanning disk location failed", map[string]interface{}{
-						"drive": drive_s,
+						"drive": drive.Location[0].Info,
 					})
+					continue
 				}
 
-				if enclosure_attached {
-					if fmt.Sprintf("%d-%d", enclosure, slot) == disk {
-						disk_found = true
-						drives_media_type = drive.MediaType
-						break
-					}
-				} else {
-					if strconv.Itoa(slot) == disk {
-						disk_found = true
-						drives_media_type = drive.MediaType
-						break
-					}
+				if slot == disk {
+					disk_found = true
+					drives_media_type = drive.MediaType
+					break
 				}
 			}
 
@@ -320,6 +302,36 @@ func verifyRequestedDisks(ctx context.Context, plan models.StorageVolumeResource
 	return physical_disks, drives_media_type, nil
 }
 
+// getDriveSlot returns slot location of drive in format used by physical_drives, i.e. "<enclosure>-<slot>"
+// for drives in enclosure and "<slot>" for directly attached drives.
+func getDriveSlot(drive *redfish.Drive) (string, error) {
+	if len(drive.Location) == 0 {
+		return "", fmt.Errorf("drive %s does not report its location", drive.ODataID)
+	}
+
+	drive_s := strings.NewReader(drive.Location[0].Info)
+	var (
+		system     int
+		controller int
+		enclosure  int
+		slot       int
+	)
+
+	// Differentiate between drives in enclosure and directly attached
+	if drive.Location[0].InfoFormat == "[ System_Id : Controller_Id : Enclosure_Id : Slot_Id ]" {
+		if _, err := fmt.Fscanf(drive_s, "[ %d : %d : %d : %d ]", &system, &controller, &enclosure, &slot); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d-%d", enclosure, slot), nil
+	}
+
+	if _, err := fmt.Fscanf(drive_s, "[ %d : %d : %d ]", &system, &controller, &slot); err != nil {
+		return "", err
+	}
+
+	return strconv.Itoa(slot), nil
+}
+
 // getNewVolumeConfigFromPlan based on plan and already converted list of disks in physical_disks
 // returns map containing whole request as map.
 func getNewVolumeConfigFromPlan(plan models.StorageVolumeResourceModel,
@@ -376,13 +388,13 @@ func getNewVolumeConfigFromPlan(plan models.StorageVolumeResourceModel,
 func getVolumesIdsList(service *gofish.Service, storage_id string) (out []string, diags diag.Diagnostics) {
 	storage, err := getSystemStorageFromSerialNumber(service, storage_id)
 	if err != nil {
-		diags.AddError("Could not obtain storage controller with requested id", err.Error())
+		diags.Append(redfishErrorDiagnostics("Could not obtain storage controller with requested id", err)...)
 		return
 	}
 
 	volumes, err := storage.Volumes()
 	if err != nil {
-		diags.AddError("Could not obtain volumes of storage controller with requested id", err.Error())
+		diags.Append(redfishErrorDiagnostics("Could not obtain volumes of storage controller with requested id", err)...)
 		return
 	}
 
@@ -404,34 +416,73 @@ func getRecentlyCreatedVolumeId(ids_after, ids_before []string) string {
 }
 
 // requestVolumeCreationAndSuperviseTheProcess sends creation request and waits until created task
-// will finish.
+// will finish. Endpoint of created volume is returned if service reported it, either as Location
+// of synchronously created volume or in Links.CreatedResources of the task. If waiting for the task
+// has been interrupted, location of still running task is returned as pending_task.
 func requestVolumeCreationAndSuperviseTheProcess(ctx context.Context, service *gofish.Service,
-	volumes_collection_endpoint string, new_volume_payload map[string]interface{}, is_fsas bool, timeout int64) (diags diag.Diagnostics) {
+	volumes_collection_endpoint string, new_volume_payload map[string]interface{}, is_fsas bool, timeout int64) (volume_endpoint string, pending_task string, diags diag.Diagnostics) {
 	res, err := service.GetClient().Post(volumes_collection_endpoint, new_volume_payload)
 	if err != nil {
-		diags.AddError("Error while requesting POST on volume collection", err.Error())
-		return diags
+		diags.Append(redfishErrorDiagnostics("Error while requesting POST on volume collection", err)...)
+		return "", "", diags
 	}
 
 	defer CloseResource(res.Body)
 
-	if res.StatusCode == http.StatusAccepted {
+	switch res.StatusCode {
+	case http.StatusCreated:
+		return res.Header.Get(HTTP_HEADER_LOCATION), "", diags
+	case http.StatusAccepted:
 		task_location := res.Header.Get(HTTP_HEADER_LOCATION)
-		_, err := WaitForRedfishTaskEnd(ctx, service, task_location, timeout)
-		if err != nil {
-			diags.AddError("Task for volume creation reported error", err.Error())
-			logs, internal_diags := FetchRedfishTaskLog(service, task_location, is_fsas)
-			if logs == nil {
-				diags = append(diags, internal_diags...)
-			} else {
-				diags.AddError("Task logs for volume creation", string(logs))
-			}
+		volume_endpoint, interrupted, diags := superviseVolumeCreationTask(ctx, service, task_location, is_fsas, timeout)
+		if interrupted {
+			return "", task_location, diags
 		}
+		return volume_endpoint, "", diags
+	default:
+		diags.AddError("POST request on volume collection finished with error",
+			fmt.Sprintf("Service responded with status %d instead of expected %d", res.StatusCode, http.StatusAccepted))
+	}
+	return "", "", diags
+}
 
-	} else {
-		diags.AddError("POST request on volume collection finished with error", "Non 200")
+// superviseVolumeCreationTask waits until task pointed by task_location finishes and returns
+// endpoint of volume created by the task, if the task reports it. If waiting has been interrupted,
+// interrupted is returned as true together with error diagnostics, while the task keeps running.
+func superviseVolumeCreationTask(ctx context.Context, service *gofish.Service, task_location string,
+	is_fsas bool, timeout int64) (volume_endpoint string, interrupted bool, diags diag.Diagnostics) {
+	_, err := WaitForRedfishTaskEnd(ctx, service, task_location, timeout)
+	if errors.Is(err, errPollCancelled) {
+		diags.Append(redfishErrorDiagnostics("Waiting for volume creation task has been interrupted", err)...)
+		return "", true, diags
 	}
-	return diags
+
+	if err != nil {
+		diags.Append(taskFailureDiagnostics(service, task_location, is_fsas, "Task for volume creation reported error", err)...)
+		return "", false, diags
+	}
+
+	created, err := GetRedfishTaskCreatedResources(service, task_location)
+	if err != nil {
+		tflog.Warn(ctx, "Resources created by volume creation task could not be read", map[string]interface{}{
+			"task":  task_location,
+			"error": err.Error(),
+		})
+		return "", false, diags
+	}
+
+	return getCreatedVolumeEndpoint(created), false, diags
+}
+
+// getCreatedVolumeEndpoint returns first of resources created by task which is a volume.
+func getCreatedVolumeEndpoint(created []string) string {
+	for _, endpoint := range created {
+		if strings.Contains(endpoint, "/Volumes/") {
+			return strings.TrimSuffix(endpoint, "/")
+		}
+	}
+
+	return ""
 }
 
 // getValidStorageEndpointFromSerial returns storage which represents itself
@@ -460,29 +511,31 @@ func updateVolumeODataId(validStorageEndpoint string, state *models.StorageVolum
 }
 
 // requestAndSuperviseVolumeCreationProcess tries to create volume inside of service according to plan.
+// Endpoint of created volume is returned if reported by service, otherwise it's empty.
+// Location of creation task is returned as pending_task if waiting for it has been interrupted.
 func requestAndSuperviseVolumeCreationProcess(ctx context.Context, api *gofish.APIClient,
-	plan models.StorageVolumeResourceModel) (diags diag.Diagnostics) {
+	plan models.StorageVolumeResourceModel) (volume_endpoint string, task_location string, diags diag.Diagnostics) {
 
 	storage_id := plan.StorageControllerSN.ValueString()
 
 	is_fsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		diags.AddError("Vendor detection failed", err.Error())
-		return diags
+		diags.Append(redfishErrorDiagnostics("Vendor detection failed", err)...)
+		return "", "", diags
 	}
 
 	physical_disk_groups, err := validateRequestAgainstStorageControllerCapabilities(ctx, api.Service, storage_id, is_fsas, plan)
 	if err != nil {
-		diags.AddError("Error during request validation", err.Error())
-		return diags
+		diags.Append(redfishErrorDiagnostics("Error during request validation", err)...)
+		return "", "", diags
 	}
 
 	new_volume_payload := getNewVolumeConfigFromPlan(plan, physical_disk_groups)
 
 	volumes_collection_endpoint, err := getVolumesCollectionUrl(api.Service, storage_id)
 	if err != nil {
-		diags.AddError("Could not obtain volumes url", err.Error())
-		return diags
+		diags.Append(redfishErrorDiagnostics("Could not obtain volumes url", err)...)
+		return "", "", diags
 	}
 
 	tflog.Info(ctx, "Volume create request details", map[string]interface{}{
@@ -502,7 +555,7 @@ func deleteStorageVolume(ctx context.Context, service *gofish.Service,
 
 	res, err := service.GetClient().Delete(volume_endpoint)
 	if err != nil {
-		diags.AddError("Request to delete volume reported error", err.Error())
+		diags.Append(redfishErrorDiagnostics("Request to delete volume reported error", err)...)
 		return diags
 	}
 
@@ -512,16 +565,11 @@ func deleteStorageVolume(ctx context.Context, service *gofish.Service,
 		task_location := res.Header.Get(HTTP_HEADER_LOCATION)
 		_, err := WaitForRedfishTaskEnd(ctx, service, task_location, timeout)
 		if err != nil {
-			diags.AddError("Task for volume deletion reported error", err.Error())
-			logs, internal_diags := FetchRedfishTaskLog(service, task_location, is_fsas)
-			if logs == nil {
-				diags = append(diags, internal_diags...)
-			} else {
-				diags.AddError("Task logs for volume creation", string(logs))
-			}
+			diags.Append(taskFailureDiagnostics(service, task_location, is_fsas, "Task for volume deletion reported error", err)...)
 		}
 	} else {
-		diags.AddError("DELETE request on volume collection finished with error", "Non 200")
+		diags.AddError("DELETE request on volume collection finished with error",
+			fmt.Sprintf("Service responded with status %d instead of expected %d", res.StatusCode, http.StatusAccepted))
 	}
 
 	return diags
@@ -535,7 +583,7 @@ func doesVolumeStillExist(service *gofish.Service, volume_endpoint string) (volu
 	if err != nil {
 		var err_detailed *common.Error
 		if !errors.As(err, &err_detailed) {
-			diags.AddError("Error with getting volume", err.Error())
+			diags.Append(redfishErrorDiagnostics("Error with getting volume", err)...)
 			return nil, diags, false
 		}
 
@@ -577,7 +625,7 @@ func readStorageVolumeToState(volume *redfish.Volume, storage_serial string,
 	var volumeOem volumeOemObject
 	err := json.Unmarshal(volume.OEM, &volumeOem)
 	if err != nil {
-		diags.AddError("Could not unmarshal volume resource OEM object", err.Error())
+		diags.Append(redfishErrorDiagnostics("Could not unmarshal volume resource OEM object", err)...)
 		return diags
 	}
 
@@ -603,64 +651,185 @@ func readStorageVolumeToState(volume *redfish.Volume, storage_serial string,
 		state.DriveCacheMode = types.StringValue(volumeOem.OemFujitsu.DriveCacheMode)
 	}
 
+	initState, initPercent := getVolumeInitProgress(volume)
+	state.InitState = types.StringValue(initState)
+	state.InitPercent = types.Int64Value(initPercent)
+
 	return diags
 }
 
-// compareVolumePropertiesWithPlan reads current volume configuration and compare it in loop
-// until planned changes will be reflected by volume configuration from service.
-// The loop has timeout defined by timeout_s when operation will terminate if there will be still
-// differences between plan and volume.
-func compareVolumePropertiesWithPlan(ctx context.Context, service *gofish.Service, volume_id string,
-	plan models.StorageVolumeResourceModel, timeout_s int64) (bool, error) {
-	start_time := time.Now().Unix()
+// readStorageVolumeForImport reads volume pointed by volume_endpoint together with its storage controller
+// into state, so that configuration describing existing volume does not cause its replacement.
+func readStorageVolumeForImport(service *gofish.Service, volume_endpoint string,
+	state *models.StorageVolumeResourceModel) (diags diag.Diagnostics) {
 
-	nameVerified := true
-	verifyVolumeName := false
-	if !plan.VolumeName.IsUnknown() {
-		verifyVolumeName = true
-		nameVerified = false
+	volume, diags, _ := doesVolumeStillExist(service, volume_endpoint)
+	if volume == nil {
+		return diags
 	}
 
-	driveCacheVerified := true
-	verifyDriveCacheMode := false
-	if !plan.DriveCacheMode.IsUnknown() {
-		verifyDriveCacheMode = true
-		driveCacheVerified = false
+	suffix := strings.Index(volume_endpoint, "/Volumes")
+	if suffix < 0 {
+		diags.AddError("Invalid volume id", fmt.Sprintf("Volume id '%s' does not point to volume of storage resource", volume_endpoint))
+		return diags
 	}
 
-	for {
-		volume, err := redfish.GetVolume(service.GetClient(), volume_id)
+	storage, err := redfish.GetStorage(service.GetClient(), volume_endpoint[:suffix])
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not obtain storage resource of volume", err)...)
+		return diags
+	}
+
+	// Storage without serial number of controller (e.g. direct-attached NVMe) is identified by its Id
+	serial := getStorageControllerIdentity(storage).SerialNumber
+	if len(serial) == 0 {
+		serial = storage.ID
+	}
+
+	state.Id = types.StringValue(volume.ODataID)
+	state.JobTimeout = types.Int64Value(STORAGE_VOLUME_JOB_DEFAULT_TIMEOUT)
+	state.InitMode = types.StringNull()
+	state.ReadMode = &models.StorageVolumeDynamicParam{}
+	state.WriteMode = &models.StorageVolumeDynamicParam{}
+
+	diags.Append(readStorageVolumeToState(volume, serial, state)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Requested modes are not preserved in Redfish, so actual ones are the best guess
+	state.ReadMode.Requested = state.ReadMode.Actual
+	state.WriteMode.Requested = state.WriteMode.Actual
+
+	state.PhysicalDrives, diags = getVolumePhysicalDrives(service, volume)
+	return diags
+}
+
+// getVolumeDriveSlots returns slot locations of drives linked to volume. Drives are read one by one in order
+// of links, since order of drives matters for spanned RAID types.
+func getVolumeDriveSlots(service *gofish.Service, volume_endpoint string) ([]string, error) {
+	var raw struct {
+		Links struct {
+			Drives []struct {
+				ODataId string `json:"@odata.id"`
+			}
+		}
+	}
+
+	body, err := getStorageResource(service, volume_endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	slots := []string{}
+	for _, link := range raw.Links.Drives {
+		drive, err := redfish.GetDrive(service.GetClient(), link.ODataId)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 
-		var volumeOem volumeOemObject
-		err = json.Unmarshal(volume.OEM, &volumeOem)
+		slot, err := getDriveSlot(drive)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
+		slots = append(slots, slot)
+	}
 
-		if verifyVolumeName {
-			if volume.Name == plan.VolumeName.ValueString() {
-				nameVerified = true
-			}
+	return slots, nil
+}
+
+// getVolumePhysicalDrives returns drives of volume in format of physical_drives. Drives of spanned
+// RAID types are split into groups of MediaSpanCount drives, otherwise all drives form single group.
+func getVolumePhysicalDrives(service *gofish.Service, volume *redfish.Volume) (physical_drives types.List, diags diag.Diagnostics) {
+	slots, err := getVolumeDriveSlots(service, volume.ODataID)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Could not obtain drives of volume", err)...)
+		return types.ListNull(types.StringType), diags
+	}
+
+	if len(slots) == 0 {
+		diags.AddError("Could not obtain drives of volume", fmt.Sprintf("Volume %s does not link any drive", volume.ODataID))
+		return types.ListNull(types.StringType), diags
+	}
+
+	span := len(slots)
+	switch volume.RAIDType {
+	case redfish.RAID10RAIDType, redfish.RAID50RAIDType, redfish.RAID60RAIDType:
+		if volume.MediaSpanCount > 0 && len(slots)%volume.MediaSpanCount == 0 {
+			span = volume.MediaSpanCount
 		}
+	}
 
-		if verifyDriveCacheMode {
-			var driveCacheMode string
-			if volumeOem.OemFujitsu != nil {
-				driveCacheMode = volumeOem.OemFujitsu.DriveCacheMode
-			} else {
-				driveCacheMode = volumeOem.OemFsas.DriveCacheMode
-			}
+	groups := []string{}
+	for i := 0; i < len(slots); i += span {
+		group, _ := json.Marshal(slots[i : i+span])
+		groups = append(groups, string(group))
+	}
 
-			if driveCacheMode == plan.DriveCacheMode.ValueString() {
-				driveCacheVerified = true
-			}
+	return types.ListValueFrom(context.Background(), types.StringType, groups)
+}
+
+// getVolumeInitProgress returns state and percentage of volume initialization, which
+// after volume creation runs in background and is reported among volume operations.
+func getVolumeInitProgress(volume *redfish.Volume) (state string, percent int64) {
+	for _, operation := range volume.Operations {
+		if strings.Contains(strings.ToLower(operation.OperationName), "initiali") {
+			return VOLUME_INIT_STATE_IN_PROGRESS, int64(operation.PercentageComplete)
 		}
+	}
 
-		if nameVerified && driveCacheVerified {
-			return true, nil
+	return VOLUME_INIT_STATE_COMPLETED, 100
+}
+
+// waitForVolumeInitialization waits until initialization of volume pointed by volume_endpoint
+// is finished or timeout_s elapses.
+func waitForVolumeInitialization(ctx context.Context, service *gofish.Service, volume_endpoint string, timeout_s int64) error {
+	err := pollService(ctx, service, timeout_s, TASK_POLL_INITIAL_INTERVAL, func(ctx context.Context) (bool, error) {
+		volume, err := redfish.GetVolume(service.GetClient(), volume_endpoint)
+		if err != nil {
+			return false, fmt.Errorf("error during volume %s retrieval %s", volume_endpoint, err.Error())
+		}
+
+		state, percent := getVolumeInitProgress(volume)
+		tflog.Trace(ctx, "Volume initialization progress", map[string]interface{}{
+			"volume":  volume_endpoint,
+			"state":   state,
+			"percent": percent,
+		})
+
+		return state == VOLUME_INIT_STATE_COMPLETED, nil
+	})
+
+	if errors.Is(err, errPollTimeout) {
+		return fmt.Errorf("volume initialization has not finished within given timeout %d", timeout_s)
+	}
+
+	return err
+}
+
+// compareVolumePropertiesWithPlan reads current volume configuration and compare it in loop
+// until planned changes will be reflected by volume configuration from service.
+// The loop has timeout defined by timeout_s when operation will terminate if there will be still
+// differences between plan and volume.
+func compareVolumePropertiesWithPlan(ctx context.Context, service *gofish.Service, volume_id string,
+	plan models.StorageVolumeResourceModel, timeout_s int64) (bool, error) {
+	verifyVolumeName := !plan.VolumeName.IsUnknown()
+	verifyDriveCacheMode := !plan.DriveCacheMode.IsUnknown()
+
+	err := pollService(ctx, service, timeout_s, 2*time.Second, func(ctx context.Context) (bool, error) {
+		volume, err := redfish.GetVolume(service.GetClient(), volume_id)
+		if err != nil {
+			return false, err
+		}
+
+		var volumeOem volumeOemObject
+		err = json.Unmarshal(volume.OEM, &volumeOem)
+		if err != nil {
+			return false, err
 		}
 
 		var driveCacheMode string
@@ -670,6 +839,12 @@ func compareVolumePropertiesWithPlan(ctx context.Context, service *gofish.Servic
 			driveCacheMode = volumeOem.OemFsas.DriveCacheMode
 		}
 
+		nameVerified := !verifyVolumeName || volume.Name == plan.VolumeName.ValueString()
+		driveCacheVerified := !verifyDriveCacheMode || driveCacheMode == plan.DriveCacheMode.ValueString()
+		if nameVerified && driveCacheVerified {
+			return true, nil
+		}
+
 		tflog.Info(ctx, "compareVolumePropertiesWithPlan: compare plan with current volume",
 			map[string]interface{}{
 				"volume name (current)":      volume.Name,
@@ -678,12 +853,14 @@ func compareVolumePropertiesWithPlan(ctx context.Context, service *gofish.Servic
 				"drive cache mode (planned)": plan.DriveCacheMode.ValueString(),
 			})
 
-		if time.Now().Unix()-start_time > timeout_s {
-			return false, fmt.Errorf("timeout of %d s has been reached", timeout_s)
-		}
+		return false, nil
+	})
 
-		time.Sleep(2 * time.Second)
+	if errors.Is(err, errPollTimeout) {
+		return false, fmt.Errorf("timeout of %d s has been reached", timeout_s)
 	}
+
+	return err == nil, err
 }
 
 func waitUntilStorageVolumeChangesApplied(ctx context.Context, service *gofish.Service, taskLocation string, plan models.StorageVolumeResourceModel,
@@ -693,60 +870,49 @@ func waitUntilStorageVolumeChangesApplied(ctx context.Context, service *gofish.S
 		return WaitForRedfishTaskEnd(ctx, service, taskLocation, timeout)
 	}
 
-	time.Sleep(5 * time.Second)
+	if err := sleepWithContext(ctx, 5*time.Second); err != nil {
+		return false, err
+	}
 
 	// since no task is created, logic needs to wait with timeout for resource update
 	return compareVolumePropertiesWithPlan(ctx, service, volume_endpoint, plan, timeout-5)
 }
 
-func patchVolumeEndpoint(ctx context.Context, service *gofish.Service, endpoint string, payload any) (taskLocation string, err error) {
+// patchVolumeEndpoint sends payload to volume endpoint. Messages reported by service for request
+// which has been accepted only partially are returned as warnings.
+func patchVolumeEndpoint(ctx context.Context, service *gofish.Service, endpoint string, payload any) (taskLocation string, warnings diag.Diagnostics, err error) {
 	tflog.Info(ctx, "Volume change requested with payload", map[string]interface{}{
 		"storage volume endpoint": endpoint,
 		"payload":                 payload,
 	})
 
-	resp, err := service.GetClient().Patch(endpoint, payload)
+	resp, err := PatchWithEtag(service.GetClient(), endpoint, payload)
 	if err != nil {
-		return "", err
+		return "", warnings, err
 	}
 
 	defer CloseResource(resp.Body)
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return "", fmt.Errorf("PATCH request on '%s' finished with not expected status '%d'", endpoint, resp.StatusCode)
+		return "", warnings, fmt.Errorf("PATCH request on '%s' finished with not expected status '%d'", endpoint, resp.StatusCode)
 	}
 
 	if resp.StatusCode == http.StatusAccepted {
 		taskLocation := resp.Header.Get(HTTP_HEADER_LOCATION)
 		if taskLocation == "" {
-			return "", fmt.Errorf("location header not found in response")
-		}
-		return taskLocation, nil
-	} else {
-		// Request might be accepted but some properties will not be successfully validated and it should be reported to terraform
-		out, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return "", err
-		}
-
-		var respStruct StoragePatchResponse
-
-		err = json.Unmarshal(out, &respStruct)
-		if err != nil {
-			return "", err
-		}
-
-		if len(respStruct.ExtendedInfo) > 0 {
-			for _, v := range respStruct.ExtendedInfo {
-				tflog.Warn(ctx, "Request responded with non-empty ExtendedMessageInfo", map[string]interface{}{
-					"MessageId": v.MessageId,
-					"Message":   v.Message,
-				})
-			}
+			return "", warnings, fmt.Errorf("location header not found in response")
 		}
+		return taskLocation, warnings, nil
+	}
 
-		return "", err
+	// Request might be accepted but some properties will not be successfully validated and it should be reported to terraform
+	out, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", warnings, err
 	}
+
+	warnings = redfishResponseWarnings("Volume reported message for requested change", out)
+	return "", warnings, nil
 }
 
 // updateStorageVolume applies change on volume properties and verifies if planned
@@ -781,16 +947,17 @@ func requestVolumeModificationAndSuperviseTheProcess(ctx context.Context, servic
 
 	volume_endpoint := state.Id.ValueString()
 
-	task_location, err := patchVolumeEndpoint(ctx, service, volume_endpoint, payload)
+	task_location, warnings, err := patchVolumeEndpoint(ctx, service, volume_endpoint, payload)
+	diags.Append(warnings...)
 	if err != nil {
-		diags.AddError("Patch request to change volume parameters returned error", err.Error())
+		diags.Append(redfishErrorDiagnostics("Patch request to change volume parameters returned error", err)...)
 		return diags
 	}
 
 	_, err = waitUntilStorageVolumeChangesApplied(ctx, service, task_location, plan,
 		volume_endpoint, plan.JobTimeout.ValueInt64())
 	if err != nil {
-		diags.AddError("Error while waiting for resource update.", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error while waiting for resource update.", err)...)
 		return diags
 	}
 
@@ -814,6 +981,10 @@ func updateStorageVolumeState(plan models.StorageVolumeResourceModel, target_vol
 		CapacityBytes:      target_volume_state.CapacityBytes,
 		DriveCacheMode:     target_volume_state.DriveCacheMode,
 		JobTimeout:         target_volume_state.JobTimeout,
+		WaitForInit:        plan.WaitForInit,
+		InitTimeout:        plan.InitTimeout,
+		InitState:          target_volume_state.InitState,
+		InitPercent:        target_volume_state.InitPercent,
 	}
 
 	if plan.ReadMode != nil {
@@ -833,24 +1004,165 @@ func updateStorageVolumeState(plan models.StorageVolumeResourceModel, target_vol
 	return output
 }
 
-func createStorageVolume(ctx context.Context, api *gofish.APIClient, plan models.StorageVolumeResourceModel, state *models.StorageVolumeResourceModel) (removeResource bool, diags diag.Diagnostics) {
+// pendingStorageVolumeState returns state of volume whose creation task is still running.
+// Values not known until the volume is created are stored as null and id is empty.
+func pendingStorageVolumeState(plan models.StorageVolumeResourceModel) models.StorageVolumeResourceModel {
+	state := plan
+	state.Id = types.StringValue("")
+
+	if state.CapacityBytes.IsUnknown() {
+		state.CapacityBytes = models.CapacityByteValue{Int64Value: types.Int64Null()}
+	}
+
+	for _, value := range []*types.String{&state.VolumeName, &state.DriveCacheMode, &state.InitMode, &state.InitState} {
+		if value.IsUnknown() {
+			*value = types.StringNull()
+		}
+	}
+
+	for _, value := range []*types.Int64{&state.JobTimeout, &state.OptimumIOSizeBytes, &state.InitTimeout, &state.InitPercent} {
+		if value.IsUnknown() {
+			*value = types.Int64Null()
+		}
+	}
+
+	if state.WaitForInit.IsUnknown() {
+		state.WaitForInit = types.BoolNull()
+	}
+
+	for _, mode := range []**models.StorageVolumeDynamicParam{&state.ReadMode, &state.WriteMode} {
+		if *mode == nil {
+			continue
+		}
+		param := **mode
+		if param.Requested.IsUnknown() {
+			param.Requested = types.StringNull()
+		}
+		if param.Actual.IsUnknown() {
+			param.Actual = types.StringNull()
+		}
+		*mode = &param
+	}
+
+	return state
+}
+
+// createStorageVolume creates volume according to plan and fills state with its details. If waiting
+// for creation task has been interrupted, the task is returned as pending and state is not filled.
+func createStorageVolume(ctx context.Context, api *gofish.APIClient, plan models.StorageVolumeResourceModel, state *models.StorageVolumeResourceModel) (removeResource bool, pending *pendingTask, diags diag.Diagnostics) {
 	storage_id := plan.StorageControllerSN.ValueString()
 	volumes_ids_before, diags := getVolumesIdsList(api.Service, storage_id)
 	if diags.HasError() {
-		return false, diags
+		return false, nil, diags
+	}
+
+	new_volume_endpoint, pending_task, diags := requestAndSuperviseVolumeCreationProcess(ctx, api, plan)
+	if len(pending_task) > 0 {
+		task := newPendingTask(PENDING_TASK_VOLUME_CREATION, pending_task, time.Now())
+		task.Resources = volumes_ids_before
+		return false, &task, nil
 	}
 
-	diags = requestAndSuperviseVolumeCreationProcess(ctx, api, plan)
 	if diags.HasError() {
-		return false, diags
+		return false, nil, diags
+	}
+
+	removeResource, diags = completeStorageVolumeCreation(ctx, api, plan, state, new_volume_endpoint, volumes_ids_before)
+	return removeResource, nil, diags
+}
+
+// resumeStorageVolumeCreation waits for pending volume creation task and fills state with details
+// of created volume.
+func resumeStorageVolumeCreation(ctx context.Context, api *gofish.APIClient, plan models.StorageVolumeResourceModel,
+	state *models.StorageVolumeResourceModel, task pendingTask) (removeResource bool, interrupted bool, diags diag.Diagnostics) {
+	is_fsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor detection failed", err)...)
+		return false, false, diags
 	}
 
-	volumes_ids_after, diags := getVolumesIdsList(api.Service, storage_id)
+	new_volume_endpoint, interrupted, diags := superviseVolumeCreationTask(ctx, api.Service, task.Location,
+		is_fsas, plan.JobTimeout.ValueInt64())
 	if diags.HasError() {
-		return false, diags
+		return false, interrupted, diags
+	}
+
+	removeResource, diags = completeStorageVolumeCreation(ctx, api, plan, state, new_volume_endpoint, task.Resources)
+	return removeResource, false, diags
+}
+
+// deletePendingStorageVolume deletes volume of resource, whose creation task is still pending. The task
+// is awaited first, so volume created by it is not orphaned on the controller. Nothing is deleted
+// if the task has failed.
+func deletePendingStorageVolume(ctx context.Context, api *gofish.APIClient, state models.StorageVolumeResourceModel,
+	task pendingTask) (diags diag.Diagnostics) {
+	taskState, err := getPendingTaskState(api.Service, task)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics(fmt.Sprintf("Could not read state of pending volume creation task %s", task.Location), err)...)
+		return diags
+	}
+
+	if IsTaskFinished(taskState) && !IsTaskFinishedSuccessfully(taskState) {
+		tflog.Info(ctx, "Pending volume creation task failed, there is no volume to delete", map[string]interface{}{
+			"task": task.Location,
+		})
+		return diags
+	}
+
+	is_fsas, err := IsFsasCheck(ctx, api)
+	if err != nil {
+		diags.Append(redfishErrorDiagnostics("Vendor detection failed", err)...)
+		return diags
+	}
+
+	volume_endpoint, interrupted, diags := superviseVolumeCreationTask(ctx, api.Service, task.Location,
+		is_fsas, state.JobTimeout.ValueInt64())
+	if interrupted {
+		diags.AddError("Volume creation task is still running",
+			fmt.Sprintf("Volume created by task %s can be deleted only after the task finishes, destroy has to be repeated.", task.Location))
+		return diags
+	}
+
+	// Task failed while it was awaited, so it has not created any volume
+	if diags.HasError() {
+		return diags
+	}
+
+	volume_endpoint, diags = resolveCreatedVolumeEndpoint(ctx, api.Service, state.StorageControllerSN.ValueString(),
+		volume_endpoint, task.Resources)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(volume_endpoint) == 0 {
+		tflog.Warn(ctx, "Volume created by pending task has not been found", map[string]interface{}{
+			"task": task.Location,
+		})
+		return diags
+	}
+
+	// Volume might have been deleted by other means in the meantime
+	if _, _, removed := doesVolumeStillExist(api.Service, volume_endpoint); removed {
+		return nil
+	}
+
+	return deleteStorageVolume(ctx, api.Service, volume_endpoint, is_fsas, state.JobTimeout.ValueInt64())
+}
+
+// resolveCreatedVolumeEndpoint returns endpoint of volume created by finished request. Older firmware
+// does not report created volume, so it's found as the one which has not existed before the request.
+func resolveCreatedVolumeEndpoint(ctx context.Context, service *gofish.Service, storage_id string,
+	new_volume_endpoint string, volumes_ids_before []string) (string, diag.Diagnostics) {
+	if len(new_volume_endpoint) > 0 {
+		return new_volume_endpoint, nil
+	}
+
+	volumes_ids_after, diags := getVolumesIdsList(service, storage_id)
+	if diags.HasError() {
+		return "", diags
 	}
 
-	new_volume_endpoint := getRecentlyCreatedVolumeId(
+	new_volume_endpoint = getRecentlyCreatedVolumeId(
 		volumes_ids_after, volumes_ids_before)
 
 	tflog.Trace(ctx, "Information about volume request", map[string]interface{}{
@@ -859,6 +1171,18 @@ func createStorageVolume(ctx context.Context, api *gofish.APIClient, plan models
 		"new":    new_volume_endpoint,
 	})
 
+	return new_volume_endpoint, diags
+}
+
+// completeStorageVolumeCreation fills state with details of volume created by finished request.
+func completeStorageVolumeCreation(ctx context.Context, api *gofish.APIClient, plan models.StorageVolumeResourceModel,
+	state *models.StorageVolumeResourceModel, new_volume_endpoint string, volumes_ids_before []string) (removeResource bool, diags diag.Diagnostics) {
+	new_volume_endpoint, diags = resolveCreatedVolumeEndpoint(ctx, api.Service, plan.StorageControllerSN.ValueString(),
+		new_volume_endpoint, volumes_ids_before)
+	if diags.HasError() {
+		return false, diags
+	}
+
 	// Update state based on created volume details
 	volume, diags, to_remove := doesVolumeStillExist(api.Service, new_volume_endpoint)
 	if to_remove {
@@ -875,6 +1199,19 @@ func createStorageVolume(ctx context.Context, api *gofish.APIClient, plan models
 		return false, diags
 	}
 
+	if plan.WaitForInit.ValueBool() {
+		err := waitForVolumeInitialization(ctx, api.Service, new_volume_endpoint, plan.InitTimeout.ValueInt64())
+		if err != nil {
+			diags.AddError("Volume initialization has not finished", err.Error())
+			return false, diags
+		}
+
+		volume, diags, to_remove = doesVolumeStillExist(api.Service, new_volume_endpoint)
+		if to_remove || diags.HasError() {
+			return to_remove, diags
+		}
+	}
+
 	var target_volume_state models.StorageVolumeResourceModel
 	target_volume_state.ReadMode = &models.StorageVolumeDynamicParam{}
 	target_volume_state.WriteMode = &models.StorageVolumeDynamicParam{}
@@ -901,7 +1238,7 @@ func createStorageVolume(ctx context.Context, api *gofish.APIClient, plan models
 func updateStorageVolume(ctx context.Context, api *gofish.APIClient, plan models.StorageVolumeResourceModel, state *models.StorageVolumeResourceModel) (removeResource bool, diags diag.Diagnostics) {
 	is_fsas, err := IsFsasCheck(ctx, api)
 	if err != nil {
-		diags.AddError("Vendor detection failed", err.Error())
+		diags.Append(redfishErrorDiagnostics("Vendor detection failed", err)...)
 		return false, diags
 	}
 
@@ -928,5 +1265,9 @@ func updateStorageVolume(ctx context.Context, api *gofish.APIClient, plan models
 		return false, diags
 	}
 
+	state.JobTimeout = plan.JobTimeout
+	state.WaitForInit = plan.WaitForInit
+	state.InitTimeout = plan.InitTimeout
+
 	return false, diags
 }
@@ -25,37 +25,90 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// Default number of operations running in parallel against one system, which lock only part of it.
+const SYNC_POOL_MAX_CONCURRENT_OPERATIONS = 4
+
 // Synchronization must be done per system in pool, so every system can be controlled separately
 // for that reason we need ~container for keeping mutexes.
+//
+// Operations which influence whole system (e.g. power, BIOS or iRMC settings) lock the system exclusively
+// using Lock. Operations which touch only part of the system (e.g. single storage controller) use LockScope,
+// so operations on different scopes of the same system can run in parallel, bounded by concurrency limit.
 type SyncPool struct {
-	lock sync.Mutex
-	pool map[string]*sync.Mutex
+	lock     sync.Mutex
+	pool     map[string]*endpointLock
+	limit    int
+	limitSet bool
+}
+
+// endpointLock keeps synchronization primitives of single system.
+type endpointLock struct {
+	system sync.RWMutex
+	scopes map[string]*sync.Mutex
+	slots  chan struct{}
 }
 
 func InitSyncPoolInstance() *SyncPool {
 	return &SyncPool{
-		pool: make(map[string]*sync.Mutex),
+		pool:  make(map[string]*endpointLock),
+		limit: SYNC_POOL_MAX_CONCURRENT_OPERATIONS,
+	}
+}
+
+// SetConcurrencyLimit sets maximum number of scoped operations running in parallel against one system.
+// Pool is shared by all provider configurations served by the process, so once limit is set,
+// different limit requested by other configuration is rejected.
+func (sp *SyncPool) SetConcurrencyLimit(limit int) error {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+
+	if limit < 1 {
+		limit = 1
+	}
+
+	if sp.limitSet && sp.limit != limit {
+		return fmt.Errorf("limit %d conflicts with limit %d set by other provider configuration", limit, sp.limit)
 	}
+
+	sp.limit = limit
+	sp.limitSet = true
+	return nil
 }
 
-func (sp *SyncPool) getEndpointMutex(endpoint string) *sync.Mutex {
+func (sp *SyncPool) getEndpointLock(endpoint string) *endpointLock {
 	sp.lock.Lock()
 	defer sp.lock.Unlock()
 
-	mutex, ok := sp.pool[endpoint]
+	el, ok := sp.pool[endpoint]
+	if !ok {
+		el = &endpointLock{
+			scopes: make(map[string]*sync.Mutex),
+			slots:  make(chan struct{}, sp.limit),
+		}
+		sp.pool[endpoint] = el
+	}
+	return el
+}
+
+func (sp *SyncPool) getScopeMutex(el *endpointLock, scope string) *sync.Mutex {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+
+	mutex, ok := el.scopes[scope]
 	if !ok {
 		mutex = &sync.Mutex{}
-		sp.pool[endpoint] = mutex
+		el.scopes[scope] = mutex
 	}
 	return mutex
 }
 
+// Lock locks system pointed by endpoint exclusively.
 func (sp *SyncPool) Lock(ctx context.Context, endpoint string, resource string) {
 	var msg string
 	msg = fmt.Sprintf("Before locking mutex for endpoint '%s', resource '%s'", endpoint, resource)
 	tflog.Info(ctx, msg)
 
-	sp.getEndpointMutex(endpoint).Lock()
+	sp.getEndpointLock(endpoint).system.Lock()
 
 	msg = fmt.Sprintf("Successfully locked mutex for endpoint '%s', resource '%s'", endpoint, resource)
 	tflog.Info(ctx, msg)
@@ -66,8 +119,33 @@ func (sp *SyncPool) Unlock(ctx context.Context, endpoint string, resource string
 	msg = fmt.Sprintf("Before unlocking mutex for endpoint '%s', resource '%s'", endpoint, resource)
 	tflog.Info(ctx, msg)
 
-	sp.getEndpointMutex(endpoint).Unlock()
+	sp.getEndpointLock(endpoint).system.Unlock()
 
 	msg = fmt.Sprintf("Successfully unlocked mutex for endpoint '%s', resource '%s'", endpoint, resource)
 	tflog.Info(ctx, msg)
 }
+
+// LockScope locks scope (e.g. serial number of storage controller) of system pointed by endpoint.
+// It waits for exclusive locks of the system, other operations on the same scope and for free slot
+// within concurrency limit of the system.
+func (sp *SyncPool) LockScope(ctx context.Context, endpoint string, scope string, resource string) {
+	tflog.Info(ctx, fmt.Sprintf("Before locking mutex for endpoint '%s', scope '%s', resource '%s'", endpoint, scope, resource))
+
+	el := sp.getEndpointLock(endpoint)
+	el.system.RLock()
+	sp.getScopeMutex(el, scope).Lock()
+	el.slots <- struct{}{}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully locked mutex for endpoint '%s', scope '%s', resource '%s'", endpoint, scope, resource))
+}
+
+func (sp *SyncPool) UnlockScope(ctx context.Context, endpoint string, scope string, resource string) {
+	tflog.Info(ctx, fmt.Sprintf("Before unlocking mutex for endpoint '%s', scope '%s', resource '%s'", endpoint, scope, resource))
+
+	el := sp.getEndpointLock(endpoint)
+	<-el.slots
+	sp.getScopeMutex(el, scope).Unlock()
+	el.system.RUnlock()
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully unlocked mutex for endpoint '%s', scope '%s', resource '%s'", endpoint, scope, resource))
+}
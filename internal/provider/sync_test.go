@@ -20,7 +20,9 @@ package provider
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestSyncPool(t *testing.T) {
@@ -52,3 +54,94 @@ func TestSyncPool(t *testing.T) {
 		}
 	})
 }
+
+func TestSyncPoolScopes(t *testing.T) {
+	t.Run("DifferentScopesRunInParallel", func(t *testing.T) {
+		pool := InitSyncPoolInstance()
+		pool.LockScope(context.TODO(), "host", "ctrl1", "")
+
+		done := make(chan struct{})
+		go func() {
+			pool.LockScope(context.TODO(), "host", "ctrl2", "")
+			pool.UnlockScope(context.TODO(), "host", "ctrl2", "")
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Errorf("Lock of different scope blocked by other scope")
+		}
+		pool.UnlockScope(context.TODO(), "host", "ctrl1", "")
+	})
+
+	t.Run("ConcurrencyLimit", func(t *testing.T) {
+		pool := InitSyncPoolInstance()
+		if err := pool.SetConcurrencyLimit(2); err != nil {
+			t.Fatalf("Unexpected error %s", err.Error())
+		}
+
+		var running, maxRunning int32
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(scope string) {
+				defer wg.Done()
+				pool.LockScope(context.TODO(), "host", scope, "")
+				defer pool.UnlockScope(context.TODO(), "host", scope, "")
+
+				current := atomic.AddInt32(&running, 1)
+				for {
+					observed := atomic.LoadInt32(&maxRunning)
+					if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+			}(string(rune('a' + i)))
+		}
+		wg.Wait()
+
+		if maxRunning > 2 {
+			t.Errorf("Got %d operations in parallel, expected at most %d", maxRunning, 2)
+		}
+	})
+
+	t.Run("ConflictingConcurrencyLimit", func(t *testing.T) {
+		pool := InitSyncPoolInstance()
+		if err := pool.SetConcurrencyLimit(2); err != nil {
+			t.Fatalf("Unexpected error %s", err.Error())
+		}
+
+		// Same limit of other provider configuration is accepted, different one is rejected
+		if err := pool.SetConcurrencyLimit(2); err != nil {
+			t.Errorf("Unexpected error %s", err.Error())
+		}
+		if err := pool.SetConcurrencyLimit(8); err == nil {
+			t.Errorf("Expected conflicting limit to be rejected")
+		}
+	})
+
+	t.Run("ExclusiveLockWaitsForScopes", func(t *testing.T) {
+		pool := InitSyncPoolInstance()
+		pool.LockScope(context.TODO(), "host", "ctrl1", "")
+
+		var locked int32
+		done := make(chan struct{})
+		go func() {
+			pool.Lock(context.TODO(), "host", "")
+			atomic.StoreInt32(&locked, 1)
+			pool.Unlock(context.TODO(), "host", "")
+			close(done)
+		}()
+
+		time.Sleep(50 * time.Millisecond)
+		if atomic.LoadInt32(&locked) != 0 {
+			t.Errorf("Exclusive lock acquired while scope is locked")
+		}
+
+		pool.UnlockScope(context.TODO(), "host", "ctrl1", "")
+		<-done
+	})
+}
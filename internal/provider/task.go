@@ -19,15 +19,18 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"time"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
 	"github.com/stmcginnis/gofish/redfish"
 )
 
@@ -58,6 +61,70 @@ func IsTaskFinishedSuccessfully(state redfish.TaskState) bool {
 	}
 }
 
+// getRedfishTask reads task pointed by location together with links to resources
+// created by the task. Links.CreatedResources are handled separately, since gofish
+// expects them as plain strings while Redfish defines them as list of links.
+func getRedfishTask(client common.Client, location string) (task *redfish.Task, createdResources []string, err error) {
+	res, err := client.Get(location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer CloseResource(res.Body)
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, nil, err
+	}
+
+	if rawLinks, ok := raw["Links"]; ok {
+		var links map[string]json.RawMessage
+		if err := json.Unmarshal(rawLinks, &links); err != nil {
+			return nil, nil, err
+		}
+
+		if rawCreated, ok := links["CreatedResources"]; ok {
+			var created common.Links
+			if err := json.Unmarshal(rawCreated, &created); err != nil {
+				return nil, nil, err
+			}
+			for _, link := range created.ToStrings() {
+				if len(link) > 0 {
+					createdResources = append(createdResources, link)
+				}
+			}
+
+			delete(links, "CreatedResources")
+			if raw["Links"], err = json.Marshal(links); err != nil {
+				return nil, nil, err
+			}
+			if body, err = json.Marshal(raw); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	task = &redfish.Task{}
+	if err := json.Unmarshal(body, task); err != nil {
+		return nil, nil, err
+	}
+	task.SetClient(client)
+
+	return task, createdResources, nil
+}
+
+// GetRedfishTaskCreatedResources returns endpoints of resources created by finished
+// task pointed by location, as reported in Links.CreatedResources of the task.
+func GetRedfishTaskCreatedResources(service *gofish.Service, location string) ([]string, error) {
+	_, createdResources, err := getRedfishTask(service.GetClient(), location)
+	return createdResources, err
+}
+
 // FetchRedfishTaskLog tries to fetch logs of task pointed by location
 // from system accessed by service. If logs content could not be accessed
 // diags is filled with reason.
@@ -71,7 +138,7 @@ func FetchRedfishTaskLog(service *gofish.Service, location string, is_fsas bool)
 
 	res, err := service.GetClient().Get(task_log_endpoint)
 	if err != nil {
-		diags.AddError("Error while reading task log endpoint", err.Error())
+		diags.Append(redfishErrorDiagnostics("Error while reading task log endpoint", err)...)
 		return nil, diags
 	}
 
@@ -80,47 +147,194 @@ func FetchRedfishTaskLog(service *gofish.Service, location string, is_fsas bool)
 	if res.StatusCode == http.StatusOK {
 		bodyBytes, err := io.ReadAll(res.Body)
 		if err != nil {
-			diags.AddError("Error while reading task logs", err.Error())
+			diags.Append(redfishErrorDiagnostics("Error while reading task logs", err)...)
 			return nil, diags
 		}
 
 		return bodyBytes, diags
 	} else {
-		diags.AddError("Error while reading task logs", "Endpoint returned non 200 code")
+		diags.AddError("Error while reading task logs", fmt.Sprintf("Endpoint returned status %d instead of expected %d", res.StatusCode, http.StatusOK))
 		return nil, diags
 	}
 }
 
+// TaskLogMessage represents single message reported for task, either in Task.Messages
+// or in OEM task log.
+type TaskLogMessage struct {
+	Time        string   `json:"Time"`
+	Severity    string   `json:"Severity"`
+	Message     string   `json:"Message"`
+	MessageId   string   `json:"MessageId"`
+	MessageArgs []string `json:"MessageArgs"`
+}
+
+type taskLog struct {
+	Messages []TaskLogMessage `json:"Messages"`
+}
+
+// isError reports whether message describes failure. OEM task log usually does not define
+// severity, so then message content is checked.
+func (m TaskLogMessage) isError() bool {
+	if len(m.Severity) == 0 {
+		return strings.Contains(m.Message, "Error")
+	}
+
+	return strings.EqualFold(m.Severity, "Critical") || strings.EqualFold(m.Severity, "Error")
+}
+
+func (m TaskLogMessage) isWarning() bool {
+	return strings.EqualFold(m.Severity, "Warning")
+}
+
+func (m TaskLogMessage) String() string {
+	out := ""
+	if len(m.Time) > 0 {
+		out += fmt.Sprintf("[%s] ", m.Time)
+	}
+
+	if len(m.Severity) > 0 {
+		out += fmt.Sprintf("%s: ", m.Severity)
+	}
+
+	return out + m.Message
+}
+
+// ParseRedfishTaskLog extracts messages out of OEM task log content.
+func ParseRedfishTaskLog(logs []byte) ([]TaskLogMessage, error) {
+	var parsed taskLog
+	if err := json.Unmarshal(logs, &parsed); err != nil {
+		return nil, fmt.Errorf("task log could not be parsed: %s", err.Error())
+	}
+
+	return parsed.Messages, nil
+}
+
+// RedfishTaskReport describes final state of task together with messages reported for it.
+type RedfishTaskReport struct {
+	State    redfish.TaskState
+	Messages []TaskLogMessage
+}
+
+// GetRedfishTaskReport collects state and messages of task pointed by location. Messages
+// come from Task.Messages and from OEM task log. Problems with accessing any part of the
+// report are returned as warnings, since report is only supplementary information.
+func GetRedfishTaskReport(service *gofish.Service, location string, is_fsas bool) (report RedfishTaskReport, diags diag.Diagnostics) {
+	task, _, err := getRedfishTask(service.GetClient(), location)
+	if err != nil {
+		diags.AddWarning("Task state could not be read", err.Error())
+	} else {
+		report.State = task.TaskState
+		for _, msg := range task.Messages {
+			report.Messages = append(report.Messages, TaskLogMessage{
+				Severity:    msg.Severity,
+				Message:     msg.Message,
+				MessageId:   msg.MessageID,
+				MessageArgs: msg.MessageArgs,
+			})
+		}
+	}
+
+	logs, internal_diags := FetchRedfishTaskLog(service, location, is_fsas)
+	if logs == nil {
+		for _, d := range internal_diags {
+			diags.AddWarning(d.Summary(), d.Detail())
+		}
+		return report, diags
+	}
+
+	messages, err := ParseRedfishTaskLog(logs)
+	if err != nil {
+		diags.AddWarning("Task log could not be parsed", fmt.Sprintf("%s, raw log: %s", err.Error(), string(logs)))
+		return report, diags
+	}
+
+	report.Messages = append(report.Messages, messages...)
+	return report, diags
+}
+
+// String formats report into multiline summary.
+func (r RedfishTaskReport) String() string {
+	lines := []string{}
+	if len(r.State) > 0 {
+		lines = append(lines, fmt.Sprintf("Task state: %s", r.State))
+	}
+
+	for _, msg := range r.Messages {
+		lines = append(lines, msg.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Diagnostics converts messages of report into individual diagnostics. Only messages
+// describing errors or warnings are converted, informational ones are skipped.
+func (r RedfishTaskReport) Diagnostics(summary string) (diags diag.Diagnostics) {
+	for _, msg := range r.Messages {
+		if msg.isError() {
+			diags.AddError(summary, msg.String())
+		} else if msg.isWarning() {
+			diags.AddWarning(summary, msg.String())
+		}
+	}
+
+	return diags
+}
+
+// taskFailureDiagnostics describes failed task pointed by location with err returned while
+// waiting for the task and with formatted task report.
+func taskFailureDiagnostics(service *gofish.Service, location string, is_fsas bool, summary string, err error) (diags diag.Diagnostics) {
+	diags.Append(redfishErrorDiagnostics(summary, err)...)
+
+	report, internal_diags := GetRedfishTaskReport(service, location, is_fsas)
+	diags.Append(internal_diags...)
+	if len(report.State) > 0 || len(report.Messages) > 0 {
+		diags.AddError(fmt.Sprintf("%s, task report", summary), report.String())
+	}
+
+	return diags
+}
+
 // WaitForRedfishTaskEnd checks in loop until task pointed by location on service
 // will report finished state or operation will timeout (maximum time pointed by timeout_s).
-// If task has been finished with success, status is returned as true. If loop has timed or
-// information about task could not be retrieved, status will be returned as false with error
-// pointing to reason.
+// If task has been finished with success, status is returned as true. If loop has timed,
+// has been cancelled or information about task could not be retrieved, status will be
+// returned as false with error pointing to reason. Error caused by cancellation reports
+// last known progress of the task, which keeps running on iRMC.
 func WaitForRedfishTaskEnd(ctx context.Context, service *gofish.Service, location string, timeout_s int64) (bool, error) {
-	start_time := time.Now().Unix()
-	for {
-		task, err := redfish.GetTask(service.GetClient(), location)
+	var finishedSuccessfully bool
+	var lastTask *redfish.Task
+	err := pollService(ctx, service, timeout_s, TASK_POLL_INITIAL_INTERVAL, func(ctx context.Context) (bool, error) {
+		task, _, err := getRedfishTask(service.GetClient(), location)
 		if err != nil {
 			return false, fmt.Errorf("error during task %s retrieval %s", location, err.Error())
 		}
+		lastTask = task
 
 		tflog.Trace(ctx, "Task details", map[string]interface{}{
 			"location": location,
 			"state":    task.TaskState,
 		})
 
-		if IsTaskFinished(task.TaskState) {
-			if IsTaskFinishedSuccessfully(task.TaskState) {
-				return true, nil
-			}
-
-			return false, fmt.Errorf("task finished with TaskState %s", task.TaskState)
-		} else {
-			time.Sleep(5 * time.Second)
+		if !IsTaskFinished(task.TaskState) {
+			return false, nil
 		}
 
-		if time.Now().Unix()-start_time > timeout_s {
-			return false, fmt.Errorf("task has not finished within given timeout %d", timeout_s)
+		if IsTaskFinishedSuccessfully(task.TaskState) {
+			finishedSuccessfully = true
+			return true, nil
 		}
+
+		return false, fmt.Errorf("task finished with TaskState %s", task.TaskState)
+	})
+
+	if errors.Is(err, errPollTimeout) {
+		return false, fmt.Errorf("task has not finished within given timeout %d", timeout_s)
 	}
+
+	if errors.Is(err, errPollCancelled) && lastTask != nil {
+		return false, fmt.Errorf("%w (task %s last reported state %s, %d%% complete)",
+			err, location, lastTask.TaskState, lastTask.PercentComplete)
+	}
+
+	return finishedSuccessfully, err
 }
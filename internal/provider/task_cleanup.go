@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Default age in seconds after which unfinished task is reported as stale by tasks data source,
+// it exceeds default timeouts of all operations of the provider.
+const STALE_TASK_AGE = 7200
+
+// providerTaskTargetRegex matches target URIs (Payload.TargetUri) of requests, by which the provider
+// starts long running tasks (storage changes, firmware updates, OEM iRMC actions and configuration).
+var providerTaskTargetRegex = regexp.MustCompile(
+	`^/redfish/v1/(Managers/iRMC/(Actions/Oem/|Oem/[^/]+/iRMCConfiguration/)|UpdateService/|Systems/[^/]+/Storage/|Chassis/[^/]+/Drives/)`)
+
+// isProviderTask checks if task has been started by request of type used by the provider.
+// The same requests can be issued by other tools (web UI, eLCM), so it's only informational
+// and must never be used to decide about cancellation of the task.
+func isProviderTask(task *redfish.Task) bool {
+	return providerTaskTargetRegex.MatchString(task.Payload.TargetURI)
+}
+
+// isStaleTask checks if task has not finished within maxAge since its start.
+// Tasks without valid start time are never treated as stale.
+func isStaleTask(task *redfish.Task, maxAge time.Duration, now time.Time) bool {
+	if IsTaskFinished(task.TaskState) {
+		return false
+	}
+
+	start, err := time.Parse(time.RFC3339, task.StartTime)
+	if err != nil {
+		return false
+	}
+
+	return now.Sub(start) > maxAge
+}
+
+// cancelStalePendingTask cancels (by DELETE request) pending task of the resource if enabled
+// by cancel_stale_tasks and the task has not finished within stale_task_age since the provider
+// started it. Only tasks kept in private state of resources are cancelled, so tasks started
+// by other tools are never touched. Failure of cancellation does not block the operation.
+func cancelStalePendingTask(ctx context.Context, pconfig *IrmcProvider, service *gofish.Service,
+	task pendingTask, taskState redfish.TaskState, now time.Time) bool {
+	if pconfig == nil || !pconfig.CancelStaleTasks || pconfig.StaleTaskAge <= 0 || IsTaskFinished(taskState) {
+		return false
+	}
+
+	start, err := time.Parse(time.RFC3339, task.StartedAt)
+	if err != nil || now.Sub(start) <= time.Duration(pconfig.StaleTaskAge)*time.Second {
+		return false
+	}
+
+	res, err := service.GetClient().Delete(task.Location)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Cancellation of stale %s task %s failed: %s", task.Operation, task.Location, err.Error()))
+		return false
+	}
+	CloseResource(res.Body)
+
+	tflog.Info(ctx, fmt.Sprintf("Cancelled stale %s task %s started at %s", task.Operation, task.Location, task.StartedAt))
+	return true
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// seedMockStaleTasks stores tasks covering all combinations relevant for stale task cleanup.
+func seedMockStaleTasks(server *mockRedfishServer, now time.Time) {
+	old := now.Add(-3 * time.Hour).Format(time.RFC3339)
+	fresh := now.Add(-10 * time.Minute).Format(time.RFC3339)
+	volumes := "/redfish/v1/Systems/0/Storage/0/Volumes"
+
+	tasks := map[string]map[string]interface{}{
+		"1": {"Name": "Volume creation", "TaskState": "Running", "StartTime": old, "Payload": map[string]interface{}{"TargetUri": volumes}},
+		"2": {"Name": "Web UI task", "TaskState": "Running", "StartTime": old, "Payload": map[string]interface{}{"TargetUri": "/redfish/v1/Systems/0/Actions/Oem/Unknown"}},
+		"3": {"Name": "Volume creation", "TaskState": "Running", "StartTime": fresh, "Payload": map[string]interface{}{"TargetUri": volumes}},
+		"4": {"Name": "Volume creation", "TaskState": "Completed", "StartTime": old, "Payload": map[string]interface{}{"TargetUri": volumes}},
+	}
+
+	members := []string{}
+	for id, task := range tasks {
+		endpoint := MOCK_REDFISH_TASKS_ENDPOINT + "/" + id
+		server.Set(endpoint, task)
+		members = append(members, endpoint)
+	}
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.collection(MOCK_REDFISH_TASKS_ENDPOINT, members...)
+}
+
+func TestCancelStalePendingTask(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	now := time.Now()
+	seedMockStaleTasks(server, now)
+
+	pconfig := connectMockRedfishServer(t, server)
+	api, err := ConnectTargetSystem(pconfig, &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	pending := func(id string, age time.Duration) pendingTask {
+		return newPendingTask(PENDING_TASK_VOLUME_CREATION, MOCK_REDFISH_TASKS_ENDPOINT+"/"+id, now.Add(-age))
+	}
+
+	stale := pending("1", 3*time.Hour)
+	if cancelStalePendingTask(context.Background(), pconfig, api.Service, stale, redfish.RunningTaskState, now) {
+		t.Errorf("Task must not be cancelled when cancel_stale_tasks is disabled")
+	}
+
+	pconfig.CancelStaleTasks = true
+	pconfig.StaleTaskAge = 3600
+
+	if cancelStalePendingTask(context.Background(), pconfig, api.Service, pending("3", 10*time.Minute), redfish.RunningTaskState, now) {
+		t.Errorf("Task running shorter than stale_task_age must not be cancelled")
+	}
+	if cancelStalePendingTask(context.Background(), pconfig, api.Service, pending("4", 3*time.Hour), redfish.CompletedTaskState, now) {
+		t.Errorf("Finished task must not be cancelled")
+	}
+	if !cancelStalePendingTask(context.Background(), pconfig, api.Service, stale, redfish.RunningTaskState, now) {
+		t.Errorf("Stale pending task should be cancelled")
+	}
+
+	if server.Resource(stale.Location) != nil {
+		t.Errorf("Stale task %s should be deleted", stale.Location)
+	}
+	// Only pending task of the resource is cancelled, old task started by other tool is kept
+	for _, id := range []string{"2", "3", "4"} {
+		if server.Resource(MOCK_REDFISH_TASKS_ENDPOINT+"/"+id) == nil {
+			t.Errorf("Task %s should not be cancelled", id)
+		}
+	}
+}
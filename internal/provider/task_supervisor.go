@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	TASK_POLL_INITIAL_INTERVAL = 2 * time.Second
+	TASK_POLL_MAX_INTERVAL     = 30 * time.Second
+	TASK_POLL_JITTER_FACTOR    = 0.2
+	// Maximum number of polling requests sent in parallel to a single iRMC.
+	TASK_POLL_MAX_PER_ENDPOINT = 2
+)
+
+var (
+	errPollTimeout   = errors.New("operation has not finished within given timeout")
+	errPollCancelled = errors.New("operation has been cancelled")
+)
+
+// PollFunc is a single check executed by TaskSupervisor. It returns done as true
+// when awaited condition has been reached, non nil error terminates polling.
+type PollFunc func(ctx context.Context) (done bool, err error)
+
+// PollOptions describes how TaskSupervisor should poll the condition.
+type PollOptions struct {
+	// Key identifies the system which is polled, concurrency limit is applied per key.
+	Key string
+	// Timeout limits the whole polling, non positive value means it has already expired.
+	Timeout         time.Duration
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+}
+
+// TaskSupervisor is responsible for waiting on long running operations on iRMC.
+// Checks are executed with jittered exponential backoff, are interrupted on context
+// cancellation and number of checks running in parallel against one system is limited,
+// so many resources waiting at the same time do not hammer the same iRMC.
+type TaskSupervisor struct {
+	lock  sync.Mutex
+	slots map[string]chan struct{}
+	limit int
+}
+
+func InitTaskSupervisorInstance(limit int) *TaskSupervisor {
+	if limit < 1 {
+		limit = 1
+	}
+
+	return &TaskSupervisor{
+		slots: make(map[string]chan struct{}),
+		limit: limit,
+	}
+}
+
+func (ts *TaskSupervisor) getEndpointSlots(key string) chan struct{} {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	slots, ok := ts.slots[key]
+	if !ok {
+		slots = make(chan struct{}, ts.limit)
+		ts.slots[key] = slots
+	}
+	return slots
+}
+
+func (ts *TaskSupervisor) acquire(ctx context.Context, key string) (release func(), err error) {
+	slots := ts.getEndpointSlots(key)
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Poll executes check in loop until it reports done, returns error or until timeout
+// or cancellation of ctx. Errors caused by timeout wrap errPollTimeout, errors caused
+// by ctx cancellation wrap errPollCancelled.
+func (ts *TaskSupervisor) Poll(ctx context.Context, opts PollOptions, check PollFunc) error {
+	// Callers pass remaining part of overall timeout, which might have been used up already
+	if opts.Timeout <= 0 {
+		return pollContextError(ctx, opts.Timeout)
+	}
+
+	if opts.InitialInterval <= 0 {
+		opts.InitialInterval = TASK_POLL_INITIAL_INTERVAL
+	}
+
+	if opts.MaxInterval < opts.InitialInterval {
+		opts.MaxInterval = opts.InitialInterval
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	interval := opts.InitialInterval
+	for {
+		release, err := ts.acquire(pollCtx, opts.Key)
+		if err != nil {
+			return pollContextError(ctx, opts.Timeout)
+		}
+
+		done, err := check(pollCtx)
+		release()
+
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(jitterInterval(interval))
+		select {
+		case <-pollCtx.Done():
+			timer.Stop()
+			return pollContextError(ctx, opts.Timeout)
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+func pollContextError(parent context.Context, timeout time.Duration) error {
+	if parent.Err() != nil {
+		return fmt.Errorf("%w: %s", errPollCancelled, parent.Err().Error())
+	}
+	return fmt.Errorf("%w (%d s)", errPollTimeout, int64(timeout.Seconds()))
+}
+
+// jitterInterval randomizes interval by +/- TASK_POLL_JITTER_FACTOR,
+// so resources started at the same time do not poll in lockstep.
+func jitterInterval(interval time.Duration) time.Duration {
+	delta := float64(interval) * TASK_POLL_JITTER_FACTOR
+	return time.Duration(float64(interval) - delta + rand.Float64()*2*delta)
+}
+
+// sleepWithContext waits for given duration unless ctx is cancelled earlier.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %s", errPollCancelled, ctx.Err().Error())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// pollKeyForService returns key identifying the system behind service
+// used to apply per endpoint concurrency limit.
+func pollKeyForService(service *gofish.Service) string {
+	if service == nil || len(service.UUID) == 0 {
+		return "default"
+	}
+	return service.UUID
+}
+
+// pollService is a shortcut to poll condition on system accessed by service with default intervals.
+func pollService(ctx context.Context, service *gofish.Service, timeout_s int64, interval time.Duration, check PollFunc) error {
+	return taskSupervisor.Poll(ctx, PollOptions{
+		Key:             pollKeyForService(service),
+		Timeout:         time.Duration(timeout_s) * time.Second,
+		InitialInterval: interval,
+		MaxInterval:     TASK_POLL_MAX_INTERVAL,
+	}, check)
+}
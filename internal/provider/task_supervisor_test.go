@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTaskSupervisorPoll(t *testing.T) {
+	supervisor := InitTaskSupervisorInstance(1)
+
+	t.Run("FinishesWhenDone", func(t *testing.T) {
+		calls := 0
+		err := supervisor.Poll(context.TODO(), PollOptions{Key: "a", Timeout: time.Second, InitialInterval: time.Millisecond},
+			func(ctx context.Context) (bool, error) {
+				calls++
+				return calls == 3, nil
+			})
+		if err != nil || calls != 3 {
+			t.Errorf("Got err %v after %d calls, expected nil after 3 calls", err, calls)
+		}
+	})
+
+	t.Run("ReturnsCheckError", func(t *testing.T) {
+		expected := errors.New("check failed")
+		err := supervisor.Poll(context.TODO(), PollOptions{Key: "a", Timeout: time.Second, InitialInterval: time.Millisecond},
+			func(ctx context.Context) (bool, error) {
+				return false, expected
+			})
+		if !errors.Is(err, expected) {
+			t.Errorf("Got %v, expected %v", err, expected)
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		err := supervisor.Poll(context.TODO(), PollOptions{Key: "a", Timeout: 50 * time.Millisecond, InitialInterval: time.Millisecond},
+			func(ctx context.Context) (bool, error) {
+				return false, nil
+			})
+		if !errors.Is(err, errPollTimeout) {
+			t.Errorf("Got %v, expected timeout error", err)
+		}
+	})
+
+	t.Run("ExpiredTimeout", func(t *testing.T) {
+		for _, timeout := range []time.Duration{0, -5 * time.Second} {
+			calls := 0
+			err := supervisor.Poll(context.TODO(), PollOptions{Key: "a", Timeout: timeout, InitialInterval: time.Millisecond},
+				func(ctx context.Context) (bool, error) {
+					calls++
+					return false, nil
+				})
+			if !errors.Is(err, errPollTimeout) || calls != 0 {
+				t.Errorf("Got %v after %d calls for timeout %v, expected timeout error without any call", err, calls, timeout)
+			}
+		}
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		err := supervisor.Poll(ctx, PollOptions{Key: "a", Timeout: time.Second, InitialInterval: time.Millisecond},
+			func(ctx context.Context) (bool, error) {
+				return false, nil
+			})
+		if !errors.Is(err, errPollCancelled) {
+			t.Errorf("Got %v, expected cancellation error", err)
+		}
+	})
+
+	t.Run("ConcurrencyLimit", func(t *testing.T) {
+		var running, maxRunning int32
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = supervisor.Poll(context.TODO(), PollOptions{Key: "b", Timeout: time.Second, InitialInterval: time.Millisecond},
+					func(ctx context.Context) (bool, error) {
+						current := atomic.AddInt32(&running, 1)
+						for {
+							observed := atomic.LoadInt32(&maxRunning)
+							if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+								break
+							}
+						}
+						time.Sleep(time.Millisecond)
+						atomic.AddInt32(&running, -1)
+						return true, nil
+					})
+			}()
+		}
+		wg.Wait()
+
+		if maxRunning != 1 {
+			t.Errorf("Got %d checks running in parallel, expected 1", maxRunning)
+		}
+	})
+}
+
+func TestJitterInterval(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		interval := jitterInterval(10 * time.Second)
+		if interval < 8*time.Second || interval > 12*time.Second {
+			t.Errorf("Got %s, expected value within 20%% of 10s", interval)
+		}
+	}
+}
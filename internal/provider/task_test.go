@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestParseRedfishTaskLog(t *testing.T) {
+	messages, err := ParseRedfishTaskLog([]byte(`{"Messages": [
+		{"Time": "2025-03-01T10:00:00+00:00", "Message": "Update started"},
+		{"Time": "2025-03-01T10:01:00+00:00", "Severity": "Warning", "Message": "Component skipped"},
+		{"Time": "2025-03-01T10:02:00+00:00", "Message": "Error: flash write failed"}
+	]}`))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	if len(messages) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(messages))
+	}
+
+	report := RedfishTaskReport{State: redfish.ExceptionTaskState, Messages: messages}
+	diags := report.Diagnostics("Task log")
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 1 {
+		t.Errorf("expected 1 error and 1 warning, got %d errors and %d warnings", diags.ErrorsCount(), diags.WarningsCount())
+	}
+
+	expected := "Task state: Exception\n" +
+		"[2025-03-01T10:00:00+00:00] Update started\n" +
+		"[2025-03-01T10:01:00+00:00] Warning: Component skipped\n" +
+		"[2025-03-01T10:02:00+00:00] Error: flash write failed"
+	if report.String() != expected {
+		t.Errorf("unexpected report:\n%s", report.String())
+	}
+
+	if _, err = ParseRedfishTaskLog([]byte("not a json")); err == nil {
+		t.Errorf("expected error for not parsable log")
+	}
+}
+
+func TestWaitForRedfishTaskEndCancelled(t *testing.T) {
+	server := newMockRedfishServer()
+	defer server.Close()
+
+	location := MOCK_REDFISH_TASKS_ENDPOINT + "/1"
+	server.Set(location, map[string]interface{}{"Name": "Volume creation", "TaskState": "Running", "PercentComplete": 40})
+
+	api, err := ConnectTargetSystem(connectMockRedfishServer(t, server), &[]models.RedfishServer{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer ReleaseTargetSystem(api)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	finished, err := WaitForRedfishTaskEnd(ctx, api.Service, location, 600)
+	if finished || !errors.Is(err, errPollCancelled) {
+		t.Fatalf("Expected cancellation error, got %t, %v", finished, err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Waiting should return promptly after cancellation, took %s", elapsed)
+	}
+	if !strings.Contains(err.Error(), "Running, 40% complete") {
+		t.Errorf("Error should report progress of the task, got %s", err.Error())
+	}
+
+	diags := redfishErrorDiagnostics("Volume creation interrupted", err)
+	if diags.ErrorsCount() != 1 || !strings.Contains(diags[0].Detail(), "still in progress") {
+		t.Errorf("Unexpected diagnostics %v", diags)
+	}
+}
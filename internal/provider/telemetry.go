@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+)
+
+const SERVICE_ROOT_ENDPOINT = "/redfish/v1"
+
+// jsonLinkValue returns @odata.id of link stored under key of decoded JSON object or empty string.
+func jsonLinkValue(data map[string]interface{}, key string) string {
+	if odataId, ok := jsonObjectValue(data, key)["@odata.id"].(string); ok {
+		return odataId
+	}
+
+	return ""
+}
+
+// jsonStringListValue returns list of strings stored under key of decoded JSON object.
+func jsonStringListValue(data map[string]interface{}, key string) []types.String {
+	list := []types.String{}
+	values, _ := data[key].([]interface{})
+	for _, value := range values {
+		if val, ok := value.(string); ok {
+			list = append(list, types.StringValue(val))
+		}
+	}
+
+	return list
+}
+
+// getTelemetryServiceEndpoint returns endpoint of TelemetryService, if it is exposed by firmware.
+func getTelemetryServiceEndpoint(api *gofish.APIClient) (string, error) {
+	root, err := getJsonObject(api, SERVICE_ROOT_ENDPOINT)
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := jsonLinkValue(root, "TelemetryService")
+	if len(endpoint) == 0 {
+		return "", fmt.Errorf("TelemetryService is not exposed by the firmware of the server")
+	}
+
+	return endpoint, nil
+}
+
+// getMetricReportDefinitions returns all metric report definitions of TelemetryService.
+func getMetricReportDefinitions(api *gofish.APIClient, telemetryService map[string]interface{}) ([]map[string]interface{}, error) {
+	definitions := []map[string]interface{}{}
+
+	collectionEndpoint := jsonLinkValue(telemetryService, "MetricReportDefinitions")
+	if len(collectionEndpoint) == 0 {
+		return definitions, nil
+	}
+
+	collection, err := getJsonObject(api, collectionEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	members, _ := collection["Members"].([]interface{})
+	for _, member := range members {
+		link, _ := member.(map[string]interface{})
+		odataId, _ := link["@odata.id"].(string)
+		if len(odataId) == 0 {
+			continue
+		}
+
+		definition, err := getJsonObject(api, odataId)
+		if err != nil {
+			return nil, err
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, nil
+}
+
+// findMetricReportDefinition returns metric report definition identified by id.
+func findMetricReportDefinition(api *gofish.APIClient, id string) (map[string]interface{}, error) {
+	endpoint, err := getTelemetryServiceEndpoint(api)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetryService, err := getJsonObject(api, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions, err := getMetricReportDefinitions(api, telemetryService)
+	if err != nil {
+		return nil, err
+	}
+
+	available := []string{}
+	for _, definition := range definitions {
+		definitionId, _ := definition["Id"].(string)
+		if definitionId == id {
+			return definition, nil
+		}
+		available = append(available, definitionId)
+	}
+
+	return nil, fmt.Errorf("metric report definition '%s' does not exist, available definitions: %v", id, available)
+}
+
+// metricReportDefinitionData converts decoded metric report definition into data source model.
+func metricReportDefinitionData(definition map[string]interface{}) models.MetricReportDefinitionData {
+	return models.MetricReportDefinitionData{
+		Id:                         jsonStringValue(definition, "Id"),
+		ODataId:                    jsonStringValue(definition, "@odata.id"),
+		Name:                       jsonStringValue(definition, "Name"),
+		Enabled:                    jsonBoolValue(definition, "MetricReportDefinitionEnabled"),
+		MetricReportDefinitionType: jsonStringValue(definition, "MetricReportDefinitionType"),
+		RecurrenceInterval:         jsonStringValue(jsonObjectValue(definition, "Schedule"), "RecurrenceInterval"),
+		ReportUpdates:              jsonStringValue(definition, "ReportUpdates"),
+		MetricProperties:           jsonStringListValue(definition, "MetricProperties"),
+		MetricReport:               types.StringValue(jsonLinkValue(definition, "MetricReport")),
+	}
+}
+
+// metricReportDefinitionToModel copies configurable values of metric report definition into resource model.
+func metricReportDefinitionToModel(definition map[string]interface{}, model *models.MetricReportDefinitionResourceModel) {
+	model.Id = jsonStringValue(definition, "@odata.id")
+	model.Enabled = jsonBoolValue(definition, "MetricReportDefinitionEnabled")
+	model.MetricReportDefinitionType = jsonStringValue(definition, "MetricReportDefinitionType")
+	model.RecurrenceInterval = jsonStringValue(jsonObjectValue(definition, "Schedule"), "RecurrenceInterval")
+	model.ReportUpdates = jsonStringValue(definition, "ReportUpdates")
+}
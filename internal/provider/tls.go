@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// loadRootCAs returns certificate pool extended with certificates read from PEM bundle
+// pointed by caCertFile, so iRMC presenting certificate issued by private CA can be verified.
+func loadRootCAs(caCertFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read CA certificate bundle '%s': %w", caCertFile, err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("CA certificate bundle '%s' does not contain any valid PEM certificate", caCertFile)
+	}
+
+	return pool, nil
+}
+
+// loadClientCertificate reads certificate and private key used for mutual TLS authentication to iRMC.
+func loadClientCertificate(certFile string, keyFile string) (tls.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("could not load client certificate '%s' with key '%s': %w", certFile, keyFile, err)
+	}
+
+	return cert, nil
+}
+
+// newRedfishTlsConfig returns TLS configuration for connection to iRMC
+// based on provider settings and ssl_insecure of server block.
+func newRedfishTlsConfig(pconfig *IrmcProvider, insecure bool) *tls.Config {
+	config := &tls.Config{
+		InsecureSkipVerify: insecure, //nolint:gosec
+	}
+
+	if pconfig != nil {
+		config.RootCAs = pconfig.RootCAs
+		config.Certificates = pconfig.ClientCertificates
+	}
+
+	return config
+}
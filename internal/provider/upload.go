@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	// Minimal interval between two upload progress log entries.
+	UPLOAD_PROGRESS_INTERVAL = 10 * time.Second
+)
+
+// multipartFileReaderAt exposes multipart/form-data body with single file part as io.ReaderAt,
+// so the body can be streamed directly from file instead of being buffered in memory.
+type multipartFileReaderAt struct {
+	head []byte
+	file io.ReaderAt
+	size int64
+	tail []byte
+}
+
+func (m *multipartFileReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		headLen, fileEnd := int64(len(m.head)), int64(len(m.head))+m.size
+		var read int
+		var err error
+
+		switch {
+		case pos < headLen:
+			read = copy(p[n:], m.head[pos:])
+		case pos < fileEnd:
+			chunk := p[n:]
+			if int64(len(chunk)) > fileEnd-pos {
+				chunk = chunk[:fileEnd-pos]
+			}
+			read, err = m.file.ReadAt(chunk, pos-headLen)
+			if err == io.EOF && read == len(chunk) {
+				err = nil
+			}
+		case pos < fileEnd+int64(len(m.tail)):
+			read = copy(p[n:], m.tail[pos-fileEnd:])
+		default:
+			return n, io.EOF
+		}
+
+		n += read
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// newMultipartFileBody returns multipart/form-data body containing JSON encoded jsonParts (if any) followed by file
+// as part named field, together with its content type.
+func newMultipartFileBody(jsonParts map[string]interface{}, field string, file *os.File) (*io.SectionReader, string, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, "", fmt.Errorf("could not read size of file %s: %w", file.Name(), err)
+	}
+
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+
+	names := make([]string, 0, len(jsonParts))
+	for name := range jsonParts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		content, err := json.Marshal(jsonParts[name])
+		if err != nil {
+			return nil, "", fmt.Errorf("could not encode multipart field %s: %w", name, err)
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf("form-data; name=%q", name))
+		header.Set("Content-Type", "application/json")
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if _, err = part.Write(content); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if _, err = writer.CreateFormFile(field, filepath.Base(file.Name())); err != nil {
+		return nil, "", err
+	}
+
+	// File content is not written into buffer, so everything written so far becomes head of the body
+	// and closing boundary written by Close becomes its tail
+	head := bytes.Clone(buffer.Bytes())
+	buffer.Reset()
+	if err = writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	body := &multipartFileReaderAt{
+		head: head,
+		file: file,
+		size: info.Size(),
+		tail: bytes.Clone(buffer.Bytes()),
+	}
+
+	length := int64(len(body.head)) + body.size + int64(len(body.tail))
+	return io.NewSectionReader(body, 0, length), writer.FormDataContentType(), nil
+}
+
+// uploadProgressReader logs progress of request body transfer.
+type uploadProgressReader struct {
+	ctx     context.Context
+	body    io.ReadSeeker
+	total   int64
+	sent    int64
+	lastLog time.Time
+}
+
+func (u *uploadProgressReader) Read(p []byte) (int, error) {
+	n, err := u.body.Read(p)
+	u.sent += int64(n)
+
+	if time.Since(u.lastLog) >= UPLOAD_PROGRESS_INTERVAL || (err == io.EOF && u.sent == u.total) {
+		u.lastLog = time.Now()
+		tflog.Info(u.ctx, fmt.Sprintf("Uploaded %d of %d bytes (%d%%)", u.sent, u.total, uploadPercent(u.sent, u.total)))
+	}
+
+	return n, err
+}
+
+func (u *uploadProgressReader) Seek(offset int64, whence int) (int64, error) {
+	pos, err := u.body.Seek(offset, whence)
+	if err == nil {
+		u.sent = pos
+	}
+	return pos, err
+}
+
+func uploadPercent(sent int64, total int64) int64 {
+	if total <= 0 {
+		return 100
+	}
+	return sent * 100 / total
+}
+
+// uploadFileMultipart streams file to endpoint as multipart/form-data POST request with file part named field,
+// preceded by JSON encoded jsonParts. Progress is logged periodically, timeout (in seconds, 0 means no limit)
+// limits whole round trip of the request including waiting for response.
+func uploadFileMultipart(ctx context.Context, api *gofish.APIClient, endpoint string, jsonParts map[string]interface{},
+	field string, file *os.File, timeout int64) (*http.Response, error) {
+	body, contentType, err := newMultipartFileBody(jsonParts, field, file)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := &uploadProgressReader{
+		ctx:     ctx,
+		body:    body,
+		total:   body.Size(),
+		lastLog: time.Now(),
+	}
+
+	// Timeout applies only to the upload, so copy of the client with its own http.Client is used
+	uploadApi := *api
+	if timeout > 0 {
+		httpClient := *api.HTTPClient
+		httpClient.Timeout = time.Duration(timeout) * time.Second
+		uploadApi.HTTPClient = &httpClient
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Uploading %s (%d bytes) to %s", filepath.Base(file.Name()), body.Size(), endpoint))
+	res, err := uploadApi.RunRawRequestWithHeaders(http.MethodPost, endpoint, reader, contentType, map[string]string{
+		"Content-Length": fmt.Sprintf("%d", body.Size()),
+	})
+	if err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return nil, fmt.Errorf("upload of %s did not finish within %d seconds: %w", filepath.Base(file.Name()), timeout, err)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+)
+
+func TestUploadFileMultipart(t *testing.T) {
+	content := bytes.Repeat([]byte("firmware"), 100000)
+	path := filepath.Join(t.TempDir(), "firmware.bin")
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	var received []byte
+	var contentLength int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redfish/v1/":
+			fmt.Fprint(w, `{"@odata.id": "/redfish/v1/"}`)
+		case "/upload":
+			contentLength = r.ContentLength
+			file, header, err := r.FormFile("data")
+			if err != nil || header.Filename != "firmware.bin" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			received, _ = io.ReadAll(file)
+			w.Header().Set(HTTP_HEADER_LOCATION, "/redfish/v1/TaskService/Tasks/1")
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	api, err := gofish.Connect(gofish.ClientConfig{Endpoint: server.URL, BasicAuth: true})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer CloseResource(file)
+
+	res, err := uploadFileMultipart(context.Background(), api, "/upload", nil, "data", file, 60)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	CloseResource(res.Body)
+
+	if res.StatusCode != http.StatusAccepted {
+		t.Errorf("Got status %d, expected %d", res.StatusCode, http.StatusAccepted)
+	}
+	if contentLength <= int64(len(content)) {
+		t.Errorf("Got content length %d, expected more than file size %d", contentLength, len(content))
+	}
+	if !bytes.Equal(received, content) {
+		t.Errorf("Received file content differs from uploaded one (%d of %d bytes)", len(received), len(content))
+	}
+}
+
+func TestNewMultipartFileBody(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "update.bin")
+	if err := os.WriteFile(path, []byte("image"), 0o600); err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer CloseResource(file)
+
+	body, contentType, err := newMultipartFileBody(map[string]interface{}{
+		"UpdateParameters": map[string]interface{}{"Targets": []string{}},
+	}, "UpdateFile", file)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	reader := multipart.NewReader(body, params["boundary"])
+	expected := []struct{ name, contentType, content string }{
+		{"UpdateParameters", "application/json", `{"Targets":[]}`},
+		{"UpdateFile", "application/octet-stream", "image"},
+	}
+
+	for _, e := range expected {
+		part, err := reader.NextPart()
+		if err != nil {
+			t.Fatalf("Unexpected error %s", err.Error())
+		}
+
+		content, _ := io.ReadAll(part)
+		if part.FormName() != e.name || part.Header.Get("Content-Type") != e.contentType || string(content) != e.content {
+			t.Errorf("Got part %s (%s) with content '%s', expected %s (%s) with '%s'",
+				part.FormName(), part.Header.Get("Content-Type"), content, e.name, e.contentType, e.content)
+		}
+	}
+
+	if _, err := reader.NextPart(); err != io.EOF {
+		t.Errorf("Expected end of multipart body, got %v", err)
+	}
+}
+
+func TestUploadProgressReader(t *testing.T) {
+	t.Run("Seek", func(t *testing.T) {
+		reader := &uploadProgressReader{ctx: context.Background(), body: bytes.NewReader(make([]byte, 100)), total: 100}
+		if _, err := io.ReadAll(reader); err != nil {
+			t.Fatalf("Unexpected error %s", err.Error())
+		}
+		if reader.sent != 100 {
+			t.Errorf("Got %d bytes sent, expected 100", reader.sent)
+		}
+
+		if _, err := reader.Seek(0, io.SeekStart); err != nil || reader.sent != 0 {
+			t.Errorf("Sent bytes should be reset by seek, got %d", reader.sent)
+		}
+	})
+}
+
+func TestUploadFileMultipartTimeout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "update.bin")
+	if err := os.WriteFile(path, []byte("image"), 0o600); err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	// Server accepts whole body, but never answers
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redfish/v1/" {
+			fmt.Fprint(w, `{"@odata.id": "/redfish/v1/"}`)
+			return
+		}
+
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	api, err := gofish.Connect(gofish.ClientConfig{Endpoint: server.URL, BasicAuth: true})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	defer CloseResource(file)
+
+	start := time.Now()
+	if _, err := uploadFileMultipart(context.Background(), api, "/upload", nil, "data", file, 1); err == nil {
+		t.Fatalf("Expected upload timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Upload should be aborted after timeout, took %s", elapsed)
+	}
+	if api.HTTPClient.Timeout != 0 {
+		t.Errorf("Upload timeout must not be applied to shared client")
+	}
+}
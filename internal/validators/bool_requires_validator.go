@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RequiresEnabledValidator struct {
+	DependentFieldName string
+}
+
+func (v RequiresEnabledValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Ensures '%s' is not set to false if value is true.", v.DependentFieldName)
+}
+
+func (v RequiresEnabledValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Ensures **%s** is not set to false if value is true.", v.DependentFieldName)
+}
+
+func (v RequiresEnabledValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || !req.ConfigValue.ValueBool() {
+		return
+	}
+
+	var dependentFieldValue types.Bool
+	diags := req.Config.GetAttribute(ctx, path.Root(v.DependentFieldName), &dependentFieldValue)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Value not defined in configuration is left to default of the attribute
+	if dependentFieldValue.IsNull() || dependentFieldValue.IsUnknown() || dependentFieldValue.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Validation Error",
+		fmt.Sprintf("Field '%s' requires '%s' to be set to true.", req.Path.String(), v.DependentFieldName),
+	)
+}
+
+func RequiresEnabled(dependentFieldName string) validator.Bool {
+	return RequiresEnabledValidator{
+		DependentFieldName: dependentFieldName,
+	}
+}
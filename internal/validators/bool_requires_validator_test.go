@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresEnabledValidator(t *testing.T) {
+	configSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"keep_users":   schema.BoolAttribute{Optional: true},
+			"keep_network": schema.BoolAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"keep_users":   tftypes.Bool,
+		"keep_network": tftypes.Bool,
+	}}
+
+	tests := []struct {
+		name        string
+		keepUsers   interface{}
+		keepNetwork interface{}
+		valid       bool
+	}{
+		{name: "both enabled", keepUsers: true, keepNetwork: true, valid: true},
+		{name: "dependent field not set", keepUsers: true, keepNetwork: nil, valid: true},
+		{name: "dependent field disabled", keepUsers: true, keepNetwork: false, valid: false},
+		{name: "value disabled", keepUsers: false, keepNetwork: false, valid: true},
+		{name: "value not set", keepUsers: nil, keepNetwork: false, valid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: configSchema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"keep_users":   tftypes.NewValue(tftypes.Bool, test.keepUsers),
+					"keep_network": tftypes.NewValue(tftypes.Bool, test.keepNetwork),
+				}),
+			}
+
+			value := types.BoolNull()
+			if test.keepUsers != nil {
+				value = types.BoolValue(test.keepUsers.(bool))
+			}
+
+			req := validator.BoolRequest{Path: path.Root("keep_users"), ConfigValue: value, Config: config}
+			resp := &validator.BoolResponse{}
+
+			RequiresEnabled("keep_network").ValidateBool(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() == test.valid {
+				t.Errorf("Got valid %t, expected %t: %v", !resp.Diagnostics.HasError(), test.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
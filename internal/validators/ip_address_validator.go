@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type IPAddressValidator struct{}
+
+func (v IPAddressValidator) Description(ctx context.Context) string {
+	return "Ensures a value is IPv4 or IPv6 address."
+}
+
+func (v IPAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures a value is **IPv4** or **IPv6** address."
+}
+
+func (v IPAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if net.ParseIP(req.ConfigValue.ValueString()) == nil {
+		resp.Diagnostics.AddError(
+			"Validation Error",
+			fmt.Sprintf("Field '%s' must be IPv4 or IPv6 address, got '%s'", req.Path.String(), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+func IsIPAddress() validator.String {
+	return IPAddressValidator{}
+}
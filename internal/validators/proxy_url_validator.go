@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ParseProxyURL parses URL of proxy. User credentials may contain reserved characters (e.g. '/'),
+// which are not percent-encoded, so they are split off before parsing and encoded again.
+func ParseProxyURL(raw string) (*url.URL, error) {
+	scheme, rest, found := strings.Cut(raw, "://")
+	at := strings.LastIndex(rest, "@")
+	if !found || at < 0 {
+		return url.Parse(raw)
+	}
+
+	proxy, err := url.Parse(scheme + "://" + rest[at+1:])
+	if err != nil {
+		return nil, err
+	}
+
+	username, password, hasPassword := strings.Cut(rest[:at], ":")
+	if hasPassword {
+		proxy.User = url.UserPassword(unescapeUserinfo(username), unescapeUserinfo(password))
+	} else {
+		proxy.User = url.User(unescapeUserinfo(username))
+	}
+
+	return proxy, nil
+}
+
+// unescapeUserinfo decodes percent-encoded part of credentials, value which is not valid encoding is kept as is.
+func unescapeUserinfo(value string) string {
+	if unescaped, err := url.PathUnescape(value); err == nil {
+		return unescaped
+	}
+	return value
+}
+
+type ProxyURLValidator struct{}
+
+func (v ProxyURLValidator) Description(ctx context.Context) string {
+	return "Ensures a value is URL of proxy with http, https or socks5 scheme."
+}
+
+func (v ProxyURLValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures a value is URL of proxy with **http**, **https** or **socks5** scheme."
+}
+
+func (v ProxyURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Value is not included in the message, since it may contain credentials
+	proxy, err := ParseProxyURL(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Validation Error",
+			fmt.Sprintf("Field '%s' must be URL of proxy, it could not be parsed", req.Path.String()),
+		)
+		return
+	}
+
+	switch proxy.Scheme {
+	case "http", "https", "socks5":
+	default:
+		resp.Diagnostics.AddError(
+			"Validation Error",
+			fmt.Sprintf("Field '%s' must be URL of proxy with http, https or socks5 scheme, got scheme '%s'", req.Path.String(), proxy.Scheme),
+		)
+		return
+	}
+
+	if len(proxy.Host) == 0 {
+		resp.Diagnostics.AddError(
+			"Validation Error",
+			fmt.Sprintf("Field '%s' must be URL of proxy including host", req.Path.String()),
+		)
+	}
+}
+
+func IsProxyURL() validator.String {
+	return ProxyURLValidator{}
+}
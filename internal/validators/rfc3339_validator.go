@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type RFC3339Validator struct{}
+
+func (v RFC3339Validator) Description(ctx context.Context) string {
+	return "Ensures a value is date and time in RFC 3339 format."
+}
+
+func (v RFC3339Validator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures a value is date and time in **RFC 3339** format."
+}
+
+func (v RFC3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Validation Error",
+			fmt.Sprintf("Field '%s' must be date and time in RFC 3339 format: %s", req.Path.String(), err.Error()),
+		)
+	}
+}
+
+func IsRFC3339() validator.String {
+	return RFC3339Validator{}
+}
@@ -60,6 +60,7 @@ func main() {
 	}
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	provider.Shutdown()
 
 	if err != nil {
 		log.Fatal(err.Error())